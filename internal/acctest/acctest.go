@@ -99,7 +99,7 @@ var Provider *schema.Provider
 var testAccProviderConfigure sync.Once
 
 func init() {
-	Provider = provider.Provider()
+	Provider = emulatorProvider(provider.Provider())
 
 	Providers = map[string]*schema.Provider{
 		ProviderName: Provider,
@@ -108,7 +108,7 @@ func init() {
 	// Always allocate a new provider instance each invocation, otherwise gRPC
 	// ProviderConfigure() can overwrite configuration during concurrent testing.
 	ProviderFactories = map[string]func() (*schema.Provider, error){
-		ProviderName: func() (*schema.Provider, error) { return provider.Provider(), nil }, //nolint:unparam
+		ProviderName: func() (*schema.Provider, error) { return emulatorProvider(provider.Provider()), nil }, //nolint:unparam
 	}
 }
 
@@ -117,7 +117,7 @@ func factoriesInit(providers *[]*schema.Provider, providerNames []string) map[st
 	var factories = make(map[string]func() (*schema.Provider, error), len(providerNames))
 
 	for _, name := range providerNames {
-		p := provider.Provider()
+		p := emulatorProvider(provider.Provider())
 
 		factories[name] = func() (*schema.Provider, error) { //nolint:unparam
 			return p, nil
@@ -189,7 +189,14 @@ func FactoriesMultipleRegion(providers *[]*schema.Provider, regions int) map[str
 //
 // These verifications and configuration are preferred at this level to prevent
 // provider developers from experiencing less clear errors for every test.
+//
+// When running against a local AWS API emulator, tests not registered with
+// RegisterEmulatorCompatibleTests are skipped.
 func PreCheck(t *testing.T) {
+	if IsEmulator() {
+		preCheckEmulator(t)
+	}
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
@@ -610,6 +617,11 @@ func PreCheckAlternateAccount(t *testing.T) {
 }
 
 func PreCheckPartitionHasService(serviceId string, t *testing.T) {
+	// Emulators do not model partitions. Unsupported services are caught by ErrorCheck.
+	if IsEmulator() {
+		return
+	}
+
 	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), Region()); ok {
 		if _, ok := partition.Services()[serviceId]; !ok {
 			t.Skipf("skipping tests; partition %s does not support %s service", partition.ID(), serviceId)
@@ -1057,6 +1069,14 @@ func ErrorCheck(t *testing.T, endpointIDs ...string) resource.ErrorCheckFunc {
 			}
 		}
 
+		if err != nil && IsEmulator() {
+			err = errorCheckEmulator(t, err, endpointIDs...)
+
+			if err == nil {
+				return nil
+			}
+		}
+
 		if errorCheckCommon(err) {
 			t.Skipf("skipping test for %s/%s: %s", Partition(), Region(), err.Error())
 		}
@@ -1183,6 +1203,26 @@ provider "aws" {
 `, tag1, value1, tag2, value2))
 }
 
+// ConfigTagsValue returns an HCL map expression of the specified tag key and value pairs,
+// for use as the value of a resource's tags argument. Returns "null" if there are no pairs.
+func ConfigTagsValue(keyValues ...string) string {
+	if len(keyValues) == 0 {
+		return "null"
+	}
+
+	var b strings.Builder
+
+	b.WriteString("{\n")
+
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		fmt.Fprintf(&b, "    %q = %q\n", keyValues[i], keyValues[i+1])
+	}
+
+	b.WriteString("  }")
+
+	return b.String()
+}
+
 func PreCheckAssumeRoleARN(t *testing.T) {
 	conns.SkipIfEnvVarEmpty(t, conns.EnvVarAccAssumeRoleARN, "Amazon Resource Name (ARN) of existing IAM Role to assume for testing restricted permissions")
 }
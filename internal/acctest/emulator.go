@@ -0,0 +1,214 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Account ID reported by LocalStack. moto server reports 123456789012.
+const defaultEmulatorAccountID = "000000000000"
+
+// Static credentials used against an emulator when none are configured.
+const emulatorCredentialsValue = "test"
+
+// EmulatorEndpoint returns the base URL of the local AWS API emulator
+// acceptance tests are run against, or "" if tests are run against AWS.
+func EmulatorEndpoint() string {
+	return os.Getenv(conns.EnvVarAccEmulatorEndpoint)
+}
+
+// IsEmulator returns whether acceptance tests are run against a local AWS API emulator.
+func IsEmulator() bool {
+	return EmulatorEndpoint() != ""
+}
+
+// EmulatorAccountID returns the AWS account ID reported by the local AWS API emulator.
+func EmulatorAccountID() string {
+	return conns.GetEnvVarWithDefault(conns.EnvVarAccEmulatorAccountID, defaultEmulatorAccountID)
+}
+
+var emulatorCompatibleTests map[string]struct{}
+
+// RegisterEmulatorCompatibleTests marks acceptance tests as passing against a local AWS API emulator.
+// Tests are identified by name, e.g. "TestAccSQSQueue_basic", or by the full name of a subtest.
+// When running against an emulator, PreCheck skips all other tests.
+func RegisterEmulatorCompatibleTests(testNames ...string) {
+	if emulatorCompatibleTests == nil {
+		emulatorCompatibleTests = make(map[string]struct{})
+	}
+
+	for _, name := range testNames {
+		emulatorCompatibleTests[name] = struct{}{}
+	}
+}
+
+// isEmulatorCompatibleTest returns whether the named test, or any test it is a subtest of,
+// is registered as emulator compatible.
+func isEmulatorCompatibleTest(name string) bool {
+	parts := strings.Split(name, "/")
+
+	for i := range parts {
+		if _, ok := emulatorCompatibleTests[strings.Join(parts[:i+1], "/")]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// preCheckEmulator skips tests not registered as emulator compatible and
+// defaults static credentials, as emulators accept any.
+func preCheckEmulator(t *testing.T) {
+	if !isEmulatorCompatibleTest(t.Name()) {
+		t.Skipf("skipping test; %s is not registered as compatible with the local AWS API emulator (%s)", t.Name(), EmulatorEndpoint())
+	}
+
+	if os.Getenv(conns.EnvVarProfile) == "" && os.Getenv(conns.EnvVarAccessKeyId) == "" {
+		os.Setenv(conns.EnvVarAccessKeyId, emulatorCredentialsValue)
+		os.Setenv(conns.EnvVarSecretAccessKey, emulatorCredentialsValue)
+	}
+}
+
+// emulatorProvider returns the specified provider, configured to send all
+// requests to the local AWS API emulator when running against one.
+func emulatorProvider(p *schema.Provider) *schema.Provider {
+	if !IsEmulator() {
+		return p
+	}
+
+	configure := p.ConfigureContextFunc
+
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if err := emulatorConfigure(d, EmulatorEndpoint()); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		meta, diags := configure(ctx, d)
+
+		// The account ID is not requested from the emulator's STS endpoint.
+		if client, ok := meta.(*conns.AWSClient); ok && client.AccountID == "" {
+			client.AccountID = EmulatorAccountID()
+		}
+
+		return meta, diags
+	}
+
+	return p
+}
+
+// emulatorConfigure overrides the provider configuration to send all
+// requests to the specified endpoint and skip AWS-only validation.
+func emulatorConfigure(d *schema.ResourceData, endpoint string) error {
+	endpoints := make(map[string]interface{})
+
+	for _, alias := range names.Aliases() {
+		endpoints[alias] = endpoint
+	}
+
+	if err := d.Set("endpoints", []interface{}{endpoints}); err != nil {
+		return fmt.Errorf("setting endpoints: %w", err)
+	}
+
+	for _, key := range []string{
+		"s3_use_path_style",
+		"skip_credentials_validation",
+		"skip_get_ec2_platforms",
+		"skip_region_validation",
+		"skip_requesting_account_id",
+	} {
+		if err := d.Set(key, true); err != nil {
+			return fmt.Errorf("setting %s: %w", key, err)
+		}
+	}
+
+	if err := d.Set("skip_metadata_api_check", "true"); err != nil {
+		return fmt.Errorf("setting skip_metadata_api_check: %w", err)
+	}
+
+	return nil
+}
+
+var serviceEmulatorErrorCheckFuncs map[string]ServiceErrorCheckFunc
+
+// RegisterServiceEmulatorErrorCheckFunc registers a service-specific ErrorCheck
+// that is only applied when running against a local AWS API emulator.
+// Use it to skip tests on known gaps in an emulator's implementation of the service.
+func RegisterServiceEmulatorErrorCheckFunc(endpointID string, f ServiceErrorCheckFunc) {
+	if serviceEmulatorErrorCheckFuncs == nil {
+		serviceEmulatorErrorCheckFuncs = make(map[string]ServiceErrorCheckFunc)
+	}
+
+	if _, ok := serviceEmulatorErrorCheckFuncs[endpointID]; ok {
+		// already registered
+		panic(fmt.Sprintf("Cannot re-register a service! emulator ServiceErrorCheckFunc exists for %s", endpointID)) //lintignore:R009
+	}
+
+	serviceEmulatorErrorCheckFuncs[endpointID] = f
+}
+
+// errorCheckEmulator skips tests on errors an emulator returns for unimplemented functionality.
+func errorCheckEmulator(t *testing.T, err error, endpointIDs ...string) error {
+	for _, endpointID := range endpointIDs {
+		if f, ok := serviceEmulatorErrorCheckFuncs[endpointID]; ok {
+			err = f(t)(err)
+		}
+
+		if err == nil {
+			return nil
+		}
+	}
+
+	if errorCheckEmulatorCommon(err) {
+		t.Skipf("skipping test on local AWS API emulator (%s): %s", EmulatorEndpoint(), err.Error())
+	}
+
+	return err
+}
+
+// NOTE: This function cannot use the standard tfawserr helpers
+// as it is receiving error strings from the SDK testing framework,
+// not actual error types from the resource logic.
+func errorCheckEmulatorCommon(err error) bool {
+	// LocalStack: "API for service 'x' not yet implemented or pro feature".
+	if strings.Contains(err.Error(), "not yet implemented") {
+		return true
+	}
+
+	// moto server.
+	if strings.Contains(err.Error(), "has not been implemented") {
+		return true
+	}
+
+	if strings.Contains(err.Error(), "NotImplemented") {
+		return true
+	}
+
+	return false
+}
+
+// ErrorCheckSkipEmulatorMessagesContaining is ErrorCheckSkipMessagesContaining for known local AWS API emulator gaps.
+func ErrorCheckSkipEmulatorMessagesContaining(t *testing.T, messages ...string) resource.ErrorCheckFunc {
+	return func(err error) error {
+		if err == nil {
+			return nil
+		}
+
+		for _, message := range messages {
+			errorMessage := err.Error()
+			if strings.Contains(errorMessage, message) {
+				t.Skipf("skipping test on local AWS API emulator (%s): %s", EmulatorEndpoint(), errorMessage)
+			}
+		}
+
+		return err
+	}
+}
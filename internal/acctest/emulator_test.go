@@ -0,0 +1,132 @@
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+func TestIsEmulatorCompatibleTest(t *testing.T) {
+	RegisterEmulatorCompatibleTests(
+		"TestAccExampleThing_basic",
+		"TestAccExampleThing_serial/Thing/basic",
+	)
+
+	testCases := []struct {
+		name     string
+		expected bool
+	}{
+		{
+			name:     "TestAccExampleThing_basic",
+			expected: true,
+		},
+		{
+			name:     "TestAccExampleThing_basic/subtest",
+			expected: true,
+		},
+		{
+			name:     "TestAccExampleThing_disappears",
+			expected: false,
+		},
+		{
+			name:     "TestAccExampleThing_serial/Thing/basic",
+			expected: true,
+		},
+		{
+			name:     "TestAccExampleThing_serial/Thing/disappears",
+			expected: false,
+		},
+		{
+			name:     "TestAccExampleThing",
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := isEmulatorCompatibleTest(testCase.name); got != testCase.expected {
+			t.Errorf("isEmulatorCompatibleTest(%q) = %t, want %t", testCase.name, got, testCase.expected)
+		}
+	}
+}
+
+func TestEmulatorProvider(t *testing.T) {
+	endpoint := "http://localhost:4566"
+
+	t.Setenv(conns.EnvVarAccEmulatorEndpoint, endpoint)
+	t.Setenv(conns.EnvVarAccEmulatorAccountID, "")
+
+	var d *schema.ResourceData
+	p := provider.Provider()
+	p.ConfigureContextFunc = func(_ context.Context, v *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		d = v
+		return &conns.AWSClient{}, nil
+	}
+
+	p = emulatorProvider(p)
+
+	if err := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := p.Meta().(*conns.AWSClient).AccountID, defaultEmulatorAccountID; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+
+	for _, key := range []string{
+		"s3_use_path_style",
+		"skip_credentials_validation",
+		"skip_get_ec2_platforms",
+		"skip_region_validation",
+		"skip_requesting_account_id",
+	} {
+		if !d.Get(key).(bool) {
+			t.Errorf("%s = false, want true", key)
+		}
+	}
+
+	endpoints := d.Get("endpoints").(*schema.Set).List()
+
+	if got, want := len(endpoints), 1; got != want {
+		t.Fatalf("len(endpoints) = %d, want %d", got, want)
+	}
+
+	for _, alias := range []string{"dynamodb", "iam", "s3", "sqs", "sts"} {
+		if got := endpoints[0].(map[string]interface{})[alias]; got != endpoint {
+			t.Errorf("endpoints.%s = %v, want %q", alias, got, endpoint)
+		}
+	}
+}
+
+func TestEmulatorProvider_disabled(t *testing.T) {
+	t.Setenv(conns.EnvVarAccEmulatorEndpoint, "")
+
+	var d *schema.ResourceData
+	p := provider.Provider()
+	p.ConfigureContextFunc = func(_ context.Context, v *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		d = v
+		return &conns.AWSClient{}, nil
+	}
+
+	p = emulatorProvider(p)
+
+	if err := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := p.Meta().(*conns.AWSClient).AccountID; got != "" {
+		t.Errorf("AccountID = %q, want empty", got)
+	}
+
+	if d.Get("skip_region_validation").(bool) {
+		t.Error("skip_region_validation = true, want false")
+	}
+
+	if got := d.Get("endpoints").(*schema.Set).Len(); got != 0 {
+		t.Errorf("len(endpoints) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,158 @@
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// FakeClient returns a provider client with in-memory fakes injected for the
+// specified services (e.g. names.SQS). The fakes are returned by the client's
+// interface-typed accessors (e.g. SQSAPI). No credentials are required and
+// resources that use the accessors make no network calls to the faked services.
+func FakeClient(t *testing.T, fakes map[string]interface{}) *conns.AWSClient {
+	t.Helper()
+
+	config := conns.Config{
+		AccessKey:               "fake",
+		Region:                  fake.Region,
+		SecretKey:               "fake",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipRegionValidation:    true,
+		SkipRequestingAccountId: true,
+		SuppressDebugLog:        true,
+		TerraformVersion:        "0.0.0-fake",
+	}
+
+	raw, diags := config.Client(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("error configuring fake AWS client: %v", diags)
+	}
+
+	client := raw.(*conns.AWSClient)
+	client.AccountID = fake.AccountID
+	client.Partition = fake.Partition
+
+	for service, f := range fakes {
+		if err := client.InjectFake(service, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return client
+}
+
+// FakeResourceStep is a single configuration applied by FakeResourceTest.
+type FakeResourceStep struct {
+	// Config is the raw resource configuration, as it would be decoded from HCL.
+	Config map[string]interface{}
+
+	// Check is called with the refreshed resource data after the step is applied.
+	Check func(*schema.ResourceData) error
+}
+
+// FakeResourceTest drives a resource through its CRUD lifecycle without Terraform core.
+// Each step is planned, applied and refreshed, and must then plan empty.
+// The resource is destroyed after the last step.
+func FakeResourceTest(t *testing.T, r *schema.Resource, meta interface{}, steps ...FakeResourceStep) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var state *terraform.InstanceState
+
+	for i, step := range steps {
+		config := terraform.NewResourceConfigRaw(step.Config)
+
+		if diags := r.Validate(config); diags.HasError() {
+			t.Fatalf("step %d: validating configuration: %v", i+1, diags)
+		}
+
+		diff, err := r.Diff(ctx, state, config, meta)
+
+		if err != nil {
+			t.Fatalf("step %d: planning: %s", i+1, err)
+		}
+
+		if diff != nil && !diff.Empty() {
+			newState, diags := r.Apply(ctx, state, diff, meta)
+
+			if diags.HasError() {
+				t.Fatalf("step %d: applying: %v", i+1, diags)
+			}
+
+			state = newState
+		}
+
+		if state == nil || state.ID == "" {
+			t.Fatalf("step %d: resource was not created", i+1)
+		}
+
+		newState, diags := r.RefreshWithoutUpgrade(ctx, state, meta)
+
+		if diags.HasError() {
+			t.Fatalf("step %d: refreshing: %v", i+1, diags)
+		}
+
+		if newState == nil {
+			t.Fatalf("step %d: resource disappeared after apply", i+1)
+		}
+
+		state = newState
+
+		diff, err = r.Diff(ctx, state, config, meta)
+
+		if err != nil {
+			t.Fatalf("step %d: planning after apply: %s", i+1, err)
+		}
+
+		if diff != nil && !diff.Empty() {
+			t.Fatalf("step %d: plan not empty after apply: %s", i+1, diff.GoString())
+		}
+
+		if step.Check != nil {
+			if err := step.Check(r.Data(state)); err != nil {
+				t.Fatalf("step %d: check: %s", i+1, err)
+			}
+		}
+	}
+
+	if state == nil {
+		return
+	}
+
+	if _, diags := r.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, meta); diags.HasError() {
+		t.Fatalf("destroying: %v", diags)
+	}
+}
+
+// FakeDataSourceRead reads a data source with the specified raw configuration
+// and returns the resulting data.
+func FakeDataSourceRead(t *testing.T, r *schema.Resource, meta interface{}, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+
+	switch {
+	case r.ReadWithoutTimeout != nil:
+		if diags := r.ReadWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+			t.Fatalf("reading data source: %v", diags)
+		}
+	case r.ReadContext != nil:
+		if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+			t.Fatalf("reading data source: %v", diags)
+		}
+	default:
+		if err := r.Read(d, meta); err != nil { //nolint:staticcheck // Data sources may still implement Read.
+			t.Fatalf("reading data source: %s", err)
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,286 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+)
+
+// DynamoDB is an in-memory fake of Amazon DynamoDB tables and items.
+// Only the legacy (non-expression) conditional and update parameters are supported.
+type DynamoDB struct {
+	// Operations the fake does not implement panic.
+	dynamodbiface.DynamoDBAPI
+
+	lock   sync.Mutex
+	tables map[string]*dynamoDBTable // keyed by table name
+}
+
+type dynamoDBTable struct {
+	description *dynamodb.TableDescription
+	items       map[string]map[string]*dynamodb.AttributeValue // keyed by serialized primary key
+}
+
+// NewDynamoDB returns an empty DynamoDB fake.
+func NewDynamoDB() *DynamoDB {
+	return &DynamoDB{
+		tables: make(map[string]*dynamoDBTable),
+	}
+}
+
+func (f *DynamoDB) findTable(name *string) (*dynamoDBTable, error) {
+	table, ok := f.tables[aws.StringValue(name)]
+
+	if !ok {
+		return nil, newBadRequestError(dynamodb.ErrCodeResourceNotFoundException, "Requested resource not found: Table: %s not found", aws.StringValue(name))
+	}
+
+	return table, nil
+}
+
+// primaryKey returns the serialized primary key of the specified item or key.
+func (t *dynamoDBTable) primaryKey(item map[string]*dynamodb.AttributeValue) (string, error) {
+	var values []*dynamodb.AttributeValue
+
+	for _, element := range t.description.KeySchema {
+		name := aws.StringValue(element.AttributeName)
+		v, ok := item[name]
+
+		if !ok {
+			return "", newBadRequestError("ValidationException", "One of the required keys was not given a value: %s", name)
+		}
+
+		values = append(values, v)
+	}
+
+	b, err := json.Marshal(values)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func (t *dynamoDBTable) checkExpected(current map[string]*dynamodb.AttributeValue, expected map[string]*dynamodb.ExpectedAttributeValue) error {
+	for name, e := range expected {
+		v, exists := current[name]
+
+		if e.Exists != nil && !aws.BoolValue(e.Exists) && exists {
+			return newBadRequestError(dynamodb.ErrCodeConditionalCheckFailedException, "The conditional request failed")
+		}
+
+		if e.Value != nil && (!exists || !reflect.DeepEqual(v, e.Value)) {
+			return newBadRequestError(dynamodb.ErrCodeConditionalCheckFailedException, "The conditional request failed")
+		}
+	}
+
+	return nil
+}
+
+func (f *DynamoDB) CreateTable(input *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	name := aws.StringValue(input.TableName)
+
+	if _, ok := f.tables[name]; ok {
+		return nil, newBadRequestError(dynamodb.ErrCodeResourceInUseException, "Table already exists: %s", name)
+	}
+
+	table := &dynamoDBTable{
+		description: &dynamodb.TableDescription{
+			AttributeDefinitions: input.AttributeDefinitions,
+			BillingModeSummary: &dynamodb.BillingModeSummary{
+				BillingMode: input.BillingMode,
+			},
+			CreationDateTime: aws.Time(time.Now()),
+			KeySchema:        input.KeySchema,
+			TableArn:         aws.String(fmt.Sprintf("arn:%s:dynamodb:%s:%s:table/%s", Partition, Region, AccountID, name)),
+			TableId:          aws.String(uniqueID("")),
+			TableName:        aws.String(name),
+			TableStatus:      aws.String(dynamodb.TableStatusActive),
+		},
+		items: make(map[string]map[string]*dynamodb.AttributeValue),
+	}
+
+	f.tables[name] = table
+
+	return &dynamodb.CreateTableOutput{TableDescription: table.description}, nil
+}
+
+func (f *DynamoDB) DeleteItem(input *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	table, err := f.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.primaryKey(input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := table.checkExpected(table.items[key], input.Expected); err != nil {
+		return nil, err
+	}
+
+	delete(table.items, key)
+
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func (f *DynamoDB) DeleteTable(input *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	table, err := f.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(f.tables, aws.StringValue(input.TableName))
+
+	return &dynamodb.DeleteTableOutput{TableDescription: table.description}, nil
+}
+
+func (f *DynamoDB) DescribeTable(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	table, err := f.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	description := *table.description
+	description.ItemCount = aws.Int64(int64(len(table.items)))
+
+	return &dynamodb.DescribeTableOutput{Table: &description}, nil
+}
+
+func (f *DynamoDB) GetItem(input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	table, err := f.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.primaryKey(input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item, ok := table.items[key]
+
+	if !ok {
+		return &dynamodb.GetItemOutput{}, nil
+	}
+
+	output := make(map[string]*dynamodb.AttributeValue, len(item))
+
+	for k, v := range item {
+		output[k] = v
+	}
+
+	return &dynamodb.GetItemOutput{Item: output}, nil
+}
+
+func (f *DynamoDB) PutItem(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	table, err := f.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.primaryKey(input.Item)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := table.checkExpected(table.items[key], input.Expected); err != nil {
+		return nil, err
+	}
+
+	item := make(map[string]*dynamodb.AttributeValue, len(input.Item))
+
+	for k, v := range input.Item {
+		item[k] = v
+	}
+
+	table.items[key] = item
+
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (f *DynamoDB) UpdateItem(input *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if input.UpdateExpression != nil {
+		return nil, newBadRequestError("ValidationException", "UpdateExpression is not supported by %T", f)
+	}
+
+	table, err := f.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.primaryKey(input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	current := table.items[key]
+
+	if err := table.checkExpected(current, input.Expected); err != nil {
+		return nil, err
+	}
+
+	item := make(map[string]*dynamodb.AttributeValue)
+
+	for k, v := range current {
+		item[k] = v
+	}
+
+	for k, v := range input.Key {
+		item[k] = v
+	}
+
+	for k, update := range input.AttributeUpdates {
+		switch aws.StringValue(update.Action) {
+		case dynamodb.AttributeActionDelete:
+			delete(item, k)
+		case dynamodb.AttributeActionPut, "":
+			item[k] = update.Value
+		default:
+			return nil, newBadRequestError("ValidationException", "AttributeUpdates action %s is not supported by %T", aws.StringValue(update.Action), f)
+		}
+	}
+
+	table.items[key] = item
+
+	return &dynamodb.UpdateItemOutput{}, nil
+}
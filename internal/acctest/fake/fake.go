@@ -0,0 +1,92 @@
+// Package fake contains in-memory fakes of a handful of core AWS services.
+//
+// Each fake embeds its service's AWS SDK for Go v1 API interface (e.g.
+// sqsiface.SQSAPI), implements the subset of it used by the provider's core
+// resources for that service and is injected into a provider client with
+// acctest.FakeClient. Resources reach the fake through the client's
+// interface-typed accessor (e.g. SQSAPI). Calling an operation the fake does
+// not implement panics. Fakes hold no state beyond the lifetime of the value
+// and are safe for concurrent use.
+package fake
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// AccountID is the AWS account ID of all fake resources.
+	AccountID = "123456789012"
+
+	// Partition is the AWS partition of all fake resources.
+	Partition = "aws"
+
+	// Region is the AWS Region of all fake resources.
+	Region = "us-west-2"
+)
+
+var sequence uint64
+
+// uniqueID returns a new identifier with the specified prefix, e.g. "AGPA0000000000000001".
+func uniqueID(prefix string) string {
+	return fmt.Sprintf("%s%016d", prefix, atomic.AddUint64(&sequence, 1))
+}
+
+// newError returns an AWS error with the specified code and HTTP status code.
+func newError(code string, statusCode int, format string, a ...interface{}) error {
+	return awserr.NewRequestFailure(awserr.New(code, fmt.Sprintf(format, a...), nil), statusCode, "")
+}
+
+func newNotFoundError(code string, format string, a ...interface{}) error {
+	return newError(code, http.StatusNotFound, format, a...)
+}
+
+func newBadRequestError(code string, format string, a ...interface{}) error {
+	return newError(code, http.StatusBadRequest, format, a...)
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	c := make(map[string]string, len(m))
+
+	for k, v := range m {
+		c[k] = v
+	}
+
+	return c
+}
+
+// newRequest returns a request for the named operation that calls send instead of AWS when sent.
+// It backs the request-form methods (e.g. PutObjectRequest) used by AWS SDK for Go v1 utilities such as s3manager.
+func newRequest(endpoint, operation, path string, params, data interface{}, send func() error) *request.Request {
+	r := request.New(aws.Config{}, metadata.ClientInfo{Endpoint: endpoint}, request.Handlers{}, nil, &request.Operation{
+		Name:       operation,
+		HTTPMethod: http.MethodPost,
+		HTTPPath:   path,
+	}, params, data)
+
+	r.Handlers.Send.PushBack(func(r *request.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		}
+
+		if err := send(); err != nil {
+			r.Error = err
+
+			if v, ok := err.(awserr.RequestFailure); ok {
+				r.HTTPResponse.StatusCode = v.StatusCode()
+			}
+		}
+	})
+
+	return r
+}
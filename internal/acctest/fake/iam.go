@@ -0,0 +1,281 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+)
+
+// IAM is an in-memory fake of AWS IAM groups, roles and role managed policy attachments.
+type IAM struct {
+	// Operations the fake does not implement panic.
+	iamiface.IAMAPI
+
+	lock   sync.Mutex
+	groups map[string]*iam.Group // keyed by group name
+	roles  map[string]*iamRole   // keyed by role name
+}
+
+type iamRole struct {
+	attachedPolicyARNs []string
+	role               *iam.Role
+}
+
+// NewIAM returns an empty IAM fake.
+func NewIAM() *IAM {
+	return &IAM{
+		groups: make(map[string]*iam.Group),
+		roles:  make(map[string]*iamRole),
+	}
+}
+
+func (f *IAM) findGroup(name *string) (*iam.Group, error) {
+	group, ok := f.groups[aws.StringValue(name)]
+
+	if !ok {
+		return nil, newNotFoundError(iam.ErrCodeNoSuchEntityException, "The group with name %s cannot be found.", aws.StringValue(name))
+	}
+
+	return group, nil
+}
+
+func (f *IAM) findRole(name *string) (*iamRole, error) {
+	role, ok := f.roles[aws.StringValue(name)]
+
+	if !ok {
+		return nil, newNotFoundError(iam.ErrCodeNoSuchEntityException, "The role with name %s cannot be found.", aws.StringValue(name))
+	}
+
+	return role, nil
+}
+
+func iamPath(path *string) string {
+	if path == nil {
+		return "/"
+	}
+
+	return aws.StringValue(path)
+}
+
+func (f *IAM) AttachRolePolicy(input *iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	role, err := f.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arn := aws.StringValue(input.PolicyArn)
+
+	for _, v := range role.attachedPolicyARNs {
+		if v == arn {
+			return &iam.AttachRolePolicyOutput{}, nil
+		}
+	}
+
+	role.attachedPolicyARNs = append(role.attachedPolicyARNs, arn)
+
+	return &iam.AttachRolePolicyOutput{}, nil
+}
+
+func (f *IAM) CreateGroup(input *iam.CreateGroupInput) (*iam.CreateGroupOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	name := aws.StringValue(input.GroupName)
+
+	if _, ok := f.groups[name]; ok {
+		return nil, newError(iam.ErrCodeEntityAlreadyExistsException, http.StatusConflict, "Group with name %s already exists.", name)
+	}
+
+	path := iamPath(input.Path)
+	group := &iam.Group{
+		Arn:        aws.String(fmt.Sprintf("arn:%s:iam::%s:group%s%s", Partition, AccountID, path, name)),
+		CreateDate: aws.Time(time.Now()),
+		GroupId:    aws.String(uniqueID("AGPA")),
+		GroupName:  aws.String(name),
+		Path:       aws.String(path),
+	}
+
+	f.groups[name] = group
+
+	return &iam.CreateGroupOutput{Group: group}, nil
+}
+
+func (f *IAM) CreateRole(input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	name := aws.StringValue(input.RoleName)
+
+	if _, ok := f.roles[name]; ok {
+		return nil, newError(iam.ErrCodeEntityAlreadyExistsException, http.StatusConflict, "Role with name %s already exists.", name)
+	}
+
+	path := iamPath(input.Path)
+	role := &iam.Role{
+		Arn:                      aws.String(fmt.Sprintf("arn:%s:iam::%s:role%s%s", Partition, AccountID, path, name)),
+		AssumeRolePolicyDocument: input.AssumeRolePolicyDocument,
+		CreateDate:               aws.Time(time.Now()),
+		Description:              input.Description,
+		MaxSessionDuration:       aws.Int64(3600),
+		Path:                     aws.String(path),
+		RoleId:                   aws.String(uniqueID("AROA")),
+		RoleName:                 aws.String(name),
+		Tags:                     input.Tags,
+	}
+
+	if input.MaxSessionDuration != nil {
+		role.MaxSessionDuration = input.MaxSessionDuration
+	}
+
+	f.roles[name] = &iamRole{role: role}
+
+	return &iam.CreateRoleOutput{Role: role}, nil
+}
+
+func (f *IAM) DeleteGroup(input *iam.DeleteGroupInput) (*iam.DeleteGroupOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, err := f.findGroup(input.GroupName); err != nil {
+		return nil, err
+	}
+
+	delete(f.groups, aws.StringValue(input.GroupName))
+
+	return &iam.DeleteGroupOutput{}, nil
+}
+
+func (f *IAM) DeleteRole(input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	role, err := f.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(role.attachedPolicyARNs) > 0 {
+		return nil, newError(iam.ErrCodeDeleteConflictException, http.StatusConflict, "Cannot delete entity, must detach all policies first.")
+	}
+
+	delete(f.roles, aws.StringValue(input.RoleName))
+
+	return &iam.DeleteRoleOutput{}, nil
+}
+
+func (f *IAM) DetachRolePolicy(input *iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	role, err := f.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arn := aws.StringValue(input.PolicyArn)
+
+	for i, v := range role.attachedPolicyARNs {
+		if v == arn {
+			role.attachedPolicyARNs = append(role.attachedPolicyARNs[:i], role.attachedPolicyARNs[i+1:]...)
+
+			return &iam.DetachRolePolicyOutput{}, nil
+		}
+	}
+
+	return nil, newNotFoundError(iam.ErrCodeNoSuchEntityException, "Policy %s was not found.", arn)
+}
+
+func (f *IAM) GetGroup(input *iam.GetGroupInput) (*iam.GetGroupOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	group, err := f.findGroup(input.GroupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.GetGroupOutput{Group: group, Users: []*iam.User{}}, nil
+}
+
+func (f *IAM) GetRole(input *iam.GetRoleInput) (*iam.GetRoleOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	role, err := f.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.GetRoleOutput{Role: role.role}, nil
+}
+
+func (f *IAM) ListAttachedRolePolicies(input *iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	role, err := f.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &iam.ListAttachedRolePoliciesOutput{}
+
+	for _, arn := range role.attachedPolicyARNs {
+		output.AttachedPolicies = append(output.AttachedPolicies, &iam.AttachedPolicy{
+			PolicyArn: aws.String(arn),
+		})
+	}
+
+	return output, nil
+}
+
+func (f *IAM) UpdateGroup(input *iam.UpdateGroupInput) (*iam.UpdateGroupOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	group, err := f.findGroup(input.GroupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := aws.StringValue(group.GroupName)
+	path := aws.StringValue(group.Path)
+
+	if input.NewGroupName != nil {
+		name = aws.StringValue(input.NewGroupName)
+	}
+
+	if input.NewPath != nil {
+		path = aws.StringValue(input.NewPath)
+	}
+
+	if _, ok := f.groups[name]; ok && name != aws.StringValue(group.GroupName) {
+		return nil, newError(iam.ErrCodeEntityAlreadyExistsException, http.StatusConflict, "Group with name %s already exists.", name)
+	}
+
+	delete(f.groups, aws.StringValue(group.GroupName))
+
+	updated := *group
+	updated.Arn = aws.String(fmt.Sprintf("arn:%s:iam::%s:group%s%s", Partition, AccountID, path, name))
+	updated.GroupName = aws.String(name)
+	updated.Path = aws.String(path)
+
+	f.groups[name] = &updated
+
+	return &iam.UpdateGroupOutput{}, nil
+}
@@ -0,0 +1,871 @@
+package fake
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// s3MinPartSize is the minimum size of all but the last part of a multipart upload.
+const s3MinPartSize = 5 * 1024 * 1024
+
+// S3 is an in-memory fake of Amazon S3 buckets, (unversioned) objects and multipart uploads.
+type S3 struct {
+	// Operations the fake does not implement panic.
+	s3iface.S3API
+
+	lock    sync.Mutex
+	buckets map[string]*s3Bucket          // keyed by bucket name
+	uploads map[string]*s3MultipartUpload // keyed by upload ID
+}
+
+type s3Bucket struct {
+	created time.Time
+	objects map[string]*s3Object // keyed by object key
+}
+
+type s3Object struct {
+	body                    []byte
+	cacheControl            *string
+	checksum                string
+	checksumAlgorithm       string
+	contentDisposition      *string
+	contentEncoding         *string
+	contentLanguage         *string
+	contentType             *string
+	etag                    string
+	lastModified            time.Time
+	metadata                map[string]*string
+	serverSideEncryption    *string
+	sseKMSKeyID             *string
+	storageClass            *string
+	tags                    map[string]string
+	websiteRedirectLocation *string
+}
+
+type s3MultipartUpload struct {
+	input *s3.PutObjectInput // the object's properties
+	parts map[int64]*s3Part  // keyed by part number
+}
+
+type s3Part struct {
+	body     []byte
+	checksum string
+	etag     string
+}
+
+// NewS3 returns an empty S3 fake.
+func NewS3() *S3 {
+	return &S3{
+		buckets: make(map[string]*s3Bucket),
+		uploads: make(map[string]*s3MultipartUpload),
+	}
+}
+
+func (f *S3) findBucket(name *string) (*s3Bucket, error) {
+	bucket, ok := f.buckets[aws.StringValue(name)]
+
+	if !ok {
+		return nil, newNotFoundError(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist")
+	}
+
+	return bucket, nil
+}
+
+func (f *S3) findObject(bucketName, key *string) (*s3Object, error) {
+	bucket, err := f.findBucket(bucketName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := bucket.objects[aws.StringValue(key)]
+
+	if !ok {
+		return nil, newNotFoundError(s3.ErrCodeNoSuchKey, "The specified key does not exist.")
+	}
+
+	return object, nil
+}
+
+func (f *S3) findMultipartUpload(uploadID *string) (*s3MultipartUpload, error) {
+	upload, ok := f.uploads[aws.StringValue(uploadID)]
+
+	if !ok {
+		return nil, newNotFoundError(s3.ErrCodeNoSuchUpload, "The specified upload does not exist. The upload ID may be invalid, or the upload may have been aborted or completed.")
+	}
+
+	return upload, nil
+}
+
+// putObject stores an object with the specified properties and data, replacing any existing object.
+func (f *S3) putObject(input *s3.PutObjectInput, body []byte, etag, checksum string) (*s3Object, error) {
+	bucket, err := f.findBucket(input.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object := &s3Object{
+		body:                    body,
+		cacheControl:            input.CacheControl,
+		checksum:                checksum,
+		checksumAlgorithm:       aws.StringValue(input.ChecksumAlgorithm),
+		contentDisposition:      input.ContentDisposition,
+		contentEncoding:         input.ContentEncoding,
+		contentLanguage:         input.ContentLanguage,
+		contentType:             input.ContentType,
+		etag:                    etag,
+		lastModified:            time.Now(),
+		metadata:                input.Metadata,
+		serverSideEncryption:    input.ServerSideEncryption,
+		sseKMSKeyID:             input.SSEKMSKeyId,
+		websiteRedirectLocation: input.WebsiteRedirectLocation,
+	}
+
+	if object.contentType == nil {
+		object.contentType = aws.String("binary/octet-stream")
+	}
+
+	if v := aws.StringValue(input.StorageClass); v != "" && v != s3.StorageClassStandard {
+		object.storageClass = input.StorageClass
+	}
+
+	if v := aws.StringValue(input.Tagging); v != "" {
+		values, err := url.ParseQuery(v)
+
+		if err != nil {
+			return nil, newBadRequestError("InvalidArgument", "The header 'x-amz-tagging' shall be encoded as UTF-8 then URLEncoded URL query parameters without tag name duplicates.")
+		}
+
+		object.tags = make(map[string]string, len(values))
+
+		for k, v := range values {
+			object.tags[k] = strings.Join(v, "")
+		}
+	}
+
+	bucket.objects[aws.StringValue(input.Key)] = object
+
+	return object, nil
+}
+
+// s3Checksum returns the base64-encoded checksum of the data using the specified algorithm,
+// verifying it against any checksum sent by the client.
+func s3Checksum(algorithm string, body []byte, expected *string) (string, error) {
+	if algorithm == "" {
+		return "", nil
+	}
+
+	h, err := newS3ChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	h.Write(body)
+	checksum := base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+	if expected != nil && aws.StringValue(expected) != checksum {
+		return "", newBadRequestError("BadDigest", "The %s you specified did not match the calculated checksum.", algorithm)
+	}
+
+	return checksum, nil
+}
+
+func newS3ChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	}
+
+	return nil, newBadRequestError("InvalidRequest", "Value for x-amz-checksum-algorithm header is invalid.")
+}
+
+// s3ChecksumFields returns the checksum of the specified algorithm as the CRC32, CRC32C, SHA1 and SHA256 fields of a response.
+func s3ChecksumFields(algorithm, checksum string) (crc32, crc32c, sha1, sha256 *string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		crc32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		crc32c = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		sha1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		sha256 = aws.String(checksum)
+	}
+
+	return
+}
+
+// s3ChecksumField returns the checksum of the specified algorithm from the CRC32, CRC32C, SHA1 and SHA256 fields of a request.
+func s3ChecksumField(algorithm string, crc32, crc32c, sha1, sha256 *string) *string {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32c
+	case s3.ChecksumAlgorithmSha1:
+		return sha1
+	case s3.ChecksumAlgorithmSha256:
+		return sha256
+	}
+
+	return nil
+}
+
+func s3TagSet(tags map[string]string) []*s3.Tag {
+	var keys []string
+
+	for k := range tags {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	tagSet := make([]*s3.Tag, 0, len(keys))
+
+	for _, k := range keys {
+		tagSet = append(tagSet, &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return tagSet
+}
+
+func (f *S3) AbortMultipartUpload(input *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, err := f.findMultipartUpload(input.UploadId); err != nil {
+		return nil, err
+	}
+
+	delete(f.uploads, aws.StringValue(input.UploadId))
+
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func (f *S3) CompleteMultipartUpload(input *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	upload, err := f.findMultipartUpload(input.UploadId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var completedParts []*s3.CompletedPart
+
+	if input.MultipartUpload != nil {
+		completedParts = input.MultipartUpload.Parts
+	}
+
+	if len(completedParts) == 0 {
+		return nil, newBadRequestError("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema.")
+	}
+
+	algorithm := aws.StringValue(upload.input.ChecksumAlgorithm)
+	var body, etags []byte
+	var checksums hash.Hash
+
+	if algorithm != "" {
+		checksums, _ = newS3ChecksumHash(algorithm)
+	}
+
+	for i, completedPart := range completedParts {
+		partNumber := aws.Int64Value(completedPart.PartNumber)
+
+		if i > 0 && partNumber <= aws.Int64Value(completedParts[i-1].PartNumber) {
+			return nil, newBadRequestError("InvalidPartOrder", "The list of parts was not in ascending order. The parts list must be specified in order by part number.")
+		}
+
+		part, ok := upload.parts[partNumber]
+
+		if !ok || aws.StringValue(completedPart.ETag) != part.etag {
+			return nil, newBadRequestError("InvalidPart", "One or more of the specified parts could not be found.")
+		}
+
+		if algorithm != "" && aws.StringValue(s3ChecksumField(algorithm, completedPart.ChecksumCRC32, completedPart.ChecksumCRC32C, completedPart.ChecksumSHA1, completedPart.ChecksumSHA256)) != part.checksum {
+			return nil, newBadRequestError("InvalidPart", "One or more of the specified parts could not be found.")
+		}
+
+		if i < len(completedParts)-1 && len(part.body) < s3MinPartSize {
+			return nil, newBadRequestError("EntityTooSmall", "Your proposed upload is smaller than the minimum allowed size")
+		}
+
+		body = append(body, part.body...)
+		etag, _ := hex.DecodeString(strings.Trim(part.etag, `"`))
+		etags = append(etags, etag...)
+
+		if checksums != nil {
+			checksum, _ := base64.StdEncoding.DecodeString(part.checksum)
+			checksums.Write(checksum)
+		}
+	}
+
+	sum := md5.Sum(etags)
+	etag := fmt.Sprintf(`"%s-%d"`, hex.EncodeToString(sum[:]), len(completedParts))
+	var checksum string
+
+	if checksums != nil {
+		checksum = fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(checksums.Sum(nil)), len(completedParts))
+	}
+
+	object, err := f.putObject(upload.input, body, etag, checksum)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(f.uploads, aws.StringValue(input.UploadId))
+
+	output := &s3.CompleteMultipartUploadOutput{
+		Bucket:               input.Bucket,
+		ETag:                 aws.String(object.etag),
+		Key:                  input.Key,
+		ServerSideEncryption: object.serverSideEncryption,
+		SSEKMSKeyId:          object.sseKMSKeyID,
+	}
+	output.ChecksumCRC32, output.ChecksumCRC32C, output.ChecksumSHA1, output.ChecksumSHA256 = s3ChecksumFields(algorithm, checksum)
+
+	return output, nil
+}
+
+func (f *S3) CreateBucket(input *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	name := aws.StringValue(input.Bucket)
+
+	if _, ok := f.buckets[name]; ok {
+		return nil, newError(s3.ErrCodeBucketAlreadyOwnedByYou, http.StatusConflict, "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	f.buckets[name] = &s3Bucket{
+		created: time.Now(),
+		objects: make(map[string]*s3Object),
+	}
+
+	return &s3.CreateBucketOutput{Location: aws.String("/" + name)}, nil
+}
+
+func (f *S3) CreateMultipartUpload(input *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, err := f.findBucket(input.Bucket); err != nil {
+		return nil, err
+	}
+
+	if v := aws.StringValue(input.ChecksumAlgorithm); v != "" {
+		if _, err := newS3ChecksumHash(v); err != nil {
+			return nil, err
+		}
+	}
+
+	upload := &s3MultipartUpload{
+		input: &s3.PutObjectInput{},
+		parts: make(map[int64]*s3Part),
+	}
+	awsutil.Copy(upload.input, input)
+	uploadID := uniqueID("UPLOAD")
+	f.uploads[uploadID] = upload
+
+	return &s3.CreateMultipartUploadOutput{
+		Bucket:            input.Bucket,
+		ChecksumAlgorithm: input.ChecksumAlgorithm,
+		Key:               input.Key,
+		UploadId:          aws.String(uploadID),
+	}, nil
+}
+
+func (f *S3) DeleteBucket(input *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	bucket, err := f.findBucket(input.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucket.objects) > 0 {
+		return nil, newError("BucketNotEmpty", http.StatusConflict, "The bucket you tried to delete is not empty")
+	}
+
+	delete(f.buckets, aws.StringValue(input.Bucket))
+
+	return &s3.DeleteBucketOutput{}, nil
+}
+
+func (f *S3) DeleteObject(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	bucket, err := f.findBucket(input.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(bucket.objects, aws.StringValue(input.Key))
+
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func (f *S3) DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	bucket, err := f.findBucket(input.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &s3.DeleteObjectsOutput{}
+
+	for _, v := range input.Delete.Objects {
+		delete(bucket.objects, aws.StringValue(v.Key))
+
+		if !aws.BoolValue(input.Delete.Quiet) {
+			output.Deleted = append(output.Deleted, &s3.DeletedObject{
+				Key: v.Key,
+			})
+		}
+	}
+
+	return output, nil
+}
+
+func (f *S3) DeleteObjectTagging(input *s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	object, err := f.findObject(input.Bucket, input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object.tags = nil
+
+	return &s3.DeleteObjectTaggingOutput{}, nil
+}
+
+func (f *S3) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	object, err := f.findObject(input.Bucket, input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetObjectOutput{
+		Body:                    io.NopCloser(bytes.NewReader(object.body)),
+		CacheControl:            object.cacheControl,
+		ContentDisposition:      object.contentDisposition,
+		ContentEncoding:         object.contentEncoding,
+		ContentLanguage:         object.contentLanguage,
+		ContentLength:           aws.Int64(int64(len(object.body))),
+		ContentType:             object.contentType,
+		ETag:                    aws.String(object.etag),
+		LastModified:            aws.Time(object.lastModified),
+		Metadata:                object.metadata,
+		ServerSideEncryption:    object.serverSideEncryption,
+		SSEKMSKeyId:             object.sseKMSKeyID,
+		StorageClass:            object.storageClass,
+		TagCount:                aws.Int64(int64(len(object.tags))),
+		WebsiteRedirectLocation: object.websiteRedirectLocation,
+	}, nil
+}
+
+func (f *S3) GetObjectTagging(input *s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	object, err := f.findObject(input.Bucket, input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetObjectTaggingOutput{TagSet: s3TagSet(object.tags)}, nil
+}
+
+func (f *S3) HeadBucket(input *s3.HeadBucketInput) (*s3.HeadBucketOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, err := f.findBucket(input.Bucket); err != nil {
+		return nil, newNotFoundError("NotFound", "Not Found")
+	}
+
+	return &s3.HeadBucketOutput{}, nil
+}
+
+func (f *S3) HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	object, err := f.findObject(input.Bucket, input.Key)
+
+	if err != nil {
+		// HEAD responses have no body, so no error code.
+		return nil, newNotFoundError("NotFound", "Not Found")
+	}
+
+	output := &s3.HeadObjectOutput{
+		CacheControl:            object.cacheControl,
+		ContentDisposition:      object.contentDisposition,
+		ContentEncoding:         object.contentEncoding,
+		ContentLanguage:         object.contentLanguage,
+		ContentLength:           aws.Int64(int64(len(object.body))),
+		ContentType:             object.contentType,
+		ETag:                    aws.String(object.etag),
+		LastModified:            aws.Time(object.lastModified),
+		Metadata:                object.metadata,
+		ServerSideEncryption:    object.serverSideEncryption,
+		SSEKMSKeyId:             object.sseKMSKeyID,
+		StorageClass:            object.storageClass,
+		WebsiteRedirectLocation: object.websiteRedirectLocation,
+	}
+
+	if aws.StringValue(input.ChecksumMode) == s3.ChecksumModeEnabled {
+		output.ChecksumCRC32, output.ChecksumCRC32C, output.ChecksumSHA1, output.ChecksumSHA256 = s3ChecksumFields(object.checksumAlgorithm, object.checksum)
+	}
+
+	return output, nil
+}
+
+func (f *S3) ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	bucket, err := f.findBucket(input.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &s3.ListObjectVersionsOutput{
+		Name:   input.Bucket,
+		Prefix: input.Prefix,
+	}
+
+	for _, key := range f.sortedKeys(bucket, aws.StringValue(input.Prefix)) {
+		object := bucket.objects[key]
+		output.Versions = append(output.Versions, &s3.ObjectVersion{
+			ETag:         aws.String(object.etag),
+			IsLatest:     aws.Bool(true),
+			Key:          aws.String(key),
+			LastModified: aws.Time(object.lastModified),
+			Size:         aws.Int64(int64(len(object.body))),
+			StorageClass: object.storageClass,
+			VersionId:    aws.String("null"),
+		})
+	}
+
+	return output, nil
+}
+
+func (f *S3) ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	bucket, err := f.findBucket(input.Bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &s3.ListObjectsV2Output{
+		Name:   input.Bucket,
+		Prefix: input.Prefix,
+	}
+
+	for _, key := range f.sortedKeys(bucket, aws.StringValue(input.Prefix)) {
+		object := bucket.objects[key]
+		output.Contents = append(output.Contents, &s3.Object{
+			ETag:         aws.String(object.etag),
+			Key:          aws.String(key),
+			LastModified: aws.Time(object.lastModified),
+			Size:         aws.Int64(int64(len(object.body))),
+			StorageClass: object.storageClass,
+		})
+	}
+
+	output.KeyCount = aws.Int64(int64(len(output.Contents)))
+
+	return output, nil
+}
+
+func (f *S3) ListMultipartUploads(input *s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, err := f.findBucket(input.Bucket); err != nil {
+		return nil, err
+	}
+
+	output := &s3.ListMultipartUploadsOutput{
+		Bucket: input.Bucket,
+	}
+
+	for uploadID, upload := range f.uploads {
+		if aws.StringValue(upload.input.Bucket) != aws.StringValue(input.Bucket) || !strings.HasPrefix(aws.StringValue(upload.input.Key), aws.StringValue(input.Prefix)) {
+			continue
+		}
+
+		output.Uploads = append(output.Uploads, &s3.MultipartUpload{
+			ChecksumAlgorithm: upload.input.ChecksumAlgorithm,
+			Key:               upload.input.Key,
+			UploadId:          aws.String(uploadID),
+		})
+	}
+
+	sort.Slice(output.Uploads, func(i, j int) bool {
+		return aws.StringValue(output.Uploads[i].Key) < aws.StringValue(output.Uploads[j].Key)
+	})
+
+	return output, nil
+}
+
+func (f *S3) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var body []byte
+
+	if input.Body != nil {
+		var err error
+		body, err = io.ReadAll(input.Body)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	algorithm := aws.StringValue(input.ChecksumAlgorithm)
+	checksum, err := s3Checksum(algorithm, body, s3ChecksumField(algorithm, input.ChecksumCRC32, input.ChecksumCRC32C, input.ChecksumSHA1, input.ChecksumSHA256))
+
+	if err != nil {
+		return nil, err
+	}
+
+	hash := md5.Sum(body)
+	object, err := f.putObject(input, body, `"`+hex.EncodeToString(hash[:])+`"`, checksum)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &s3.PutObjectOutput{
+		ETag:                 aws.String(object.etag),
+		ServerSideEncryption: object.serverSideEncryption,
+		SSEKMSKeyId:          object.sseKMSKeyID,
+	}
+	output.ChecksumCRC32, output.ChecksumCRC32C, output.ChecksumSHA1, output.ChecksumSHA256 = s3ChecksumFields(algorithm, checksum)
+
+	return output, nil
+}
+
+func (f *S3) PutObjectAcl(input *s3.PutObjectAclInput) (*s3.PutObjectAclOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, err := f.findObject(input.Bucket, input.Key); err != nil {
+		return nil, err
+	}
+
+	return &s3.PutObjectAclOutput{}, nil
+}
+
+func (f *S3) PutObjectTagging(input *s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	object, err := f.findObject(input.Bucket, input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object.tags = make(map[string]string)
+
+	if input.Tagging != nil {
+		for _, tag := range input.Tagging.TagSet {
+			object.tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+
+	return &s3.PutObjectTaggingOutput{}, nil
+}
+
+func (f *S3) UploadPart(input *s3.UploadPartInput) (*s3.UploadPartOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	upload, err := f.findMultipartUpload(input.UploadId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+
+	if input.Body != nil {
+		body, err = io.ReadAll(input.Body)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	algorithm := aws.StringValue(upload.input.ChecksumAlgorithm)
+	checksum, err := s3Checksum(algorithm, body, s3ChecksumField(algorithm, input.ChecksumCRC32, input.ChecksumCRC32C, input.ChecksumSHA1, input.ChecksumSHA256))
+
+	if err != nil {
+		return nil, err
+	}
+
+	hash := md5.Sum(body)
+	part := &s3Part{
+		body:     body,
+		checksum: checksum,
+		etag:     `"` + hex.EncodeToString(hash[:]) + `"`,
+	}
+	upload.parts[aws.Int64Value(input.PartNumber)] = part
+
+	output := &s3.UploadPartOutput{
+		ETag: aws.String(part.etag),
+	}
+	output.ChecksumCRC32, output.ChecksumCRC32C, output.ChecksumSHA1, output.ChecksumSHA256 = s3ChecksumFields(algorithm, checksum)
+
+	return output, nil
+}
+
+func (f *S3) sortedKeys(bucket *s3Bucket, prefix string) []string {
+	var keys []string
+
+	for key := range bucket.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (f *S3) endpoint() string {
+	return fmt.Sprintf("https://s3.%s.amazonaws.com", Region)
+}
+
+func (f *S3) AbortMultipartUploadWithContext(_ aws.Context, input *s3.AbortMultipartUploadInput, _ ...request.Option) (*s3.AbortMultipartUploadOutput, error) {
+	return f.AbortMultipartUpload(input)
+}
+
+func (f *S3) CompleteMultipartUploadWithContext(_ aws.Context, input *s3.CompleteMultipartUploadInput, _ ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
+	return f.CompleteMultipartUpload(input)
+}
+
+func (f *S3) CreateMultipartUploadWithContext(_ aws.Context, input *s3.CreateMultipartUploadInput, _ ...request.Option) (*s3.CreateMultipartUploadOutput, error) {
+	return f.CreateMultipartUpload(input)
+}
+
+// GetObjectRequest is used by the s3manager Uploader to presign the location of a multipart upload.
+func (f *S3) GetObjectRequest(input *s3.GetObjectInput) (*request.Request, *s3.GetObjectOutput) {
+	output := &s3.GetObjectOutput{}
+	path := fmt.Sprintf("/%s/%s", aws.StringValue(input.Bucket), aws.StringValue(input.Key))
+
+	return newRequest(f.endpoint(), "GetObject", path, input, output, func() error {
+		v, err := f.GetObject(input)
+
+		if err != nil {
+			return err
+		}
+
+		*output = *v
+
+		return nil
+	}), output
+}
+
+func (f *S3) ListObjectVersionsPages(input *s3.ListObjectVersionsInput, fn func(*s3.ListObjectVersionsOutput, bool) bool) error {
+	output, err := f.ListObjectVersions(input)
+
+	if err != nil {
+		return err
+	}
+
+	fn(output, true)
+
+	return nil
+}
+
+func (f *S3) ListObjectsV2Pages(input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool) error {
+	output, err := f.ListObjectsV2(input)
+
+	if err != nil {
+		return err
+	}
+
+	fn(output, true)
+
+	return nil
+}
+
+// PutObjectRequest is used by the s3manager Uploader for single part uploads.
+func (f *S3) PutObjectRequest(input *s3.PutObjectInput) (*request.Request, *s3.PutObjectOutput) {
+	output := &s3.PutObjectOutput{}
+	path := fmt.Sprintf("/%s/%s", aws.StringValue(input.Bucket), aws.StringValue(input.Key))
+
+	return newRequest(f.endpoint(), "PutObject", path, input, output, func() error {
+		v, err := f.PutObject(input)
+
+		if err != nil {
+			return err
+		}
+
+		*output = *v
+
+		return nil
+	}), output
+}
+
+func (f *S3) UploadPartWithContext(_ aws.Context, input *s3.UploadPartInput, _ ...request.Option) (*s3.UploadPartOutput, error) {
+	return f.UploadPart(input)
+}
@@ -0,0 +1,177 @@
+package fake
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+)
+
+// SNS is an in-memory fake of Amazon SNS topics, their attributes and tags.
+type SNS struct {
+	// Operations the fake does not implement panic.
+	snsiface.SNSAPI
+
+	lock   sync.Mutex
+	topics map[string]*snsTopic // keyed by topic ARN
+}
+
+type snsTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// NewSNS returns an empty SNS fake.
+func NewSNS() *SNS {
+	return &SNS{
+		topics: make(map[string]*snsTopic),
+	}
+}
+
+func (f *SNS) findTopic(arn *string) (*snsTopic, error) {
+	topic, ok := f.topics[aws.StringValue(arn)]
+
+	if !ok {
+		return nil, newNotFoundError(sns.ErrCodeNotFoundException, "Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+func (f *SNS) CreateTopic(input *sns.CreateTopicInput) (*sns.CreateTopicOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	name := aws.StringValue(input.Name)
+	arn := fmt.Sprintf("arn:%s:sns:%s:%s:%s", Partition, Region, AccountID, name)
+
+	if _, ok := f.topics[arn]; ok {
+		return &sns.CreateTopicOutput{TopicArn: aws.String(arn)}, nil
+	}
+
+	topic := &snsTopic{
+		attributes: map[string]string{
+			"DisplayName": "",
+			"Owner":       AccountID,
+			"TopicArn":    arn,
+		},
+		tags: make(map[string]string),
+	}
+
+	if strings.HasSuffix(name, ".fifo") {
+		topic.attributes["FifoTopic"] = "true"
+		topic.attributes["ContentBasedDeduplication"] = "false"
+	}
+
+	for k, v := range aws.StringValueMap(input.Attributes) {
+		topic.attributes[k] = v
+	}
+
+	for _, tag := range input.Tags {
+		topic.tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	f.topics[arn] = topic
+
+	return &sns.CreateTopicOutput{TopicArn: aws.String(arn)}, nil
+}
+
+func (f *SNS) DeleteTopic(input *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, err := f.findTopic(input.TopicArn); err != nil {
+		return nil, err
+	}
+
+	delete(f.topics, aws.StringValue(input.TopicArn))
+
+	return &sns.DeleteTopicOutput{}, nil
+}
+
+func (f *SNS) GetTopicAttributes(input *sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	topic, err := f.findTopic(input.TopicArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sns.GetTopicAttributesOutput{Attributes: aws.StringMap(copyStringMap(topic.attributes))}, nil
+}
+
+func (f *SNS) ListTagsForResource(input *sns.ListTagsForResourceInput) (*sns.ListTagsForResourceOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	topic, err := f.findTopic(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &sns.ListTagsForResourceOutput{}
+
+	for k, v := range topic.tags {
+		output.Tags = append(output.Tags, &sns.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return output, nil
+}
+
+func (f *SNS) SetTopicAttributes(input *sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	topic, err := f.findTopic(input.TopicArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	topic.attributes[aws.StringValue(input.AttributeName)] = aws.StringValue(input.AttributeValue)
+
+	return &sns.SetTopicAttributesOutput{}, nil
+}
+
+func (f *SNS) TagResource(input *sns.TagResourceInput) (*sns.TagResourceOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	topic, err := f.findTopic(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		topic.tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return &sns.TagResourceOutput{}, nil
+}
+
+func (f *SNS) UntagResource(input *sns.UntagResourceInput) (*sns.UntagResourceOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	topic, err := f.findTopic(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range aws.StringValueSlice(input.TagKeys) {
+		delete(topic.tags, k)
+	}
+
+	return &sns.UntagResourceOutput{}, nil
+}
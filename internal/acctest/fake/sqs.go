@@ -0,0 +1,217 @@
+package fake
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+)
+
+// SQS is an in-memory fake of Amazon SQS queues, their attributes and tags.
+type SQS struct {
+	// Operations the fake does not implement panic.
+	sqsiface.SQSAPI
+
+	lock   sync.Mutex
+	queues map[string]*sqsQueue // keyed by queue URL
+}
+
+type sqsQueue struct {
+	attributes map[string]string
+	name       string
+	tags       map[string]string
+}
+
+// NewSQS returns an empty SQS fake.
+func NewSQS() *SQS {
+	return &SQS{
+		queues: make(map[string]*sqsQueue),
+	}
+}
+
+func (f *SQS) queueURL(name string) string {
+	return fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", Region, AccountID, name)
+}
+
+func (f *SQS) findQueue(url *string) (*sqsQueue, error) {
+	queue, ok := f.queues[aws.StringValue(url)]
+
+	if !ok {
+		return nil, newBadRequestError(sqs.ErrCodeQueueDoesNotExist, "The specified queue does not exist for this wsdl version.")
+	}
+
+	return queue, nil
+}
+
+func (f *SQS) CreateQueue(input *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	name := aws.StringValue(input.QueueName)
+	url := f.queueURL(name)
+	attributes := aws.StringValueMap(input.Attributes)
+
+	if queue, ok := f.queues[url]; ok {
+		for k, v := range attributes {
+			if queue.attributes[k] != v {
+				return nil, newBadRequestError(sqs.ErrCodeQueueNameExists, "A queue already exists with the same name and a different value for attribute %s", k)
+			}
+		}
+
+		return &sqs.CreateQueueOutput{QueueUrl: aws.String(url)}, nil
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	queue := &sqsQueue{
+		attributes: map[string]string{
+			sqs.QueueAttributeNameCreatedTimestamp:              now,
+			sqs.QueueAttributeNameDelaySeconds:                  "0",
+			sqs.QueueAttributeNameLastModifiedTimestamp:         now,
+			sqs.QueueAttributeNameMaximumMessageSize:            "262144",
+			sqs.QueueAttributeNameMessageRetentionPeriod:        "345600",
+			sqs.QueueAttributeNameQueueArn:                      fmt.Sprintf("arn:%s:sqs:%s:%s:%s", Partition, Region, AccountID, name),
+			sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds: "0",
+			sqs.QueueAttributeNameVisibilityTimeout:             "30",
+		},
+		name: name,
+		tags: aws.StringValueMap(input.Tags),
+	}
+
+	if strings.HasSuffix(name, ".fifo") {
+		queue.attributes[sqs.QueueAttributeNameFifoQueue] = "true"
+	}
+
+	for k, v := range attributes {
+		queue.attributes[k] = v
+	}
+
+	f.queues[url] = queue
+
+	return &sqs.CreateQueueOutput{QueueUrl: aws.String(url)}, nil
+}
+
+func (f *SQS) DeleteQueue(input *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, err := f.findQueue(input.QueueUrl); err != nil {
+		return nil, err
+	}
+
+	delete(f.queues, aws.StringValue(input.QueueUrl))
+
+	return &sqs.DeleteQueueOutput{}, nil
+}
+
+func (f *SQS) GetQueueAttributes(input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	queue, err := f.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string)
+
+	for _, name := range aws.StringValueSlice(input.AttributeNames) {
+		if name == sqs.QueueAttributeNameAll {
+			attributes = copyStringMap(queue.attributes)
+			break
+		}
+
+		if v, ok := queue.attributes[name]; ok {
+			attributes[name] = v
+		}
+	}
+
+	return &sqs.GetQueueAttributesOutput{Attributes: aws.StringMap(attributes)}, nil
+}
+
+func (f *SQS) GetQueueUrl(input *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	url := f.queueURL(aws.StringValue(input.QueueName))
+
+	if _, err := f.findQueue(aws.String(url)); err != nil {
+		return nil, err
+	}
+
+	return &sqs.GetQueueUrlOutput{QueueUrl: aws.String(url)}, nil
+}
+
+func (f *SQS) ListQueueTags(input *sqs.ListQueueTagsInput) (*sqs.ListQueueTagsOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	queue, err := f.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqs.ListQueueTagsOutput{Tags: aws.StringMap(queue.tags)}, nil
+}
+
+func (f *SQS) SetQueueAttributes(input *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	queue, err := f.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range aws.StringValueMap(input.Attributes) {
+		queue.attributes[k] = v
+	}
+	queue.attributes[sqs.QueueAttributeNameLastModifiedTimestamp] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return &sqs.SetQueueAttributesOutput{}, nil
+}
+
+func (f *SQS) TagQueue(input *sqs.TagQueueInput) (*sqs.TagQueueOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	queue, err := f.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if queue.tags == nil {
+		queue.tags = make(map[string]string)
+	}
+
+	for k, v := range aws.StringValueMap(input.Tags) {
+		queue.tags[k] = v
+	}
+
+	return &sqs.TagQueueOutput{}, nil
+}
+
+func (f *SQS) UntagQueue(input *sqs.UntagQueueInput) (*sqs.UntagQueueOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	queue, err := f.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range aws.StringValueSlice(input.TagKeys) {
+		delete(queue.tags, k)
+	}
+
+	return &sqs.UntagQueueOutput{}, nil
+}
@@ -0,0 +1,447 @@
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ImportVerifyDifference is an attribute whose imported value differs from its created value,
+// or, if Error is set, a resource that could not be imported.
+type ImportVerifyDifference struct {
+	Test         string `json:"test"`
+	ResourceName string `json:"resource_name"`
+	ResourceType string `json:"resource_type"`
+	Attribute    string `json:"attribute,omitempty"`
+	Created      string `json:"created,omitempty"`
+	Imported     string `json:"imported,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// ImportVerifyOption configures the import verification of ParallelTest and Test.
+type ImportVerifyOption func(*importVerifyOptions)
+
+type importVerifyOptions struct {
+	ignore []string
+	skip   bool
+	strict bool
+}
+
+// ImportVerifyIgnore excludes attributes whose names start with any of the
+// prefixes from import verification, as with TestStep.ImportStateVerifyIgnore.
+func ImportVerifyIgnore(prefixes ...string) ImportVerifyOption {
+	return func(o *importVerifyOptions) {
+		o.ignore = append(o.ignore, prefixes...)
+	}
+}
+
+// ImportVerifySkip disables import verification for the test case.
+func ImportVerifySkip() ImportVerifyOption {
+	return func(o *importVerifyOptions) {
+		o.skip = true
+	}
+}
+
+// ImportVerifyStrict fails the test if a resource cannot be imported or its
+// imported state differs from its created state, instead of only reporting it.
+func ImportVerifyStrict() ImportVerifyOption {
+	return func(o *importVerifyOptions) {
+		o.strict = true
+	}
+}
+
+// ParallelTest is resource.ParallelTest with WithImportVerify applied to the test case.
+func ParallelTest(t *testing.T, c resource.TestCase, opts ...ImportVerifyOption) {
+	t.Helper()
+
+	resource.ParallelTest(t, WithImportVerify(t, c, opts...))
+}
+
+// Test is resource.Test with WithImportVerify applied to the test case.
+func Test(t *testing.T, c resource.TestCase, opts ...ImportVerifyOption) {
+	t.Helper()
+
+	resource.Test(t, WithImportVerify(t, c, opts...))
+}
+
+// WithImportVerify returns the test case with import verification added.
+//
+// After the last step that applies a configuration, a plan-only step verifies
+// that re-planning that configuration produces an empty plan. The check of the
+// applying step then imports every resource in its configuration whose type
+// defines an Importer, and which has no import step of its own, by its ID and
+// compares the imported state to the created state.
+//
+// Resources using count, for_each or a provider alias are not imported.
+// Import errors and differences are logged and appended as JSON lines to the
+// file named by TF_ACC_IMPORT_VERIFY_REPORT, or by default to
+// terraform-provider-aws-import-verify.jsonl in the temporary directory.
+// They fail the test only with ImportVerifyStrict.
+func WithImportVerify(t *testing.T, c resource.TestCase, opts ...ImportVerifyOption) resource.TestCase {
+	var o importVerifyOptions
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.skip || c.IsUnitTest || os.Getenv(resource.EnvTfAcc) == "" {
+		return c
+	}
+
+	last := -1
+
+	for i, step := range c.Steps {
+		if step.Config != "" && !step.ImportState && !step.PlanOnly && !step.Destroy && step.ExpectError == nil {
+			last = i
+		}
+	}
+
+	// The final state must be stable for its import to be meaningful.
+	if last == -1 || c.Steps[last].ExpectNonEmptyPlan {
+		return c
+	}
+
+	imported := make(map[string]bool)
+
+	for _, step := range c.Steps {
+		if step.ImportState {
+			imported[step.ResourceName] = true
+		}
+	}
+
+	var resources []importableResource
+
+	for _, r := range importableResources(c.Steps[last].Config) {
+		if !imported[r.name] {
+			resources = append(resources, r)
+		}
+	}
+
+	step := c.Steps[last]
+	check := step.Check
+	step.Check = func(s *terraform.State) error {
+		if check != nil {
+			if err := check(s); err != nil {
+				return err
+			}
+		}
+
+		return importVerifyCheck(t, s, resources, o)
+	}
+
+	steps := make([]resource.TestStep, 0, len(c.Steps)+1)
+	steps = append(steps, c.Steps[:last]...)
+	steps = append(steps, step)
+	steps = append(steps, resource.TestStep{
+		Config:   step.Config,
+		PlanOnly: true,
+	})
+
+	c.Steps = append(steps, c.Steps[last+1:]...)
+
+	return c
+}
+
+type importableResource struct {
+	name  string // e.g. "aws_sqs_queue.test"
+	rType string // e.g. "aws_sqs_queue"
+}
+
+var (
+	configResourceBlockRegexp = regexp.MustCompile(`(?m)^\s*resource\s+"([^"]+)"\s+"([^"]+)"\s*\{`)
+	configMultipleRegexp      = regexp.MustCompile(`(?m)^\s*(count|for_each|provider)\s*=`)
+)
+
+// importableResources returns the single-instance, default-provider resources
+// declared in the configuration whose types define an Importer, in declaration order.
+func importableResources(config string) []importableResource {
+	var resources []importableResource
+
+	for _, m := range configResourceBlockRegexp.FindAllStringSubmatchIndex(config, -1) {
+		rType, name := config[m[2]:m[3]], config[m[4]:m[5]]
+		body := configBlockBody(config[m[1]:])
+
+		// Only direct arguments, not those of nested blocks.
+		if configMultipleRegexp.MatchString(configTopLevel(body)) {
+			continue
+		}
+
+		r, ok := Provider.ResourcesMap[rType]
+
+		if !ok || r.Importer == nil {
+			continue
+		}
+
+		resources = append(resources, importableResource{
+			name:  rType + "." + name,
+			rType: rType,
+		})
+	}
+
+	return resources
+}
+
+// configBlockBody returns the body of the block whose opening brace immediately precedes s.
+func configBlockBody(s string) string {
+	depth := 1
+
+	for i, c := range s {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+
+			if depth == 0 {
+				return s[:i]
+			}
+		}
+	}
+
+	return s
+}
+
+// configTopLevel returns the block body with all nested blocks removed.
+func configTopLevel(body string) string {
+	var sb strings.Builder
+	depth := 0
+
+	for _, c := range body {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		default:
+			if depth == 0 {
+				sb.WriteRune(c)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// importVerifyCheck imports each resource and compares its imported state to its created state.
+func importVerifyCheck(t *testing.T, created *terraform.State, resources []importableResource, o importVerifyOptions) error {
+	// Provider is configured by PreCheck.
+	if len(resources) == 0 || Provider.Meta() == nil {
+		return nil
+	}
+
+	var report []ImportVerifyDifference
+	var errs []string
+
+	for _, r := range resources {
+		differences, err := importVerifyResource(created, r, o.ignore)
+
+		if err != nil {
+			report = append(report, ImportVerifyDifference{
+				Test:         t.Name(),
+				ResourceName: r.name,
+				ResourceType: r.rType,
+				Error:        err.Error(),
+			})
+			errs = append(errs, fmt.Sprintf("importing %s: %s", r.name, err))
+
+			continue
+		}
+
+		if len(differences) == 0 {
+			continue
+		}
+
+		var sb strings.Builder
+
+		fmt.Fprintf(&sb, "imported state of %s differs from created state:", r.name)
+
+		for i := range differences {
+			differences[i].Test = t.Name()
+			differences[i].ResourceName = r.name
+			differences[i].ResourceType = r.rType
+
+			fmt.Fprintf(&sb, "\n  %s: created %q, imported %q", differences[i].Attribute, differences[i].Created, differences[i].Imported)
+		}
+
+		report = append(report, differences...)
+		errs = append(errs, sb.String())
+	}
+
+	if len(report) == 0 {
+		return nil
+	}
+
+	path, err := writeImportVerifyReport(report)
+
+	if err != nil {
+		t.Logf("[WARN] writing import verification report: %s", err)
+	} else {
+		t.Logf("[INFO] import verification results appended to %s", path)
+	}
+
+	if o.strict {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	for _, err := range errs {
+		t.Logf("[WARN] %s", err)
+	}
+
+	return nil
+}
+
+// importVerifyResource imports a resource by its ID and returns the differences
+// between its imported and created states.
+func importVerifyResource(created *terraform.State, r importableResource, ignore []string) ([]ImportVerifyDifference, error) {
+	rs, ok := created.RootModule().Resources[r.name]
+
+	if !ok || rs.Primary == nil {
+		return nil, fmt.Errorf("not found in created state")
+	}
+
+	ctx := context.Background()
+	states, err := Provider.ImportState(ctx, &terraform.InstanceInfo{Type: r.rType}, rs.Primary.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var is *terraform.InstanceState
+
+	for _, v := range states {
+		if v.ID == rs.Primary.ID && (v.Ephemeral.Type == "" || v.Ephemeral.Type == r.rType) {
+			is = v
+			break
+		}
+	}
+
+	if is == nil {
+		return nil, fmt.Errorf("ID %s not found in imported state", rs.Primary.ID)
+	}
+
+	is, diags := Provider.ResourcesMap[r.rType].RefreshWithoutUpgrade(ctx, is, Provider.Meta())
+
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return nil, errors.New(d.Summary)
+		}
+	}
+
+	if is == nil {
+		return nil, fmt.Errorf("ID %s not found after import", rs.Primary.ID)
+	}
+
+	return importVerifyDifferences(rs.Primary.Attributes, is.Attributes, ignore), nil
+}
+
+// importVerifyDifferences returns the differing attributes of two flatmapped states, sorted by attribute.
+// The resource ID, timeouts, empty containers and ignored attributes are not compared.
+func importVerifyDifferences(created, imported map[string]string, ignore []string) []ImportVerifyDifference {
+	skip := func(k, v string) bool {
+		if k == "id" || k == "%" || k == "timeouts" || strings.HasPrefix(k, "timeouts.") {
+			return true
+		}
+
+		if (strings.HasSuffix(k, ".#") || strings.HasSuffix(k, ".%")) && v == "0" {
+			return true
+		}
+
+		for _, prefix := range ignore {
+			if strings.HasPrefix(k, prefix) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	keys := make(map[string]struct{})
+
+	for k, v := range created {
+		if !skip(k, v) {
+			keys[k] = struct{}{}
+		}
+	}
+
+	for k, v := range imported {
+		if !skip(k, v) {
+			keys[k] = struct{}{}
+		}
+	}
+
+	var differences []ImportVerifyDifference
+
+	for k := range keys {
+		c, i := created[k], imported[k]
+
+		if skip(k, c) {
+			c = ""
+		}
+
+		if skip(k, i) {
+			i = ""
+		}
+
+		if c != i {
+			differences = append(differences, ImportVerifyDifference{
+				Attribute: k,
+				Created:   c,
+				Imported:  i,
+			})
+		}
+	}
+
+	sort.Slice(differences, func(i, j int) bool {
+		return differences[i].Attribute < differences[j].Attribute
+	})
+
+	return differences
+}
+
+// importVerifyReportPath returns the file to which import verification results are appended.
+func importVerifyReportPath() string {
+	if path := os.Getenv(conns.EnvVarAccImportVerifyReport); path != "" {
+		return path
+	}
+
+	return filepath.Join(os.TempDir(), "terraform-provider-aws-import-verify.jsonl")
+}
+
+var importVerifyReportLock sync.Mutex
+
+func writeImportVerifyReport(differences []ImportVerifyDifference) (string, error) {
+	path := importVerifyReportPath()
+
+	importVerifyReportLock.Lock()
+	defer importVerifyReportLock.Unlock()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+
+	if err != nil {
+		return path, err
+	}
+
+	enc := json.NewEncoder(f)
+
+	for _, d := range differences {
+		if err := enc.Encode(d); err != nil {
+			f.Close()
+			return path, err
+		}
+	}
+
+	return path, f.Close()
+}
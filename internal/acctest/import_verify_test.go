@@ -0,0 +1,191 @@
+package acctest
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestImportableResources(t *testing.T) {
+	config := `
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = "test"
+
+  tags = {
+    count = 2
+  }
+}
+
+resource "aws_sqs_queue" "counted" {
+  count = 2
+
+  name = "test-${count.index}"
+}
+
+resource "aws_sqs_queue_policy" "test" {
+  queue_url = aws_sqs_queue.test.id
+  policy    = "{}"
+}
+
+resource "aws_iam_role" "test" {
+  for_each = toset(["a", "b"])
+
+  name = each.key
+}
+
+resource "aws_sqs_queue" "alternate" {
+  provider = "awsalternate"
+
+  name = "test"
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket = "test"
+  key    = "test"
+  source = "test/test"
+}
+`
+
+	expected := []importableResource{
+		{name: "aws_sqs_queue.test", rType: "aws_sqs_queue"},
+		{name: "aws_sqs_queue_policy.test", rType: "aws_sqs_queue_policy"},
+	}
+
+	if got := importableResources(config); !reflect.DeepEqual(got, expected) {
+		t.Errorf("importableResources() = %v, want %v", got, expected)
+	}
+}
+
+func TestImportVerifyDifferences(t *testing.T) {
+	created := map[string]string{
+		"id":                  "test",
+		"arn":                 "arn:aws:sqs:us-west-2:123456789012:test",
+		"name":                "test",
+		"password":            "secret",
+		"tags.%":              "1",
+		"tags.Name":           "test",
+		"timeouts.create":     "10m",
+		"redrive_policy":      "",
+		"kms_master_key_id.#": "0",
+		"delay_seconds":       "0",
+	}
+	imported := map[string]string{
+		"id":             "test",
+		"arn":            "arn:aws:sqs:us-west-2:123456789012:test",
+		"name":           "test",
+		"tags.%":         "1",
+		"tags.Name":      "test2",
+		"redrive_policy": "",
+		"delay_seconds":  "5",
+	}
+
+	expected := []ImportVerifyDifference{
+		{Attribute: "delay_seconds", Created: "0", Imported: "5"},
+		{Attribute: "tags.Name", Created: "test", Imported: "test2"},
+	}
+
+	if got := importVerifyDifferences(created, imported, []string{"password"}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("importVerifyDifferences() = %v, want %v", got, expected)
+	}
+}
+
+func TestWithImportVerify(t *testing.T) {
+	t.Setenv(resource.EnvTfAcc, "1")
+
+	config := `
+resource "aws_sqs_queue" "test" {
+  name = "test"
+}
+
+resource "aws_sqs_queue_policy" "test" {
+  queue_url = aws_sqs_queue.test.id
+  policy    = "{}"
+}
+`
+
+	c := WithImportVerify(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      "aws_sqs_queue.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	if got, want := len(c.Steps), 3; got != want {
+		t.Fatalf("len(Steps) = %d, want %d", got, want)
+	}
+
+	if step := c.Steps[0]; step.Check == nil {
+		t.Error("Steps[0].Check is nil, want import verification check")
+	}
+
+	if step := c.Steps[1]; !step.PlanOnly || step.Config != config {
+		t.Errorf("Steps[1] = %+v, want plan-only step", step)
+	}
+
+	if step := c.Steps[2]; !step.ImportState || step.ResourceName != "aws_sqs_queue.test" || !step.ImportStateVerify {
+		t.Errorf("Steps[2] = %+v, want existing import step", step)
+	}
+}
+
+func TestWithImportVerify_expectNonEmptyPlan(t *testing.T) {
+	t.Setenv(resource.EnvTfAcc, "1")
+
+	c := WithImportVerify(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:             `resource "aws_sqs_queue" "test" {}`,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+
+	if got, want := len(c.Steps), 1; got != want {
+		t.Errorf("len(Steps) = %d, want %d", got, want)
+	}
+}
+
+func TestWithImportVerify_skip(t *testing.T) {
+	t.Setenv(resource.EnvTfAcc, "1")
+
+	c := WithImportVerify(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "aws_sqs_queue" "test" {}`,
+			},
+		},
+	}, ImportVerifySkip())
+
+	if got, want := len(c.Steps), 1; got != want {
+		t.Errorf("len(Steps) = %d, want %d", got, want)
+	}
+
+	if c.Steps[0].Check != nil {
+		t.Error("Steps[0].Check is set, want unchanged step")
+	}
+}
+
+func TestImportVerifyReportPath(t *testing.T) {
+	t.Setenv(conns.EnvVarAccImportVerifyReport, "")
+
+	if got, want := importVerifyReportPath(), filepath.Join(os.TempDir(), "terraform-provider-aws-import-verify.jsonl"); got != want {
+		t.Errorf("importVerifyReportPath() = %q, want %q", got, want)
+	}
+
+	t.Setenv(conns.EnvVarAccImportVerifyReport, "/tmp/report.jsonl")
+
+	if got, want := importVerifyReportPath(), "/tmp/report.jsonl"; got != want {
+		t.Errorf("importVerifyReportPath() = %q, want %q", got, want)
+	}
+}
@@ -73,7 +73,7 @@ func TestReverseDNS(t *testing.T) {
 func TestAccProvider_DefaultTags_emptyBlock(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -92,7 +92,7 @@ func TestAccProvider_DefaultTags_emptyBlock(t *testing.T) {
 func TestAccProvider_DefaultTagsTags_none(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -111,7 +111,7 @@ func TestAccProvider_DefaultTagsTags_none(t *testing.T) {
 func TestAccProvider_DefaultTagsTags_one(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -130,7 +130,7 @@ func TestAccProvider_DefaultTagsTags_one(t *testing.T) {
 func TestAccProvider_DefaultTagsTags_multiple(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -152,7 +152,7 @@ func TestAccProvider_DefaultTagsTags_multiple(t *testing.T) {
 func TestAccProvider_DefaultAndIgnoreTags_emptyBlocks(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -179,7 +179,7 @@ func TestAccProvider_endpoints(t *testing.T) {
 		endpoints.WriteString(fmt.Sprintf("%s = \"http://%s\"\n", serviceKey, serviceKey))
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -199,7 +199,7 @@ func TestAccProvider_fipsEndpoint(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(ResourcePrefix)
 	resourceName := "aws_s3_bucket.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: ProviderFactories,
@@ -222,7 +222,7 @@ func TestAccProvider_unusualEndpoints(t *testing.T) {
 	unusual2 := []string{"databasemigration", "dms", "http://alsonotarealendpoint"}
 	unusual3 := []string{"lexmodelbuildingservice", "lexmodels", "http://kingofspain"}
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -241,7 +241,7 @@ func TestAccProvider_unusualEndpoints(t *testing.T) {
 func TestAccProvider_IgnoreTags_emptyBlock(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -261,7 +261,7 @@ func TestAccProvider_IgnoreTags_emptyBlock(t *testing.T) {
 func TestAccProvider_IgnoreTagsKeyPrefixes_none(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -280,7 +280,7 @@ func TestAccProvider_IgnoreTagsKeyPrefixes_none(t *testing.T) {
 func TestAccProvider_IgnoreTagsKeyPrefixes_one(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -299,7 +299,7 @@ func TestAccProvider_IgnoreTagsKeyPrefixes_one(t *testing.T) {
 func TestAccProvider_IgnoreTagsKeyPrefixes_multiple(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -318,7 +318,7 @@ func TestAccProvider_IgnoreTagsKeyPrefixes_multiple(t *testing.T) {
 func TestAccProvider_IgnoreTagsKeys_none(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -337,7 +337,7 @@ func TestAccProvider_IgnoreTagsKeys_none(t *testing.T) {
 func TestAccProvider_IgnoreTagsKeys_one(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -356,7 +356,7 @@ func TestAccProvider_IgnoreTagsKeys_one(t *testing.T) {
 func TestAccProvider_IgnoreTagsKeys_multiple(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -375,7 +375,7 @@ func TestAccProvider_IgnoreTagsKeys_multiple(t *testing.T) {
 func TestAccProvider_Region_c2s(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -397,7 +397,7 @@ func TestAccProvider_Region_c2s(t *testing.T) {
 func TestAccProvider_Region_china(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -419,7 +419,7 @@ func TestAccProvider_Region_china(t *testing.T) {
 func TestAccProvider_Region_commercial(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -441,7 +441,7 @@ func TestAccProvider_Region_commercial(t *testing.T) {
 func TestAccProvider_Region_govCloud(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -463,7 +463,7 @@ func TestAccProvider_Region_govCloud(t *testing.T) {
 func TestAccProvider_Region_sc2s(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -485,7 +485,7 @@ func TestAccProvider_Region_sc2s(t *testing.T) {
 func TestAccProvider_Region_stsRegion(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
@@ -506,7 +506,7 @@ func TestAccProvider_Region_stsRegion(t *testing.T) {
 func TestAccProvider_AssumeRole_empty(t *testing.T) {
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { PreCheck(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
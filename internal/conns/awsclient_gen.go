@@ -8,304 +8,603 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer/accessanalyzeriface"
 	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/aws/aws-sdk-go/service/account/accountiface"
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
 	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/acmpca/acmpcaiface"
 	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
+	"github.com/aws/aws-sdk-go/service/alexaforbusiness/alexaforbusinessiface"
 	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/amplify/amplifyiface"
 	"github.com/aws/aws-sdk-go/service/amplifybackend"
+	"github.com/aws/aws-sdk-go/service/amplifybackend/amplifybackendiface"
 	"github.com/aws/aws-sdk-go/service/amplifyuibuilder"
+	"github.com/aws/aws-sdk-go/service/amplifyuibuilder/amplifyuibuilderiface"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/aws/aws-sdk-go/service/apigatewaymanagementapi"
+	"github.com/aws/aws-sdk-go/service/apigatewaymanagementapi/apigatewaymanagementapiiface"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
 	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/appconfig/appconfigiface"
 	"github.com/aws/aws-sdk-go/service/appconfigdata"
+	"github.com/aws/aws-sdk-go/service/appconfigdata/appconfigdataiface"
 	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/aws/aws-sdk-go/service/appflow/appflowiface"
 	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice/appintegrationsserviceiface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/applicationcostprofiler"
+	"github.com/aws/aws-sdk-go/service/applicationcostprofiler/applicationcostprofileriface"
 	"github.com/aws/aws-sdk-go/service/applicationdiscoveryservice"
+	"github.com/aws/aws-sdk-go/service/applicationdiscoveryservice/applicationdiscoveryserviceiface"
 	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/aws/aws-sdk-go/service/applicationinsights/applicationinsightsiface"
 	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appmesh/appmeshiface"
 	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/aws/aws-sdk-go/service/appregistry/appregistryiface"
 	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/aws-sdk-go/service/apprunner/apprunneriface"
 	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appstream/appstreamiface"
 	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/appsync/appsynciface"
 	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/athena/athenaiface"
 	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/aws/aws-sdk-go/service/auditmanager/auditmanageriface"
 	"github.com/aws/aws-sdk-go/service/augmentedairuntime"
+	"github.com/aws/aws-sdk-go/service/augmentedairuntime/augmentedairuntimeiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/autoscalingplans/autoscalingplansiface"
 	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/backup/backupiface"
 	"github.com/aws/aws-sdk-go/service/backupgateway"
+	"github.com/aws/aws-sdk-go/service/backupgateway/backupgatewayiface"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
 	"github.com/aws/aws-sdk-go/service/billingconductor"
+	"github.com/aws/aws-sdk-go/service/billingconductor/billingconductoriface"
 	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/aws/aws-sdk-go/service/braket/braketiface"
 	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/budgets/budgetsiface"
 	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/chime/chimeiface"
 	"github.com/aws/aws-sdk-go/service/chimesdkidentity"
+	"github.com/aws/aws-sdk-go/service/chimesdkidentity/chimesdkidentityiface"
 	"github.com/aws/aws-sdk-go/service/chimesdkmeetings"
+	"github.com/aws/aws-sdk-go/service/chimesdkmeetings/chimesdkmeetingsiface"
 	"github.com/aws/aws-sdk-go/service/chimesdkmessaging"
+	"github.com/aws/aws-sdk-go/service/chimesdkmessaging/chimesdkmessagingiface"
 	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloud9/cloud9iface"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi/cloudcontrolapiiface"
 	"github.com/aws/aws-sdk-go/service/clouddirectory"
+	"github.com/aws/aws-sdk-go/service/clouddirectory/clouddirectoryiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2/cloudhsmv2iface"
 	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudsearch/cloudsearchiface"
 	"github.com/aws/aws-sdk-go/service/cloudsearchdomain"
+	"github.com/aws/aws-sdk-go/service/cloudsearchdomain/cloudsearchdomainiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently/cloudwatchevidentlyiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatchrum"
+	"github.com/aws/aws-sdk-go/service/cloudwatchrum/cloudwatchrumiface"
 	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/aws/aws-sdk-go/service/codeartifact/codeartifactiface"
 	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codebuild/codebuildiface"
 	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codecommit/codecommitiface"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codedeploy/codedeployiface"
 	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	"github.com/aws/aws-sdk-go/service/codeguruprofiler/codeguruprofileriface"
 	"github.com/aws/aws-sdk-go/service/codegurureviewer"
+	"github.com/aws/aws-sdk-go/service/codegurureviewer/codegururevieweriface"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codepipeline/codepipelineiface"
 	"github.com/aws/aws-sdk-go/service/codestar"
+	"github.com/aws/aws-sdk-go/service/codestar/codestariface"
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/aws-sdk-go/service/codestarconnections/codestarconnectionsiface"
 	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications/codestarnotificationsiface"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity/cognitoidentityiface"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
 	"github.com/aws/aws-sdk-go/service/cognitosync"
+	"github.com/aws/aws-sdk-go/service/cognitosync/cognitosynciface"
 	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/aws/aws-sdk-go/service/comprehend/comprehendiface"
 	"github.com/aws/aws-sdk-go/service/comprehendmedical"
+	"github.com/aws/aws-sdk-go/service/comprehendmedical/comprehendmedicaliface"
 	"github.com/aws/aws-sdk-go/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go/service/computeoptimizer/computeoptimizeriface"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/configservice/configserviceiface"
 	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/connect/connectiface"
 	"github.com/aws/aws-sdk-go/service/connectcontactlens"
+	"github.com/aws/aws-sdk-go/service/connectcontactlens/connectcontactlensiface"
 	"github.com/aws/aws-sdk-go/service/connectparticipant"
+	"github.com/aws/aws-sdk-go/service/connectparticipant/connectparticipantiface"
 	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice/connectwisdomserviceiface"
 	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice/costandusagereportserviceiface"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/aws-sdk-go/service/costexplorer/costexploreriface"
 	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/aws/aws-sdk-go/service/customerprofiles/customerprofilesiface"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice/databasemigrationserviceiface"
 	"github.com/aws/aws-sdk-go/service/dataexchange"
+	"github.com/aws/aws-sdk-go/service/dataexchange/dataexchangeiface"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datapipeline/datapipelineiface"
 	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/datasync/datasynciface"
 	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/dax/daxiface"
 	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/aws/aws-sdk-go/service/detective/detectiveiface"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/devicefarm/devicefarmiface"
 	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/aws/aws-sdk-go/service/devopsguru/devopsguruiface"
 	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directconnect/directconnectiface"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/directoryservice/directoryserviceiface"
 	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/dlm/dlmiface"
 	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/docdb/docdbiface"
 	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/aws/aws-sdk-go/service/drs/drsiface"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams/dynamodbstreamsiface"
 	"github.com/aws/aws-sdk-go/service/ebs"
+	"github.com/aws/aws-sdk-go/service/ebs/ebsiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ec2instanceconnect"
+	"github.com/aws/aws-sdk-go/service/ec2instanceconnect/ec2instanceconnectiface"
 	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/aws/aws-sdk-go/service/ecrpublic/ecrpubliciface"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
 	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
 	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
 	"github.com/aws/aws-sdk-go/service/elasticinference"
+	"github.com/aws/aws-sdk-go/service/elasticinference/elasticinferenceiface"
 	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
 	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder/elastictranscoderiface"
 	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emr/emriface"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/aws/aws-sdk-go/service/emrcontainers/emrcontainersiface"
 	"github.com/aws/aws-sdk-go/service/emrserverless"
+	"github.com/aws/aws-sdk-go/service/emrserverless/emrserverlessiface"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
 	"github.com/aws/aws-sdk-go/service/finspace"
+	"github.com/aws/aws-sdk-go/service/finspace/finspaceiface"
 	"github.com/aws/aws-sdk-go/service/finspacedata"
+	"github.com/aws/aws-sdk-go/service/finspacedata/finspacedataiface"
 	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/firehose/firehoseiface"
 	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/aws/aws-sdk-go/service/fis/fisiface"
 	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/fms/fmsiface"
 	"github.com/aws/aws-sdk-go/service/forecastqueryservice"
+	"github.com/aws/aws-sdk-go/service/forecastqueryservice/forecastqueryserviceiface"
 	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/aws/aws-sdk-go/service/forecastservice/forecastserviceiface"
 	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/aws/aws-sdk-go/service/frauddetector/frauddetectoriface"
 	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/fsx/fsxiface"
 	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/gamelift/gameliftiface"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/glacier/glacieriface"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator/globalacceleratoriface"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/glue/glueiface"
 	"github.com/aws/aws-sdk-go/service/gluedatabrew"
+	"github.com/aws/aws-sdk-go/service/gluedatabrew/gluedatabrewiface"
 	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/aws/aws-sdk-go/service/greengrass/greengrassiface"
 	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/aws/aws-sdk-go/service/greengrassv2/greengrassv2iface"
 	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/aws/aws-sdk-go/service/groundstation/groundstationiface"
 	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/guardduty/guarddutyiface"
 	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/aws/aws-sdk-go/service/health/healthiface"
 	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/aws/aws-sdk-go/service/healthlake/healthlakeiface"
 	"github.com/aws/aws-sdk-go/service/honeycode"
+	"github.com/aws/aws-sdk-go/service/honeycode/honeycodeiface"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/identitystore/identitystoreiface"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/imagebuilder/imagebuilderiface"
 	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/inspector/inspectoriface"
 	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/aws/aws-sdk-go/service/inspector2/inspector2iface"
 	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iot/iotiface"
 	"github.com/aws/aws-sdk-go/service/iot1clickdevicesservice"
+	"github.com/aws/aws-sdk-go/service/iot1clickdevicesservice/iot1clickdevicesserviceiface"
 	"github.com/aws/aws-sdk-go/service/iot1clickprojects"
+	"github.com/aws/aws-sdk-go/service/iot1clickprojects/iot1clickprojectsiface"
 	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/aws/aws-sdk-go/service/iotanalytics/iotanalyticsiface"
 	"github.com/aws/aws-sdk-go/service/iotdataplane"
+	"github.com/aws/aws-sdk-go/service/iotdataplane/iotdataplaneiface"
 	"github.com/aws/aws-sdk-go/service/iotdeviceadvisor"
+	"github.com/aws/aws-sdk-go/service/iotdeviceadvisor/iotdeviceadvisoriface"
 	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/aws/aws-sdk-go/service/iotevents/ioteventsiface"
 	"github.com/aws/aws-sdk-go/service/ioteventsdata"
+	"github.com/aws/aws-sdk-go/service/ioteventsdata/ioteventsdataiface"
 	"github.com/aws/aws-sdk-go/service/iotfleethub"
+	"github.com/aws/aws-sdk-go/service/iotfleethub/iotfleethubiface"
 	"github.com/aws/aws-sdk-go/service/iotjobsdataplane"
+	"github.com/aws/aws-sdk-go/service/iotjobsdataplane/iotjobsdataplaneiface"
 	"github.com/aws/aws-sdk-go/service/iotsecuretunneling"
+	"github.com/aws/aws-sdk-go/service/iotsecuretunneling/iotsecuretunnelingiface"
 	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/aws/aws-sdk-go/service/iotsitewise/iotsitewiseiface"
 	"github.com/aws/aws-sdk-go/service/iotthingsgraph"
+	"github.com/aws/aws-sdk-go/service/iotthingsgraph/iotthingsgraphiface"
 	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker/iottwinmakeriface"
 	"github.com/aws/aws-sdk-go/service/iotwireless"
+	"github.com/aws/aws-sdk-go/service/iotwireless/iotwirelessiface"
 	"github.com/aws/aws-sdk-go/service/ivs"
+	"github.com/aws/aws-sdk-go/service/ivs/ivsiface"
 	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/aws/aws-sdk-go/service/kafkaconnect/kafkaconnectiface"
 	"github.com/aws/aws-sdk-go/service/keyspaces"
+	"github.com/aws/aws-sdk-go/service/keyspaces/keyspacesiface"
 	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
 	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics/kinesisanalyticsiface"
 	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2/kinesisanalyticsv2iface"
 	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo/kinesisvideoiface"
 	"github.com/aws/aws-sdk-go/service/kinesisvideoarchivedmedia"
+	"github.com/aws/aws-sdk-go/service/kinesisvideoarchivedmedia/kinesisvideoarchivedmediaiface"
 	"github.com/aws/aws-sdk-go/service/kinesisvideomedia"
+	"github.com/aws/aws-sdk-go/service/kinesisvideomedia/kinesisvideomediaiface"
 	"github.com/aws/aws-sdk-go/service/kinesisvideosignalingchannels"
+	"github.com/aws/aws-sdk-go/service/kinesisvideosignalingchannels/kinesisvideosignalingchannelsiface"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/aws/aws-sdk-go/service/lakeformation/lakeformationiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice/lexmodelbuildingserviceiface"
 	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2/lexmodelsv2iface"
 	"github.com/aws/aws-sdk-go/service/lexruntimeservice"
+	"github.com/aws/aws-sdk-go/service/lexruntimeservice/lexruntimeserviceiface"
 	"github.com/aws/aws-sdk-go/service/lexruntimev2"
+	"github.com/aws/aws-sdk-go/service/lexruntimev2/lexruntimev2iface"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/licensemanager/licensemanageriface"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/lightsail/lightsailiface"
 	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/aws/aws-sdk-go/service/locationservice/locationserviceiface"
 	"github.com/aws/aws-sdk-go/service/lookoutequipment"
+	"github.com/aws/aws-sdk-go/service/lookoutequipment/lookoutequipmentiface"
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
+	"github.com/aws/aws-sdk-go/service/lookoutforvision/lookoutforvisioniface"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
+	"github.com/aws/aws-sdk-go/service/lookoutmetrics/lookoutmetricsiface"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
+	"github.com/aws/aws-sdk-go/service/machinelearning/machinelearningiface"
 	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/macie/macieiface"
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/macie2/macie2iface"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/managedblockchain/managedblockchainiface"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/aws/aws-sdk-go/service/managedgrafana/managedgrafanaiface"
 	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog/marketplacecatalogiface"
 	"github.com/aws/aws-sdk-go/service/marketplacecommerceanalytics"
+	"github.com/aws/aws-sdk-go/service/marketplacecommerceanalytics/marketplacecommerceanalyticsiface"
 	"github.com/aws/aws-sdk-go/service/marketplaceentitlementservice"
+	"github.com/aws/aws-sdk-go/service/marketplaceentitlementservice/marketplaceentitlementserviceiface"
 	"github.com/aws/aws-sdk-go/service/marketplacemetering"
+	"github.com/aws/aws-sdk-go/service/marketplacemetering/marketplacemeteringiface"
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconnect/mediaconnectiface"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/mediaconvert/mediaconvertiface"
 	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/medialive/medialiveiface"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediapackage/mediapackageiface"
 	"github.com/aws/aws-sdk-go/service/mediapackagevod"
+	"github.com/aws/aws-sdk-go/service/mediapackagevod/mediapackagevodiface"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastore/mediastoreiface"
 	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/mediastoredata/mediastoredataiface"
 	"github.com/aws/aws-sdk-go/service/mediatailor"
+	"github.com/aws/aws-sdk-go/service/mediatailor/mediatailoriface"
 	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/aws/aws-sdk-go/service/memorydb/memorydbiface"
 	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/aws/aws-sdk-go/service/mgn/mgniface"
 	"github.com/aws/aws-sdk-go/service/migrationhub"
+	"github.com/aws/aws-sdk-go/service/migrationhub/migrationhubiface"
 	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
+	"github.com/aws/aws-sdk-go/service/migrationhubconfig/migrationhubconfigiface"
 	"github.com/aws/aws-sdk-go/service/migrationhubrefactorspaces"
+	"github.com/aws/aws-sdk-go/service/migrationhubrefactorspaces/migrationhubrefactorspacesiface"
 	"github.com/aws/aws-sdk-go/service/migrationhubstrategyrecommendations"
+	"github.com/aws/aws-sdk-go/service/migrationhubstrategyrecommendations/migrationhubstrategyrecommendationsiface"
 	"github.com/aws/aws-sdk-go/service/mobile"
+	"github.com/aws/aws-sdk-go/service/mobile/mobileiface"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mq/mqiface"
 	"github.com/aws/aws-sdk-go/service/mturk"
+	"github.com/aws/aws-sdk-go/service/mturk/mturkiface"
 	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/mwaa/mwaaiface"
 	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/neptune/neptuneiface"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkfirewall/networkfirewalliface"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/aws/aws-sdk-go/service/networkmanager/networkmanageriface"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/nimblestudio/nimblestudioiface"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/aws/aws-sdk-go/service/opensearchservice/opensearchserviceiface"
 	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/opsworks/opsworksiface"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
+	"github.com/aws/aws-sdk-go/service/opsworkscm/opsworkscmiface"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
 	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/outposts/outpostsiface"
 	"github.com/aws/aws-sdk-go/service/panorama"
+	"github.com/aws/aws-sdk-go/service/panorama/panoramaiface"
 	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/aws/aws-sdk-go/service/personalize/personalizeiface"
 	"github.com/aws/aws-sdk-go/service/personalizeevents"
+	"github.com/aws/aws-sdk-go/service/personalizeevents/personalizeeventsiface"
 	"github.com/aws/aws-sdk-go/service/personalizeruntime"
+	"github.com/aws/aws-sdk-go/service/personalizeruntime/personalizeruntimeiface"
 	"github.com/aws/aws-sdk-go/service/pi"
+	"github.com/aws/aws-sdk-go/service/pi/piiface"
 	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pinpoint/pinpointiface"
 	"github.com/aws/aws-sdk-go/service/pinpointemail"
+	"github.com/aws/aws-sdk-go/service/pinpointemail/pinpointemailiface"
 	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice/pinpointsmsvoiceiface"
 	"github.com/aws/aws-sdk-go/service/polly"
+	"github.com/aws/aws-sdk-go/service/polly/pollyiface"
 	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/pricing/pricingiface"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/aws/aws-sdk-go/service/prometheusservice/prometheusserviceiface"
 	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/aws/aws-sdk-go/service/proton/protoniface"
 	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/qldb/qldbiface"
 	"github.com/aws/aws-sdk-go/service/qldbsession"
+	"github.com/aws/aws-sdk-go/service/qldbsession/qldbsessioniface"
 	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/quicksight/quicksightiface"
 	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/ram/ramiface"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/rdsdataservice"
+	"github.com/aws/aws-sdk-go/service/rdsdataservice/rdsdataserviceiface"
 	"github.com/aws/aws-sdk-go/service/recyclebin"
+	"github.com/aws/aws-sdk-go/service/recyclebin/recyclebiniface"
 	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
 	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice"
+	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice/redshiftdataapiserviceiface"
 	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/aws/aws-sdk-go/service/rekognition/rekognitioniface"
 	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/aws/aws-sdk-go/service/resiliencehub/resiliencehubiface"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/resourcegroups/resourcegroupsiface"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
 	"github.com/aws/aws-sdk-go/service/robomaker"
+	"github.com/aws/aws-sdk-go/service/robomaker/robomakeriface"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/route53recoverycluster"
+	"github.com/aws/aws-sdk-go/service/route53recoverycluster/route53recoveryclusteriface"
 	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig/route53recoverycontrolconfigiface"
 	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness/route53recoveryreadinessiface"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/route53resolver/route53resolveriface"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3control/s3controliface"
 	"github.com/aws/aws-sdk-go/service/s3outposts"
+	"github.com/aws/aws-sdk-go/service/s3outposts/s3outpostsiface"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sagemaker/sagemakeriface"
 	"github.com/aws/aws-sdk-go/service/sagemakeredgemanager"
+	"github.com/aws/aws-sdk-go/service/sagemakeredgemanager/sagemakeredgemanageriface"
 	"github.com/aws/aws-sdk-go/service/sagemakerfeaturestoreruntime"
+	"github.com/aws/aws-sdk-go/service/sagemakerfeaturestoreruntime/sagemakerfeaturestoreruntimeiface"
 	"github.com/aws/aws-sdk-go/service/sagemakerruntime"
+	"github.com/aws/aws-sdk-go/service/sagemakerruntime/sagemakerruntimeiface"
 	"github.com/aws/aws-sdk-go/service/savingsplans"
+	"github.com/aws/aws-sdk-go/service/savingsplans/savingsplansiface"
 	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/aws/aws-sdk-go/service/schemas/schemasiface"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/securityhub/securityhubiface"
 	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository/serverlessapplicationrepositoryiface"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicecatalog/servicecatalogiface"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
 	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/ses/sesiface"
 	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/aws/aws-sdk-go/service/sesv2/sesv2iface"
 	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/shield/shieldiface"
 	"github.com/aws/aws-sdk-go/service/signer"
+	"github.com/aws/aws-sdk-go/service/signer/signeriface"
 	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/simpledb/simpledbiface"
 	"github.com/aws/aws-sdk-go/service/sms"
+	"github.com/aws/aws-sdk-go/service/sms/smsiface"
 	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/aws/aws-sdk-go/service/snowball/snowballiface"
 	"github.com/aws/aws-sdk-go/service/snowdevicemanagement"
+	"github.com/aws/aws-sdk-go/service/snowdevicemanagement/snowdevicemanagementiface"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts/ssmcontactsiface"
 	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/aws/aws-sdk-go/service/ssmincidents/ssmincidentsiface"
 	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sso/ssoiface"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/ssoadmin/ssoadminiface"
 	"github.com/aws/aws-sdk-go/service/ssooidc"
+	"github.com/aws/aws-sdk-go/service/ssooidc/ssooidciface"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/storagegateway/storagegatewayiface"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/aws/aws-sdk-go/service/support"
+	"github.com/aws/aws-sdk-go/service/support/supportiface"
 	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/swf/swfiface"
 	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/synthetics/syntheticsiface"
 	"github.com/aws/aws-sdk-go/service/textract"
+	"github.com/aws/aws-sdk-go/service/textract/textractiface"
 	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/aws/aws-sdk-go/service/timestreamquery/timestreamqueryiface"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite/timestreamwriteiface"
 	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/aws/aws-sdk-go/service/transcribeservice/transcribeserviceiface"
 	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
+	"github.com/aws/aws-sdk-go/service/transcribestreamingservice/transcribestreamingserviceiface"
 	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/transfer/transferiface"
 	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/aws/aws-sdk-go/service/translate/translateiface"
 	"github.com/aws/aws-sdk-go/service/voiceid"
+	"github.com/aws/aws-sdk-go/service/voiceid/voiceidiface"
 	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/waf/wafiface"
 	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafregional/wafregionaliface"
 	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 	"github.com/aws/aws-sdk-go/service/wellarchitected"
+	"github.com/aws/aws-sdk-go/service/wellarchitected/wellarchitectediface"
 	"github.com/aws/aws-sdk-go/service/workdocs"
+	"github.com/aws/aws-sdk-go/service/workdocs/workdocsiface"
 	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/worklink/worklinkiface"
 	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/aws/aws-sdk-go/service/workmail/workmailiface"
 	"github.com/aws/aws-sdk-go/service/workmailmessageflow"
+	"github.com/aws/aws-sdk-go/service/workmailmessageflow/workmailmessageflowiface"
 	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/workspaces/workspacesiface"
 	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/aws/aws-sdk-go/service/workspacesweb/workspaceswebiface"
 	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/aws/aws-sdk-go/service/xray/xrayiface"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
@@ -315,6 +614,7 @@ type AWSClient struct {
 	IgnoreTagsConfig          *tftags.IgnoreConfig
 	MediaConvertAccountConn   *mediaconvert.MediaConvert
 	Partition                 string
+	ReadCache                 *ReadCache
 	Region                    string
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
@@ -622,6 +922,8 @@ type AWSClient struct {
 	WorkSpacesConn                   *workspaces.WorkSpaces
 	WorkSpacesWebConn                *workspacesweb.WorkSpacesWeb
 	XRayConn                         *xray.XRay
+
+	fakes map[string]interface{}
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -637,3 +939,3588 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// implementsSDKv1API returns whether v implements the AWS SDK for Go v1 API interface of the specified service, e.g. names.SQS.
+// ok is false if the service is unknown or uses AWS SDK for Go v2.
+func implementsSDKv1API(service string, v interface{}) (implements bool, ok bool) {
+	switch service {
+	case names.ACM:
+		_, implements = v.(acmiface.ACMAPI)
+		return implements, true
+	case names.ACMPCA:
+		_, implements = v.(acmpcaiface.ACMPCAAPI)
+		return implements, true
+	case names.AMP:
+		_, implements = v.(prometheusserviceiface.PrometheusServiceAPI)
+		return implements, true
+	case names.APIGateway:
+		_, implements = v.(apigatewayiface.APIGatewayAPI)
+		return implements, true
+	case names.APIGatewayManagementAPI:
+		_, implements = v.(apigatewaymanagementapiiface.ApiGatewayManagementApiAPI)
+		return implements, true
+	case names.APIGatewayV2:
+		_, implements = v.(apigatewayv2iface.ApiGatewayV2API)
+		return implements, true
+	case names.AccessAnalyzer:
+		_, implements = v.(accessanalyzeriface.AccessAnalyzerAPI)
+		return implements, true
+	case names.Account:
+		_, implements = v.(accountiface.AccountAPI)
+		return implements, true
+	case names.AlexaForBusiness:
+		_, implements = v.(alexaforbusinessiface.AlexaForBusinessAPI)
+		return implements, true
+	case names.Amplify:
+		_, implements = v.(amplifyiface.AmplifyAPI)
+		return implements, true
+	case names.AmplifyBackend:
+		_, implements = v.(amplifybackendiface.AmplifyBackendAPI)
+		return implements, true
+	case names.AmplifyUIBuilder:
+		_, implements = v.(amplifyuibuilderiface.AmplifyUIBuilderAPI)
+		return implements, true
+	case names.AppAutoScaling:
+		_, implements = v.(applicationautoscalingiface.ApplicationAutoScalingAPI)
+		return implements, true
+	case names.AppConfig:
+		_, implements = v.(appconfigiface.AppConfigAPI)
+		return implements, true
+	case names.AppConfigData:
+		_, implements = v.(appconfigdataiface.AppConfigDataAPI)
+		return implements, true
+	case names.AppFlow:
+		_, implements = v.(appflowiface.AppflowAPI)
+		return implements, true
+	case names.AppIntegrations:
+		_, implements = v.(appintegrationsserviceiface.AppIntegrationsServiceAPI)
+		return implements, true
+	case names.AppMesh:
+		_, implements = v.(appmeshiface.AppMeshAPI)
+		return implements, true
+	case names.AppRunner:
+		_, implements = v.(apprunneriface.AppRunnerAPI)
+		return implements, true
+	case names.AppStream:
+		_, implements = v.(appstreamiface.AppStreamAPI)
+		return implements, true
+	case names.AppSync:
+		_, implements = v.(appsynciface.AppSyncAPI)
+		return implements, true
+	case names.ApplicationCostProfiler:
+		_, implements = v.(applicationcostprofileriface.ApplicationCostProfilerAPI)
+		return implements, true
+	case names.ApplicationInsights:
+		_, implements = v.(applicationinsightsiface.ApplicationInsightsAPI)
+		return implements, true
+	case names.Athena:
+		_, implements = v.(athenaiface.AthenaAPI)
+		return implements, true
+	case names.AuditManager:
+		_, implements = v.(auditmanageriface.AuditManagerAPI)
+		return implements, true
+	case names.AutoScaling:
+		_, implements = v.(autoscalingiface.AutoScalingAPI)
+		return implements, true
+	case names.AutoScalingPlans:
+		_, implements = v.(autoscalingplansiface.AutoScalingPlansAPI)
+		return implements, true
+	case names.Backup:
+		_, implements = v.(backupiface.BackupAPI)
+		return implements, true
+	case names.BackupGateway:
+		_, implements = v.(backupgatewayiface.BackupGatewayAPI)
+		return implements, true
+	case names.Batch:
+		_, implements = v.(batchiface.BatchAPI)
+		return implements, true
+	case names.BillingConductor:
+		_, implements = v.(billingconductoriface.BillingConductorAPI)
+		return implements, true
+	case names.Braket:
+		_, implements = v.(braketiface.BraketAPI)
+		return implements, true
+	case names.Budgets:
+		_, implements = v.(budgetsiface.BudgetsAPI)
+		return implements, true
+	case names.CE:
+		_, implements = v.(costexploreriface.CostExplorerAPI)
+		return implements, true
+	case names.CUR:
+		_, implements = v.(costandusagereportserviceiface.CostandUsageReportServiceAPI)
+		return implements, true
+	case names.Chime:
+		_, implements = v.(chimeiface.ChimeAPI)
+		return implements, true
+	case names.ChimeSDKIdentity:
+		_, implements = v.(chimesdkidentityiface.ChimeSDKIdentityAPI)
+		return implements, true
+	case names.ChimeSDKMeetings:
+		_, implements = v.(chimesdkmeetingsiface.ChimeSDKMeetingsAPI)
+		return implements, true
+	case names.ChimeSDKMessaging:
+		_, implements = v.(chimesdkmessagingiface.ChimeSDKMessagingAPI)
+		return implements, true
+	case names.Cloud9:
+		_, implements = v.(cloud9iface.Cloud9API)
+		return implements, true
+	case names.CloudControl:
+		_, implements = v.(cloudcontrolapiiface.CloudControlApiAPI)
+		return implements, true
+	case names.CloudDirectory:
+		_, implements = v.(clouddirectoryiface.CloudDirectoryAPI)
+		return implements, true
+	case names.CloudFormation:
+		_, implements = v.(cloudformationiface.CloudFormationAPI)
+		return implements, true
+	case names.CloudFront:
+		_, implements = v.(cloudfrontiface.CloudFrontAPI)
+		return implements, true
+	case names.CloudHSMV2:
+		_, implements = v.(cloudhsmv2iface.CloudHSMV2API)
+		return implements, true
+	case names.CloudSearch:
+		_, implements = v.(cloudsearchiface.CloudSearchAPI)
+		return implements, true
+	case names.CloudSearchDomain:
+		_, implements = v.(cloudsearchdomainiface.CloudSearchDomainAPI)
+		return implements, true
+	case names.CloudTrail:
+		_, implements = v.(cloudtrailiface.CloudTrailAPI)
+		return implements, true
+	case names.CloudWatch:
+		_, implements = v.(cloudwatchiface.CloudWatchAPI)
+		return implements, true
+	case names.CodeArtifact:
+		_, implements = v.(codeartifactiface.CodeArtifactAPI)
+		return implements, true
+	case names.CodeBuild:
+		_, implements = v.(codebuildiface.CodeBuildAPI)
+		return implements, true
+	case names.CodeCommit:
+		_, implements = v.(codecommitiface.CodeCommitAPI)
+		return implements, true
+	case names.CodeGuruProfiler:
+		_, implements = v.(codeguruprofileriface.CodeGuruProfilerAPI)
+		return implements, true
+	case names.CodeGuruReviewer:
+		_, implements = v.(codegururevieweriface.CodeGuruReviewerAPI)
+		return implements, true
+	case names.CodePipeline:
+		_, implements = v.(codepipelineiface.CodePipelineAPI)
+		return implements, true
+	case names.CodeStar:
+		_, implements = v.(codestariface.CodeStarAPI)
+		return implements, true
+	case names.CodeStarConnections:
+		_, implements = v.(codestarconnectionsiface.CodeStarConnectionsAPI)
+		return implements, true
+	case names.CodeStarNotifications:
+		_, implements = v.(codestarnotificationsiface.CodeStarNotificationsAPI)
+		return implements, true
+	case names.CognitoIDP:
+		_, implements = v.(cognitoidentityprovideriface.CognitoIdentityProviderAPI)
+		return implements, true
+	case names.CognitoIdentity:
+		_, implements = v.(cognitoidentityiface.CognitoIdentityAPI)
+		return implements, true
+	case names.CognitoSync:
+		_, implements = v.(cognitosynciface.CognitoSyncAPI)
+		return implements, true
+	case names.Comprehend:
+		_, implements = v.(comprehendiface.ComprehendAPI)
+		return implements, true
+	case names.ComprehendMedical:
+		_, implements = v.(comprehendmedicaliface.ComprehendMedicalAPI)
+		return implements, true
+	case names.ComputeOptimizer:
+		_, implements = v.(computeoptimizeriface.ComputeOptimizerAPI)
+		return implements, true
+	case names.ConfigService:
+		_, implements = v.(configserviceiface.ConfigServiceAPI)
+		return implements, true
+	case names.Connect:
+		_, implements = v.(connectiface.ConnectAPI)
+		return implements, true
+	case names.ConnectContactLens:
+		_, implements = v.(connectcontactlensiface.ConnectContactLensAPI)
+		return implements, true
+	case names.ConnectParticipant:
+		_, implements = v.(connectparticipantiface.ConnectParticipantAPI)
+		return implements, true
+	case names.CustomerProfiles:
+		_, implements = v.(customerprofilesiface.CustomerProfilesAPI)
+		return implements, true
+	case names.DAX:
+		_, implements = v.(daxiface.DAXAPI)
+		return implements, true
+	case names.DLM:
+		_, implements = v.(dlmiface.DLMAPI)
+		return implements, true
+	case names.DMS:
+		_, implements = v.(databasemigrationserviceiface.DatabaseMigrationServiceAPI)
+		return implements, true
+	case names.DRS:
+		_, implements = v.(drsiface.DrsAPI)
+		return implements, true
+	case names.DS:
+		_, implements = v.(directoryserviceiface.DirectoryServiceAPI)
+		return implements, true
+	case names.DataBrew:
+		_, implements = v.(gluedatabrewiface.GlueDataBrewAPI)
+		return implements, true
+	case names.DataExchange:
+		_, implements = v.(dataexchangeiface.DataExchangeAPI)
+		return implements, true
+	case names.DataPipeline:
+		_, implements = v.(datapipelineiface.DataPipelineAPI)
+		return implements, true
+	case names.DataSync:
+		_, implements = v.(datasynciface.DataSyncAPI)
+		return implements, true
+	case names.Deploy:
+		_, implements = v.(codedeployiface.CodeDeployAPI)
+		return implements, true
+	case names.Detective:
+		_, implements = v.(detectiveiface.DetectiveAPI)
+		return implements, true
+	case names.DevOpsGuru:
+		_, implements = v.(devopsguruiface.DevOpsGuruAPI)
+		return implements, true
+	case names.DeviceFarm:
+		_, implements = v.(devicefarmiface.DeviceFarmAPI)
+		return implements, true
+	case names.DirectConnect:
+		_, implements = v.(directconnectiface.DirectConnectAPI)
+		return implements, true
+	case names.Discovery:
+		_, implements = v.(applicationdiscoveryserviceiface.ApplicationDiscoveryServiceAPI)
+		return implements, true
+	case names.DocDB:
+		_, implements = v.(docdbiface.DocDBAPI)
+		return implements, true
+	case names.DynamoDB:
+		_, implements = v.(dynamodbiface.DynamoDBAPI)
+		return implements, true
+	case names.DynamoDBStreams:
+		_, implements = v.(dynamodbstreamsiface.DynamoDBStreamsAPI)
+		return implements, true
+	case names.EBS:
+		_, implements = v.(ebsiface.EBSAPI)
+		return implements, true
+	case names.EC2:
+		_, implements = v.(ec2iface.EC2API)
+		return implements, true
+	case names.EC2InstanceConnect:
+		_, implements = v.(ec2instanceconnectiface.EC2InstanceConnectAPI)
+		return implements, true
+	case names.ECR:
+		_, implements = v.(ecriface.ECRAPI)
+		return implements, true
+	case names.ECRPublic:
+		_, implements = v.(ecrpubliciface.ECRPublicAPI)
+		return implements, true
+	case names.ECS:
+		_, implements = v.(ecsiface.ECSAPI)
+		return implements, true
+	case names.EFS:
+		_, implements = v.(efsiface.EFSAPI)
+		return implements, true
+	case names.EKS:
+		_, implements = v.(eksiface.EKSAPI)
+		return implements, true
+	case names.ELB:
+		_, implements = v.(elbiface.ELBAPI)
+		return implements, true
+	case names.ELBV2:
+		_, implements = v.(elbv2iface.ELBV2API)
+		return implements, true
+	case names.EMR:
+		_, implements = v.(emriface.EMRAPI)
+		return implements, true
+	case names.EMRContainers:
+		_, implements = v.(emrcontainersiface.EMRContainersAPI)
+		return implements, true
+	case names.EMRServerless:
+		_, implements = v.(emrserverlessiface.EMRServerlessAPI)
+		return implements, true
+	case names.ElastiCache:
+		_, implements = v.(elasticacheiface.ElastiCacheAPI)
+		return implements, true
+	case names.ElasticBeanstalk:
+		_, implements = v.(elasticbeanstalkiface.ElasticBeanstalkAPI)
+		return implements, true
+	case names.ElasticInference:
+		_, implements = v.(elasticinferenceiface.ElasticInferenceAPI)
+		return implements, true
+	case names.ElasticTranscoder:
+		_, implements = v.(elastictranscoderiface.ElasticTranscoderAPI)
+		return implements, true
+	case names.Elasticsearch:
+		_, implements = v.(elasticsearchserviceiface.ElasticsearchServiceAPI)
+		return implements, true
+	case names.Events:
+		_, implements = v.(eventbridgeiface.EventBridgeAPI)
+		return implements, true
+	case names.Evidently:
+		_, implements = v.(cloudwatchevidentlyiface.CloudWatchEvidentlyAPI)
+		return implements, true
+	case names.FIS:
+		_, implements = v.(fisiface.FISAPI)
+		return implements, true
+	case names.FMS:
+		_, implements = v.(fmsiface.FMSAPI)
+		return implements, true
+	case names.FSx:
+		_, implements = v.(fsxiface.FSxAPI)
+		return implements, true
+	case names.FinSpace:
+		_, implements = v.(finspaceiface.FinspaceAPI)
+		return implements, true
+	case names.FinSpaceData:
+		_, implements = v.(finspacedataiface.FinSpaceDataAPI)
+		return implements, true
+	case names.Firehose:
+		_, implements = v.(firehoseiface.FirehoseAPI)
+		return implements, true
+	case names.Forecast:
+		_, implements = v.(forecastserviceiface.ForecastServiceAPI)
+		return implements, true
+	case names.ForecastQuery:
+		_, implements = v.(forecastqueryserviceiface.ForecastQueryServiceAPI)
+		return implements, true
+	case names.FraudDetector:
+		_, implements = v.(frauddetectoriface.FraudDetectorAPI)
+		return implements, true
+	case names.GameLift:
+		_, implements = v.(gameliftiface.GameLiftAPI)
+		return implements, true
+	case names.Glacier:
+		_, implements = v.(glacieriface.GlacierAPI)
+		return implements, true
+	case names.GlobalAccelerator:
+		_, implements = v.(globalacceleratoriface.GlobalAcceleratorAPI)
+		return implements, true
+	case names.Glue:
+		_, implements = v.(glueiface.GlueAPI)
+		return implements, true
+	case names.Grafana:
+		_, implements = v.(managedgrafanaiface.ManagedGrafanaAPI)
+		return implements, true
+	case names.Greengrass:
+		_, implements = v.(greengrassiface.GreengrassAPI)
+		return implements, true
+	case names.GreengrassV2:
+		_, implements = v.(greengrassv2iface.GreengrassV2API)
+		return implements, true
+	case names.GroundStation:
+		_, implements = v.(groundstationiface.GroundStationAPI)
+		return implements, true
+	case names.GuardDuty:
+		_, implements = v.(guarddutyiface.GuardDutyAPI)
+		return implements, true
+	case names.Health:
+		_, implements = v.(healthiface.HealthAPI)
+		return implements, true
+	case names.HealthLake:
+		_, implements = v.(healthlakeiface.HealthLakeAPI)
+		return implements, true
+	case names.Honeycode:
+		_, implements = v.(honeycodeiface.HoneycodeAPI)
+		return implements, true
+	case names.IAM:
+		_, implements = v.(iamiface.IAMAPI)
+		return implements, true
+	case names.IVS:
+		_, implements = v.(ivsiface.IVSAPI)
+		return implements, true
+	case names.IdentityStore:
+		_, implements = v.(identitystoreiface.IdentityStoreAPI)
+		return implements, true
+	case names.ImageBuilder:
+		_, implements = v.(imagebuilderiface.ImagebuilderAPI)
+		return implements, true
+	case names.Inspector:
+		_, implements = v.(inspectoriface.InspectorAPI)
+		return implements, true
+	case names.Inspector2:
+		_, implements = v.(inspector2iface.Inspector2API)
+		return implements, true
+	case names.IoT:
+		_, implements = v.(iotiface.IoTAPI)
+		return implements, true
+	case names.IoT1ClickDevices:
+		_, implements = v.(iot1clickdevicesserviceiface.IoT1ClickDevicesServiceAPI)
+		return implements, true
+	case names.IoT1ClickProjects:
+		_, implements = v.(iot1clickprojectsiface.IoT1ClickProjectsAPI)
+		return implements, true
+	case names.IoTAnalytics:
+		_, implements = v.(iotanalyticsiface.IoTAnalyticsAPI)
+		return implements, true
+	case names.IoTData:
+		_, implements = v.(iotdataplaneiface.IoTDataPlaneAPI)
+		return implements, true
+	case names.IoTDeviceAdvisor:
+		_, implements = v.(iotdeviceadvisoriface.IoTDeviceAdvisorAPI)
+		return implements, true
+	case names.IoTEvents:
+		_, implements = v.(ioteventsiface.IoTEventsAPI)
+		return implements, true
+	case names.IoTEventsData:
+		_, implements = v.(ioteventsdataiface.IoTEventsDataAPI)
+		return implements, true
+	case names.IoTFleetHub:
+		_, implements = v.(iotfleethubiface.IoTFleetHubAPI)
+		return implements, true
+	case names.IoTJobsData:
+		_, implements = v.(iotjobsdataplaneiface.IoTJobsDataPlaneAPI)
+		return implements, true
+	case names.IoTSecureTunneling:
+		_, implements = v.(iotsecuretunnelingiface.IoTSecureTunnelingAPI)
+		return implements, true
+	case names.IoTSiteWise:
+		_, implements = v.(iotsitewiseiface.IoTSiteWiseAPI)
+		return implements, true
+	case names.IoTThingsGraph:
+		_, implements = v.(iotthingsgraphiface.IoTThingsGraphAPI)
+		return implements, true
+	case names.IoTTwinMaker:
+		_, implements = v.(iottwinmakeriface.IoTTwinMakerAPI)
+		return implements, true
+	case names.IoTWireless:
+		_, implements = v.(iotwirelessiface.IoTWirelessAPI)
+		return implements, true
+	case names.KMS:
+		_, implements = v.(kmsiface.KMSAPI)
+		return implements, true
+	case names.Kafka:
+		_, implements = v.(kafkaiface.KafkaAPI)
+		return implements, true
+	case names.KafkaConnect:
+		_, implements = v.(kafkaconnectiface.KafkaConnectAPI)
+		return implements, true
+	case names.Keyspaces:
+		_, implements = v.(keyspacesiface.KeyspacesAPI)
+		return implements, true
+	case names.Kinesis:
+		_, implements = v.(kinesisiface.KinesisAPI)
+		return implements, true
+	case names.KinesisAnalytics:
+		_, implements = v.(kinesisanalyticsiface.KinesisAnalyticsAPI)
+		return implements, true
+	case names.KinesisAnalyticsV2:
+		_, implements = v.(kinesisanalyticsv2iface.KinesisAnalyticsV2API)
+		return implements, true
+	case names.KinesisVideo:
+		_, implements = v.(kinesisvideoiface.KinesisVideoAPI)
+		return implements, true
+	case names.KinesisVideoArchivedMedia:
+		_, implements = v.(kinesisvideoarchivedmediaiface.KinesisVideoArchivedMediaAPI)
+		return implements, true
+	case names.KinesisVideoMedia:
+		_, implements = v.(kinesisvideomediaiface.KinesisVideoMediaAPI)
+		return implements, true
+	case names.KinesisVideoSignaling:
+		_, implements = v.(kinesisvideosignalingchannelsiface.KinesisVideoSignalingChannelsAPI)
+		return implements, true
+	case names.LakeFormation:
+		_, implements = v.(lakeformationiface.LakeFormationAPI)
+		return implements, true
+	case names.Lambda:
+		_, implements = v.(lambdaiface.LambdaAPI)
+		return implements, true
+	case names.LexModels:
+		_, implements = v.(lexmodelbuildingserviceiface.LexModelBuildingServiceAPI)
+		return implements, true
+	case names.LexModelsV2:
+		_, implements = v.(lexmodelsv2iface.LexModelsV2API)
+		return implements, true
+	case names.LexRuntime:
+		_, implements = v.(lexruntimeserviceiface.LexRuntimeServiceAPI)
+		return implements, true
+	case names.LexRuntimeV2:
+		_, implements = v.(lexruntimev2iface.LexRuntimeV2API)
+		return implements, true
+	case names.LicenseManager:
+		_, implements = v.(licensemanageriface.LicenseManagerAPI)
+		return implements, true
+	case names.Lightsail:
+		_, implements = v.(lightsailiface.LightsailAPI)
+		return implements, true
+	case names.Location:
+		_, implements = v.(locationserviceiface.LocationServiceAPI)
+		return implements, true
+	case names.Logs:
+		_, implements = v.(cloudwatchlogsiface.CloudWatchLogsAPI)
+		return implements, true
+	case names.LookoutEquipment:
+		_, implements = v.(lookoutequipmentiface.LookoutEquipmentAPI)
+		return implements, true
+	case names.LookoutMetrics:
+		_, implements = v.(lookoutmetricsiface.LookoutMetricsAPI)
+		return implements, true
+	case names.LookoutVision:
+		_, implements = v.(lookoutforvisioniface.LookoutForVisionAPI)
+		return implements, true
+	case names.MQ:
+		_, implements = v.(mqiface.MQAPI)
+		return implements, true
+	case names.MTurk:
+		_, implements = v.(mturkiface.MTurkAPI)
+		return implements, true
+	case names.MWAA:
+		_, implements = v.(mwaaiface.MWAAAPI)
+		return implements, true
+	case names.MachineLearning:
+		_, implements = v.(machinelearningiface.MachineLearningAPI)
+		return implements, true
+	case names.Macie:
+		_, implements = v.(macieiface.MacieAPI)
+		return implements, true
+	case names.Macie2:
+		_, implements = v.(macie2iface.Macie2API)
+		return implements, true
+	case names.ManagedBlockchain:
+		_, implements = v.(managedblockchainiface.ManagedBlockchainAPI)
+		return implements, true
+	case names.MarketplaceCatalog:
+		_, implements = v.(marketplacecatalogiface.MarketplaceCatalogAPI)
+		return implements, true
+	case names.MarketplaceCommerceAnalytics:
+		_, implements = v.(marketplacecommerceanalyticsiface.MarketplaceCommerceAnalyticsAPI)
+		return implements, true
+	case names.MarketplaceEntitlement:
+		_, implements = v.(marketplaceentitlementserviceiface.MarketplaceEntitlementServiceAPI)
+		return implements, true
+	case names.MarketplaceMetering:
+		_, implements = v.(marketplacemeteringiface.MarketplaceMeteringAPI)
+		return implements, true
+	case names.MediaConnect:
+		_, implements = v.(mediaconnectiface.MediaConnectAPI)
+		return implements, true
+	case names.MediaConvert:
+		_, implements = v.(mediaconvertiface.MediaConvertAPI)
+		return implements, true
+	case names.MediaLive:
+		_, implements = v.(medialiveiface.MediaLiveAPI)
+		return implements, true
+	case names.MediaPackage:
+		_, implements = v.(mediapackageiface.MediaPackageAPI)
+		return implements, true
+	case names.MediaPackageVOD:
+		_, implements = v.(mediapackagevodiface.MediaPackageVodAPI)
+		return implements, true
+	case names.MediaStore:
+		_, implements = v.(mediastoreiface.MediaStoreAPI)
+		return implements, true
+	case names.MediaStoreData:
+		_, implements = v.(mediastoredataiface.MediaStoreDataAPI)
+		return implements, true
+	case names.MediaTailor:
+		_, implements = v.(mediatailoriface.MediaTailorAPI)
+		return implements, true
+	case names.MemoryDB:
+		_, implements = v.(memorydbiface.MemoryDBAPI)
+		return implements, true
+	case names.MgH:
+		_, implements = v.(migrationhubiface.MigrationHubAPI)
+		return implements, true
+	case names.Mgn:
+		_, implements = v.(mgniface.MgnAPI)
+		return implements, true
+	case names.MigrationHubConfig:
+		_, implements = v.(migrationhubconfigiface.MigrationHubConfigAPI)
+		return implements, true
+	case names.MigrationHubRefactorSpaces:
+		_, implements = v.(migrationhubrefactorspacesiface.MigrationHubRefactorSpacesAPI)
+		return implements, true
+	case names.MigrationHubStrategy:
+		_, implements = v.(migrationhubstrategyrecommendationsiface.MigrationHubStrategyRecommendationsAPI)
+		return implements, true
+	case names.Mobile:
+		_, implements = v.(mobileiface.MobileAPI)
+		return implements, true
+	case names.Neptune:
+		_, implements = v.(neptuneiface.NeptuneAPI)
+		return implements, true
+	case names.NetworkFirewall:
+		_, implements = v.(networkfirewalliface.NetworkFirewallAPI)
+		return implements, true
+	case names.NetworkManager:
+		_, implements = v.(networkmanageriface.NetworkManagerAPI)
+		return implements, true
+	case names.Nimble:
+		_, implements = v.(nimblestudioiface.NimbleStudioAPI)
+		return implements, true
+	case names.OpenSearch:
+		_, implements = v.(opensearchserviceiface.OpenSearchServiceAPI)
+		return implements, true
+	case names.OpsWorks:
+		_, implements = v.(opsworksiface.OpsWorksAPI)
+		return implements, true
+	case names.OpsWorksCM:
+		_, implements = v.(opsworkscmiface.OpsWorksCMAPI)
+		return implements, true
+	case names.Organizations:
+		_, implements = v.(organizationsiface.OrganizationsAPI)
+		return implements, true
+	case names.Outposts:
+		_, implements = v.(outpostsiface.OutpostsAPI)
+		return implements, true
+	case names.PI:
+		_, implements = v.(piiface.PIAPI)
+		return implements, true
+	case names.Panorama:
+		_, implements = v.(panoramaiface.PanoramaAPI)
+		return implements, true
+	case names.Personalize:
+		_, implements = v.(personalizeiface.PersonalizeAPI)
+		return implements, true
+	case names.PersonalizeEvents:
+		_, implements = v.(personalizeeventsiface.PersonalizeEventsAPI)
+		return implements, true
+	case names.PersonalizeRuntime:
+		_, implements = v.(personalizeruntimeiface.PersonalizeRuntimeAPI)
+		return implements, true
+	case names.Pinpoint:
+		_, implements = v.(pinpointiface.PinpointAPI)
+		return implements, true
+	case names.PinpointEmail:
+		_, implements = v.(pinpointemailiface.PinpointEmailAPI)
+		return implements, true
+	case names.PinpointSMSVoice:
+		_, implements = v.(pinpointsmsvoiceiface.PinpointSMSVoiceAPI)
+		return implements, true
+	case names.Polly:
+		_, implements = v.(pollyiface.PollyAPI)
+		return implements, true
+	case names.Pricing:
+		_, implements = v.(pricingiface.PricingAPI)
+		return implements, true
+	case names.Proton:
+		_, implements = v.(protoniface.ProtonAPI)
+		return implements, true
+	case names.QLDB:
+		_, implements = v.(qldbiface.QLDBAPI)
+		return implements, true
+	case names.QLDBSession:
+		_, implements = v.(qldbsessioniface.QLDBSessionAPI)
+		return implements, true
+	case names.QuickSight:
+		_, implements = v.(quicksightiface.QuickSightAPI)
+		return implements, true
+	case names.RAM:
+		_, implements = v.(ramiface.RAMAPI)
+		return implements, true
+	case names.RBin:
+		_, implements = v.(recyclebiniface.RecycleBinAPI)
+		return implements, true
+	case names.RDS:
+		_, implements = v.(rdsiface.RDSAPI)
+		return implements, true
+	case names.RDSData:
+		_, implements = v.(rdsdataserviceiface.RDSDataServiceAPI)
+		return implements, true
+	case names.RUM:
+		_, implements = v.(cloudwatchrumiface.CloudWatchRUMAPI)
+		return implements, true
+	case names.Redshift:
+		_, implements = v.(redshiftiface.RedshiftAPI)
+		return implements, true
+	case names.RedshiftData:
+		_, implements = v.(redshiftdataapiserviceiface.RedshiftDataAPIServiceAPI)
+		return implements, true
+	case names.Rekognition:
+		_, implements = v.(rekognitioniface.RekognitionAPI)
+		return implements, true
+	case names.ResilienceHub:
+		_, implements = v.(resiliencehubiface.ResilienceHubAPI)
+		return implements, true
+	case names.ResourceGroups:
+		_, implements = v.(resourcegroupsiface.ResourceGroupsAPI)
+		return implements, true
+	case names.ResourceGroupsTaggingAPI:
+		_, implements = v.(resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI)
+		return implements, true
+	case names.RoboMaker:
+		_, implements = v.(robomakeriface.RoboMakerAPI)
+		return implements, true
+	case names.Route53:
+		_, implements = v.(route53iface.Route53API)
+		return implements, true
+	case names.Route53RecoveryCluster:
+		_, implements = v.(route53recoveryclusteriface.Route53RecoveryClusterAPI)
+		return implements, true
+	case names.Route53RecoveryControlConfig:
+		_, implements = v.(route53recoverycontrolconfigiface.Route53RecoveryControlConfigAPI)
+		return implements, true
+	case names.Route53RecoveryReadiness:
+		_, implements = v.(route53recoveryreadinessiface.Route53RecoveryReadinessAPI)
+		return implements, true
+	case names.Route53Resolver:
+		_, implements = v.(route53resolveriface.Route53ResolverAPI)
+		return implements, true
+	case names.S3:
+		_, implements = v.(s3iface.S3API)
+		return implements, true
+	case names.S3Control:
+		_, implements = v.(s3controliface.S3ControlAPI)
+		return implements, true
+	case names.S3Outposts:
+		_, implements = v.(s3outpostsiface.S3OutpostsAPI)
+		return implements, true
+	case names.SES:
+		_, implements = v.(sesiface.SESAPI)
+		return implements, true
+	case names.SESV2:
+		_, implements = v.(sesv2iface.SESV2API)
+		return implements, true
+	case names.SFN:
+		_, implements = v.(sfniface.SFNAPI)
+		return implements, true
+	case names.SMS:
+		_, implements = v.(smsiface.SMSAPI)
+		return implements, true
+	case names.SNS:
+		_, implements = v.(snsiface.SNSAPI)
+		return implements, true
+	case names.SQS:
+		_, implements = v.(sqsiface.SQSAPI)
+		return implements, true
+	case names.SSM:
+		_, implements = v.(ssmiface.SSMAPI)
+		return implements, true
+	case names.SSMContacts:
+		_, implements = v.(ssmcontactsiface.SSMContactsAPI)
+		return implements, true
+	case names.SSMIncidents:
+		_, implements = v.(ssmincidentsiface.SSMIncidentsAPI)
+		return implements, true
+	case names.SSO:
+		_, implements = v.(ssoiface.SSOAPI)
+		return implements, true
+	case names.SSOAdmin:
+		_, implements = v.(ssoadminiface.SSOAdminAPI)
+		return implements, true
+	case names.SSOOIDC:
+		_, implements = v.(ssooidciface.SSOOIDCAPI)
+		return implements, true
+	case names.STS:
+		_, implements = v.(stsiface.STSAPI)
+		return implements, true
+	case names.SWF:
+		_, implements = v.(swfiface.SWFAPI)
+		return implements, true
+	case names.SageMaker:
+		_, implements = v.(sagemakeriface.SageMakerAPI)
+		return implements, true
+	case names.SageMakerA2IRuntime:
+		_, implements = v.(augmentedairuntimeiface.AugmentedAIRuntimeAPI)
+		return implements, true
+	case names.SageMakerEdge:
+		_, implements = v.(sagemakeredgemanageriface.SagemakerEdgeManagerAPI)
+		return implements, true
+	case names.SageMakerFeatureStoreRuntime:
+		_, implements = v.(sagemakerfeaturestoreruntimeiface.SageMakerFeatureStoreRuntimeAPI)
+		return implements, true
+	case names.SageMakerRuntime:
+		_, implements = v.(sagemakerruntimeiface.SageMakerRuntimeAPI)
+		return implements, true
+	case names.SavingsPlans:
+		_, implements = v.(savingsplansiface.SavingsPlansAPI)
+		return implements, true
+	case names.Schemas:
+		_, implements = v.(schemasiface.SchemasAPI)
+		return implements, true
+	case names.SecretsManager:
+		_, implements = v.(secretsmanageriface.SecretsManagerAPI)
+		return implements, true
+	case names.SecurityHub:
+		_, implements = v.(securityhubiface.SecurityHubAPI)
+		return implements, true
+	case names.ServerlessRepo:
+		_, implements = v.(serverlessapplicationrepositoryiface.ServerlessApplicationRepositoryAPI)
+		return implements, true
+	case names.ServiceCatalog:
+		_, implements = v.(servicecatalogiface.ServiceCatalogAPI)
+		return implements, true
+	case names.ServiceCatalogAppRegistry:
+		_, implements = v.(appregistryiface.AppRegistryAPI)
+		return implements, true
+	case names.ServiceDiscovery:
+		_, implements = v.(servicediscoveryiface.ServiceDiscoveryAPI)
+		return implements, true
+	case names.ServiceQuotas:
+		_, implements = v.(servicequotasiface.ServiceQuotasAPI)
+		return implements, true
+	case names.Shield:
+		_, implements = v.(shieldiface.ShieldAPI)
+		return implements, true
+	case names.Signer:
+		_, implements = v.(signeriface.SignerAPI)
+		return implements, true
+	case names.SimpleDB:
+		_, implements = v.(simpledbiface.SimpleDBAPI)
+		return implements, true
+	case names.SnowDeviceManagement:
+		_, implements = v.(snowdevicemanagementiface.SnowDeviceManagementAPI)
+		return implements, true
+	case names.Snowball:
+		_, implements = v.(snowballiface.SnowballAPI)
+		return implements, true
+	case names.StorageGateway:
+		_, implements = v.(storagegatewayiface.StorageGatewayAPI)
+		return implements, true
+	case names.Support:
+		_, implements = v.(supportiface.SupportAPI)
+		return implements, true
+	case names.Synthetics:
+		_, implements = v.(syntheticsiface.SyntheticsAPI)
+		return implements, true
+	case names.Textract:
+		_, implements = v.(textractiface.TextractAPI)
+		return implements, true
+	case names.TimestreamQuery:
+		_, implements = v.(timestreamqueryiface.TimestreamQueryAPI)
+		return implements, true
+	case names.TimestreamWrite:
+		_, implements = v.(timestreamwriteiface.TimestreamWriteAPI)
+		return implements, true
+	case names.Transcribe:
+		_, implements = v.(transcribeserviceiface.TranscribeServiceAPI)
+		return implements, true
+	case names.TranscribeStreaming:
+		_, implements = v.(transcribestreamingserviceiface.TranscribeStreamingServiceAPI)
+		return implements, true
+	case names.Transfer:
+		_, implements = v.(transferiface.TransferAPI)
+		return implements, true
+	case names.Translate:
+		_, implements = v.(translateiface.TranslateAPI)
+		return implements, true
+	case names.VoiceID:
+		_, implements = v.(voiceidiface.VoiceIDAPI)
+		return implements, true
+	case names.WAF:
+		_, implements = v.(wafiface.WAFAPI)
+		return implements, true
+	case names.WAFRegional:
+		_, implements = v.(wafregionaliface.WAFRegionalAPI)
+		return implements, true
+	case names.WAFV2:
+		_, implements = v.(wafv2iface.WAFV2API)
+		return implements, true
+	case names.WellArchitected:
+		_, implements = v.(wellarchitectediface.WellArchitectedAPI)
+		return implements, true
+	case names.Wisdom:
+		_, implements = v.(connectwisdomserviceiface.ConnectWisdomServiceAPI)
+		return implements, true
+	case names.WorkDocs:
+		_, implements = v.(workdocsiface.WorkDocsAPI)
+		return implements, true
+	case names.WorkLink:
+		_, implements = v.(worklinkiface.WorkLinkAPI)
+		return implements, true
+	case names.WorkMail:
+		_, implements = v.(workmailiface.WorkMailAPI)
+		return implements, true
+	case names.WorkMailMessageFlow:
+		_, implements = v.(workmailmessageflowiface.WorkMailMessageFlowAPI)
+		return implements, true
+	case names.WorkSpaces:
+		_, implements = v.(workspacesiface.WorkSpacesAPI)
+		return implements, true
+	case names.WorkSpacesWeb:
+		_, implements = v.(workspaceswebiface.WorkSpacesWebAPI)
+		return implements, true
+	case names.XRay:
+		_, implements = v.(xrayiface.XRayAPI)
+		return implements, true
+	}
+
+	return false, false
+}
+
+// ACMAPI returns the ACM client, or the fake injected with InjectFake.
+func (client *AWSClient) ACMAPI() acmiface.ACMAPI {
+	if v, ok := client.fakes[names.ACM].(acmiface.ACMAPI); ok {
+		return v
+	}
+
+	return client.ACMConn
+}
+
+// ACMPCAAPI returns the ACMPCA client, or the fake injected with InjectFake.
+func (client *AWSClient) ACMPCAAPI() acmpcaiface.ACMPCAAPI {
+	if v, ok := client.fakes[names.ACMPCA].(acmpcaiface.ACMPCAAPI); ok {
+		return v
+	}
+
+	return client.ACMPCAConn
+}
+
+// AMPAPI returns the AMP client, or the fake injected with InjectFake.
+func (client *AWSClient) AMPAPI() prometheusserviceiface.PrometheusServiceAPI {
+	if v, ok := client.fakes[names.AMP].(prometheusserviceiface.PrometheusServiceAPI); ok {
+		return v
+	}
+
+	return client.AMPConn
+}
+
+// APIGatewayAPI returns the APIGateway client, or the fake injected with InjectFake.
+func (client *AWSClient) APIGatewayAPI() apigatewayiface.APIGatewayAPI {
+	if v, ok := client.fakes[names.APIGateway].(apigatewayiface.APIGatewayAPI); ok {
+		return v
+	}
+
+	return client.APIGatewayConn
+}
+
+// APIGatewayManagementAPIAPI returns the APIGatewayManagementAPI client, or the fake injected with InjectFake.
+func (client *AWSClient) APIGatewayManagementAPIAPI() apigatewaymanagementapiiface.ApiGatewayManagementApiAPI {
+	if v, ok := client.fakes[names.APIGatewayManagementAPI].(apigatewaymanagementapiiface.ApiGatewayManagementApiAPI); ok {
+		return v
+	}
+
+	return client.APIGatewayManagementAPIConn
+}
+
+// APIGatewayV2API returns the APIGatewayV2 client, or the fake injected with InjectFake.
+func (client *AWSClient) APIGatewayV2API() apigatewayv2iface.ApiGatewayV2API {
+	if v, ok := client.fakes[names.APIGatewayV2].(apigatewayv2iface.ApiGatewayV2API); ok {
+		return v
+	}
+
+	return client.APIGatewayV2Conn
+}
+
+// AccessAnalyzerAPI returns the AccessAnalyzer client, or the fake injected with InjectFake.
+func (client *AWSClient) AccessAnalyzerAPI() accessanalyzeriface.AccessAnalyzerAPI {
+	if v, ok := client.fakes[names.AccessAnalyzer].(accessanalyzeriface.AccessAnalyzerAPI); ok {
+		return v
+	}
+
+	return client.AccessAnalyzerConn
+}
+
+// AccountAPI returns the Account client, or the fake injected with InjectFake.
+func (client *AWSClient) AccountAPI() accountiface.AccountAPI {
+	if v, ok := client.fakes[names.Account].(accountiface.AccountAPI); ok {
+		return v
+	}
+
+	return client.AccountConn
+}
+
+// AlexaForBusinessAPI returns the AlexaForBusiness client, or the fake injected with InjectFake.
+func (client *AWSClient) AlexaForBusinessAPI() alexaforbusinessiface.AlexaForBusinessAPI {
+	if v, ok := client.fakes[names.AlexaForBusiness].(alexaforbusinessiface.AlexaForBusinessAPI); ok {
+		return v
+	}
+
+	return client.AlexaForBusinessConn
+}
+
+// AmplifyAPI returns the Amplify client, or the fake injected with InjectFake.
+func (client *AWSClient) AmplifyAPI() amplifyiface.AmplifyAPI {
+	if v, ok := client.fakes[names.Amplify].(amplifyiface.AmplifyAPI); ok {
+		return v
+	}
+
+	return client.AmplifyConn
+}
+
+// AmplifyBackendAPI returns the AmplifyBackend client, or the fake injected with InjectFake.
+func (client *AWSClient) AmplifyBackendAPI() amplifybackendiface.AmplifyBackendAPI {
+	if v, ok := client.fakes[names.AmplifyBackend].(amplifybackendiface.AmplifyBackendAPI); ok {
+		return v
+	}
+
+	return client.AmplifyBackendConn
+}
+
+// AmplifyUIBuilderAPI returns the AmplifyUIBuilder client, or the fake injected with InjectFake.
+func (client *AWSClient) AmplifyUIBuilderAPI() amplifyuibuilderiface.AmplifyUIBuilderAPI {
+	if v, ok := client.fakes[names.AmplifyUIBuilder].(amplifyuibuilderiface.AmplifyUIBuilderAPI); ok {
+		return v
+	}
+
+	return client.AmplifyUIBuilderConn
+}
+
+// AppAutoScalingAPI returns the AppAutoScaling client, or the fake injected with InjectFake.
+func (client *AWSClient) AppAutoScalingAPI() applicationautoscalingiface.ApplicationAutoScalingAPI {
+	if v, ok := client.fakes[names.AppAutoScaling].(applicationautoscalingiface.ApplicationAutoScalingAPI); ok {
+		return v
+	}
+
+	return client.AppAutoScalingConn
+}
+
+// AppConfigAPI returns the AppConfig client, or the fake injected with InjectFake.
+func (client *AWSClient) AppConfigAPI() appconfigiface.AppConfigAPI {
+	if v, ok := client.fakes[names.AppConfig].(appconfigiface.AppConfigAPI); ok {
+		return v
+	}
+
+	return client.AppConfigConn
+}
+
+// AppConfigDataAPI returns the AppConfigData client, or the fake injected with InjectFake.
+func (client *AWSClient) AppConfigDataAPI() appconfigdataiface.AppConfigDataAPI {
+	if v, ok := client.fakes[names.AppConfigData].(appconfigdataiface.AppConfigDataAPI); ok {
+		return v
+	}
+
+	return client.AppConfigDataConn
+}
+
+// AppFlowAPI returns the AppFlow client, or the fake injected with InjectFake.
+func (client *AWSClient) AppFlowAPI() appflowiface.AppflowAPI {
+	if v, ok := client.fakes[names.AppFlow].(appflowiface.AppflowAPI); ok {
+		return v
+	}
+
+	return client.AppFlowConn
+}
+
+// AppIntegrationsAPI returns the AppIntegrations client, or the fake injected with InjectFake.
+func (client *AWSClient) AppIntegrationsAPI() appintegrationsserviceiface.AppIntegrationsServiceAPI {
+	if v, ok := client.fakes[names.AppIntegrations].(appintegrationsserviceiface.AppIntegrationsServiceAPI); ok {
+		return v
+	}
+
+	return client.AppIntegrationsConn
+}
+
+// AppMeshAPI returns the AppMesh client, or the fake injected with InjectFake.
+func (client *AWSClient) AppMeshAPI() appmeshiface.AppMeshAPI {
+	if v, ok := client.fakes[names.AppMesh].(appmeshiface.AppMeshAPI); ok {
+		return v
+	}
+
+	return client.AppMeshConn
+}
+
+// AppRunnerAPI returns the AppRunner client, or the fake injected with InjectFake.
+func (client *AWSClient) AppRunnerAPI() apprunneriface.AppRunnerAPI {
+	if v, ok := client.fakes[names.AppRunner].(apprunneriface.AppRunnerAPI); ok {
+		return v
+	}
+
+	return client.AppRunnerConn
+}
+
+// AppStreamAPI returns the AppStream client, or the fake injected with InjectFake.
+func (client *AWSClient) AppStreamAPI() appstreamiface.AppStreamAPI {
+	if v, ok := client.fakes[names.AppStream].(appstreamiface.AppStreamAPI); ok {
+		return v
+	}
+
+	return client.AppStreamConn
+}
+
+// AppSyncAPI returns the AppSync client, or the fake injected with InjectFake.
+func (client *AWSClient) AppSyncAPI() appsynciface.AppSyncAPI {
+	if v, ok := client.fakes[names.AppSync].(appsynciface.AppSyncAPI); ok {
+		return v
+	}
+
+	return client.AppSyncConn
+}
+
+// ApplicationCostProfilerAPI returns the ApplicationCostProfiler client, or the fake injected with InjectFake.
+func (client *AWSClient) ApplicationCostProfilerAPI() applicationcostprofileriface.ApplicationCostProfilerAPI {
+	if v, ok := client.fakes[names.ApplicationCostProfiler].(applicationcostprofileriface.ApplicationCostProfilerAPI); ok {
+		return v
+	}
+
+	return client.ApplicationCostProfilerConn
+}
+
+// ApplicationInsightsAPI returns the ApplicationInsights client, or the fake injected with InjectFake.
+func (client *AWSClient) ApplicationInsightsAPI() applicationinsightsiface.ApplicationInsightsAPI {
+	if v, ok := client.fakes[names.ApplicationInsights].(applicationinsightsiface.ApplicationInsightsAPI); ok {
+		return v
+	}
+
+	return client.ApplicationInsightsConn
+}
+
+// AthenaAPI returns the Athena client, or the fake injected with InjectFake.
+func (client *AWSClient) AthenaAPI() athenaiface.AthenaAPI {
+	if v, ok := client.fakes[names.Athena].(athenaiface.AthenaAPI); ok {
+		return v
+	}
+
+	return client.AthenaConn
+}
+
+// AuditManagerAPI returns the AuditManager client, or the fake injected with InjectFake.
+func (client *AWSClient) AuditManagerAPI() auditmanageriface.AuditManagerAPI {
+	if v, ok := client.fakes[names.AuditManager].(auditmanageriface.AuditManagerAPI); ok {
+		return v
+	}
+
+	return client.AuditManagerConn
+}
+
+// AutoScalingAPI returns the AutoScaling client, or the fake injected with InjectFake.
+func (client *AWSClient) AutoScalingAPI() autoscalingiface.AutoScalingAPI {
+	if v, ok := client.fakes[names.AutoScaling].(autoscalingiface.AutoScalingAPI); ok {
+		return v
+	}
+
+	return client.AutoScalingConn
+}
+
+// AutoScalingPlansAPI returns the AutoScalingPlans client, or the fake injected with InjectFake.
+func (client *AWSClient) AutoScalingPlansAPI() autoscalingplansiface.AutoScalingPlansAPI {
+	if v, ok := client.fakes[names.AutoScalingPlans].(autoscalingplansiface.AutoScalingPlansAPI); ok {
+		return v
+	}
+
+	return client.AutoScalingPlansConn
+}
+
+// BackupAPI returns the Backup client, or the fake injected with InjectFake.
+func (client *AWSClient) BackupAPI() backupiface.BackupAPI {
+	if v, ok := client.fakes[names.Backup].(backupiface.BackupAPI); ok {
+		return v
+	}
+
+	return client.BackupConn
+}
+
+// BackupGatewayAPI returns the BackupGateway client, or the fake injected with InjectFake.
+func (client *AWSClient) BackupGatewayAPI() backupgatewayiface.BackupGatewayAPI {
+	if v, ok := client.fakes[names.BackupGateway].(backupgatewayiface.BackupGatewayAPI); ok {
+		return v
+	}
+
+	return client.BackupGatewayConn
+}
+
+// BatchAPI returns the Batch client, or the fake injected with InjectFake.
+func (client *AWSClient) BatchAPI() batchiface.BatchAPI {
+	if v, ok := client.fakes[names.Batch].(batchiface.BatchAPI); ok {
+		return v
+	}
+
+	return client.BatchConn
+}
+
+// BillingConductorAPI returns the BillingConductor client, or the fake injected with InjectFake.
+func (client *AWSClient) BillingConductorAPI() billingconductoriface.BillingConductorAPI {
+	if v, ok := client.fakes[names.BillingConductor].(billingconductoriface.BillingConductorAPI); ok {
+		return v
+	}
+
+	return client.BillingConductorConn
+}
+
+// BraketAPI returns the Braket client, or the fake injected with InjectFake.
+func (client *AWSClient) BraketAPI() braketiface.BraketAPI {
+	if v, ok := client.fakes[names.Braket].(braketiface.BraketAPI); ok {
+		return v
+	}
+
+	return client.BraketConn
+}
+
+// BudgetsAPI returns the Budgets client, or the fake injected with InjectFake.
+func (client *AWSClient) BudgetsAPI() budgetsiface.BudgetsAPI {
+	if v, ok := client.fakes[names.Budgets].(budgetsiface.BudgetsAPI); ok {
+		return v
+	}
+
+	return client.BudgetsConn
+}
+
+// CEAPI returns the CE client, or the fake injected with InjectFake.
+func (client *AWSClient) CEAPI() costexploreriface.CostExplorerAPI {
+	if v, ok := client.fakes[names.CE].(costexploreriface.CostExplorerAPI); ok {
+		return v
+	}
+
+	return client.CEConn
+}
+
+// CURAPI returns the CUR client, or the fake injected with InjectFake.
+func (client *AWSClient) CURAPI() costandusagereportserviceiface.CostandUsageReportServiceAPI {
+	if v, ok := client.fakes[names.CUR].(costandusagereportserviceiface.CostandUsageReportServiceAPI); ok {
+		return v
+	}
+
+	return client.CURConn
+}
+
+// ChimeAPI returns the Chime client, or the fake injected with InjectFake.
+func (client *AWSClient) ChimeAPI() chimeiface.ChimeAPI {
+	if v, ok := client.fakes[names.Chime].(chimeiface.ChimeAPI); ok {
+		return v
+	}
+
+	return client.ChimeConn
+}
+
+// ChimeSDKIdentityAPI returns the ChimeSDKIdentity client, or the fake injected with InjectFake.
+func (client *AWSClient) ChimeSDKIdentityAPI() chimesdkidentityiface.ChimeSDKIdentityAPI {
+	if v, ok := client.fakes[names.ChimeSDKIdentity].(chimesdkidentityiface.ChimeSDKIdentityAPI); ok {
+		return v
+	}
+
+	return client.ChimeSDKIdentityConn
+}
+
+// ChimeSDKMeetingsAPI returns the ChimeSDKMeetings client, or the fake injected with InjectFake.
+func (client *AWSClient) ChimeSDKMeetingsAPI() chimesdkmeetingsiface.ChimeSDKMeetingsAPI {
+	if v, ok := client.fakes[names.ChimeSDKMeetings].(chimesdkmeetingsiface.ChimeSDKMeetingsAPI); ok {
+		return v
+	}
+
+	return client.ChimeSDKMeetingsConn
+}
+
+// ChimeSDKMessagingAPI returns the ChimeSDKMessaging client, or the fake injected with InjectFake.
+func (client *AWSClient) ChimeSDKMessagingAPI() chimesdkmessagingiface.ChimeSDKMessagingAPI {
+	if v, ok := client.fakes[names.ChimeSDKMessaging].(chimesdkmessagingiface.ChimeSDKMessagingAPI); ok {
+		return v
+	}
+
+	return client.ChimeSDKMessagingConn
+}
+
+// Cloud9API returns the Cloud9 client, or the fake injected with InjectFake.
+func (client *AWSClient) Cloud9API() cloud9iface.Cloud9API {
+	if v, ok := client.fakes[names.Cloud9].(cloud9iface.Cloud9API); ok {
+		return v
+	}
+
+	return client.Cloud9Conn
+}
+
+// CloudControlAPI returns the CloudControl client, or the fake injected with InjectFake.
+func (client *AWSClient) CloudControlAPI() cloudcontrolapiiface.CloudControlApiAPI {
+	if v, ok := client.fakes[names.CloudControl].(cloudcontrolapiiface.CloudControlApiAPI); ok {
+		return v
+	}
+
+	return client.CloudControlConn
+}
+
+// CloudDirectoryAPI returns the CloudDirectory client, or the fake injected with InjectFake.
+func (client *AWSClient) CloudDirectoryAPI() clouddirectoryiface.CloudDirectoryAPI {
+	if v, ok := client.fakes[names.CloudDirectory].(clouddirectoryiface.CloudDirectoryAPI); ok {
+		return v
+	}
+
+	return client.CloudDirectoryConn
+}
+
+// CloudFormationAPI returns the CloudFormation client, or the fake injected with InjectFake.
+func (client *AWSClient) CloudFormationAPI() cloudformationiface.CloudFormationAPI {
+	if v, ok := client.fakes[names.CloudFormation].(cloudformationiface.CloudFormationAPI); ok {
+		return v
+	}
+
+	return client.CloudFormationConn
+}
+
+// CloudFrontAPI returns the CloudFront client, or the fake injected with InjectFake.
+func (client *AWSClient) CloudFrontAPI() cloudfrontiface.CloudFrontAPI {
+	if v, ok := client.fakes[names.CloudFront].(cloudfrontiface.CloudFrontAPI); ok {
+		return v
+	}
+
+	return client.CloudFrontConn
+}
+
+// CloudHSMV2API returns the CloudHSMV2 client, or the fake injected with InjectFake.
+func (client *AWSClient) CloudHSMV2API() cloudhsmv2iface.CloudHSMV2API {
+	if v, ok := client.fakes[names.CloudHSMV2].(cloudhsmv2iface.CloudHSMV2API); ok {
+		return v
+	}
+
+	return client.CloudHSMV2Conn
+}
+
+// CloudSearchAPI returns the CloudSearch client, or the fake injected with InjectFake.
+func (client *AWSClient) CloudSearchAPI() cloudsearchiface.CloudSearchAPI {
+	if v, ok := client.fakes[names.CloudSearch].(cloudsearchiface.CloudSearchAPI); ok {
+		return v
+	}
+
+	return client.CloudSearchConn
+}
+
+// CloudSearchDomainAPI returns the CloudSearchDomain client, or the fake injected with InjectFake.
+func (client *AWSClient) CloudSearchDomainAPI() cloudsearchdomainiface.CloudSearchDomainAPI {
+	if v, ok := client.fakes[names.CloudSearchDomain].(cloudsearchdomainiface.CloudSearchDomainAPI); ok {
+		return v
+	}
+
+	return client.CloudSearchDomainConn
+}
+
+// CloudTrailAPI returns the CloudTrail client, or the fake injected with InjectFake.
+func (client *AWSClient) CloudTrailAPI() cloudtrailiface.CloudTrailAPI {
+	if v, ok := client.fakes[names.CloudTrail].(cloudtrailiface.CloudTrailAPI); ok {
+		return v
+	}
+
+	return client.CloudTrailConn
+}
+
+// CloudWatchAPI returns the CloudWatch client, or the fake injected with InjectFake.
+func (client *AWSClient) CloudWatchAPI() cloudwatchiface.CloudWatchAPI {
+	if v, ok := client.fakes[names.CloudWatch].(cloudwatchiface.CloudWatchAPI); ok {
+		return v
+	}
+
+	return client.CloudWatchConn
+}
+
+// CodeArtifactAPI returns the CodeArtifact client, or the fake injected with InjectFake.
+func (client *AWSClient) CodeArtifactAPI() codeartifactiface.CodeArtifactAPI {
+	if v, ok := client.fakes[names.CodeArtifact].(codeartifactiface.CodeArtifactAPI); ok {
+		return v
+	}
+
+	return client.CodeArtifactConn
+}
+
+// CodeBuildAPI returns the CodeBuild client, or the fake injected with InjectFake.
+func (client *AWSClient) CodeBuildAPI() codebuildiface.CodeBuildAPI {
+	if v, ok := client.fakes[names.CodeBuild].(codebuildiface.CodeBuildAPI); ok {
+		return v
+	}
+
+	return client.CodeBuildConn
+}
+
+// CodeCommitAPI returns the CodeCommit client, or the fake injected with InjectFake.
+func (client *AWSClient) CodeCommitAPI() codecommitiface.CodeCommitAPI {
+	if v, ok := client.fakes[names.CodeCommit].(codecommitiface.CodeCommitAPI); ok {
+		return v
+	}
+
+	return client.CodeCommitConn
+}
+
+// CodeGuruProfilerAPI returns the CodeGuruProfiler client, or the fake injected with InjectFake.
+func (client *AWSClient) CodeGuruProfilerAPI() codeguruprofileriface.CodeGuruProfilerAPI {
+	if v, ok := client.fakes[names.CodeGuruProfiler].(codeguruprofileriface.CodeGuruProfilerAPI); ok {
+		return v
+	}
+
+	return client.CodeGuruProfilerConn
+}
+
+// CodeGuruReviewerAPI returns the CodeGuruReviewer client, or the fake injected with InjectFake.
+func (client *AWSClient) CodeGuruReviewerAPI() codegururevieweriface.CodeGuruReviewerAPI {
+	if v, ok := client.fakes[names.CodeGuruReviewer].(codegururevieweriface.CodeGuruReviewerAPI); ok {
+		return v
+	}
+
+	return client.CodeGuruReviewerConn
+}
+
+// CodePipelineAPI returns the CodePipeline client, or the fake injected with InjectFake.
+func (client *AWSClient) CodePipelineAPI() codepipelineiface.CodePipelineAPI {
+	if v, ok := client.fakes[names.CodePipeline].(codepipelineiface.CodePipelineAPI); ok {
+		return v
+	}
+
+	return client.CodePipelineConn
+}
+
+// CodeStarAPI returns the CodeStar client, or the fake injected with InjectFake.
+func (client *AWSClient) CodeStarAPI() codestariface.CodeStarAPI {
+	if v, ok := client.fakes[names.CodeStar].(codestariface.CodeStarAPI); ok {
+		return v
+	}
+
+	return client.CodeStarConn
+}
+
+// CodeStarConnectionsAPI returns the CodeStarConnections client, or the fake injected with InjectFake.
+func (client *AWSClient) CodeStarConnectionsAPI() codestarconnectionsiface.CodeStarConnectionsAPI {
+	if v, ok := client.fakes[names.CodeStarConnections].(codestarconnectionsiface.CodeStarConnectionsAPI); ok {
+		return v
+	}
+
+	return client.CodeStarConnectionsConn
+}
+
+// CodeStarNotificationsAPI returns the CodeStarNotifications client, or the fake injected with InjectFake.
+func (client *AWSClient) CodeStarNotificationsAPI() codestarnotificationsiface.CodeStarNotificationsAPI {
+	if v, ok := client.fakes[names.CodeStarNotifications].(codestarnotificationsiface.CodeStarNotificationsAPI); ok {
+		return v
+	}
+
+	return client.CodeStarNotificationsConn
+}
+
+// CognitoIDPAPI returns the CognitoIDP client, or the fake injected with InjectFake.
+func (client *AWSClient) CognitoIDPAPI() cognitoidentityprovideriface.CognitoIdentityProviderAPI {
+	if v, ok := client.fakes[names.CognitoIDP].(cognitoidentityprovideriface.CognitoIdentityProviderAPI); ok {
+		return v
+	}
+
+	return client.CognitoIDPConn
+}
+
+// CognitoIdentityAPI returns the CognitoIdentity client, or the fake injected with InjectFake.
+func (client *AWSClient) CognitoIdentityAPI() cognitoidentityiface.CognitoIdentityAPI {
+	if v, ok := client.fakes[names.CognitoIdentity].(cognitoidentityiface.CognitoIdentityAPI); ok {
+		return v
+	}
+
+	return client.CognitoIdentityConn
+}
+
+// CognitoSyncAPI returns the CognitoSync client, or the fake injected with InjectFake.
+func (client *AWSClient) CognitoSyncAPI() cognitosynciface.CognitoSyncAPI {
+	if v, ok := client.fakes[names.CognitoSync].(cognitosynciface.CognitoSyncAPI); ok {
+		return v
+	}
+
+	return client.CognitoSyncConn
+}
+
+// ComprehendAPI returns the Comprehend client, or the fake injected with InjectFake.
+func (client *AWSClient) ComprehendAPI() comprehendiface.ComprehendAPI {
+	if v, ok := client.fakes[names.Comprehend].(comprehendiface.ComprehendAPI); ok {
+		return v
+	}
+
+	return client.ComprehendConn
+}
+
+// ComprehendMedicalAPI returns the ComprehendMedical client, or the fake injected with InjectFake.
+func (client *AWSClient) ComprehendMedicalAPI() comprehendmedicaliface.ComprehendMedicalAPI {
+	if v, ok := client.fakes[names.ComprehendMedical].(comprehendmedicaliface.ComprehendMedicalAPI); ok {
+		return v
+	}
+
+	return client.ComprehendMedicalConn
+}
+
+// ComputeOptimizerAPI returns the ComputeOptimizer client, or the fake injected with InjectFake.
+func (client *AWSClient) ComputeOptimizerAPI() computeoptimizeriface.ComputeOptimizerAPI {
+	if v, ok := client.fakes[names.ComputeOptimizer].(computeoptimizeriface.ComputeOptimizerAPI); ok {
+		return v
+	}
+
+	return client.ComputeOptimizerConn
+}
+
+// ConfigServiceAPI returns the ConfigService client, or the fake injected with InjectFake.
+func (client *AWSClient) ConfigServiceAPI() configserviceiface.ConfigServiceAPI {
+	if v, ok := client.fakes[names.ConfigService].(configserviceiface.ConfigServiceAPI); ok {
+		return v
+	}
+
+	return client.ConfigServiceConn
+}
+
+// ConnectAPI returns the Connect client, or the fake injected with InjectFake.
+func (client *AWSClient) ConnectAPI() connectiface.ConnectAPI {
+	if v, ok := client.fakes[names.Connect].(connectiface.ConnectAPI); ok {
+		return v
+	}
+
+	return client.ConnectConn
+}
+
+// ConnectContactLensAPI returns the ConnectContactLens client, or the fake injected with InjectFake.
+func (client *AWSClient) ConnectContactLensAPI() connectcontactlensiface.ConnectContactLensAPI {
+	if v, ok := client.fakes[names.ConnectContactLens].(connectcontactlensiface.ConnectContactLensAPI); ok {
+		return v
+	}
+
+	return client.ConnectContactLensConn
+}
+
+// ConnectParticipantAPI returns the ConnectParticipant client, or the fake injected with InjectFake.
+func (client *AWSClient) ConnectParticipantAPI() connectparticipantiface.ConnectParticipantAPI {
+	if v, ok := client.fakes[names.ConnectParticipant].(connectparticipantiface.ConnectParticipantAPI); ok {
+		return v
+	}
+
+	return client.ConnectParticipantConn
+}
+
+// CustomerProfilesAPI returns the CustomerProfiles client, or the fake injected with InjectFake.
+func (client *AWSClient) CustomerProfilesAPI() customerprofilesiface.CustomerProfilesAPI {
+	if v, ok := client.fakes[names.CustomerProfiles].(customerprofilesiface.CustomerProfilesAPI); ok {
+		return v
+	}
+
+	return client.CustomerProfilesConn
+}
+
+// DAXAPI returns the DAX client, or the fake injected with InjectFake.
+func (client *AWSClient) DAXAPI() daxiface.DAXAPI {
+	if v, ok := client.fakes[names.DAX].(daxiface.DAXAPI); ok {
+		return v
+	}
+
+	return client.DAXConn
+}
+
+// DLMAPI returns the DLM client, or the fake injected with InjectFake.
+func (client *AWSClient) DLMAPI() dlmiface.DLMAPI {
+	if v, ok := client.fakes[names.DLM].(dlmiface.DLMAPI); ok {
+		return v
+	}
+
+	return client.DLMConn
+}
+
+// DMSAPI returns the DMS client, or the fake injected with InjectFake.
+func (client *AWSClient) DMSAPI() databasemigrationserviceiface.DatabaseMigrationServiceAPI {
+	if v, ok := client.fakes[names.DMS].(databasemigrationserviceiface.DatabaseMigrationServiceAPI); ok {
+		return v
+	}
+
+	return client.DMSConn
+}
+
+// DRSAPI returns the DRS client, or the fake injected with InjectFake.
+func (client *AWSClient) DRSAPI() drsiface.DrsAPI {
+	if v, ok := client.fakes[names.DRS].(drsiface.DrsAPI); ok {
+		return v
+	}
+
+	return client.DRSConn
+}
+
+// DSAPI returns the DS client, or the fake injected with InjectFake.
+func (client *AWSClient) DSAPI() directoryserviceiface.DirectoryServiceAPI {
+	if v, ok := client.fakes[names.DS].(directoryserviceiface.DirectoryServiceAPI); ok {
+		return v
+	}
+
+	return client.DSConn
+}
+
+// DataBrewAPI returns the DataBrew client, or the fake injected with InjectFake.
+func (client *AWSClient) DataBrewAPI() gluedatabrewiface.GlueDataBrewAPI {
+	if v, ok := client.fakes[names.DataBrew].(gluedatabrewiface.GlueDataBrewAPI); ok {
+		return v
+	}
+
+	return client.DataBrewConn
+}
+
+// DataExchangeAPI returns the DataExchange client, or the fake injected with InjectFake.
+func (client *AWSClient) DataExchangeAPI() dataexchangeiface.DataExchangeAPI {
+	if v, ok := client.fakes[names.DataExchange].(dataexchangeiface.DataExchangeAPI); ok {
+		return v
+	}
+
+	return client.DataExchangeConn
+}
+
+// DataPipelineAPI returns the DataPipeline client, or the fake injected with InjectFake.
+func (client *AWSClient) DataPipelineAPI() datapipelineiface.DataPipelineAPI {
+	if v, ok := client.fakes[names.DataPipeline].(datapipelineiface.DataPipelineAPI); ok {
+		return v
+	}
+
+	return client.DataPipelineConn
+}
+
+// DataSyncAPI returns the DataSync client, or the fake injected with InjectFake.
+func (client *AWSClient) DataSyncAPI() datasynciface.DataSyncAPI {
+	if v, ok := client.fakes[names.DataSync].(datasynciface.DataSyncAPI); ok {
+		return v
+	}
+
+	return client.DataSyncConn
+}
+
+// DeployAPI returns the Deploy client, or the fake injected with InjectFake.
+func (client *AWSClient) DeployAPI() codedeployiface.CodeDeployAPI {
+	if v, ok := client.fakes[names.Deploy].(codedeployiface.CodeDeployAPI); ok {
+		return v
+	}
+
+	return client.DeployConn
+}
+
+// DetectiveAPI returns the Detective client, or the fake injected with InjectFake.
+func (client *AWSClient) DetectiveAPI() detectiveiface.DetectiveAPI {
+	if v, ok := client.fakes[names.Detective].(detectiveiface.DetectiveAPI); ok {
+		return v
+	}
+
+	return client.DetectiveConn
+}
+
+// DevOpsGuruAPI returns the DevOpsGuru client, or the fake injected with InjectFake.
+func (client *AWSClient) DevOpsGuruAPI() devopsguruiface.DevOpsGuruAPI {
+	if v, ok := client.fakes[names.DevOpsGuru].(devopsguruiface.DevOpsGuruAPI); ok {
+		return v
+	}
+
+	return client.DevOpsGuruConn
+}
+
+// DeviceFarmAPI returns the DeviceFarm client, or the fake injected with InjectFake.
+func (client *AWSClient) DeviceFarmAPI() devicefarmiface.DeviceFarmAPI {
+	if v, ok := client.fakes[names.DeviceFarm].(devicefarmiface.DeviceFarmAPI); ok {
+		return v
+	}
+
+	return client.DeviceFarmConn
+}
+
+// DirectConnectAPI returns the DirectConnect client, or the fake injected with InjectFake.
+func (client *AWSClient) DirectConnectAPI() directconnectiface.DirectConnectAPI {
+	if v, ok := client.fakes[names.DirectConnect].(directconnectiface.DirectConnectAPI); ok {
+		return v
+	}
+
+	return client.DirectConnectConn
+}
+
+// DiscoveryAPI returns the Discovery client, or the fake injected with InjectFake.
+func (client *AWSClient) DiscoveryAPI() applicationdiscoveryserviceiface.ApplicationDiscoveryServiceAPI {
+	if v, ok := client.fakes[names.Discovery].(applicationdiscoveryserviceiface.ApplicationDiscoveryServiceAPI); ok {
+		return v
+	}
+
+	return client.DiscoveryConn
+}
+
+// DocDBAPI returns the DocDB client, or the fake injected with InjectFake.
+func (client *AWSClient) DocDBAPI() docdbiface.DocDBAPI {
+	if v, ok := client.fakes[names.DocDB].(docdbiface.DocDBAPI); ok {
+		return v
+	}
+
+	return client.DocDBConn
+}
+
+// DynamoDBAPI returns the DynamoDB client, or the fake injected with InjectFake.
+func (client *AWSClient) DynamoDBAPI() dynamodbiface.DynamoDBAPI {
+	if v, ok := client.fakes[names.DynamoDB].(dynamodbiface.DynamoDBAPI); ok {
+		return v
+	}
+
+	return client.DynamoDBConn
+}
+
+// DynamoDBStreamsAPI returns the DynamoDBStreams client, or the fake injected with InjectFake.
+func (client *AWSClient) DynamoDBStreamsAPI() dynamodbstreamsiface.DynamoDBStreamsAPI {
+	if v, ok := client.fakes[names.DynamoDBStreams].(dynamodbstreamsiface.DynamoDBStreamsAPI); ok {
+		return v
+	}
+
+	return client.DynamoDBStreamsConn
+}
+
+// EBSAPI returns the EBS client, or the fake injected with InjectFake.
+func (client *AWSClient) EBSAPI() ebsiface.EBSAPI {
+	if v, ok := client.fakes[names.EBS].(ebsiface.EBSAPI); ok {
+		return v
+	}
+
+	return client.EBSConn
+}
+
+// EC2API returns the EC2 client, or the fake injected with InjectFake.
+func (client *AWSClient) EC2API() ec2iface.EC2API {
+	if v, ok := client.fakes[names.EC2].(ec2iface.EC2API); ok {
+		return v
+	}
+
+	return client.EC2Conn
+}
+
+// EC2InstanceConnectAPI returns the EC2InstanceConnect client, or the fake injected with InjectFake.
+func (client *AWSClient) EC2InstanceConnectAPI() ec2instanceconnectiface.EC2InstanceConnectAPI {
+	if v, ok := client.fakes[names.EC2InstanceConnect].(ec2instanceconnectiface.EC2InstanceConnectAPI); ok {
+		return v
+	}
+
+	return client.EC2InstanceConnectConn
+}
+
+// ECRAPI returns the ECR client, or the fake injected with InjectFake.
+func (client *AWSClient) ECRAPI() ecriface.ECRAPI {
+	if v, ok := client.fakes[names.ECR].(ecriface.ECRAPI); ok {
+		return v
+	}
+
+	return client.ECRConn
+}
+
+// ECRPublicAPI returns the ECRPublic client, or the fake injected with InjectFake.
+func (client *AWSClient) ECRPublicAPI() ecrpubliciface.ECRPublicAPI {
+	if v, ok := client.fakes[names.ECRPublic].(ecrpubliciface.ECRPublicAPI); ok {
+		return v
+	}
+
+	return client.ECRPublicConn
+}
+
+// ECSAPI returns the ECS client, or the fake injected with InjectFake.
+func (client *AWSClient) ECSAPI() ecsiface.ECSAPI {
+	if v, ok := client.fakes[names.ECS].(ecsiface.ECSAPI); ok {
+		return v
+	}
+
+	return client.ECSConn
+}
+
+// EFSAPI returns the EFS client, or the fake injected with InjectFake.
+func (client *AWSClient) EFSAPI() efsiface.EFSAPI {
+	if v, ok := client.fakes[names.EFS].(efsiface.EFSAPI); ok {
+		return v
+	}
+
+	return client.EFSConn
+}
+
+// EKSAPI returns the EKS client, or the fake injected with InjectFake.
+func (client *AWSClient) EKSAPI() eksiface.EKSAPI {
+	if v, ok := client.fakes[names.EKS].(eksiface.EKSAPI); ok {
+		return v
+	}
+
+	return client.EKSConn
+}
+
+// ELBAPI returns the ELB client, or the fake injected with InjectFake.
+func (client *AWSClient) ELBAPI() elbiface.ELBAPI {
+	if v, ok := client.fakes[names.ELB].(elbiface.ELBAPI); ok {
+		return v
+	}
+
+	return client.ELBConn
+}
+
+// ELBV2API returns the ELBV2 client, or the fake injected with InjectFake.
+func (client *AWSClient) ELBV2API() elbv2iface.ELBV2API {
+	if v, ok := client.fakes[names.ELBV2].(elbv2iface.ELBV2API); ok {
+		return v
+	}
+
+	return client.ELBV2Conn
+}
+
+// EMRAPI returns the EMR client, or the fake injected with InjectFake.
+func (client *AWSClient) EMRAPI() emriface.EMRAPI {
+	if v, ok := client.fakes[names.EMR].(emriface.EMRAPI); ok {
+		return v
+	}
+
+	return client.EMRConn
+}
+
+// EMRContainersAPI returns the EMRContainers client, or the fake injected with InjectFake.
+func (client *AWSClient) EMRContainersAPI() emrcontainersiface.EMRContainersAPI {
+	if v, ok := client.fakes[names.EMRContainers].(emrcontainersiface.EMRContainersAPI); ok {
+		return v
+	}
+
+	return client.EMRContainersConn
+}
+
+// EMRServerlessAPI returns the EMRServerless client, or the fake injected with InjectFake.
+func (client *AWSClient) EMRServerlessAPI() emrserverlessiface.EMRServerlessAPI {
+	if v, ok := client.fakes[names.EMRServerless].(emrserverlessiface.EMRServerlessAPI); ok {
+		return v
+	}
+
+	return client.EMRServerlessConn
+}
+
+// ElastiCacheAPI returns the ElastiCache client, or the fake injected with InjectFake.
+func (client *AWSClient) ElastiCacheAPI() elasticacheiface.ElastiCacheAPI {
+	if v, ok := client.fakes[names.ElastiCache].(elasticacheiface.ElastiCacheAPI); ok {
+		return v
+	}
+
+	return client.ElastiCacheConn
+}
+
+// ElasticBeanstalkAPI returns the ElasticBeanstalk client, or the fake injected with InjectFake.
+func (client *AWSClient) ElasticBeanstalkAPI() elasticbeanstalkiface.ElasticBeanstalkAPI {
+	if v, ok := client.fakes[names.ElasticBeanstalk].(elasticbeanstalkiface.ElasticBeanstalkAPI); ok {
+		return v
+	}
+
+	return client.ElasticBeanstalkConn
+}
+
+// ElasticInferenceAPI returns the ElasticInference client, or the fake injected with InjectFake.
+func (client *AWSClient) ElasticInferenceAPI() elasticinferenceiface.ElasticInferenceAPI {
+	if v, ok := client.fakes[names.ElasticInference].(elasticinferenceiface.ElasticInferenceAPI); ok {
+		return v
+	}
+
+	return client.ElasticInferenceConn
+}
+
+// ElasticTranscoderAPI returns the ElasticTranscoder client, or the fake injected with InjectFake.
+func (client *AWSClient) ElasticTranscoderAPI() elastictranscoderiface.ElasticTranscoderAPI {
+	if v, ok := client.fakes[names.ElasticTranscoder].(elastictranscoderiface.ElasticTranscoderAPI); ok {
+		return v
+	}
+
+	return client.ElasticTranscoderConn
+}
+
+// ElasticsearchAPI returns the Elasticsearch client, or the fake injected with InjectFake.
+func (client *AWSClient) ElasticsearchAPI() elasticsearchserviceiface.ElasticsearchServiceAPI {
+	if v, ok := client.fakes[names.Elasticsearch].(elasticsearchserviceiface.ElasticsearchServiceAPI); ok {
+		return v
+	}
+
+	return client.ElasticsearchConn
+}
+
+// EventsAPI returns the Events client, or the fake injected with InjectFake.
+func (client *AWSClient) EventsAPI() eventbridgeiface.EventBridgeAPI {
+	if v, ok := client.fakes[names.Events].(eventbridgeiface.EventBridgeAPI); ok {
+		return v
+	}
+
+	return client.EventsConn
+}
+
+// EvidentlyAPI returns the Evidently client, or the fake injected with InjectFake.
+func (client *AWSClient) EvidentlyAPI() cloudwatchevidentlyiface.CloudWatchEvidentlyAPI {
+	if v, ok := client.fakes[names.Evidently].(cloudwatchevidentlyiface.CloudWatchEvidentlyAPI); ok {
+		return v
+	}
+
+	return client.EvidentlyConn
+}
+
+// FISAPI returns the FIS client, or the fake injected with InjectFake.
+func (client *AWSClient) FISAPI() fisiface.FISAPI {
+	if v, ok := client.fakes[names.FIS].(fisiface.FISAPI); ok {
+		return v
+	}
+
+	return client.FISConn
+}
+
+// FMSAPI returns the FMS client, or the fake injected with InjectFake.
+func (client *AWSClient) FMSAPI() fmsiface.FMSAPI {
+	if v, ok := client.fakes[names.FMS].(fmsiface.FMSAPI); ok {
+		return v
+	}
+
+	return client.FMSConn
+}
+
+// FSxAPI returns the FSx client, or the fake injected with InjectFake.
+func (client *AWSClient) FSxAPI() fsxiface.FSxAPI {
+	if v, ok := client.fakes[names.FSx].(fsxiface.FSxAPI); ok {
+		return v
+	}
+
+	return client.FSxConn
+}
+
+// FinSpaceAPI returns the FinSpace client, or the fake injected with InjectFake.
+func (client *AWSClient) FinSpaceAPI() finspaceiface.FinspaceAPI {
+	if v, ok := client.fakes[names.FinSpace].(finspaceiface.FinspaceAPI); ok {
+		return v
+	}
+
+	return client.FinSpaceConn
+}
+
+// FinSpaceDataAPI returns the FinSpaceData client, or the fake injected with InjectFake.
+func (client *AWSClient) FinSpaceDataAPI() finspacedataiface.FinSpaceDataAPI {
+	if v, ok := client.fakes[names.FinSpaceData].(finspacedataiface.FinSpaceDataAPI); ok {
+		return v
+	}
+
+	return client.FinSpaceDataConn
+}
+
+// FirehoseAPI returns the Firehose client, or the fake injected with InjectFake.
+func (client *AWSClient) FirehoseAPI() firehoseiface.FirehoseAPI {
+	if v, ok := client.fakes[names.Firehose].(firehoseiface.FirehoseAPI); ok {
+		return v
+	}
+
+	return client.FirehoseConn
+}
+
+// ForecastAPI returns the Forecast client, or the fake injected with InjectFake.
+func (client *AWSClient) ForecastAPI() forecastserviceiface.ForecastServiceAPI {
+	if v, ok := client.fakes[names.Forecast].(forecastserviceiface.ForecastServiceAPI); ok {
+		return v
+	}
+
+	return client.ForecastConn
+}
+
+// ForecastQueryAPI returns the ForecastQuery client, or the fake injected with InjectFake.
+func (client *AWSClient) ForecastQueryAPI() forecastqueryserviceiface.ForecastQueryServiceAPI {
+	if v, ok := client.fakes[names.ForecastQuery].(forecastqueryserviceiface.ForecastQueryServiceAPI); ok {
+		return v
+	}
+
+	return client.ForecastQueryConn
+}
+
+// FraudDetectorAPI returns the FraudDetector client, or the fake injected with InjectFake.
+func (client *AWSClient) FraudDetectorAPI() frauddetectoriface.FraudDetectorAPI {
+	if v, ok := client.fakes[names.FraudDetector].(frauddetectoriface.FraudDetectorAPI); ok {
+		return v
+	}
+
+	return client.FraudDetectorConn
+}
+
+// GameLiftAPI returns the GameLift client, or the fake injected with InjectFake.
+func (client *AWSClient) GameLiftAPI() gameliftiface.GameLiftAPI {
+	if v, ok := client.fakes[names.GameLift].(gameliftiface.GameLiftAPI); ok {
+		return v
+	}
+
+	return client.GameLiftConn
+}
+
+// GlacierAPI returns the Glacier client, or the fake injected with InjectFake.
+func (client *AWSClient) GlacierAPI() glacieriface.GlacierAPI {
+	if v, ok := client.fakes[names.Glacier].(glacieriface.GlacierAPI); ok {
+		return v
+	}
+
+	return client.GlacierConn
+}
+
+// GlobalAcceleratorAPI returns the GlobalAccelerator client, or the fake injected with InjectFake.
+func (client *AWSClient) GlobalAcceleratorAPI() globalacceleratoriface.GlobalAcceleratorAPI {
+	if v, ok := client.fakes[names.GlobalAccelerator].(globalacceleratoriface.GlobalAcceleratorAPI); ok {
+		return v
+	}
+
+	return client.GlobalAcceleratorConn
+}
+
+// GlueAPI returns the Glue client, or the fake injected with InjectFake.
+func (client *AWSClient) GlueAPI() glueiface.GlueAPI {
+	if v, ok := client.fakes[names.Glue].(glueiface.GlueAPI); ok {
+		return v
+	}
+
+	return client.GlueConn
+}
+
+// GrafanaAPI returns the Grafana client, or the fake injected with InjectFake.
+func (client *AWSClient) GrafanaAPI() managedgrafanaiface.ManagedGrafanaAPI {
+	if v, ok := client.fakes[names.Grafana].(managedgrafanaiface.ManagedGrafanaAPI); ok {
+		return v
+	}
+
+	return client.GrafanaConn
+}
+
+// GreengrassAPI returns the Greengrass client, or the fake injected with InjectFake.
+func (client *AWSClient) GreengrassAPI() greengrassiface.GreengrassAPI {
+	if v, ok := client.fakes[names.Greengrass].(greengrassiface.GreengrassAPI); ok {
+		return v
+	}
+
+	return client.GreengrassConn
+}
+
+// GreengrassV2API returns the GreengrassV2 client, or the fake injected with InjectFake.
+func (client *AWSClient) GreengrassV2API() greengrassv2iface.GreengrassV2API {
+	if v, ok := client.fakes[names.GreengrassV2].(greengrassv2iface.GreengrassV2API); ok {
+		return v
+	}
+
+	return client.GreengrassV2Conn
+}
+
+// GroundStationAPI returns the GroundStation client, or the fake injected with InjectFake.
+func (client *AWSClient) GroundStationAPI() groundstationiface.GroundStationAPI {
+	if v, ok := client.fakes[names.GroundStation].(groundstationiface.GroundStationAPI); ok {
+		return v
+	}
+
+	return client.GroundStationConn
+}
+
+// GuardDutyAPI returns the GuardDuty client, or the fake injected with InjectFake.
+func (client *AWSClient) GuardDutyAPI() guarddutyiface.GuardDutyAPI {
+	if v, ok := client.fakes[names.GuardDuty].(guarddutyiface.GuardDutyAPI); ok {
+		return v
+	}
+
+	return client.GuardDutyConn
+}
+
+// HealthAPI returns the Health client, or the fake injected with InjectFake.
+func (client *AWSClient) HealthAPI() healthiface.HealthAPI {
+	if v, ok := client.fakes[names.Health].(healthiface.HealthAPI); ok {
+		return v
+	}
+
+	return client.HealthConn
+}
+
+// HealthLakeAPI returns the HealthLake client, or the fake injected with InjectFake.
+func (client *AWSClient) HealthLakeAPI() healthlakeiface.HealthLakeAPI {
+	if v, ok := client.fakes[names.HealthLake].(healthlakeiface.HealthLakeAPI); ok {
+		return v
+	}
+
+	return client.HealthLakeConn
+}
+
+// HoneycodeAPI returns the Honeycode client, or the fake injected with InjectFake.
+func (client *AWSClient) HoneycodeAPI() honeycodeiface.HoneycodeAPI {
+	if v, ok := client.fakes[names.Honeycode].(honeycodeiface.HoneycodeAPI); ok {
+		return v
+	}
+
+	return client.HoneycodeConn
+}
+
+// IAMAPI returns the IAM client, or the fake injected with InjectFake.
+func (client *AWSClient) IAMAPI() iamiface.IAMAPI {
+	if v, ok := client.fakes[names.IAM].(iamiface.IAMAPI); ok {
+		return v
+	}
+
+	return client.IAMConn
+}
+
+// IVSAPI returns the IVS client, or the fake injected with InjectFake.
+func (client *AWSClient) IVSAPI() ivsiface.IVSAPI {
+	if v, ok := client.fakes[names.IVS].(ivsiface.IVSAPI); ok {
+		return v
+	}
+
+	return client.IVSConn
+}
+
+// IdentityStoreAPI returns the IdentityStore client, or the fake injected with InjectFake.
+func (client *AWSClient) IdentityStoreAPI() identitystoreiface.IdentityStoreAPI {
+	if v, ok := client.fakes[names.IdentityStore].(identitystoreiface.IdentityStoreAPI); ok {
+		return v
+	}
+
+	return client.IdentityStoreConn
+}
+
+// ImageBuilderAPI returns the ImageBuilder client, or the fake injected with InjectFake.
+func (client *AWSClient) ImageBuilderAPI() imagebuilderiface.ImagebuilderAPI {
+	if v, ok := client.fakes[names.ImageBuilder].(imagebuilderiface.ImagebuilderAPI); ok {
+		return v
+	}
+
+	return client.ImageBuilderConn
+}
+
+// InspectorAPI returns the Inspector client, or the fake injected with InjectFake.
+func (client *AWSClient) InspectorAPI() inspectoriface.InspectorAPI {
+	if v, ok := client.fakes[names.Inspector].(inspectoriface.InspectorAPI); ok {
+		return v
+	}
+
+	return client.InspectorConn
+}
+
+// Inspector2API returns the Inspector2 client, or the fake injected with InjectFake.
+func (client *AWSClient) Inspector2API() inspector2iface.Inspector2API {
+	if v, ok := client.fakes[names.Inspector2].(inspector2iface.Inspector2API); ok {
+		return v
+	}
+
+	return client.Inspector2Conn
+}
+
+// IoTAPI returns the IoT client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTAPI() iotiface.IoTAPI {
+	if v, ok := client.fakes[names.IoT].(iotiface.IoTAPI); ok {
+		return v
+	}
+
+	return client.IoTConn
+}
+
+// IoT1ClickDevicesAPI returns the IoT1ClickDevices client, or the fake injected with InjectFake.
+func (client *AWSClient) IoT1ClickDevicesAPI() iot1clickdevicesserviceiface.IoT1ClickDevicesServiceAPI {
+	if v, ok := client.fakes[names.IoT1ClickDevices].(iot1clickdevicesserviceiface.IoT1ClickDevicesServiceAPI); ok {
+		return v
+	}
+
+	return client.IoT1ClickDevicesConn
+}
+
+// IoT1ClickProjectsAPI returns the IoT1ClickProjects client, or the fake injected with InjectFake.
+func (client *AWSClient) IoT1ClickProjectsAPI() iot1clickprojectsiface.IoT1ClickProjectsAPI {
+	if v, ok := client.fakes[names.IoT1ClickProjects].(iot1clickprojectsiface.IoT1ClickProjectsAPI); ok {
+		return v
+	}
+
+	return client.IoT1ClickProjectsConn
+}
+
+// IoTAnalyticsAPI returns the IoTAnalytics client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTAnalyticsAPI() iotanalyticsiface.IoTAnalyticsAPI {
+	if v, ok := client.fakes[names.IoTAnalytics].(iotanalyticsiface.IoTAnalyticsAPI); ok {
+		return v
+	}
+
+	return client.IoTAnalyticsConn
+}
+
+// IoTDataAPI returns the IoTData client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTDataAPI() iotdataplaneiface.IoTDataPlaneAPI {
+	if v, ok := client.fakes[names.IoTData].(iotdataplaneiface.IoTDataPlaneAPI); ok {
+		return v
+	}
+
+	return client.IoTDataConn
+}
+
+// IoTDeviceAdvisorAPI returns the IoTDeviceAdvisor client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTDeviceAdvisorAPI() iotdeviceadvisoriface.IoTDeviceAdvisorAPI {
+	if v, ok := client.fakes[names.IoTDeviceAdvisor].(iotdeviceadvisoriface.IoTDeviceAdvisorAPI); ok {
+		return v
+	}
+
+	return client.IoTDeviceAdvisorConn
+}
+
+// IoTEventsAPI returns the IoTEvents client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTEventsAPI() ioteventsiface.IoTEventsAPI {
+	if v, ok := client.fakes[names.IoTEvents].(ioteventsiface.IoTEventsAPI); ok {
+		return v
+	}
+
+	return client.IoTEventsConn
+}
+
+// IoTEventsDataAPI returns the IoTEventsData client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTEventsDataAPI() ioteventsdataiface.IoTEventsDataAPI {
+	if v, ok := client.fakes[names.IoTEventsData].(ioteventsdataiface.IoTEventsDataAPI); ok {
+		return v
+	}
+
+	return client.IoTEventsDataConn
+}
+
+// IoTFleetHubAPI returns the IoTFleetHub client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTFleetHubAPI() iotfleethubiface.IoTFleetHubAPI {
+	if v, ok := client.fakes[names.IoTFleetHub].(iotfleethubiface.IoTFleetHubAPI); ok {
+		return v
+	}
+
+	return client.IoTFleetHubConn
+}
+
+// IoTJobsDataAPI returns the IoTJobsData client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTJobsDataAPI() iotjobsdataplaneiface.IoTJobsDataPlaneAPI {
+	if v, ok := client.fakes[names.IoTJobsData].(iotjobsdataplaneiface.IoTJobsDataPlaneAPI); ok {
+		return v
+	}
+
+	return client.IoTJobsDataConn
+}
+
+// IoTSecureTunnelingAPI returns the IoTSecureTunneling client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTSecureTunnelingAPI() iotsecuretunnelingiface.IoTSecureTunnelingAPI {
+	if v, ok := client.fakes[names.IoTSecureTunneling].(iotsecuretunnelingiface.IoTSecureTunnelingAPI); ok {
+		return v
+	}
+
+	return client.IoTSecureTunnelingConn
+}
+
+// IoTSiteWiseAPI returns the IoTSiteWise client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTSiteWiseAPI() iotsitewiseiface.IoTSiteWiseAPI {
+	if v, ok := client.fakes[names.IoTSiteWise].(iotsitewiseiface.IoTSiteWiseAPI); ok {
+		return v
+	}
+
+	return client.IoTSiteWiseConn
+}
+
+// IoTThingsGraphAPI returns the IoTThingsGraph client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTThingsGraphAPI() iotthingsgraphiface.IoTThingsGraphAPI {
+	if v, ok := client.fakes[names.IoTThingsGraph].(iotthingsgraphiface.IoTThingsGraphAPI); ok {
+		return v
+	}
+
+	return client.IoTThingsGraphConn
+}
+
+// IoTTwinMakerAPI returns the IoTTwinMaker client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTTwinMakerAPI() iottwinmakeriface.IoTTwinMakerAPI {
+	if v, ok := client.fakes[names.IoTTwinMaker].(iottwinmakeriface.IoTTwinMakerAPI); ok {
+		return v
+	}
+
+	return client.IoTTwinMakerConn
+}
+
+// IoTWirelessAPI returns the IoTWireless client, or the fake injected with InjectFake.
+func (client *AWSClient) IoTWirelessAPI() iotwirelessiface.IoTWirelessAPI {
+	if v, ok := client.fakes[names.IoTWireless].(iotwirelessiface.IoTWirelessAPI); ok {
+		return v
+	}
+
+	return client.IoTWirelessConn
+}
+
+// KMSAPI returns the KMS client, or the fake injected with InjectFake.
+func (client *AWSClient) KMSAPI() kmsiface.KMSAPI {
+	if v, ok := client.fakes[names.KMS].(kmsiface.KMSAPI); ok {
+		return v
+	}
+
+	return client.KMSConn
+}
+
+// KafkaAPI returns the Kafka client, or the fake injected with InjectFake.
+func (client *AWSClient) KafkaAPI() kafkaiface.KafkaAPI {
+	if v, ok := client.fakes[names.Kafka].(kafkaiface.KafkaAPI); ok {
+		return v
+	}
+
+	return client.KafkaConn
+}
+
+// KafkaConnectAPI returns the KafkaConnect client, or the fake injected with InjectFake.
+func (client *AWSClient) KafkaConnectAPI() kafkaconnectiface.KafkaConnectAPI {
+	if v, ok := client.fakes[names.KafkaConnect].(kafkaconnectiface.KafkaConnectAPI); ok {
+		return v
+	}
+
+	return client.KafkaConnectConn
+}
+
+// KeyspacesAPI returns the Keyspaces client, or the fake injected with InjectFake.
+func (client *AWSClient) KeyspacesAPI() keyspacesiface.KeyspacesAPI {
+	if v, ok := client.fakes[names.Keyspaces].(keyspacesiface.KeyspacesAPI); ok {
+		return v
+	}
+
+	return client.KeyspacesConn
+}
+
+// KinesisAPI returns the Kinesis client, or the fake injected with InjectFake.
+func (client *AWSClient) KinesisAPI() kinesisiface.KinesisAPI {
+	if v, ok := client.fakes[names.Kinesis].(kinesisiface.KinesisAPI); ok {
+		return v
+	}
+
+	return client.KinesisConn
+}
+
+// KinesisAnalyticsAPI returns the KinesisAnalytics client, or the fake injected with InjectFake.
+func (client *AWSClient) KinesisAnalyticsAPI() kinesisanalyticsiface.KinesisAnalyticsAPI {
+	if v, ok := client.fakes[names.KinesisAnalytics].(kinesisanalyticsiface.KinesisAnalyticsAPI); ok {
+		return v
+	}
+
+	return client.KinesisAnalyticsConn
+}
+
+// KinesisAnalyticsV2API returns the KinesisAnalyticsV2 client, or the fake injected with InjectFake.
+func (client *AWSClient) KinesisAnalyticsV2API() kinesisanalyticsv2iface.KinesisAnalyticsV2API {
+	if v, ok := client.fakes[names.KinesisAnalyticsV2].(kinesisanalyticsv2iface.KinesisAnalyticsV2API); ok {
+		return v
+	}
+
+	return client.KinesisAnalyticsV2Conn
+}
+
+// KinesisVideoAPI returns the KinesisVideo client, or the fake injected with InjectFake.
+func (client *AWSClient) KinesisVideoAPI() kinesisvideoiface.KinesisVideoAPI {
+	if v, ok := client.fakes[names.KinesisVideo].(kinesisvideoiface.KinesisVideoAPI); ok {
+		return v
+	}
+
+	return client.KinesisVideoConn
+}
+
+// KinesisVideoArchivedMediaAPI returns the KinesisVideoArchivedMedia client, or the fake injected with InjectFake.
+func (client *AWSClient) KinesisVideoArchivedMediaAPI() kinesisvideoarchivedmediaiface.KinesisVideoArchivedMediaAPI {
+	if v, ok := client.fakes[names.KinesisVideoArchivedMedia].(kinesisvideoarchivedmediaiface.KinesisVideoArchivedMediaAPI); ok {
+		return v
+	}
+
+	return client.KinesisVideoArchivedMediaConn
+}
+
+// KinesisVideoMediaAPI returns the KinesisVideoMedia client, or the fake injected with InjectFake.
+func (client *AWSClient) KinesisVideoMediaAPI() kinesisvideomediaiface.KinesisVideoMediaAPI {
+	if v, ok := client.fakes[names.KinesisVideoMedia].(kinesisvideomediaiface.KinesisVideoMediaAPI); ok {
+		return v
+	}
+
+	return client.KinesisVideoMediaConn
+}
+
+// KinesisVideoSignalingAPI returns the KinesisVideoSignaling client, or the fake injected with InjectFake.
+func (client *AWSClient) KinesisVideoSignalingAPI() kinesisvideosignalingchannelsiface.KinesisVideoSignalingChannelsAPI {
+	if v, ok := client.fakes[names.KinesisVideoSignaling].(kinesisvideosignalingchannelsiface.KinesisVideoSignalingChannelsAPI); ok {
+		return v
+	}
+
+	return client.KinesisVideoSignalingConn
+}
+
+// LakeFormationAPI returns the LakeFormation client, or the fake injected with InjectFake.
+func (client *AWSClient) LakeFormationAPI() lakeformationiface.LakeFormationAPI {
+	if v, ok := client.fakes[names.LakeFormation].(lakeformationiface.LakeFormationAPI); ok {
+		return v
+	}
+
+	return client.LakeFormationConn
+}
+
+// LambdaAPI returns the Lambda client, or the fake injected with InjectFake.
+func (client *AWSClient) LambdaAPI() lambdaiface.LambdaAPI {
+	if v, ok := client.fakes[names.Lambda].(lambdaiface.LambdaAPI); ok {
+		return v
+	}
+
+	return client.LambdaConn
+}
+
+// LexModelsAPI returns the LexModels client, or the fake injected with InjectFake.
+func (client *AWSClient) LexModelsAPI() lexmodelbuildingserviceiface.LexModelBuildingServiceAPI {
+	if v, ok := client.fakes[names.LexModels].(lexmodelbuildingserviceiface.LexModelBuildingServiceAPI); ok {
+		return v
+	}
+
+	return client.LexModelsConn
+}
+
+// LexModelsV2API returns the LexModelsV2 client, or the fake injected with InjectFake.
+func (client *AWSClient) LexModelsV2API() lexmodelsv2iface.LexModelsV2API {
+	if v, ok := client.fakes[names.LexModelsV2].(lexmodelsv2iface.LexModelsV2API); ok {
+		return v
+	}
+
+	return client.LexModelsV2Conn
+}
+
+// LexRuntimeAPI returns the LexRuntime client, or the fake injected with InjectFake.
+func (client *AWSClient) LexRuntimeAPI() lexruntimeserviceiface.LexRuntimeServiceAPI {
+	if v, ok := client.fakes[names.LexRuntime].(lexruntimeserviceiface.LexRuntimeServiceAPI); ok {
+		return v
+	}
+
+	return client.LexRuntimeConn
+}
+
+// LexRuntimeV2API returns the LexRuntimeV2 client, or the fake injected with InjectFake.
+func (client *AWSClient) LexRuntimeV2API() lexruntimev2iface.LexRuntimeV2API {
+	if v, ok := client.fakes[names.LexRuntimeV2].(lexruntimev2iface.LexRuntimeV2API); ok {
+		return v
+	}
+
+	return client.LexRuntimeV2Conn
+}
+
+// LicenseManagerAPI returns the LicenseManager client, or the fake injected with InjectFake.
+func (client *AWSClient) LicenseManagerAPI() licensemanageriface.LicenseManagerAPI {
+	if v, ok := client.fakes[names.LicenseManager].(licensemanageriface.LicenseManagerAPI); ok {
+		return v
+	}
+
+	return client.LicenseManagerConn
+}
+
+// LightsailAPI returns the Lightsail client, or the fake injected with InjectFake.
+func (client *AWSClient) LightsailAPI() lightsailiface.LightsailAPI {
+	if v, ok := client.fakes[names.Lightsail].(lightsailiface.LightsailAPI); ok {
+		return v
+	}
+
+	return client.LightsailConn
+}
+
+// LocationAPI returns the Location client, or the fake injected with InjectFake.
+func (client *AWSClient) LocationAPI() locationserviceiface.LocationServiceAPI {
+	if v, ok := client.fakes[names.Location].(locationserviceiface.LocationServiceAPI); ok {
+		return v
+	}
+
+	return client.LocationConn
+}
+
+// LogsAPI returns the Logs client, or the fake injected with InjectFake.
+func (client *AWSClient) LogsAPI() cloudwatchlogsiface.CloudWatchLogsAPI {
+	if v, ok := client.fakes[names.Logs].(cloudwatchlogsiface.CloudWatchLogsAPI); ok {
+		return v
+	}
+
+	return client.LogsConn
+}
+
+// LookoutEquipmentAPI returns the LookoutEquipment client, or the fake injected with InjectFake.
+func (client *AWSClient) LookoutEquipmentAPI() lookoutequipmentiface.LookoutEquipmentAPI {
+	if v, ok := client.fakes[names.LookoutEquipment].(lookoutequipmentiface.LookoutEquipmentAPI); ok {
+		return v
+	}
+
+	return client.LookoutEquipmentConn
+}
+
+// LookoutMetricsAPI returns the LookoutMetrics client, or the fake injected with InjectFake.
+func (client *AWSClient) LookoutMetricsAPI() lookoutmetricsiface.LookoutMetricsAPI {
+	if v, ok := client.fakes[names.LookoutMetrics].(lookoutmetricsiface.LookoutMetricsAPI); ok {
+		return v
+	}
+
+	return client.LookoutMetricsConn
+}
+
+// LookoutVisionAPI returns the LookoutVision client, or the fake injected with InjectFake.
+func (client *AWSClient) LookoutVisionAPI() lookoutforvisioniface.LookoutForVisionAPI {
+	if v, ok := client.fakes[names.LookoutVision].(lookoutforvisioniface.LookoutForVisionAPI); ok {
+		return v
+	}
+
+	return client.LookoutVisionConn
+}
+
+// MQAPI returns the MQ client, or the fake injected with InjectFake.
+func (client *AWSClient) MQAPI() mqiface.MQAPI {
+	if v, ok := client.fakes[names.MQ].(mqiface.MQAPI); ok {
+		return v
+	}
+
+	return client.MQConn
+}
+
+// MTurkAPI returns the MTurk client, or the fake injected with InjectFake.
+func (client *AWSClient) MTurkAPI() mturkiface.MTurkAPI {
+	if v, ok := client.fakes[names.MTurk].(mturkiface.MTurkAPI); ok {
+		return v
+	}
+
+	return client.MTurkConn
+}
+
+// MWAAAPI returns the MWAA client, or the fake injected with InjectFake.
+func (client *AWSClient) MWAAAPI() mwaaiface.MWAAAPI {
+	if v, ok := client.fakes[names.MWAA].(mwaaiface.MWAAAPI); ok {
+		return v
+	}
+
+	return client.MWAAConn
+}
+
+// MachineLearningAPI returns the MachineLearning client, or the fake injected with InjectFake.
+func (client *AWSClient) MachineLearningAPI() machinelearningiface.MachineLearningAPI {
+	if v, ok := client.fakes[names.MachineLearning].(machinelearningiface.MachineLearningAPI); ok {
+		return v
+	}
+
+	return client.MachineLearningConn
+}
+
+// MacieAPI returns the Macie client, or the fake injected with InjectFake.
+func (client *AWSClient) MacieAPI() macieiface.MacieAPI {
+	if v, ok := client.fakes[names.Macie].(macieiface.MacieAPI); ok {
+		return v
+	}
+
+	return client.MacieConn
+}
+
+// Macie2API returns the Macie2 client, or the fake injected with InjectFake.
+func (client *AWSClient) Macie2API() macie2iface.Macie2API {
+	if v, ok := client.fakes[names.Macie2].(macie2iface.Macie2API); ok {
+		return v
+	}
+
+	return client.Macie2Conn
+}
+
+// ManagedBlockchainAPI returns the ManagedBlockchain client, or the fake injected with InjectFake.
+func (client *AWSClient) ManagedBlockchainAPI() managedblockchainiface.ManagedBlockchainAPI {
+	if v, ok := client.fakes[names.ManagedBlockchain].(managedblockchainiface.ManagedBlockchainAPI); ok {
+		return v
+	}
+
+	return client.ManagedBlockchainConn
+}
+
+// MarketplaceCatalogAPI returns the MarketplaceCatalog client, or the fake injected with InjectFake.
+func (client *AWSClient) MarketplaceCatalogAPI() marketplacecatalogiface.MarketplaceCatalogAPI {
+	if v, ok := client.fakes[names.MarketplaceCatalog].(marketplacecatalogiface.MarketplaceCatalogAPI); ok {
+		return v
+	}
+
+	return client.MarketplaceCatalogConn
+}
+
+// MarketplaceCommerceAnalyticsAPI returns the MarketplaceCommerceAnalytics client, or the fake injected with InjectFake.
+func (client *AWSClient) MarketplaceCommerceAnalyticsAPI() marketplacecommerceanalyticsiface.MarketplaceCommerceAnalyticsAPI {
+	if v, ok := client.fakes[names.MarketplaceCommerceAnalytics].(marketplacecommerceanalyticsiface.MarketplaceCommerceAnalyticsAPI); ok {
+		return v
+	}
+
+	return client.MarketplaceCommerceAnalyticsConn
+}
+
+// MarketplaceEntitlementAPI returns the MarketplaceEntitlement client, or the fake injected with InjectFake.
+func (client *AWSClient) MarketplaceEntitlementAPI() marketplaceentitlementserviceiface.MarketplaceEntitlementServiceAPI {
+	if v, ok := client.fakes[names.MarketplaceEntitlement].(marketplaceentitlementserviceiface.MarketplaceEntitlementServiceAPI); ok {
+		return v
+	}
+
+	return client.MarketplaceEntitlementConn
+}
+
+// MarketplaceMeteringAPI returns the MarketplaceMetering client, or the fake injected with InjectFake.
+func (client *AWSClient) MarketplaceMeteringAPI() marketplacemeteringiface.MarketplaceMeteringAPI {
+	if v, ok := client.fakes[names.MarketplaceMetering].(marketplacemeteringiface.MarketplaceMeteringAPI); ok {
+		return v
+	}
+
+	return client.MarketplaceMeteringConn
+}
+
+// MediaConnectAPI returns the MediaConnect client, or the fake injected with InjectFake.
+func (client *AWSClient) MediaConnectAPI() mediaconnectiface.MediaConnectAPI {
+	if v, ok := client.fakes[names.MediaConnect].(mediaconnectiface.MediaConnectAPI); ok {
+		return v
+	}
+
+	return client.MediaConnectConn
+}
+
+// MediaConvertAPI returns the MediaConvert client, or the fake injected with InjectFake.
+func (client *AWSClient) MediaConvertAPI() mediaconvertiface.MediaConvertAPI {
+	if v, ok := client.fakes[names.MediaConvert].(mediaconvertiface.MediaConvertAPI); ok {
+		return v
+	}
+
+	return client.MediaConvertConn
+}
+
+// MediaLiveAPI returns the MediaLive client, or the fake injected with InjectFake.
+func (client *AWSClient) MediaLiveAPI() medialiveiface.MediaLiveAPI {
+	if v, ok := client.fakes[names.MediaLive].(medialiveiface.MediaLiveAPI); ok {
+		return v
+	}
+
+	return client.MediaLiveConn
+}
+
+// MediaPackageAPI returns the MediaPackage client, or the fake injected with InjectFake.
+func (client *AWSClient) MediaPackageAPI() mediapackageiface.MediaPackageAPI {
+	if v, ok := client.fakes[names.MediaPackage].(mediapackageiface.MediaPackageAPI); ok {
+		return v
+	}
+
+	return client.MediaPackageConn
+}
+
+// MediaPackageVODAPI returns the MediaPackageVOD client, or the fake injected with InjectFake.
+func (client *AWSClient) MediaPackageVODAPI() mediapackagevodiface.MediaPackageVodAPI {
+	if v, ok := client.fakes[names.MediaPackageVOD].(mediapackagevodiface.MediaPackageVodAPI); ok {
+		return v
+	}
+
+	return client.MediaPackageVODConn
+}
+
+// MediaStoreAPI returns the MediaStore client, or the fake injected with InjectFake.
+func (client *AWSClient) MediaStoreAPI() mediastoreiface.MediaStoreAPI {
+	if v, ok := client.fakes[names.MediaStore].(mediastoreiface.MediaStoreAPI); ok {
+		return v
+	}
+
+	return client.MediaStoreConn
+}
+
+// MediaStoreDataAPI returns the MediaStoreData client, or the fake injected with InjectFake.
+func (client *AWSClient) MediaStoreDataAPI() mediastoredataiface.MediaStoreDataAPI {
+	if v, ok := client.fakes[names.MediaStoreData].(mediastoredataiface.MediaStoreDataAPI); ok {
+		return v
+	}
+
+	return client.MediaStoreDataConn
+}
+
+// MediaTailorAPI returns the MediaTailor client, or the fake injected with InjectFake.
+func (client *AWSClient) MediaTailorAPI() mediatailoriface.MediaTailorAPI {
+	if v, ok := client.fakes[names.MediaTailor].(mediatailoriface.MediaTailorAPI); ok {
+		return v
+	}
+
+	return client.MediaTailorConn
+}
+
+// MemoryDBAPI returns the MemoryDB client, or the fake injected with InjectFake.
+func (client *AWSClient) MemoryDBAPI() memorydbiface.MemoryDBAPI {
+	if v, ok := client.fakes[names.MemoryDB].(memorydbiface.MemoryDBAPI); ok {
+		return v
+	}
+
+	return client.MemoryDBConn
+}
+
+// MgHAPI returns the MgH client, or the fake injected with InjectFake.
+func (client *AWSClient) MgHAPI() migrationhubiface.MigrationHubAPI {
+	if v, ok := client.fakes[names.MgH].(migrationhubiface.MigrationHubAPI); ok {
+		return v
+	}
+
+	return client.MgHConn
+}
+
+// MgnAPI returns the Mgn client, or the fake injected with InjectFake.
+func (client *AWSClient) MgnAPI() mgniface.MgnAPI {
+	if v, ok := client.fakes[names.Mgn].(mgniface.MgnAPI); ok {
+		return v
+	}
+
+	return client.MgnConn
+}
+
+// MigrationHubConfigAPI returns the MigrationHubConfig client, or the fake injected with InjectFake.
+func (client *AWSClient) MigrationHubConfigAPI() migrationhubconfigiface.MigrationHubConfigAPI {
+	if v, ok := client.fakes[names.MigrationHubConfig].(migrationhubconfigiface.MigrationHubConfigAPI); ok {
+		return v
+	}
+
+	return client.MigrationHubConfigConn
+}
+
+// MigrationHubRefactorSpacesAPI returns the MigrationHubRefactorSpaces client, or the fake injected with InjectFake.
+func (client *AWSClient) MigrationHubRefactorSpacesAPI() migrationhubrefactorspacesiface.MigrationHubRefactorSpacesAPI {
+	if v, ok := client.fakes[names.MigrationHubRefactorSpaces].(migrationhubrefactorspacesiface.MigrationHubRefactorSpacesAPI); ok {
+		return v
+	}
+
+	return client.MigrationHubRefactorSpacesConn
+}
+
+// MigrationHubStrategyAPI returns the MigrationHubStrategy client, or the fake injected with InjectFake.
+func (client *AWSClient) MigrationHubStrategyAPI() migrationhubstrategyrecommendationsiface.MigrationHubStrategyRecommendationsAPI {
+	if v, ok := client.fakes[names.MigrationHubStrategy].(migrationhubstrategyrecommendationsiface.MigrationHubStrategyRecommendationsAPI); ok {
+		return v
+	}
+
+	return client.MigrationHubStrategyConn
+}
+
+// MobileAPI returns the Mobile client, or the fake injected with InjectFake.
+func (client *AWSClient) MobileAPI() mobileiface.MobileAPI {
+	if v, ok := client.fakes[names.Mobile].(mobileiface.MobileAPI); ok {
+		return v
+	}
+
+	return client.MobileConn
+}
+
+// NeptuneAPI returns the Neptune client, or the fake injected with InjectFake.
+func (client *AWSClient) NeptuneAPI() neptuneiface.NeptuneAPI {
+	if v, ok := client.fakes[names.Neptune].(neptuneiface.NeptuneAPI); ok {
+		return v
+	}
+
+	return client.NeptuneConn
+}
+
+// NetworkFirewallAPI returns the NetworkFirewall client, or the fake injected with InjectFake.
+func (client *AWSClient) NetworkFirewallAPI() networkfirewalliface.NetworkFirewallAPI {
+	if v, ok := client.fakes[names.NetworkFirewall].(networkfirewalliface.NetworkFirewallAPI); ok {
+		return v
+	}
+
+	return client.NetworkFirewallConn
+}
+
+// NetworkManagerAPI returns the NetworkManager client, or the fake injected with InjectFake.
+func (client *AWSClient) NetworkManagerAPI() networkmanageriface.NetworkManagerAPI {
+	if v, ok := client.fakes[names.NetworkManager].(networkmanageriface.NetworkManagerAPI); ok {
+		return v
+	}
+
+	return client.NetworkManagerConn
+}
+
+// NimbleAPI returns the Nimble client, or the fake injected with InjectFake.
+func (client *AWSClient) NimbleAPI() nimblestudioiface.NimbleStudioAPI {
+	if v, ok := client.fakes[names.Nimble].(nimblestudioiface.NimbleStudioAPI); ok {
+		return v
+	}
+
+	return client.NimbleConn
+}
+
+// OpenSearchAPI returns the OpenSearch client, or the fake injected with InjectFake.
+func (client *AWSClient) OpenSearchAPI() opensearchserviceiface.OpenSearchServiceAPI {
+	if v, ok := client.fakes[names.OpenSearch].(opensearchserviceiface.OpenSearchServiceAPI); ok {
+		return v
+	}
+
+	return client.OpenSearchConn
+}
+
+// OpsWorksAPI returns the OpsWorks client, or the fake injected with InjectFake.
+func (client *AWSClient) OpsWorksAPI() opsworksiface.OpsWorksAPI {
+	if v, ok := client.fakes[names.OpsWorks].(opsworksiface.OpsWorksAPI); ok {
+		return v
+	}
+
+	return client.OpsWorksConn
+}
+
+// OpsWorksCMAPI returns the OpsWorksCM client, or the fake injected with InjectFake.
+func (client *AWSClient) OpsWorksCMAPI() opsworkscmiface.OpsWorksCMAPI {
+	if v, ok := client.fakes[names.OpsWorksCM].(opsworkscmiface.OpsWorksCMAPI); ok {
+		return v
+	}
+
+	return client.OpsWorksCMConn
+}
+
+// OrganizationsAPI returns the Organizations client, or the fake injected with InjectFake.
+func (client *AWSClient) OrganizationsAPI() organizationsiface.OrganizationsAPI {
+	if v, ok := client.fakes[names.Organizations].(organizationsiface.OrganizationsAPI); ok {
+		return v
+	}
+
+	return client.OrganizationsConn
+}
+
+// OutpostsAPI returns the Outposts client, or the fake injected with InjectFake.
+func (client *AWSClient) OutpostsAPI() outpostsiface.OutpostsAPI {
+	if v, ok := client.fakes[names.Outposts].(outpostsiface.OutpostsAPI); ok {
+		return v
+	}
+
+	return client.OutpostsConn
+}
+
+// PIAPI returns the PI client, or the fake injected with InjectFake.
+func (client *AWSClient) PIAPI() piiface.PIAPI {
+	if v, ok := client.fakes[names.PI].(piiface.PIAPI); ok {
+		return v
+	}
+
+	return client.PIConn
+}
+
+// PanoramaAPI returns the Panorama client, or the fake injected with InjectFake.
+func (client *AWSClient) PanoramaAPI() panoramaiface.PanoramaAPI {
+	if v, ok := client.fakes[names.Panorama].(panoramaiface.PanoramaAPI); ok {
+		return v
+	}
+
+	return client.PanoramaConn
+}
+
+// PersonalizeAPI returns the Personalize client, or the fake injected with InjectFake.
+func (client *AWSClient) PersonalizeAPI() personalizeiface.PersonalizeAPI {
+	if v, ok := client.fakes[names.Personalize].(personalizeiface.PersonalizeAPI); ok {
+		return v
+	}
+
+	return client.PersonalizeConn
+}
+
+// PersonalizeEventsAPI returns the PersonalizeEvents client, or the fake injected with InjectFake.
+func (client *AWSClient) PersonalizeEventsAPI() personalizeeventsiface.PersonalizeEventsAPI {
+	if v, ok := client.fakes[names.PersonalizeEvents].(personalizeeventsiface.PersonalizeEventsAPI); ok {
+		return v
+	}
+
+	return client.PersonalizeEventsConn
+}
+
+// PersonalizeRuntimeAPI returns the PersonalizeRuntime client, or the fake injected with InjectFake.
+func (client *AWSClient) PersonalizeRuntimeAPI() personalizeruntimeiface.PersonalizeRuntimeAPI {
+	if v, ok := client.fakes[names.PersonalizeRuntime].(personalizeruntimeiface.PersonalizeRuntimeAPI); ok {
+		return v
+	}
+
+	return client.PersonalizeRuntimeConn
+}
+
+// PinpointAPI returns the Pinpoint client, or the fake injected with InjectFake.
+func (client *AWSClient) PinpointAPI() pinpointiface.PinpointAPI {
+	if v, ok := client.fakes[names.Pinpoint].(pinpointiface.PinpointAPI); ok {
+		return v
+	}
+
+	return client.PinpointConn
+}
+
+// PinpointEmailAPI returns the PinpointEmail client, or the fake injected with InjectFake.
+func (client *AWSClient) PinpointEmailAPI() pinpointemailiface.PinpointEmailAPI {
+	if v, ok := client.fakes[names.PinpointEmail].(pinpointemailiface.PinpointEmailAPI); ok {
+		return v
+	}
+
+	return client.PinpointEmailConn
+}
+
+// PinpointSMSVoiceAPI returns the PinpointSMSVoice client, or the fake injected with InjectFake.
+func (client *AWSClient) PinpointSMSVoiceAPI() pinpointsmsvoiceiface.PinpointSMSVoiceAPI {
+	if v, ok := client.fakes[names.PinpointSMSVoice].(pinpointsmsvoiceiface.PinpointSMSVoiceAPI); ok {
+		return v
+	}
+
+	return client.PinpointSMSVoiceConn
+}
+
+// PollyAPI returns the Polly client, or the fake injected with InjectFake.
+func (client *AWSClient) PollyAPI() pollyiface.PollyAPI {
+	if v, ok := client.fakes[names.Polly].(pollyiface.PollyAPI); ok {
+		return v
+	}
+
+	return client.PollyConn
+}
+
+// PricingAPI returns the Pricing client, or the fake injected with InjectFake.
+func (client *AWSClient) PricingAPI() pricingiface.PricingAPI {
+	if v, ok := client.fakes[names.Pricing].(pricingiface.PricingAPI); ok {
+		return v
+	}
+
+	return client.PricingConn
+}
+
+// ProtonAPI returns the Proton client, or the fake injected with InjectFake.
+func (client *AWSClient) ProtonAPI() protoniface.ProtonAPI {
+	if v, ok := client.fakes[names.Proton].(protoniface.ProtonAPI); ok {
+		return v
+	}
+
+	return client.ProtonConn
+}
+
+// QLDBAPI returns the QLDB client, or the fake injected with InjectFake.
+func (client *AWSClient) QLDBAPI() qldbiface.QLDBAPI {
+	if v, ok := client.fakes[names.QLDB].(qldbiface.QLDBAPI); ok {
+		return v
+	}
+
+	return client.QLDBConn
+}
+
+// QLDBSessionAPI returns the QLDBSession client, or the fake injected with InjectFake.
+func (client *AWSClient) QLDBSessionAPI() qldbsessioniface.QLDBSessionAPI {
+	if v, ok := client.fakes[names.QLDBSession].(qldbsessioniface.QLDBSessionAPI); ok {
+		return v
+	}
+
+	return client.QLDBSessionConn
+}
+
+// QuickSightAPI returns the QuickSight client, or the fake injected with InjectFake.
+func (client *AWSClient) QuickSightAPI() quicksightiface.QuickSightAPI {
+	if v, ok := client.fakes[names.QuickSight].(quicksightiface.QuickSightAPI); ok {
+		return v
+	}
+
+	return client.QuickSightConn
+}
+
+// RAMAPI returns the RAM client, or the fake injected with InjectFake.
+func (client *AWSClient) RAMAPI() ramiface.RAMAPI {
+	if v, ok := client.fakes[names.RAM].(ramiface.RAMAPI); ok {
+		return v
+	}
+
+	return client.RAMConn
+}
+
+// RBinAPI returns the RBin client, or the fake injected with InjectFake.
+func (client *AWSClient) RBinAPI() recyclebiniface.RecycleBinAPI {
+	if v, ok := client.fakes[names.RBin].(recyclebiniface.RecycleBinAPI); ok {
+		return v
+	}
+
+	return client.RBinConn
+}
+
+// RDSAPI returns the RDS client, or the fake injected with InjectFake.
+func (client *AWSClient) RDSAPI() rdsiface.RDSAPI {
+	if v, ok := client.fakes[names.RDS].(rdsiface.RDSAPI); ok {
+		return v
+	}
+
+	return client.RDSConn
+}
+
+// RDSDataAPI returns the RDSData client, or the fake injected with InjectFake.
+func (client *AWSClient) RDSDataAPI() rdsdataserviceiface.RDSDataServiceAPI {
+	if v, ok := client.fakes[names.RDSData].(rdsdataserviceiface.RDSDataServiceAPI); ok {
+		return v
+	}
+
+	return client.RDSDataConn
+}
+
+// RUMAPI returns the RUM client, or the fake injected with InjectFake.
+func (client *AWSClient) RUMAPI() cloudwatchrumiface.CloudWatchRUMAPI {
+	if v, ok := client.fakes[names.RUM].(cloudwatchrumiface.CloudWatchRUMAPI); ok {
+		return v
+	}
+
+	return client.RUMConn
+}
+
+// RedshiftAPI returns the Redshift client, or the fake injected with InjectFake.
+func (client *AWSClient) RedshiftAPI() redshiftiface.RedshiftAPI {
+	if v, ok := client.fakes[names.Redshift].(redshiftiface.RedshiftAPI); ok {
+		return v
+	}
+
+	return client.RedshiftConn
+}
+
+// RedshiftDataAPI returns the RedshiftData client, or the fake injected with InjectFake.
+func (client *AWSClient) RedshiftDataAPI() redshiftdataapiserviceiface.RedshiftDataAPIServiceAPI {
+	if v, ok := client.fakes[names.RedshiftData].(redshiftdataapiserviceiface.RedshiftDataAPIServiceAPI); ok {
+		return v
+	}
+
+	return client.RedshiftDataConn
+}
+
+// RekognitionAPI returns the Rekognition client, or the fake injected with InjectFake.
+func (client *AWSClient) RekognitionAPI() rekognitioniface.RekognitionAPI {
+	if v, ok := client.fakes[names.Rekognition].(rekognitioniface.RekognitionAPI); ok {
+		return v
+	}
+
+	return client.RekognitionConn
+}
+
+// ResilienceHubAPI returns the ResilienceHub client, or the fake injected with InjectFake.
+func (client *AWSClient) ResilienceHubAPI() resiliencehubiface.ResilienceHubAPI {
+	if v, ok := client.fakes[names.ResilienceHub].(resiliencehubiface.ResilienceHubAPI); ok {
+		return v
+	}
+
+	return client.ResilienceHubConn
+}
+
+// ResourceGroupsAPI returns the ResourceGroups client, or the fake injected with InjectFake.
+func (client *AWSClient) ResourceGroupsAPI() resourcegroupsiface.ResourceGroupsAPI {
+	if v, ok := client.fakes[names.ResourceGroups].(resourcegroupsiface.ResourceGroupsAPI); ok {
+		return v
+	}
+
+	return client.ResourceGroupsConn
+}
+
+// ResourceGroupsTaggingAPIAPI returns the ResourceGroupsTaggingAPI client, or the fake injected with InjectFake.
+func (client *AWSClient) ResourceGroupsTaggingAPIAPI() resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI {
+	if v, ok := client.fakes[names.ResourceGroupsTaggingAPI].(resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI); ok {
+		return v
+	}
+
+	return client.ResourceGroupsTaggingAPIConn
+}
+
+// RoboMakerAPI returns the RoboMaker client, or the fake injected with InjectFake.
+func (client *AWSClient) RoboMakerAPI() robomakeriface.RoboMakerAPI {
+	if v, ok := client.fakes[names.RoboMaker].(robomakeriface.RoboMakerAPI); ok {
+		return v
+	}
+
+	return client.RoboMakerConn
+}
+
+// Route53API returns the Route53 client, or the fake injected with InjectFake.
+func (client *AWSClient) Route53API() route53iface.Route53API {
+	if v, ok := client.fakes[names.Route53].(route53iface.Route53API); ok {
+		return v
+	}
+
+	return client.Route53Conn
+}
+
+// Route53RecoveryClusterAPI returns the Route53RecoveryCluster client, or the fake injected with InjectFake.
+func (client *AWSClient) Route53RecoveryClusterAPI() route53recoveryclusteriface.Route53RecoveryClusterAPI {
+	if v, ok := client.fakes[names.Route53RecoveryCluster].(route53recoveryclusteriface.Route53RecoveryClusterAPI); ok {
+		return v
+	}
+
+	return client.Route53RecoveryClusterConn
+}
+
+// Route53RecoveryControlConfigAPI returns the Route53RecoveryControlConfig client, or the fake injected with InjectFake.
+func (client *AWSClient) Route53RecoveryControlConfigAPI() route53recoverycontrolconfigiface.Route53RecoveryControlConfigAPI {
+	if v, ok := client.fakes[names.Route53RecoveryControlConfig].(route53recoverycontrolconfigiface.Route53RecoveryControlConfigAPI); ok {
+		return v
+	}
+
+	return client.Route53RecoveryControlConfigConn
+}
+
+// Route53RecoveryReadinessAPI returns the Route53RecoveryReadiness client, or the fake injected with InjectFake.
+func (client *AWSClient) Route53RecoveryReadinessAPI() route53recoveryreadinessiface.Route53RecoveryReadinessAPI {
+	if v, ok := client.fakes[names.Route53RecoveryReadiness].(route53recoveryreadinessiface.Route53RecoveryReadinessAPI); ok {
+		return v
+	}
+
+	return client.Route53RecoveryReadinessConn
+}
+
+// Route53ResolverAPI returns the Route53Resolver client, or the fake injected with InjectFake.
+func (client *AWSClient) Route53ResolverAPI() route53resolveriface.Route53ResolverAPI {
+	if v, ok := client.fakes[names.Route53Resolver].(route53resolveriface.Route53ResolverAPI); ok {
+		return v
+	}
+
+	return client.Route53ResolverConn
+}
+
+// S3API returns the S3 client, or the fake injected with InjectFake.
+func (client *AWSClient) S3API() s3iface.S3API {
+	if v, ok := client.fakes[names.S3].(s3iface.S3API); ok {
+		return v
+	}
+
+	return client.S3Conn
+}
+
+// S3ControlAPI returns the S3Control client, or the fake injected with InjectFake.
+func (client *AWSClient) S3ControlAPI() s3controliface.S3ControlAPI {
+	if v, ok := client.fakes[names.S3Control].(s3controliface.S3ControlAPI); ok {
+		return v
+	}
+
+	return client.S3ControlConn
+}
+
+// S3OutpostsAPI returns the S3Outposts client, or the fake injected with InjectFake.
+func (client *AWSClient) S3OutpostsAPI() s3outpostsiface.S3OutpostsAPI {
+	if v, ok := client.fakes[names.S3Outposts].(s3outpostsiface.S3OutpostsAPI); ok {
+		return v
+	}
+
+	return client.S3OutpostsConn
+}
+
+// SESAPI returns the SES client, or the fake injected with InjectFake.
+func (client *AWSClient) SESAPI() sesiface.SESAPI {
+	if v, ok := client.fakes[names.SES].(sesiface.SESAPI); ok {
+		return v
+	}
+
+	return client.SESConn
+}
+
+// SESV2API returns the SESV2 client, or the fake injected with InjectFake.
+func (client *AWSClient) SESV2API() sesv2iface.SESV2API {
+	if v, ok := client.fakes[names.SESV2].(sesv2iface.SESV2API); ok {
+		return v
+	}
+
+	return client.SESV2Conn
+}
+
+// SFNAPI returns the SFN client, or the fake injected with InjectFake.
+func (client *AWSClient) SFNAPI() sfniface.SFNAPI {
+	if v, ok := client.fakes[names.SFN].(sfniface.SFNAPI); ok {
+		return v
+	}
+
+	return client.SFNConn
+}
+
+// SMSAPI returns the SMS client, or the fake injected with InjectFake.
+func (client *AWSClient) SMSAPI() smsiface.SMSAPI {
+	if v, ok := client.fakes[names.SMS].(smsiface.SMSAPI); ok {
+		return v
+	}
+
+	return client.SMSConn
+}
+
+// SNSAPI returns the SNS client, or the fake injected with InjectFake.
+func (client *AWSClient) SNSAPI() snsiface.SNSAPI {
+	if v, ok := client.fakes[names.SNS].(snsiface.SNSAPI); ok {
+		return v
+	}
+
+	return client.SNSConn
+}
+
+// SQSAPI returns the SQS client, or the fake injected with InjectFake.
+func (client *AWSClient) SQSAPI() sqsiface.SQSAPI {
+	if v, ok := client.fakes[names.SQS].(sqsiface.SQSAPI); ok {
+		return v
+	}
+
+	return client.SQSConn
+}
+
+// SSMAPI returns the SSM client, or the fake injected with InjectFake.
+func (client *AWSClient) SSMAPI() ssmiface.SSMAPI {
+	if v, ok := client.fakes[names.SSM].(ssmiface.SSMAPI); ok {
+		return v
+	}
+
+	return client.SSMConn
+}
+
+// SSMContactsAPI returns the SSMContacts client, or the fake injected with InjectFake.
+func (client *AWSClient) SSMContactsAPI() ssmcontactsiface.SSMContactsAPI {
+	if v, ok := client.fakes[names.SSMContacts].(ssmcontactsiface.SSMContactsAPI); ok {
+		return v
+	}
+
+	return client.SSMContactsConn
+}
+
+// SSMIncidentsAPI returns the SSMIncidents client, or the fake injected with InjectFake.
+func (client *AWSClient) SSMIncidentsAPI() ssmincidentsiface.SSMIncidentsAPI {
+	if v, ok := client.fakes[names.SSMIncidents].(ssmincidentsiface.SSMIncidentsAPI); ok {
+		return v
+	}
+
+	return client.SSMIncidentsConn
+}
+
+// SSOAPI returns the SSO client, or the fake injected with InjectFake.
+func (client *AWSClient) SSOAPI() ssoiface.SSOAPI {
+	if v, ok := client.fakes[names.SSO].(ssoiface.SSOAPI); ok {
+		return v
+	}
+
+	return client.SSOConn
+}
+
+// SSOAdminAPI returns the SSOAdmin client, or the fake injected with InjectFake.
+func (client *AWSClient) SSOAdminAPI() ssoadminiface.SSOAdminAPI {
+	if v, ok := client.fakes[names.SSOAdmin].(ssoadminiface.SSOAdminAPI); ok {
+		return v
+	}
+
+	return client.SSOAdminConn
+}
+
+// SSOOIDCAPI returns the SSOOIDC client, or the fake injected with InjectFake.
+func (client *AWSClient) SSOOIDCAPI() ssooidciface.SSOOIDCAPI {
+	if v, ok := client.fakes[names.SSOOIDC].(ssooidciface.SSOOIDCAPI); ok {
+		return v
+	}
+
+	return client.SSOOIDCConn
+}
+
+// STSAPI returns the STS client, or the fake injected with InjectFake.
+func (client *AWSClient) STSAPI() stsiface.STSAPI {
+	if v, ok := client.fakes[names.STS].(stsiface.STSAPI); ok {
+		return v
+	}
+
+	return client.STSConn
+}
+
+// SWFAPI returns the SWF client, or the fake injected with InjectFake.
+func (client *AWSClient) SWFAPI() swfiface.SWFAPI {
+	if v, ok := client.fakes[names.SWF].(swfiface.SWFAPI); ok {
+		return v
+	}
+
+	return client.SWFConn
+}
+
+// SageMakerAPI returns the SageMaker client, or the fake injected with InjectFake.
+func (client *AWSClient) SageMakerAPI() sagemakeriface.SageMakerAPI {
+	if v, ok := client.fakes[names.SageMaker].(sagemakeriface.SageMakerAPI); ok {
+		return v
+	}
+
+	return client.SageMakerConn
+}
+
+// SageMakerA2IRuntimeAPI returns the SageMakerA2IRuntime client, or the fake injected with InjectFake.
+func (client *AWSClient) SageMakerA2IRuntimeAPI() augmentedairuntimeiface.AugmentedAIRuntimeAPI {
+	if v, ok := client.fakes[names.SageMakerA2IRuntime].(augmentedairuntimeiface.AugmentedAIRuntimeAPI); ok {
+		return v
+	}
+
+	return client.SageMakerA2IRuntimeConn
+}
+
+// SageMakerEdgeAPI returns the SageMakerEdge client, or the fake injected with InjectFake.
+func (client *AWSClient) SageMakerEdgeAPI() sagemakeredgemanageriface.SagemakerEdgeManagerAPI {
+	if v, ok := client.fakes[names.SageMakerEdge].(sagemakeredgemanageriface.SagemakerEdgeManagerAPI); ok {
+		return v
+	}
+
+	return client.SageMakerEdgeConn
+}
+
+// SageMakerFeatureStoreRuntimeAPI returns the SageMakerFeatureStoreRuntime client, or the fake injected with InjectFake.
+func (client *AWSClient) SageMakerFeatureStoreRuntimeAPI() sagemakerfeaturestoreruntimeiface.SageMakerFeatureStoreRuntimeAPI {
+	if v, ok := client.fakes[names.SageMakerFeatureStoreRuntime].(sagemakerfeaturestoreruntimeiface.SageMakerFeatureStoreRuntimeAPI); ok {
+		return v
+	}
+
+	return client.SageMakerFeatureStoreRuntimeConn
+}
+
+// SageMakerRuntimeAPI returns the SageMakerRuntime client, or the fake injected with InjectFake.
+func (client *AWSClient) SageMakerRuntimeAPI() sagemakerruntimeiface.SageMakerRuntimeAPI {
+	if v, ok := client.fakes[names.SageMakerRuntime].(sagemakerruntimeiface.SageMakerRuntimeAPI); ok {
+		return v
+	}
+
+	return client.SageMakerRuntimeConn
+}
+
+// SavingsPlansAPI returns the SavingsPlans client, or the fake injected with InjectFake.
+func (client *AWSClient) SavingsPlansAPI() savingsplansiface.SavingsPlansAPI {
+	if v, ok := client.fakes[names.SavingsPlans].(savingsplansiface.SavingsPlansAPI); ok {
+		return v
+	}
+
+	return client.SavingsPlansConn
+}
+
+// SchemasAPI returns the Schemas client, or the fake injected with InjectFake.
+func (client *AWSClient) SchemasAPI() schemasiface.SchemasAPI {
+	if v, ok := client.fakes[names.Schemas].(schemasiface.SchemasAPI); ok {
+		return v
+	}
+
+	return client.SchemasConn
+}
+
+// SecretsManagerAPI returns the SecretsManager client, or the fake injected with InjectFake.
+func (client *AWSClient) SecretsManagerAPI() secretsmanageriface.SecretsManagerAPI {
+	if v, ok := client.fakes[names.SecretsManager].(secretsmanageriface.SecretsManagerAPI); ok {
+		return v
+	}
+
+	return client.SecretsManagerConn
+}
+
+// SecurityHubAPI returns the SecurityHub client, or the fake injected with InjectFake.
+func (client *AWSClient) SecurityHubAPI() securityhubiface.SecurityHubAPI {
+	if v, ok := client.fakes[names.SecurityHub].(securityhubiface.SecurityHubAPI); ok {
+		return v
+	}
+
+	return client.SecurityHubConn
+}
+
+// ServerlessRepoAPI returns the ServerlessRepo client, or the fake injected with InjectFake.
+func (client *AWSClient) ServerlessRepoAPI() serverlessapplicationrepositoryiface.ServerlessApplicationRepositoryAPI {
+	if v, ok := client.fakes[names.ServerlessRepo].(serverlessapplicationrepositoryiface.ServerlessApplicationRepositoryAPI); ok {
+		return v
+	}
+
+	return client.ServerlessRepoConn
+}
+
+// ServiceCatalogAPI returns the ServiceCatalog client, or the fake injected with InjectFake.
+func (client *AWSClient) ServiceCatalogAPI() servicecatalogiface.ServiceCatalogAPI {
+	if v, ok := client.fakes[names.ServiceCatalog].(servicecatalogiface.ServiceCatalogAPI); ok {
+		return v
+	}
+
+	return client.ServiceCatalogConn
+}
+
+// ServiceCatalogAppRegistryAPI returns the ServiceCatalogAppRegistry client, or the fake injected with InjectFake.
+func (client *AWSClient) ServiceCatalogAppRegistryAPI() appregistryiface.AppRegistryAPI {
+	if v, ok := client.fakes[names.ServiceCatalogAppRegistry].(appregistryiface.AppRegistryAPI); ok {
+		return v
+	}
+
+	return client.ServiceCatalogAppRegistryConn
+}
+
+// ServiceDiscoveryAPI returns the ServiceDiscovery client, or the fake injected with InjectFake.
+func (client *AWSClient) ServiceDiscoveryAPI() servicediscoveryiface.ServiceDiscoveryAPI {
+	if v, ok := client.fakes[names.ServiceDiscovery].(servicediscoveryiface.ServiceDiscoveryAPI); ok {
+		return v
+	}
+
+	return client.ServiceDiscoveryConn
+}
+
+// ServiceQuotasAPI returns the ServiceQuotas client, or the fake injected with InjectFake.
+func (client *AWSClient) ServiceQuotasAPI() servicequotasiface.ServiceQuotasAPI {
+	if v, ok := client.fakes[names.ServiceQuotas].(servicequotasiface.ServiceQuotasAPI); ok {
+		return v
+	}
+
+	return client.ServiceQuotasConn
+}
+
+// ShieldAPI returns the Shield client, or the fake injected with InjectFake.
+func (client *AWSClient) ShieldAPI() shieldiface.ShieldAPI {
+	if v, ok := client.fakes[names.Shield].(shieldiface.ShieldAPI); ok {
+		return v
+	}
+
+	return client.ShieldConn
+}
+
+// SignerAPI returns the Signer client, or the fake injected with InjectFake.
+func (client *AWSClient) SignerAPI() signeriface.SignerAPI {
+	if v, ok := client.fakes[names.Signer].(signeriface.SignerAPI); ok {
+		return v
+	}
+
+	return client.SignerConn
+}
+
+// SimpleDBAPI returns the SimpleDB client, or the fake injected with InjectFake.
+func (client *AWSClient) SimpleDBAPI() simpledbiface.SimpleDBAPI {
+	if v, ok := client.fakes[names.SimpleDB].(simpledbiface.SimpleDBAPI); ok {
+		return v
+	}
+
+	return client.SimpleDBConn
+}
+
+// SnowDeviceManagementAPI returns the SnowDeviceManagement client, or the fake injected with InjectFake.
+func (client *AWSClient) SnowDeviceManagementAPI() snowdevicemanagementiface.SnowDeviceManagementAPI {
+	if v, ok := client.fakes[names.SnowDeviceManagement].(snowdevicemanagementiface.SnowDeviceManagementAPI); ok {
+		return v
+	}
+
+	return client.SnowDeviceManagementConn
+}
+
+// SnowballAPI returns the Snowball client, or the fake injected with InjectFake.
+func (client *AWSClient) SnowballAPI() snowballiface.SnowballAPI {
+	if v, ok := client.fakes[names.Snowball].(snowballiface.SnowballAPI); ok {
+		return v
+	}
+
+	return client.SnowballConn
+}
+
+// StorageGatewayAPI returns the StorageGateway client, or the fake injected with InjectFake.
+func (client *AWSClient) StorageGatewayAPI() storagegatewayiface.StorageGatewayAPI {
+	if v, ok := client.fakes[names.StorageGateway].(storagegatewayiface.StorageGatewayAPI); ok {
+		return v
+	}
+
+	return client.StorageGatewayConn
+}
+
+// SupportAPI returns the Support client, or the fake injected with InjectFake.
+func (client *AWSClient) SupportAPI() supportiface.SupportAPI {
+	if v, ok := client.fakes[names.Support].(supportiface.SupportAPI); ok {
+		return v
+	}
+
+	return client.SupportConn
+}
+
+// SyntheticsAPI returns the Synthetics client, or the fake injected with InjectFake.
+func (client *AWSClient) SyntheticsAPI() syntheticsiface.SyntheticsAPI {
+	if v, ok := client.fakes[names.Synthetics].(syntheticsiface.SyntheticsAPI); ok {
+		return v
+	}
+
+	return client.SyntheticsConn
+}
+
+// TextractAPI returns the Textract client, or the fake injected with InjectFake.
+func (client *AWSClient) TextractAPI() textractiface.TextractAPI {
+	if v, ok := client.fakes[names.Textract].(textractiface.TextractAPI); ok {
+		return v
+	}
+
+	return client.TextractConn
+}
+
+// TimestreamQueryAPI returns the TimestreamQuery client, or the fake injected with InjectFake.
+func (client *AWSClient) TimestreamQueryAPI() timestreamqueryiface.TimestreamQueryAPI {
+	if v, ok := client.fakes[names.TimestreamQuery].(timestreamqueryiface.TimestreamQueryAPI); ok {
+		return v
+	}
+
+	return client.TimestreamQueryConn
+}
+
+// TimestreamWriteAPI returns the TimestreamWrite client, or the fake injected with InjectFake.
+func (client *AWSClient) TimestreamWriteAPI() timestreamwriteiface.TimestreamWriteAPI {
+	if v, ok := client.fakes[names.TimestreamWrite].(timestreamwriteiface.TimestreamWriteAPI); ok {
+		return v
+	}
+
+	return client.TimestreamWriteConn
+}
+
+// TranscribeAPI returns the Transcribe client, or the fake injected with InjectFake.
+func (client *AWSClient) TranscribeAPI() transcribeserviceiface.TranscribeServiceAPI {
+	if v, ok := client.fakes[names.Transcribe].(transcribeserviceiface.TranscribeServiceAPI); ok {
+		return v
+	}
+
+	return client.TranscribeConn
+}
+
+// TranscribeStreamingAPI returns the TranscribeStreaming client, or the fake injected with InjectFake.
+func (client *AWSClient) TranscribeStreamingAPI() transcribestreamingserviceiface.TranscribeStreamingServiceAPI {
+	if v, ok := client.fakes[names.TranscribeStreaming].(transcribestreamingserviceiface.TranscribeStreamingServiceAPI); ok {
+		return v
+	}
+
+	return client.TranscribeStreamingConn
+}
+
+// TransferAPI returns the Transfer client, or the fake injected with InjectFake.
+func (client *AWSClient) TransferAPI() transferiface.TransferAPI {
+	if v, ok := client.fakes[names.Transfer].(transferiface.TransferAPI); ok {
+		return v
+	}
+
+	return client.TransferConn
+}
+
+// TranslateAPI returns the Translate client, or the fake injected with InjectFake.
+func (client *AWSClient) TranslateAPI() translateiface.TranslateAPI {
+	if v, ok := client.fakes[names.Translate].(translateiface.TranslateAPI); ok {
+		return v
+	}
+
+	return client.TranslateConn
+}
+
+// VoiceIDAPI returns the VoiceID client, or the fake injected with InjectFake.
+func (client *AWSClient) VoiceIDAPI() voiceidiface.VoiceIDAPI {
+	if v, ok := client.fakes[names.VoiceID].(voiceidiface.VoiceIDAPI); ok {
+		return v
+	}
+
+	return client.VoiceIDConn
+}
+
+// WAFAPI returns the WAF client, or the fake injected with InjectFake.
+func (client *AWSClient) WAFAPI() wafiface.WAFAPI {
+	if v, ok := client.fakes[names.WAF].(wafiface.WAFAPI); ok {
+		return v
+	}
+
+	return client.WAFConn
+}
+
+// WAFRegionalAPI returns the WAFRegional client, or the fake injected with InjectFake.
+func (client *AWSClient) WAFRegionalAPI() wafregionaliface.WAFRegionalAPI {
+	if v, ok := client.fakes[names.WAFRegional].(wafregionaliface.WAFRegionalAPI); ok {
+		return v
+	}
+
+	return client.WAFRegionalConn
+}
+
+// WAFV2API returns the WAFV2 client, or the fake injected with InjectFake.
+func (client *AWSClient) WAFV2API() wafv2iface.WAFV2API {
+	if v, ok := client.fakes[names.WAFV2].(wafv2iface.WAFV2API); ok {
+		return v
+	}
+
+	return client.WAFV2Conn
+}
+
+// WellArchitectedAPI returns the WellArchitected client, or the fake injected with InjectFake.
+func (client *AWSClient) WellArchitectedAPI() wellarchitectediface.WellArchitectedAPI {
+	if v, ok := client.fakes[names.WellArchitected].(wellarchitectediface.WellArchitectedAPI); ok {
+		return v
+	}
+
+	return client.WellArchitectedConn
+}
+
+// WisdomAPI returns the Wisdom client, or the fake injected with InjectFake.
+func (client *AWSClient) WisdomAPI() connectwisdomserviceiface.ConnectWisdomServiceAPI {
+	if v, ok := client.fakes[names.Wisdom].(connectwisdomserviceiface.ConnectWisdomServiceAPI); ok {
+		return v
+	}
+
+	return client.WisdomConn
+}
+
+// WorkDocsAPI returns the WorkDocs client, or the fake injected with InjectFake.
+func (client *AWSClient) WorkDocsAPI() workdocsiface.WorkDocsAPI {
+	if v, ok := client.fakes[names.WorkDocs].(workdocsiface.WorkDocsAPI); ok {
+		return v
+	}
+
+	return client.WorkDocsConn
+}
+
+// WorkLinkAPI returns the WorkLink client, or the fake injected with InjectFake.
+func (client *AWSClient) WorkLinkAPI() worklinkiface.WorkLinkAPI {
+	if v, ok := client.fakes[names.WorkLink].(worklinkiface.WorkLinkAPI); ok {
+		return v
+	}
+
+	return client.WorkLinkConn
+}
+
+// WorkMailAPI returns the WorkMail client, or the fake injected with InjectFake.
+func (client *AWSClient) WorkMailAPI() workmailiface.WorkMailAPI {
+	if v, ok := client.fakes[names.WorkMail].(workmailiface.WorkMailAPI); ok {
+		return v
+	}
+
+	return client.WorkMailConn
+}
+
+// WorkMailMessageFlowAPI returns the WorkMailMessageFlow client, or the fake injected with InjectFake.
+func (client *AWSClient) WorkMailMessageFlowAPI() workmailmessageflowiface.WorkMailMessageFlowAPI {
+	if v, ok := client.fakes[names.WorkMailMessageFlow].(workmailmessageflowiface.WorkMailMessageFlowAPI); ok {
+		return v
+	}
+
+	return client.WorkMailMessageFlowConn
+}
+
+// WorkSpacesAPI returns the WorkSpaces client, or the fake injected with InjectFake.
+func (client *AWSClient) WorkSpacesAPI() workspacesiface.WorkSpacesAPI {
+	if v, ok := client.fakes[names.WorkSpaces].(workspacesiface.WorkSpacesAPI); ok {
+		return v
+	}
+
+	return client.WorkSpacesConn
+}
+
+// WorkSpacesWebAPI returns the WorkSpacesWeb client, or the fake injected with InjectFake.
+func (client *AWSClient) WorkSpacesWebAPI() workspaceswebiface.WorkSpacesWebAPI {
+	if v, ok := client.fakes[names.WorkSpacesWeb].(workspaceswebiface.WorkSpacesWebAPI); ok {
+		return v
+	}
+
+	return client.WorkSpacesWebConn
+}
+
+// XRayAPI returns the XRay client, or the fake injected with InjectFake.
+func (client *AWSClient) XRayAPI() xrayiface.XRayAPI {
+	if v, ok := client.fakes[names.XRay].(xrayiface.XRayAPI); ok {
+		return v
+	}
+
+	return client.XRayConn
+}
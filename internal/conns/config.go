@@ -20,8 +20,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/fms"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/lightsail"
@@ -185,6 +187,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
+	client.ReadCache = NewReadCache(DefaultReadCacheTTL)
 	client.Region = c.Region
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.Session = sess
@@ -408,6 +411,73 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		}
 	})
 
+	// Writes invalidate any cached reads of the parent resources they modify.
+	client.EC2Conn.Handlers.Complete.PushBack(func(r *request.Request) {
+		var groupID *string
+
+		switch v := r.Params.(type) {
+		case *ec2.AuthorizeSecurityGroupEgressInput:
+			groupID = v.GroupId
+		case *ec2.AuthorizeSecurityGroupIngressInput:
+			groupID = v.GroupId
+		case *ec2.DeleteSecurityGroupInput:
+			groupID = v.GroupId
+		case *ec2.ModifySecurityGroupRulesInput:
+			groupID = v.GroupId
+		case *ec2.RevokeSecurityGroupEgressInput:
+			groupID = v.GroupId
+		case *ec2.RevokeSecurityGroupIngressInput:
+			groupID = v.GroupId
+		case *ec2.UpdateSecurityGroupRuleDescriptionsEgressInput:
+			groupID = v.GroupId
+		case *ec2.UpdateSecurityGroupRuleDescriptionsIngressInput:
+			groupID = v.GroupId
+		case *ec2.AssociateRouteTableInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RouteTableId))
+			return
+		case *ec2.CreateRouteInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RouteTableId))
+			return
+		case *ec2.DeleteRouteInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RouteTableId))
+			return
+		case *ec2.DeleteRouteTableInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RouteTableId))
+			return
+		case *ec2.DisableVgwRoutePropagationInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RouteTableId))
+			return
+		case *ec2.EnableVgwRoutePropagationInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RouteTableId))
+			return
+		case *ec2.ReplaceRouteInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RouteTableId))
+			return
+		case *ec2.ReplaceRouteTableAssociationInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RouteTableId))
+			return
+		case *ec2.DisassociateRouteTableInput:
+			// Only the association ID is known.
+			client.ReadCache.InvalidateAll()
+			return
+		case *ec2.CreateTagsInput:
+			client.ReadCache.InvalidateParent(aws.StringValueSlice(v.Resources)...)
+			return
+		case *ec2.DeleteTagsInput:
+			client.ReadCache.InvalidateParent(aws.StringValueSlice(v.Resources)...)
+			return
+		default:
+			return
+		}
+
+		// EC2-Classic and default VPC security groups can be referenced by name.
+		if groupID == nil {
+			client.ReadCache.InvalidateAll()
+		} else {
+			client.ReadCache.InvalidateParent(aws.StringValue(groupID))
+		}
+	})
+
 	client.FMSConn.Handlers.Retry.PushBack(func(r *request.Request) {
 		// Acceptance testing creates and deletes resources in quick succession.
 		// The FMS onboarding process into Organizations is opaque to consumers.
@@ -432,6 +502,17 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		}
 	})
 
+	client.IAMConn.Handlers.Complete.PushBack(func(r *request.Request) {
+		switch v := r.Params.(type) {
+		case *iam.AttachRolePolicyInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RoleName))
+		case *iam.DeleteRoleInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RoleName))
+		case *iam.DetachRolePolicyInput:
+			client.ReadCache.InvalidateParent(aws.StringValue(v.RoleName))
+		}
+	})
+
 	client.KafkaConn.Handlers.Retry.PushBack(func(r *request.Request) {
 		if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
 			r.Retryable = aws.Bool(true)
@@ -45,6 +45,16 @@ const (
 	// For tests using an alternate AWS account, the equivalent of AWS_SECRET_ACCESS_KEY for that account
 	EnvVarAlternateSecretAccessKey = "AWS_ALTERNATE_SECRET_ACCESS_KEY"
 
+	// For tests run against a local AWS API emulator (e.g. LocalStack or moto server), the emulator's base URL
+	// Setting this selects the emulator acceptance testing profile
+	EnvVarAccEmulatorEndpoint = "TF_ACC_EMULATOR_ENDPOINT"
+
+	// For tests run against a local AWS API emulator, the AWS account ID the emulator reports
+	EnvVarAccEmulatorAccountID = "TF_ACC_EMULATOR_ACCOUNT_ID"
+
+	// For acceptance tests, a file to append import verification errors and differences to
+	EnvVarAccImportVerifyReport = "TF_ACC_IMPORT_VERIFY_REPORT"
+
 	// For tests using a third AWS region, the equivalent of AWS_DEFAULT_REGION for that region
 	EnvVarThirdRegion = "AWS_THIRD_REGION"
 
@@ -0,0 +1,32 @@
+package conns
+
+import (
+	"fmt"
+)
+
+// InjectFake substitutes a fake for the AWS SDK for Go v1 client of the
+// specified service (e.g. names.SQS).
+//
+// The fake must implement the service's API interface (e.g. sqsiface.SQSAPI)
+// and is returned by the service's interface-typed accessor (e.g. SQSAPI)
+// instead of the real client. Code that uses the concrete client (e.g. SQSConn)
+// is unaffected, so only resources that use the accessor can be tested with a fake.
+func (client *AWSClient) InjectFake(service string, fake interface{}) error {
+	implements, ok := implementsSDKv1API(service, fake)
+
+	if !ok {
+		return fmt.Errorf("no AWS SDK for Go v1 client for service: %s", service)
+	}
+
+	if !implements {
+		return fmt.Errorf("%T does not implement the %s API interface", fake, service)
+	}
+
+	if client.fakes == nil {
+		client.fakes = make(map[string]interface{})
+	}
+
+	client.fakes[service] = fake
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type fakeSQS struct {
+	sqsiface.SQSAPI
+}
+
+func TestAWSClientInjectFake(t *testing.T) { // nosemgrep:aws-in-func-name
+	conn := &sqs.SQS{}
+	client := &AWSClient{
+		SQSConn: conn,
+	}
+
+	if got := client.SQSAPI(); got != conn {
+		t.Errorf("got %T before injecting fake, expected the SQS client", got)
+	}
+
+	if err := client.InjectFake("not-a-service", &fakeSQS{}); err == nil {
+		t.Error("expected error injecting fake for unknown service")
+	}
+
+	if err := client.InjectFake(names.SNS, &fakeSQS{}); err == nil {
+		t.Error("expected error injecting fake that does not implement the service API")
+	}
+
+	fake := &fakeSQS{}
+
+	if err := client.InjectFake(names.SQS, fake); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := client.SQSAPI(); got != fake {
+		t.Errorf("got %T after injecting fake, expected the fake", got)
+	}
+
+	if got := client.SNSAPI(); got != client.SNSConn {
+		t.Errorf("got %T for SNS, expected the SNS client", got)
+	}
+}
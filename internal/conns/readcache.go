@@ -0,0 +1,221 @@
+package conns
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// DefaultReadCacheTTL is the default lifetime of a ReadCache entry.
+// It is deliberately short: the cache only exists to share the results of
+// identical Describe/List calls made by many resources during one refresh walk.
+const DefaultReadCacheTTL = 30 * time.Second
+
+// ReadCache is a request-scoped cache of read API results, shared by all
+// resources within a single provider run.
+//
+// Entries are keyed by operation name and a hash of the operation input and
+// are associated with the IDs of the parent resources they describe (e.g. a
+// security group or route table ID). Writes to a parent invalidate every entry
+// associated with it, including any read still in flight.
+//
+// A nil *ReadCache is valid and disables caching.
+type ReadCache struct {
+	lock        sync.Mutex
+	entries     map[string]*readCacheEntry
+	calls       map[string]*readCacheCall
+	generations map[string]uint64
+	now         func() time.Time
+	ttl         time.Duration
+}
+
+type readCacheEntry struct {
+	expires   time.Time
+	parentIDs []string
+	value     interface{}
+}
+
+type readCacheCall struct {
+	err       error
+	parentIDs []string
+	value     interface{}
+	wg        sync.WaitGroup
+}
+
+// NewReadCache returns a properly initialized ReadCache whose entries expire after ttl.
+func NewReadCache(ttl time.Duration) *ReadCache {
+	return &ReadCache{
+		entries:     make(map[string]*readCacheEntry),
+		calls:       make(map[string]*readCacheCall),
+		generations: make(map[string]uint64),
+		now:         time.Now,
+		ttl:         ttl,
+	}
+}
+
+// Fetch returns the cached result of the specified operation and input if
+// present and unexpired. Otherwise it calls f, caches a successful result
+// against the specified parent IDs and returns it. Concurrent callers for the
+// same operation and input share a single call to f.
+// Errors are never cached.
+//
+// Cached values are shared between callers and must be treated as read-only.
+func (c *ReadCache) Fetch(operation string, input interface{}, parentIDs []string, f func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return f()
+	}
+
+	key, err := readCacheKey(operation, input)
+
+	if err != nil {
+		log.Printf("[WARN] Not caching %s: %s", operation, err)
+
+		return f()
+	}
+
+	c.lock.Lock()
+
+	if entry, ok := c.entries[key]; ok {
+		if c.now().Before(entry.expires) {
+			c.lock.Unlock()
+			log.Printf("[DEBUG] Read cache hit: %s (%v)", operation, parentIDs)
+
+			return entry.value, nil
+		}
+
+		delete(c.entries, key)
+	}
+
+	if call, ok := c.calls[key]; ok {
+		c.lock.Unlock()
+		call.wg.Wait()
+
+		return call.value, call.err
+	}
+
+	call := &readCacheCall{parentIDs: parentIDs}
+	call.wg.Add(1)
+	c.calls[key] = call
+	generation := c.generation(parentIDs)
+
+	c.lock.Unlock()
+
+	call.value, call.err = f()
+	call.wg.Done()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// The call may have been removed (or replaced) by an invalidation.
+	if c.calls[key] == call {
+		delete(c.calls, key)
+	}
+
+	// Discard results that may predate a write to any of the parents.
+	if call.err == nil && c.generation(parentIDs) == generation {
+		c.entries[key] = &readCacheEntry{
+			expires:   c.now().Add(c.ttl),
+			parentIDs: parentIDs,
+			value:     call.value,
+		}
+	}
+
+	return call.value, call.err
+}
+
+// InvalidateParent removes all entries, and forgets all in-flight calls,
+// associated with any of the specified parent IDs.
+func (c *ReadCache) InvalidateParent(ids ...string) {
+	if c == nil || len(ids) == 0 {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+
+		log.Printf("[DEBUG] Invalidating read cache entries for %s", id)
+		c.generations[id]++
+
+		for key, entry := range c.entries {
+			if containsString(entry.parentIDs, id) {
+				delete(c.entries, key)
+			}
+		}
+
+		for key, call := range c.calls {
+			if containsString(call.parentIDs, id) {
+				delete(c.calls, key)
+			}
+		}
+	}
+}
+
+// InvalidateAll removes all entries and forgets all in-flight calls.
+// It is used when a write cannot be attributed to a specific parent.
+func (c *ReadCache) InvalidateAll() {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	log.Printf("[DEBUG] Invalidating all read cache entries")
+
+	for key, entry := range c.entries {
+		for _, id := range entry.parentIDs {
+			c.generations[id]++
+		}
+		delete(c.entries, key)
+	}
+
+	for key, call := range c.calls {
+		for _, id := range call.parentIDs {
+			c.generations[id]++
+		}
+		delete(c.calls, key)
+	}
+}
+
+// generation returns the combined invalidation generation of the specified parent IDs.
+// The caller must hold the lock.
+func (c *ReadCache) generation(parentIDs []string) uint64 {
+	var generation uint64
+
+	for _, id := range parentIDs {
+		generation += c.generations[id]
+	}
+
+	return generation
+}
+
+func readCacheKey(operation string, input interface{}) (string, error) {
+	b, err := json.Marshal(input)
+
+	if err != nil {
+		return "", fmt.Errorf("error hashing input: %w", err)
+	}
+
+	hash := sha256.Sum256(b)
+
+	return operation + ":" + hex.EncodeToString(hash[:]), nil
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,177 @@
+package conns
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type readCacheTestInput struct {
+	ID *string
+}
+
+func TestReadCacheFetch(t *testing.T) {
+	cache := NewReadCache(DefaultReadCacheTTL)
+	id := "sg-12345678"
+	input := &readCacheTestInput{ID: &id}
+	calls := 0
+	f := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		v, err := cache.Fetch("DescribeSecurityGroups", input, []string{id}, f)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if v.(int) != 1 {
+			t.Errorf("expected cached value 1, got %d", v.(int))
+		}
+	}
+
+	other := "sg-87654321"
+	v, err := cache.Fetch("DescribeSecurityGroups", &readCacheTestInput{ID: &other}, []string{other}, f)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v.(int) != 2 {
+		t.Errorf("expected value 2 for different input, got %d", v.(int))
+	}
+}
+
+func TestReadCacheFetchExpired(t *testing.T) {
+	cache := NewReadCache(time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	calls := 0
+	f := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	cache.Fetch("DescribeRouteTables", nil, []string{"rtb-12345678"}, f) //nolint:errcheck
+
+	now = now.Add(2 * time.Minute)
+
+	v, _ := cache.Fetch("DescribeRouteTables", nil, []string{"rtb-12345678"}, f)
+
+	if v.(int) != 2 {
+		t.Errorf("expected expired entry to be refreshed, got %d", v.(int))
+	}
+}
+
+func TestReadCacheFetchError(t *testing.T) {
+	cache := NewReadCache(DefaultReadCacheTTL)
+	calls := 0
+	f := func() (interface{}, error) {
+		calls++
+		return nil, errors.New("test")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.Fetch("DescribeRouteTables", nil, []string{"rtb-12345678"}, f); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("expected errors not to be cached, got %d calls", calls)
+	}
+}
+
+func TestReadCacheFetchConcurrent(t *testing.T) {
+	cache := NewReadCache(DefaultReadCacheTTL)
+	release := make(chan struct{})
+	var lock sync.Mutex
+	calls := 0
+	f := func() (interface{}, error) {
+		lock.Lock()
+		calls++
+		lock.Unlock()
+		<-release
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cache.Fetch("DescribeSecurityGroups", nil, []string{"sg-12345678"}, f) //nolint:errcheck
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("expected concurrent callers to share one call, got %d calls", calls)
+	}
+}
+
+func TestReadCacheInvalidateParent(t *testing.T) {
+	cache := NewReadCache(DefaultReadCacheTTL)
+	calls := 0
+	f := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	cache.Fetch("DescribeSecurityGroups", nil, []string{"sg-12345678"}, f) //nolint:errcheck
+	cache.Fetch("DescribeRouteTables", nil, []string{"rtb-12345678"}, f)   //nolint:errcheck
+
+	cache.InvalidateParent("sg-12345678")
+
+	if v, _ := cache.Fetch("DescribeSecurityGroups", nil, []string{"sg-12345678"}, f); v.(int) != 3 {
+		t.Errorf("expected invalidated entry to be refreshed, got %d", v.(int))
+	}
+
+	if v, _ := cache.Fetch("DescribeRouteTables", nil, []string{"rtb-12345678"}, f); v.(int) != 2 {
+		t.Errorf("expected unrelated entry to remain cached, got %d", v.(int))
+	}
+}
+
+func TestReadCacheInvalidateParentInFlight(t *testing.T) {
+	cache := NewReadCache(DefaultReadCacheTTL)
+	calls := 0
+	f := func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			// A write to the parent completes while the read is in flight.
+			cache.InvalidateParent("rtb-12345678")
+		}
+		return calls, nil
+	}
+
+	cache.Fetch("DescribeRouteTables", nil, []string{"rtb-12345678"}, f) //nolint:errcheck
+
+	if v, _ := cache.Fetch("DescribeRouteTables", nil, []string{"rtb-12345678"}, f); v.(int) != 2 {
+		t.Errorf("expected result of invalidated in-flight read not to be cached, got %d", v.(int))
+	}
+}
+
+func TestReadCacheNil(t *testing.T) {
+	var cache *ReadCache
+	calls := 0
+	f := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	cache.Fetch("DescribeSecurityGroups", nil, []string{"sg-12345678"}, f) //nolint:errcheck
+	cache.InvalidateParent("sg-12345678")
+	cache.InvalidateAll()
+	cache.Fetch("DescribeSecurityGroups", nil, []string{"sg-12345678"}, f) //nolint:errcheck
+
+	if calls != 2 {
+		t.Errorf("expected nil cache not to cache, got %d calls", calls)
+	}
+}
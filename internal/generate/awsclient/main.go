@@ -130,9 +130,13 @@ import (
 
 {{ range .Services }}
 	"github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
+	{{- if eq .SDKVersion "1" }}
+	"github.com/aws/aws-sdk-go/service/{{ .GoPackage }}/{{ .GoPackage }}iface"
+	{{- end }}
 {{- end }}
 	"github.com/aws/aws-sdk-go/aws/session"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
@@ -142,6 +146,7 @@ type AWSClient struct {
 	IgnoreTagsConfig          *tftags.IgnoreConfig
 	MediaConvertAccountConn   *mediaconvert.MediaConvert
 	Partition                 string
+	ReadCache                 *ReadCache
 	Region                    string
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
@@ -152,6 +157,8 @@ type AWSClient struct {
 	{{ range .Services }}
 	{{ .ProviderNameUpper }}Conn *{{ .GoPackage }}.{{ .ClientName }}
 	{{- end }}
+
+	fakes map[string]interface{}
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -167,4 +174,32 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// implementsSDKv1API returns whether v implements the AWS SDK for Go v1 API interface of the specified service, e.g. names.SQS.
+// ok is false if the service is unknown or uses AWS SDK for Go v2.
+func implementsSDKv1API(service string, v interface{}) (implements bool, ok bool) {
+	switch service {
+	{{- range .Services }}
+	{{- if eq .SDKVersion "1" }}
+	case names.{{ .ProviderNameUpper }}:
+		_, implements = v.({{ .GoPackage }}iface.{{ .ClientName }}API)
+		return implements, true
+	{{- end }}
+	{{- end }}
+	}
+
+	return false, false
+}
+{{ range .Services }}
+{{- if eq .SDKVersion "1" }}
+// {{ .ProviderNameUpper }}API returns the {{ .ProviderNameUpper }} client, or the fake injected with InjectFake.
+func (client *AWSClient) {{ .ProviderNameUpper }}API() {{ .GoPackage }}iface.{{ .ClientName }}API {
+	if v, ok := client.fakes[names.{{ .ProviderNameUpper }}].({{ .GoPackage }}iface.{{ .ClientName }}API); ok {
+		return v
+	}
+
+	return client.{{ .ProviderNameUpper }}Conn
+}
+{{ end }}
+{{- end }}
 `
@@ -99,6 +99,7 @@ type TemplateData struct {
 
 	// The following are specific to writing import paths in the `headerBody`;
 	// to include the package, set the corresponding field's value to true
+	ClientIfacePkg  bool
 	FmtPkg          bool
 	HelperSchemaPkg bool
 	StrConvPkg      bool
@@ -123,7 +124,8 @@ func main() {
 		log.Fatalf("encountered: %s", err)
 	}
 
-	clientType := fmt.Sprintf("*%s.%s", awsPkg, clientName)
+	// Accept the service's API interface so that tests can substitute a fake client.
+	clientType := fmt.Sprintf("%siface.%sAPI", awsPkg, clientName)
 
 	tagPackage := awsPkg
 
@@ -136,6 +138,7 @@ func main() {
 		ClientType:     clientType,
 		ServicePackage: servicePackage,
 
+		ClientIfacePkg:  *getTag || *listTags || *updateTags,
 		FmtPkg:          *updateTags,
 		HelperSchemaPkg: awsPkg == "autoscaling",
 		StrConvPkg:      awsPkg == "autoscaling",
@@ -249,10 +252,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	{{- if .AWSService }}
+	{{- if or (eq .AWSService .TagPackage) (not .ClientIfacePkg) }}
 	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}"
+	{{- end }}
 	{{- if ne .AWSService .TagPackage }}
 	"github.com/aws/aws-sdk-go/service/{{ .TagPackage }}"
 	{{- end }}
+	{{- if .ClientIfacePkg }}
+	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}/{{ .AWSService }}iface"
+	{{- end }}
 	{{- end }}
 	{{- if .HelperSchemaPkg }}
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
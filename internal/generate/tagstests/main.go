@@ -0,0 +1,556 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	defaultFilename = "tags_consistency_gen_test.go"
+)
+
+var (
+	providerFile = flag.String("ProviderFile", "../../provider/provider.go", "path to the file registering the provider's resources")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-test-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	AWSService      string
+	AWSServiceUpper string
+	ServicePackage  string
+
+	Resources []ResourceData
+	// Taggable resources without a test configuration function.
+	// Their generated tests are skipped, so that the gap in coverage shows in test output.
+	Uncovered []ResourceData
+}
+
+type ResourceData struct {
+	// Terraform resource type, e.g. aws_sqs_queue.
+	TypeName string
+	// Resource name without the "Resource" prefix, e.g. Queue.
+	Name string
+	// Test configuration function, e.g. testAccQueueConfig_tagsConsistency.
+	ConfigFunc string
+	// Destroy check function, e.g. testAccCheckQueueDestroy. Empty if none.
+	DestroyFunc string
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	filename := defaultFilename
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+	awsService, err := names.AWSGoV1Package(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	u, err := names.ProviderNameUpper(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	typeNames, err := resourceTypeNames(*providerFile, servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", nil, 0)
+
+	if err != nil {
+		log.Fatalf("error parsing package: %s", err)
+	}
+
+	sourceFuncs := funcDecls(pkgs[servicePackage])
+	testFuncs := funcDecls(pkgs[servicePackage+"_test"])
+
+	templateData := TemplateData{
+		AWSService:      awsService,
+		AWSServiceUpper: u,
+		ServicePackage:  servicePackage,
+	}
+
+	for _, name := range sortedKeys(sourceFuncs) {
+		typeName, ok := typeNames[name]
+
+		if !ok || !isTaggable(sourceFuncs[name], pkgs[servicePackage]) {
+			continue
+		}
+
+		resourceName := strings.TrimPrefix(name, "Resource")
+		configFunc := fmt.Sprintf("testAcc%sConfig_tagsConsistency", resourceName)
+
+		resource := ResourceData{
+			TypeName:   typeName,
+			Name:       resourceName,
+			ConfigFunc: configFunc,
+		}
+
+		if _, ok := testFuncs[configFunc]; !ok {
+			log.Printf("%s: no %s function, generating skipped tags consistency test", typeName, configFunc)
+			templateData.Uncovered = append(templateData.Uncovered, resource)
+			continue
+		}
+
+		if destroyFunc := fmt.Sprintf("testAccCheck%sDestroy", resourceName); testFuncs[destroyFunc] != nil {
+			resource.DestroyFunc = destroyFunc
+		}
+
+		templateData.Resources = append(templateData.Resources, resource)
+	}
+
+	if len(templateData.Resources) == 0 && len(templateData.Uncovered) == 0 {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			log.Fatalf("error removing file (%s): %s", filename, err)
+		}
+
+		return
+	}
+
+	if err := generateTemplateFile(filename, testTemplateBody, templateData); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// resourceTypeNames returns the Terraform resource type names registered in the provider
+// for the service package's resources, keyed by the name of the resource's function.
+func resourceTypeNames(filename, servicePackage string) (map[string]string, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading file (%s): %w", filename, err)
+	}
+
+	re := regexp.MustCompile(fmt.Sprintf(`"(aws_[a-z0-9_]+)":\s+%s\.(Resource[A-Za-z0-9]+)\(\)`, regexp.QuoteMeta(servicePackage)))
+	typeNames := make(map[string]string)
+
+	for _, match := range re.FindAllStringSubmatch(string(b), -1) {
+		typeNames[match[2]] = match[1]
+	}
+
+	return typeNames, nil
+}
+
+func funcDecls(pkg *ast.Package) map[string]*ast.FuncDecl {
+	decls := make(map[string]*ast.FuncDecl)
+
+	if pkg == nil {
+		return decls
+	}
+
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+				decls[funcDecl.Name.Name] = funcDecl
+			}
+		}
+	}
+
+	return decls
+}
+
+// isTaggable returns whether the resource function's schema has "tags" set to
+// tftags.TagsSchema() and "tags_all" set to tftags.TagsSchemaComputed(),
+// and whether the resource uses verify.SetTagsDiff.
+func isTaggable(funcDecl *ast.FuncDecl, pkg *ast.Package) bool {
+	var schema *ast.CompositeLit
+	var setTagsDiff bool
+
+	ast.Inspect(funcDecl, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.KeyValueExpr:
+			if key, ok := n.Key.(*ast.Ident); ok && key.Name == "Schema" && schema == nil {
+				schema = schemaMap(n.Value, pkg)
+			}
+		case *ast.SelectorExpr:
+			if isSelector(n, "verify", "SetTagsDiff") {
+				setTagsDiff = true
+			}
+		}
+
+		return true
+	})
+
+	if schema == nil || !setTagsDiff {
+		return false
+	}
+
+	var tags, tagsAll bool
+
+	for _, elt := range schema.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+
+		if !ok {
+			continue
+		}
+
+		key, ok := kv.Key.(*ast.BasicLit)
+
+		if !ok || key.Kind != token.STRING {
+			continue
+		}
+
+		call, ok := kv.Value.(*ast.CallExpr)
+
+		if !ok {
+			continue
+		}
+
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+
+		if !ok {
+			continue
+		}
+
+		switch v, _ := strconv.Unquote(key.Value); v {
+		case "tags":
+			tags = isSelector(selector, "tftags", "TagsSchema")
+		case "tags_all":
+			tagsAll = isSelector(selector, "tftags", "TagsSchemaComputed")
+		}
+	}
+
+	return tags && tagsAll
+}
+
+// schemaMap returns the schema map composite literal, either declared inline
+// or as a package-level variable.
+func schemaMap(expr ast.Expr, pkg *ast.Package) *ast.CompositeLit {
+	switch v := expr.(type) {
+	case *ast.CompositeLit:
+		return v
+	case *ast.Ident:
+		for _, file := range pkg.Files {
+			if obj := file.Scope.Lookup(v.Name); obj != nil && obj.Kind == ast.Var {
+				if spec, ok := obj.Decl.(*ast.ValueSpec); ok {
+					for i, name := range spec.Names {
+						if name.Name == v.Name && i < len(spec.Values) {
+							return schemaMap(spec.Values[i], pkg)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func isSelector(selector *ast.SelectorExpr, pkg, name string) bool {
+	ident, ok := selector.X.(*ast.Ident)
+
+	return ok && ident.Name == pkg && selector.Sel.Name == name
+}
+
+func sortedKeys(m map[string]*ast.FuncDecl) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func generateTemplateFile(filename string, templateBody string, templateData interface{}) error {
+	tmpl, err := template.New(filepath.Base(filename)).Parse(templateBody)
+
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		return fmt.Errorf("error formatting generated file: %w", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return fmt.Errorf("error creating file (%s): %w", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		return fmt.Errorf("error writing to file (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+const testTemplateBody = `
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package {{ .ServicePackage }}_test
+
+import (
+	"testing"
+	{{- if .Resources }}
+
+	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	{{- end }}
+)
+{{- range .Uncovered }}
+
+func TestAcc{{ $.AWSServiceUpper }}{{ .Name }}_TagsConsistency(t *testing.T) {
+	t.Skip("{{ .TypeName }} has no {{ .ConfigFunc }} function; see internal/generate/tagstests/README.md")
+}
+{{- end }}
+{{- range .Resources }}
+
+func TestAcc{{ $.AWSServiceUpper }}{{ .Name }}_TagsConsistency_defaultTagsOnly(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "{{ .TypeName }}.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, {{ $.AWSService }}.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		{{- if .DestroyFunc }}
+		CheckDestroy:      {{ .DestroyFunc }},
+		{{- end }}
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue()),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("providerkey1", "providervalue1", "providerkey2", "providervalue2"),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue()),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey2", "providervalue2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc{{ $.AWSServiceUpper }}{{ .Name }}_TagsConsistency_overlap(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "{{ .TypeName }}.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, {{ $.AWSService }}.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		{{- if .DestroyFunc }}
+		CheckDestroy:      {{ .DestroyFunc }},
+		{{- end }}
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("overlapkey1", "providervalue1"),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue("overlapkey1", "resourcevalue1")),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.overlapkey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey1", "resourcevalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("overlapkey1", "providervalue1", "overlapkey2", "providervalue2"),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue("overlapkey1", "resourcevalue1", "overlapkey2", "resourcevalue2")),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey2", "resourcevalue2"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("overlapkey1", "providervalue1"),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue("overlapkey1", "resourcevalue2")),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.overlapkey1", "resourcevalue2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey1", "resourcevalue2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc{{ $.AWSServiceUpper }}{{ .Name }}_TagsConsistency_removal(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "{{ .TypeName }}.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, {{ $.AWSService }}.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		{{- if .DestroyFunc }}
+		CheckDestroy:      {{ .DestroyFunc }},
+		{{- end }}
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue("resourcekey1", "resourcevalue1")),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags0(),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue("resourcekey1", "resourcevalue1")),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue()),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc{{ $.AWSServiceUpper }}{{ .Name }}_TagsConsistency_ignoreKeys(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "{{ .TypeName }}.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, {{ $.AWSService }}.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		{{- if .DestroyFunc }}
+		CheckDestroy:      {{ .DestroyFunc }},
+		{{- end }}
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeys1("defaultkey1", "defaultvalue1"),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue("resourcekey1", "resourcevalue1")),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc{{ $.AWSServiceUpper }}{{ .Name }}_TagsConsistency_ignoreKeyPrefixes(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "{{ .TypeName }}.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, {{ $.AWSService }}.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		{{- if .DestroyFunc }}
+		CheckDestroy:      {{ .DestroyFunc }},
+		{{- end }}
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("defaultkey1", "defaultvalue1", "defaultkey"),
+					{{ .ConfigFunc }}(rName, acctest.ConfigTagsValue("resourcekey1", "resourcevalue1")),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+				),
+			},
+		},
+	})
+}
+{{- end }}
+`
@@ -478,12 +478,16 @@ func Provider() *schema.Provider {
 
 			"aws_cloudtrail_service_account": cloudtrail.DataSourceServiceAccount(),
 
-			"aws_cloudwatch_event_bus":        events.DataSourceBus(),
-			"aws_cloudwatch_event_connection": events.DataSourceConnection(),
-			"aws_cloudwatch_event_source":     events.DataSourceSource(),
+			"aws_cloudwatch_dashboard_document": cloudwatch.DataSourceDashboardDocument(),
 
-			"aws_cloudwatch_log_group":  logs.DataSourceGroup(),
-			"aws_cloudwatch_log_groups": logs.DataSourceGroups(),
+			"aws_cloudwatch_event_bus":           events.DataSourceBus(),
+			"aws_cloudwatch_event_connection":    events.DataSourceConnection(),
+			"aws_cloudwatch_event_pattern_match": events.DataSourcePatternMatch(),
+			"aws_cloudwatch_event_source":        events.DataSourceSource(),
+
+			"aws_cloudwatch_log_group":          logs.DataSourceGroup(),
+			"aws_cloudwatch_log_groups":         logs.DataSourceGroups(),
+			"aws_cloudwatch_log_insights_query": logs.DataSourceInsightsQuery(),
 
 			"aws_codeartifact_authorization_token": codeartifact.DataSourceAuthorizationToken(),
 			"aws_codeartifact_repository_endpoint": codeartifact.DataSourceRepositoryEndpoint(),
@@ -714,12 +718,14 @@ func Provider() *schema.Provider {
 			"aws_kinesis_stream":          kinesis.DataSourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.DataSourceStreamConsumer(),
 
-			"aws_kms_alias":      kms.DataSourceAlias(),
-			"aws_kms_ciphertext": kms.DataSourceCiphertext(),
-			"aws_kms_key":        kms.DataSourceKey(),
-			"aws_kms_public_key": kms.DataSourcePublicKey(),
-			"aws_kms_secret":     kms.DataSourceSecret(),
-			"aws_kms_secrets":    kms.DataSourceSecrets(),
+			"aws_kms_alias":               kms.DataSourceAlias(),
+			"aws_kms_ciphertext":          kms.DataSourceCiphertext(),
+			"aws_kms_envelope_ciphertext": kms.DataSourceEnvelopeCiphertext(),
+			"aws_kms_envelope_plaintext":  kms.DataSourceEnvelopePlaintext(),
+			"aws_kms_key":                 kms.DataSourceKey(),
+			"aws_kms_public_key":          kms.DataSourcePublicKey(),
+			"aws_kms_secret":              kms.DataSourceSecret(),
+			"aws_kms_secrets":             kms.DataSourceSecrets(),
 
 			"aws_lakeformation_data_lake_settings": lakeformation.DataSourceDataLakeSettings(),
 			"aws_lakeformation_permissions":        lakeformation.DataSourcePermissions(),
@@ -823,18 +829,20 @@ func Provider() *schema.Provider {
 			"aws_route53_delegation_set":          route53.DataSourceDelegationSet(),
 			"aws_route53_traffic_policy_document": route53.DataSourceTrafficPolicyDocument(),
 			"aws_route53_zone":                    route53.DataSourceZone(),
+			"aws_route53_zone_file":               route53.DataSourceZoneFile(),
 
 			"aws_route53_resolver_endpoint": route53resolver.DataSourceEndpoint(),
 			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
 			"aws_route53_resolver_rules":    route53resolver.DataSourceRules(),
 
-			"aws_canonical_user_id": s3.DataSourceCanonicalUserID(),
-			"aws_s3_bucket":         s3.DataSourceBucket(),
-			"aws_s3_object":         s3.DataSourceObject(),
-			"aws_s3_objects":        s3.DataSourceObjects(),
-			"aws_s3_bucket_object":  s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
-			"aws_s3_bucket_objects": s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
-			"aws_s3_bucket_policy":  s3.DataSourceBucketPolicy(),
+			"aws_canonical_user_id":       s3.DataSourceCanonicalUserID(),
+			"aws_s3_bucket":               s3.DataSourceBucket(),
+			"aws_s3_bucket_configuration": s3.DataSourceBucketConfiguration(),
+			"aws_s3_object":               s3.DataSourceObject(),
+			"aws_s3_objects":              s3.DataSourceObjects(),
+			"aws_s3_bucket_object":        s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
+			"aws_s3_bucket_objects":       s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
+			"aws_s3_bucket_policy":        s3.DataSourceBucketPolicy(),
 
 			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
 
@@ -856,8 +864,9 @@ func Provider() *schema.Provider {
 			"aws_servicequotas_service":       servicequotas.DataSourceService(),
 			"aws_servicequotas_service_quota": servicequotas.DataSourceServiceQuota(),
 
-			"aws_sfn_activity":      sfn.DataSourceActivity(),
-			"aws_sfn_state_machine": sfn.DataSourceStateMachine(),
+			"aws_sfn_activity":               sfn.DataSourceActivity(),
+			"aws_sfn_state_machine":          sfn.DataSourceStateMachine(),
+			"aws_sfn_state_machine_document": sfn.DataSourceStateMachineDocument(),
 
 			"aws_signer_signing_job":     signer.DataSourceSigningJob(),
 			"aws_signer_signing_profile": signer.DataSourceSigningProfile(),
@@ -1091,10 +1100,11 @@ func Provider() *schema.Provider {
 			"aws_cloudtrail":                  cloudtrail.ResourceCloudTrail(),
 			"aws_cloudtrail_event_data_store": cloudtrail.ResourceEventDataStore(),
 
-			"aws_cloudwatch_composite_alarm": cloudwatch.ResourceCompositeAlarm(),
-			"aws_cloudwatch_dashboard":       cloudwatch.ResourceDashboard(),
-			"aws_cloudwatch_metric_alarm":    cloudwatch.ResourceMetricAlarm(),
-			"aws_cloudwatch_metric_stream":   cloudwatch.ResourceMetricStream(),
+			"aws_cloudwatch_composite_alarm":         cloudwatch.ResourceCompositeAlarm(),
+			"aws_cloudwatch_dashboard":               cloudwatch.ResourceDashboard(),
+			"aws_cloudwatch_metric_alarm":            cloudwatch.ResourceMetricAlarm(),
+			"aws_cloudwatch_metric_anomaly_detector": cloudwatch.ResourceMetricAnomalyDetector(),
+			"aws_cloudwatch_metric_stream":           cloudwatch.ResourceMetricStream(),
 
 			"aws_cloudwatch_event_api_destination": events.ResourceAPIDestination(),
 			"aws_cloudwatch_event_archive":         events.ResourceArchive(),
@@ -1612,6 +1622,7 @@ func Provider() *schema.Provider {
 
 			"aws_kms_alias":                kms.ResourceAlias(),
 			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
+			"aws_kms_custom_key_store":     kms.ResourceCustomKeyStore(),
 			"aws_kms_external_key":         kms.ResourceExternalKey(),
 			"aws_kms_grant":                kms.ResourceGrant(),
 			"aws_kms_key":                  kms.ResourceKey(),
@@ -1819,6 +1830,7 @@ func Provider() *schema.Provider {
 			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
+			"aws_route53_zone_records":                  route53.ResourceZoneRecords(),
 
 			"aws_route53domains_registered_domain": route53domains.ResourceRegisteredDomain(),
 
@@ -1864,6 +1876,7 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory":                                   s3.ResourceDirectory(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
@@ -1874,10 +1887,12 @@ func Provider() *schema.Provider {
 			"aws_s3control_bucket":                            s3control.ResourceBucket(),
 			"aws_s3control_bucket_lifecycle_configuration":    s3control.ResourceBucketLifecycleConfiguration(),
 			"aws_s3control_bucket_policy":                     s3control.ResourceBucketPolicy(),
+			"aws_s3control_job":                               s3control.ResourceJob(),
 			"aws_s3control_multi_region_access_point":         s3control.ResourceMultiRegionAccessPoint(),
 			"aws_s3control_multi_region_access_point_policy":  s3control.ResourceMultiRegionAccessPointPolicy(),
 			"aws_s3control_object_lambda_access_point":        s3control.ResourceObjectLambdaAccessPoint(),
 			"aws_s3control_object_lambda_access_point_policy": s3control.ResourceObjectLambdaAccessPointPolicy(),
+			"aws_s3control_storage_lens_configuration":        s3control.ResourceStorageLensConfiguration(),
 
 			"aws_s3outposts_endpoint": s3outposts.ResourceEndpoint(),
 
@@ -1910,6 +1925,7 @@ func Provider() *schema.Provider {
 			"aws_schemas_schema":     schemas.ResourceSchema(),
 
 			"aws_secretsmanager_secret":          secretsmanager.ResourceSecret(),
+			"aws_secretsmanager_secret_key":      secretsmanager.ResourceSecretKey(),
 			"aws_secretsmanager_secret_policy":   secretsmanager.ResourceSecretPolicy(),
 			"aws_secretsmanager_secret_rotation": secretsmanager.ResourceSecretRotation(),
 			"aws_secretsmanager_secret_version":  secretsmanager.ResourceSecretVersion(),
@@ -1984,16 +2000,20 @@ func Provider() *schema.Provider {
 			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
 			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
 
-			"aws_sqs_queue":        sqs.ResourceQueue(),
-			"aws_sqs_queue_policy": sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue":                      sqs.ResourceQueue(),
+			"aws_sqs_queue_policy":               sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue_redrive_allow_policy": sqs.ResourceQueueRedriveAllowPolicy(),
+			"aws_sqs_queue_redrive_policy":       sqs.ResourceQueueRedrivePolicy(),
 
 			"aws_ssm_activation":                ssm.ResourceActivation(),
 			"aws_ssm_association":               ssm.ResourceAssociation(),
+			"aws_ssm_command_invocation":        ssm.ResourceCommandInvocation(),
 			"aws_ssm_document":                  ssm.ResourceDocument(),
 			"aws_ssm_maintenance_window":        ssm.ResourceMaintenanceWindow(),
 			"aws_ssm_maintenance_window_target": ssm.ResourceMaintenanceWindowTarget(),
 			"aws_ssm_maintenance_window_task":   ssm.ResourceMaintenanceWindowTask(),
 			"aws_ssm_parameter":                 ssm.ResourceParameter(),
+			"aws_ssm_parameters":                ssm.ResourceParameters(),
 			"aws_ssm_patch_baseline":            ssm.ResourcePatchBaseline(),
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package accessanalyzer_test
+
+import (
+	"testing"
+)
+
+func TestAccAccessAnalyzerAnalyzer_TagsConsistency(t *testing.T) {
+	t.Skip("aws_accessanalyzer_analyzer has no testAccAnalyzerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -8,19 +8,23 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -35,7 +39,12 @@ const (
 	// This timeout is unrelated to any creation or validation of those assigned DNS records.
 	certificateDNSValidationAssignmentTimeout = 5 * time.Minute
 
+	// Default maximum amount of time for ACM Certificate issuance when validation_zone_ids is configured.
+	certificateIssuedTimeout = 75 * time.Minute
+
 	certificateValidationMethodNone = "NONE"
+
+	certificateValidationRecordTTL = 60
 )
 
 func ResourceCertificate() *schema.Resource {
@@ -49,6 +58,11 @@ func ResourceCertificate() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(certificateIssuedTimeout),
+			Update: schema.DefaultTimeout(certificateIssuedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -197,6 +211,12 @@ func ResourceCertificate() *schema.Resource {
 				},
 				ConflictsWith: []string{"certificate_body", "certificate_chain", "private_key"},
 			},
+			"validation_zone_ids": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"certificate_authority_arn", "certificate_body", "certificate_chain", "private_key"},
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -249,6 +269,7 @@ func ResourceCertificate() *schema.Resource {
 
 				return nil
 			},
+			customizeDiffValidationZoneIDs,
 			verify.SetTagsDiff,
 		),
 	}
@@ -334,6 +355,35 @@ func resourceCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("waiting for ACM Certificate (%s) to be issued: %w", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("validation_zone_ids"); ok && len(v.(map[string]interface{})) > 0 {
+		certificate, err := waitCertificateDomainValidationRecordsAvailable(conn, d.Id(), certificateDNSValidationAssignmentTimeout)
+
+		if err != nil {
+			return fmt.Errorf("waiting for ACM Certificate (%s) DNS validation records: %w", d.Id(), err)
+		}
+
+		domainValidationOptions, _ := flattenDomainValidations(certificate.DomainValidationOptions)
+
+		// Record the validation records in state so that they're cleaned up even if validation fails.
+		if err := d.Set("domain_validation_options", domainValidationOptions); err != nil {
+			return fmt.Errorf("error setting domain_validation_options: %w", err)
+		}
+
+		records, err := expandCertificateValidationRecords(domainValidationOptions, v.(map[string]interface{}))
+
+		if err != nil {
+			return fmt.Errorf("creating ACM Certificate (%s) Route 53 validation records: %w", d.Id(), err)
+		}
+
+		if err := upsertCertificateValidationRecords(meta.(*conns.AWSClient).Route53Conn, records); err != nil {
+			return fmt.Errorf("creating ACM Certificate (%s) Route 53 validation records: %w", d.Id(), err)
+		}
+
+		if _, err := waitCertificateIssued(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("waiting for ACM Certificate (%s) to be issued: %w", d.Id(), err)
+		}
+	}
+
 	return resourceCertificateRead(d, meta)
 }
 
@@ -423,6 +473,40 @@ func resourceCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("validation_zone_ids") {
+		route53Conn := meta.(*conns.AWSClient).Route53Conn
+		domainValidationOptions := d.Get("domain_validation_options").(*schema.Set).List()
+		o, n := d.GetChange("validation_zone_ids")
+
+		// Records for domains that weren't previously mapped are not an error here.
+		oldRecords, _ := expandCertificateValidationRecords(domainValidationOptions, o.(map[string]interface{}))
+		newRecords, err := expandCertificateValidationRecords(domainValidationOptions, n.(map[string]interface{}))
+
+		if err != nil {
+			return fmt.Errorf("updating ACM Certificate (%s) Route 53 validation records: %w", d.Id(), err)
+		}
+
+		records, err := certificateValidationRecordsNotInUse(conn, d.Id(), certificateValidationRecordsDifference(oldRecords, newRecords))
+
+		if err != nil {
+			return fmt.Errorf("deleting ACM Certificate (%s) Route 53 validation records: %w", d.Id(), err)
+		}
+
+		if err := deleteCertificateValidationRecords(route53Conn, records); err != nil {
+			return fmt.Errorf("deleting ACM Certificate (%s) Route 53 validation records: %w", d.Id(), err)
+		}
+
+		if len(newRecords) > 0 {
+			if err := upsertCertificateValidationRecords(route53Conn, newRecords); err != nil {
+				return fmt.Errorf("updating ACM Certificate (%s) Route 53 validation records: %w", d.Id(), err)
+			}
+
+			if _, err := waitCertificateIssued(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("waiting for ACM Certificate (%s) to be issued: %w", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -445,12 +529,26 @@ func resourceCertificateDelete(d *schema.ResourceData, meta interface{}) error {
 			})
 		}, acm.ErrCodeResourceInUseException)
 
-	if tfawserr.ErrCodeEquals(err, acm.ErrCodeResourceNotFoundException) {
-		return nil
+	if err != nil && !tfawserr.ErrCodeEquals(err, acm.ErrCodeResourceNotFoundException) {
+		return fmt.Errorf("deleting ACM Certificate (%s): %w", d.Id(), err)
 	}
 
-	if err != nil {
-		return fmt.Errorf("deleting ACM Certificate (%s): %w", d.Id(), err)
+	if v, ok := d.GetOk("validation_zone_ids"); ok && len(v.(map[string]interface{})) > 0 {
+		records, err := expandCertificateValidationRecords(d.Get("domain_validation_options").(*schema.Set).List(), v.(map[string]interface{}))
+
+		if err != nil {
+			log.Printf("[WARN] ACM Certificate (%s) Route 53 validation records: %s", d.Id(), err)
+		}
+
+		records, err = certificateValidationRecordsNotInUse(conn, d.Id(), records)
+
+		if err != nil {
+			return fmt.Errorf("deleting ACM Certificate (%s) Route 53 validation records: %w", d.Id(), err)
+		}
+
+		if err := deleteCertificateValidationRecords(meta.(*conns.AWSClient).Route53Conn, records); err != nil {
+			return fmt.Errorf("deleting ACM Certificate (%s) Route 53 validation records: %w", d.Id(), err)
+		}
 	}
 
 	return nil
@@ -468,6 +566,48 @@ func certificateValidationMethod(certificate *acm.CertificateDetail) string {
 	return certificateValidationMethodNone
 }
 
+func customizeDiffValidationZoneIDs(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	zoneIDs, ok := diff.Get("validation_zone_ids").(map[string]interface{})
+
+	if !ok || len(zoneIDs) == 0 {
+		return nil
+	}
+
+	if diff.NewValueKnown("validation_method") {
+		if v := diff.Get("validation_method").(string); v != acm.ValidationMethodDns {
+			return fmt.Errorf("validation_zone_ids requires validation_method to be %s", acm.ValidationMethodDns)
+		}
+	}
+
+	if !diff.NewValueKnown("domain_name") || !diff.NewValueKnown("subject_alternative_names") {
+		return nil
+	}
+
+	domainNames := map[string]bool{diff.Get("domain_name").(string): true}
+
+	if v, ok := diff.Get("subject_alternative_names").(*schema.Set); ok {
+		for _, v := range v.List() {
+			domainNames[v.(string)] = true
+		}
+	}
+
+	var errs *multierror.Error
+
+	for domainName := range domainNames {
+		if certificateValidationZoneID(zoneIDs, domainName) == "" {
+			errs = multierror.Append(errs, fmt.Errorf("validation_zone_ids has no entry for %s", domainName))
+		}
+	}
+
+	for domainName := range zoneIDs {
+		if !domainNames[domainName] && !domainNames["*."+domainName] {
+			errs = multierror.Append(errs, fmt.Errorf("validation_zone_ids entry %s is not a domain name of the certificate", domainName))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func domainValidationOptionsHash(v interface{}) int {
 	m, ok := v.(map[string]interface{})
 
@@ -611,6 +751,260 @@ func flattenDomainValidations(apiObjects []*acm.DomainValidation) ([]interface{}
 	return tfList, tfStrings
 }
 
+type certificateValidationRecord struct {
+	name   string
+	rrType string
+	value  string
+	zoneID string
+}
+
+func (r certificateValidationRecord) key() string {
+	return r.zoneID + "|" + strings.ToLower(strings.TrimSuffix(r.name, "."))
+}
+
+// certificateValidationZoneID returns the ID of the hosted zone in which the
+// DNS validation record for the specified domain is created.
+// Wildcard domains share their validation record with the base domain, so
+// they fall back to the base domain's entry.
+func certificateValidationZoneID(zoneIDs map[string]interface{}, domainName string) string {
+	if v, ok := zoneIDs[domainName].(string); ok && v != "" {
+		return tfroute53.CleanZoneID(v)
+	}
+
+	if v, ok := zoneIDs[strings.TrimPrefix(domainName, "*.")].(string); ok && v != "" {
+		return tfroute53.CleanZoneID(v)
+	}
+
+	return ""
+}
+
+// expandCertificateValidationRecords returns the distinct DNS validation records for the
+// specified domain validation options. Domains without a hosted zone are reported in the returned error.
+func expandCertificateValidationRecords(domainValidationOptions []interface{}, zoneIDs map[string]interface{}) ([]certificateValidationRecord, error) {
+	var errs *multierror.Error
+	records := make(map[string]certificateValidationRecord)
+
+	for _, tfMapRaw := range domainValidationOptions {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		domainName, _ := tfMap["domain_name"].(string)
+		record := certificateValidationRecord{
+			zoneID: certificateValidationZoneID(zoneIDs, domainName),
+		}
+		record.name, _ = tfMap["resource_record_name"].(string)
+		record.rrType, _ = tfMap["resource_record_type"].(string)
+		record.value, _ = tfMap["resource_record_value"].(string)
+
+		if record.name == "" {
+			continue
+		}
+
+		if record.zoneID == "" {
+			errs = multierror.Append(errs, fmt.Errorf("validation_zone_ids has no entry for %s", domainName))
+			continue
+		}
+
+		records[record.key()] = record
+	}
+
+	var tfList []certificateValidationRecord
+
+	for _, v := range records {
+		tfList = append(tfList, v)
+	}
+
+	sort.Slice(tfList, func(i, j int) bool {
+		return tfList[i].key() < tfList[j].key()
+	})
+
+	return tfList, errs.ErrorOrNil()
+}
+
+// certificateValidationRecordsDifference returns the records in old that aren't in new.
+func certificateValidationRecordsDifference(old, new []certificateValidationRecord) []certificateValidationRecord {
+	keys := make(map[string]bool)
+
+	for _, v := range new {
+		keys[v.key()] = true
+	}
+
+	var records []certificateValidationRecord
+
+	for _, v := range old {
+		if !keys[v.key()] {
+			records = append(records, v)
+		}
+	}
+
+	return records
+}
+
+func (r certificateValidationRecord) resourceRecordSet() *route53.ResourceRecordSet {
+	return &route53.ResourceRecordSet{
+		Name: aws.String(r.name),
+		ResourceRecords: []*route53.ResourceRecord{{
+			Value: aws.String(r.value),
+		}},
+		TTL:  aws.Int64(certificateValidationRecordTTL),
+		Type: aws.String(r.rrType),
+	}
+}
+
+// upsertCertificateValidationRecords creates or updates the records, one change batch per hosted zone,
+// and waits for the changes to propagate to all Route 53 DNS servers.
+func upsertCertificateValidationRecords(conn *route53.Route53, records []certificateValidationRecord) error {
+	changes := make(map[string][]*route53.Change)
+	var zoneIDs []string
+
+	for _, v := range records {
+		if _, ok := changes[v.zoneID]; !ok {
+			zoneIDs = append(zoneIDs, v.zoneID)
+		}
+
+		changes[v.zoneID] = append(changes[v.zoneID], &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: v.resourceRecordSet(),
+		})
+	}
+
+	for _, zoneID := range zoneIDs {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: changes[zoneID],
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		log.Printf("[DEBUG] Upserting Route 53 Records: %s", input)
+		outputRaw, err := tfroute53.ChangeRecordSet(conn, input)
+
+		if err != nil {
+			return fmt.Errorf("upserting Route 53 Records in Hosted Zone (%s): %w", zoneID, err)
+		}
+
+		if output, ok := outputRaw.(*route53.ChangeResourceRecordSetsOutput); ok && output != nil && output.ChangeInfo != nil {
+			if err := tfroute53.WaitForRecordSetToSync(conn, tfroute53.CleanChangeID(aws.StringValue(output.ChangeInfo.Id))); err != nil {
+				return fmt.Errorf("waiting for Route 53 Records in Hosted Zone (%s) to sync: %w", zoneID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// certificateValidationRecordsNotInUse returns the records that no certificate other than the specified one uses for DNS validation.
+// ACM uses the same validation record for a domain name in every certificate in an account and Region,
+// so a record can still be needed to renew another certificate.
+func certificateValidationRecordsNotInUse(conn *acm.ACM, arn string, records []certificateValidationRecord) ([]certificateValidationRecord, error) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	input := &acm.ListCertificatesInput{
+		Includes: &acm.Filters{
+			KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+		},
+	}
+	var arns []string
+
+	err := conn.ListCertificatesPages(input, func(page *acm.ListCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CertificateSummaryList {
+			if v := aws.StringValue(v.CertificateArn); v != arn {
+				arns = append(arns, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("listing ACM Certificates: %w", err)
+	}
+
+	inUse := make(map[string]bool)
+
+	for _, arn := range arns {
+		certificate, err := findCertificate(conn, &acm.DescribeCertificateInput{
+			CertificateArn: aws.String(arn),
+		})
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("reading ACM Certificate (%s): %w", arn, err)
+		}
+
+		for _, v := range certificate.DomainValidationOptions {
+			if v.ResourceRecord != nil {
+				inUse[strings.ToLower(strings.TrimSuffix(aws.StringValue(v.ResourceRecord.Name), "."))] = true
+			}
+		}
+	}
+
+	var tfList []certificateValidationRecord
+
+	for _, v := range records {
+		if inUse[strings.ToLower(strings.TrimSuffix(v.name, "."))] {
+			log.Printf("[INFO] Route 53 Record (%s) is used by another ACM Certificate, not deleting", v.name)
+			continue
+		}
+
+		tfList = append(tfList, v)
+	}
+
+	return tfList, nil
+}
+
+// deleteCertificateValidationRecords deletes the records one at a time so that records
+// that have already been deleted don't prevent the deletion of the others.
+func deleteCertificateValidationRecords(conn *route53.Route53, records []certificateValidationRecord) error {
+	var errs *multierror.Error
+
+	for _, v := range records {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: []*route53.Change{{
+					Action:            aws.String(route53.ChangeActionDelete),
+					ResourceRecordSet: v.resourceRecordSet(),
+				}},
+				Comment: aws.String("Deleted by Terraform"),
+			},
+			HostedZoneId: aws.String(v.zoneID),
+		}
+
+		log.Printf("[DEBUG] Deleting Route 53 Record: %s", input)
+		outputRaw, err := tfroute53.DeleteRecordSet(conn, input)
+
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+			continue
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("deleting Route 53 Record (%s) in Hosted Zone (%s): %w", v.name, v.zoneID, err))
+			continue
+		}
+
+		if output, ok := outputRaw.(*route53.ChangeResourceRecordSetsOutput); ok && output != nil && output.ChangeInfo != nil {
+			if err := tfroute53.WaitForRecordSetToSync(conn, tfroute53.CleanChangeID(aws.StringValue(output.ChangeInfo.Id))); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("waiting for Route 53 Record (%s) in Hosted Zone (%s) deletion: %w", v.name, v.zoneID, err))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func isChangeNormalizeCertRemoval(oldRaw, newRaw interface{}) bool {
 	old, ok := oldRaw.(string)
 
@@ -737,3 +1131,46 @@ func waitCertificateDomainValidationsAvailable(conn *acm.ACM, arn string, timeou
 
 	return nil, err
 }
+
+func statusCertificateDomainValidationRecordsAvailable(conn *acm.ACM, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		certificate, err := FindCertificateByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// ACM assigns the DNS validation records for each domain asynchronously.
+		domainValidationRecordsAvailable := len(certificate.DomainValidationOptions) > 0
+
+		for _, v := range certificate.DomainValidationOptions {
+			if v.ResourceRecord == nil && aws.StringValue(v.ValidationStatus) != acm.DomainStatusSuccess {
+				domainValidationRecordsAvailable = false
+
+				break
+			}
+		}
+
+		return certificate, strconv.FormatBool(domainValidationRecordsAvailable), nil
+	}
+}
+
+func waitCertificateDomainValidationRecordsAvailable(conn *acm.ACM, arn string, timeout time.Duration) (*acm.CertificateDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Target:  []string{strconv.FormatBool(true)},
+		Refresh: statusCertificateDomainValidationRecordsAvailable(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*acm.CertificateDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -36,7 +36,7 @@ func TestAccACMCertificateDataSource_singleIssued(t *testing.T) {
 
 	resourceName := "data.aws_acm_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -123,7 +123,7 @@ func TestAccACMCertificateDataSource_multipleIssued(t *testing.T) {
 
 	resourceName := "data.aws_acm_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -172,7 +172,7 @@ func TestAccACMCertificateDataSource_noMatchReturnsError(t *testing.T) {
 
 	domain := fmt.Sprintf("tf-acc-nonexistent.%s", os.Getenv("ACM_CERTIFICATE_ROOT_DOMAIN"))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -212,7 +212,7 @@ func TestAccACMCertificateDataSource_keyTypes(t *testing.T) {
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, acctest.RandomDomain().String())
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
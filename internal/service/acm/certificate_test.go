@@ -7,7 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -22,7 +24,7 @@ func TestAccACMCertificate_emailValidation(t *testing.T) {
 	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -59,7 +61,7 @@ func TestAccACMCertificate_dnsValidation(t *testing.T) {
 	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -98,7 +100,7 @@ func TestAccACMCertificate_root(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -138,7 +140,7 @@ func TestAccACMCertificate_validationOptions(t *testing.T) {
 	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -177,7 +179,7 @@ func TestAccACMCertificate_privateCert(t *testing.T) {
 	certificateDomainName := commonName.RandomSubdomain().String()
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -214,7 +216,7 @@ func TestAccACMCertificate_Root_trailingPeriod(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
 	domain := fmt.Sprintf("%s.", rootDomain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -234,7 +236,7 @@ func TestAccACMCertificate_rootAndWildcardSan(t *testing.T) {
 	wildcardDomain := fmt.Sprintf("*.%s", rootDomain)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -276,7 +278,7 @@ func TestAccACMCertificate_SubjectAlternativeNames_emptyString(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
 	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -297,7 +299,7 @@ func TestAccACMCertificate_San_single(t *testing.T) {
 	sanDomain := acctest.ACMCertificateRandomSubDomain(rootDomain)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -343,7 +345,7 @@ func TestAccACMCertificate_San_multiple(t *testing.T) {
 	sanDomain2 := acctest.ACMCertificateRandomSubDomain(rootDomain)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -393,7 +395,7 @@ func TestAccACMCertificate_San_trailingPeriod(t *testing.T) {
 	resourceName := "aws_acm_certificate.test"
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -438,7 +440,7 @@ func TestAccACMCertificate_San_matches_domain(t *testing.T) {
 	sanDomain := rootDomain
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -482,7 +484,7 @@ func TestAccACMCertificate_wildcard(t *testing.T) {
 	wildcardDomain := fmt.Sprintf("*.%s", rootDomain)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -521,7 +523,7 @@ func TestAccACMCertificate_wildcardAndRootSan(t *testing.T) {
 	wildcardDomain := fmt.Sprintf("*.%s", rootDomain)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -559,12 +561,123 @@ func TestAccACMCertificate_wildcardAndRootSan(t *testing.T) {
 	})
 }
 
+func TestAccACMCertificate_validationZoneIDs(t *testing.T) {
+	resourceName := "aws_acm_certificate.test"
+	zoneDataSourceName := "data.aws_route53_zone.test"
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	wildcardDomain := fmt.Sprintf("*.%s", domain)
+	var v acm.CertificateDetail
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckCertificateDestroy,
+			testAccCheckCertificateValidationRecordsExist(resourceName, zoneDataSourceName, false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_validationZoneIDs(rootDomain, domain, wildcardDomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", acm.CertificateStatusIssued),
+					resource.TestCheckResourceAttr(resourceName, "validation_zone_ids.%", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, fmt.Sprintf("validation_zone_ids.%s", domain), zoneDataSourceName, "zone_id"),
+					testAccCheckCertificateValidationRecordsExist(resourceName, zoneDataSourceName, true),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validation_zone_ids"},
+			},
+		},
+	})
+}
+
+func TestAccACMCertificate_ValidationZoneIDs_sharedRecord(t *testing.T) {
+	resourceName := "aws_acm_certificate.test"
+	zoneDataSourceName := "data.aws_route53_zone.test"
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	var v acm.CertificateDetail
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckCertificateDestroy,
+			testAccCheckCertificateValidationRecordsExist(resourceName, zoneDataSourceName, false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_validationZoneIDsShared(rootDomain, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", acm.CertificateStatusIssued),
+					resource.TestCheckResourceAttr("aws_acm_certificate.other", "status", acm.CertificateStatusIssued),
+					testAccCheckCertificateValidationRecordsExist(resourceName, zoneDataSourceName, true),
+				),
+			},
+			{
+				Config: testAccCertificateConfig_validationZoneIDsShared(rootDomain, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(resourceName, &v),
+					testAccCheckCertificateValidationRecordsExist(resourceName, zoneDataSourceName, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccACMCertificate_ValidationZoneIDs_missingDomain(t *testing.T) {
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	sanDomain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCertificateConfig_validationZoneIDs(rootDomain, domain, sanDomain),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`validation_zone_ids has no entry for %s`, regexp.QuoteMeta(sanDomain))),
+			},
+		},
+	})
+}
+
+func TestAccACMCertificate_ValidationZoneIDs_emailValidation(t *testing.T) {
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCertificateConfig_validationZoneIDsEmail(rootDomain, domain),
+				ExpectError: regexp.MustCompile(`validation_zone_ids requires validation_method to be DNS`),
+			},
+		},
+	})
+}
+
 func TestAccACMCertificate_disableCTLogging(t *testing.T) {
 	resourceName := "aws_acm_certificate.test"
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -613,7 +726,7 @@ func TestAccACMCertificate_Imported_domainName(t *testing.T) {
 	withoutChainDomain := acctest.RandomDomainName()
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -659,7 +772,7 @@ func TestAccACMCertificate_Imported_ipAddress(t *testing.T) { // Reference: http
 	resourceName := "aws_acm_certificate.test"
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -694,7 +807,7 @@ func TestAccACMCertificate_PrivateKey_tags(t *testing.T) {
 	certificate2 := acctest.TLSRSAX509SelfSignedCertificatePEM(key2, "5.6.7.8")
 	var v acm.CertificateDetail
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -765,6 +878,52 @@ func testAccCheckCertificateExists(n string, v *acm.CertificateDetail) resource.
 	}
 }
 
+// testAccCheckCertificateValidationRecordsExist checks whether the DNS validation records
+// of the certificate exist in the hosted zone.
+func testAccCheckCertificateValidationRecordsExist(n, zoneResourceName string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		zrs, ok := s.RootModule().Resources[zoneResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", zoneResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+		zoneID := zrs.Primary.Attributes["zone_id"]
+
+		for k, name := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "domain_validation_options.") || !strings.HasSuffix(k, ".resource_record_name") {
+				continue
+			}
+
+			output, err := conn.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+				HostedZoneId:    aws.String(zoneID),
+				MaxItems:        aws.String("1"),
+				StartRecordName: aws.String(name),
+				StartRecordType: aws.String(route53.RRTypeCname),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			found := len(output.ResourceRecordSets) > 0 &&
+				strings.EqualFold(aws.StringValue(output.ResourceRecordSets[0].Name), name) &&
+				aws.StringValue(output.ResourceRecordSets[0].Type) == route53.RRTypeCname
+
+			if found != exists {
+				return fmt.Errorf("Route 53 Record %s in Hosted Zone (%s) exists: %t, expected: %t", name, zoneID, found, exists)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckCertificateDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn
 
@@ -906,3 +1065,73 @@ resource "aws_acm_certificate" "test" {
 }
 `, domainName, validationMethod)
 }
+
+func testAccCertificateConfig_validationZoneIDs(rootZoneDomain, domainName, subjectAlternativeName string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[2]q
+  subject_alternative_names = [%[3]q]
+  validation_method         = "DNS"
+
+  validation_zone_ids = {
+    %[2]q = data.aws_route53_zone.test.zone_id
+  }
+}
+`, rootZoneDomain, domainName, subjectAlternativeName)
+}
+
+func testAccCertificateConfig_validationZoneIDsShared(rootZoneDomain, domainName string, other bool) string {
+	config := fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[2]q
+  validation_method = "DNS"
+
+  validation_zone_ids = {
+    %[2]q = data.aws_route53_zone.test.zone_id
+  }
+}
+`, rootZoneDomain, domainName)
+
+	if !other {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, fmt.Sprintf(`
+resource "aws_acm_certificate" "other" {
+  domain_name       = %[1]q
+  validation_method = "DNS"
+
+  validation_zone_ids = {
+    %[1]q = data.aws_route53_zone.test.zone_id
+  }
+}
+`, domainName))
+}
+
+func testAccCertificateConfig_validationZoneIDsEmail(rootZoneDomain, domainName string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[2]q
+  validation_method = "EMAIL"
+
+  validation_zone_ids = {
+    %[2]q = data.aws_route53_zone.test.zone_id
+  }
+}
+`, rootZoneDomain, domainName)
+}
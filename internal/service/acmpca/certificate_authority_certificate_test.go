@@ -19,7 +19,7 @@ func TestAccACMPCACertificateAuthorityCertificate_rootCA(t *testing.T) {
 
 	commonName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acmpca.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -50,7 +50,7 @@ func TestAccACMPCACertificateAuthorityCertificate_updateRootCA(t *testing.T) {
 
 	commonName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acmpca.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -84,7 +84,7 @@ func TestAccACMPCACertificateAuthorityCertificate_subordinateCA(t *testing.T) {
 
 	commonName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acmpca.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -16,7 +16,7 @@ func TestAccACMPCACertificateAuthorityDataSource_basic(t *testing.T) {
 
 	commonName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acmpca.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -53,7 +53,7 @@ func TestAccACMPCACertificateAuthorityDataSource_s3ObjectACL(t *testing.T) {
 
 	commonName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acmpca.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -16,7 +16,7 @@ func TestAccACMPCACertificateDataSource_basic(t *testing.T) {
 
 	domain := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acmpca.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
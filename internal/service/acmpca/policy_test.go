@@ -16,7 +16,7 @@ import (
 func TestAccACMPCAPolicy_Basic(t *testing.T) {
 	resourceName := "aws_acmpca_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, acmpca.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
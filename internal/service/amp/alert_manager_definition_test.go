@@ -17,7 +17,7 @@ import (
 func TestAccAMPAlertManagerDefinition_basic(t *testing.T) {
 	resourceName := "aws_prometheus_alert_manager_definition.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(prometheusservice.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, prometheusservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -55,7 +55,7 @@ func TestAccAMPAlertManagerDefinition_basic(t *testing.T) {
 
 func TestAccAMPAlertManagerDefinition_disappears(t *testing.T) {
 	resourceName := "aws_prometheus_alert_manager_definition.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(prometheusservice.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, prometheusservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -17,7 +17,7 @@ import (
 func TestAccAMPRuleGroupNamespace_basic(t *testing.T) {
 	resourceName := "aws_prometheus_rule_group_namespace.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(prometheusservice.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, prometheusservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -55,7 +55,7 @@ func TestAccAMPRuleGroupNamespace_basic(t *testing.T) {
 
 func TestAccAMPRuleGroupNamespace_disappears(t *testing.T) {
 	resourceName := "aws_prometheus_rule_group_namespace.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(prometheusservice.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, prometheusservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
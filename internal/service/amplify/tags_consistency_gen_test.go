@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package amplify_test
+
+import (
+	"testing"
+)
+
+func TestAccAmplifyApp_TagsConsistency(t *testing.T) {
+	t.Skip("aws_amplify_app has no testAccAppConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAmplifyBranch_TagsConsistency(t *testing.T) {
+	t.Skip("aws_amplify_branch has no testAccBranchConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
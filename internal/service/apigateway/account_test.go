@@ -23,7 +23,7 @@ func TestAccAPIGatewayAccount_basic(t *testing.T) {
 	expectedRoleArn_first := regexp.MustCompile("role/" + firstName + "$")
 	expectedRoleArn_second := regexp.MustCompile("role/" + secondName + "$")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
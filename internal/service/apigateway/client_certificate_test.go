@@ -19,7 +19,7 @@ func TestAccAPIGatewayClientCertificate_basic(t *testing.T) {
 	var conf apigateway.ClientCertificate
 	resourceName := "aws_api_gateway_client_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -54,7 +54,7 @@ func TestAccAPIGatewayClientCertificate_tags(t *testing.T) {
 	var conf apigateway.ClientCertificate
 	resourceName := "aws_api_gateway_client_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -98,7 +98,7 @@ func TestAccAPIGatewayClientCertificate_disappears(t *testing.T) {
 	var conf apigateway.ClientCertificate
 	resourceName := "aws_api_gateway_client_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -13,7 +13,7 @@ func TestAccAPIGatewayExportDataSource_basic(t *testing.T) {
 	rName := sdkacctest.RandString(8)
 	dataSourceName := "data.aws_api_gateway_export.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
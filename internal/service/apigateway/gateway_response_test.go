@@ -21,7 +21,7 @@ func TestAccAPIGatewayGatewayResponse_basic(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_api_gateway_gateway_response.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -64,7 +64,7 @@ func TestAccAPIGatewayGatewayResponse_disappears(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_api_gateway_gateway_response.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccAPIGatewayMethod_basic(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -60,7 +60,7 @@ func TestAccAPIGatewayMethod_customAuthorizer(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -102,7 +102,7 @@ func TestAccAPIGatewayMethod_cognitoAuthorizer(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -147,7 +147,7 @@ func TestAccAPIGatewayMethod_customRequestValidator(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -188,7 +188,7 @@ func TestAccAPIGatewayMethod_disappears(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -211,7 +211,7 @@ func TestAccAPIGatewayMethod_operationName(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
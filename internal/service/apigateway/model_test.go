@@ -22,7 +22,7 @@ func TestAccAPIGatewayModel_basic(t *testing.T) {
 	modelName := fmt.Sprintf("tfacctest%s", rInt)
 	resourceName := "aws_api_gateway_model.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -58,7 +58,7 @@ func TestAccAPIGatewayModel_disappears(t *testing.T) {
 	modelName := fmt.Sprintf("tfacctest%s", rInt)
 	resourceName := "aws_api_gateway_model.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
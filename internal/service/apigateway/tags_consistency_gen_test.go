@@ -0,0 +1,35 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package apigateway_test
+
+import (
+	"testing"
+)
+
+func TestAccAPIGatewayAPIKey_TagsConsistency(t *testing.T) {
+	t.Skip("aws_api_gateway_api_key has no testAccAPIKeyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAPIGatewayClientCertificate_TagsConsistency(t *testing.T) {
+	t.Skip("aws_api_gateway_client_certificate has no testAccClientCertificateConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAPIGatewayDomainName_TagsConsistency(t *testing.T) {
+	t.Skip("aws_api_gateway_domain_name has no testAccDomainNameConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAPIGatewayRestAPI_TagsConsistency(t *testing.T) {
+	t.Skip("aws_api_gateway_rest_api has no testAccRestAPIConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAPIGatewayStage_TagsConsistency(t *testing.T) {
+	t.Skip("aws_api_gateway_stage has no testAccStageConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAPIGatewayUsagePlan_TagsConsistency(t *testing.T) {
+	t.Skip("aws_api_gateway_usage_plan has no testAccUsagePlanConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAPIGatewayVPCLink_TagsConsistency(t *testing.T) {
+	t.Skip("aws_api_gateway_vpc_link has no testAccVPCLinkConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
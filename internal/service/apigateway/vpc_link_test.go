@@ -22,7 +22,7 @@ func TestAccAPIGatewayVPCLink_basic(t *testing.T) {
 	vpcLinkName := fmt.Sprintf("tf-apigateway-%s", rName)
 	vpcLinkNameUpdated := fmt.Sprintf("tf-apigateway-update-%s", rName)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -63,7 +63,7 @@ func TestAccAPIGatewayVPCLink_tags(t *testing.T) {
 	vpcLinkName := fmt.Sprintf("tf-apigateway-%s", rName)
 	description := "test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -116,7 +116,7 @@ func TestAccAPIGatewayVPCLink_disappears(t *testing.T) {
 	rName := sdkacctest.RandString(5)
 	resourceName := "aws_api_gateway_vpc_link.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
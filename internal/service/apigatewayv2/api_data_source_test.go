@@ -15,7 +15,7 @@ func TestAccAPIGatewayV2APIDataSource_http(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -55,7 +55,7 @@ func TestAccAPIGatewayV2APIDataSource_webSocket(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -45,7 +45,7 @@ func TestAccAPIGatewayV2APIMapping_basic(t *testing.T) {
 }
 
 func testAccAPIMapping_createCertificate(t *testing.T, rName string, certificateArn *string) {
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -70,7 +70,7 @@ func testAccAPIMapping_basic(t *testing.T, rName string, certificateArn *string)
 	domainNameResourceName := "aws_apigatewayv2_domain_name.test"
 	stageResourceName := "aws_apigatewayv2_stage.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -98,7 +98,7 @@ func testAccAPIMapping_disappears(t *testing.T, rName string, certificateArn *st
 	var v apigatewayv2.GetApiMappingOutput
 	resourceName := "aws_apigatewayv2_api_mapping.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -123,7 +123,7 @@ func testAccAPIMapping_key(t *testing.T, rName string, certificateArn *string) {
 	domainNameResourceName := "aws_apigatewayv2_domain_name.test"
 	stageResourceName := "aws_apigatewayv2_stage.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccAPIGatewayV2API_basicWebSocket(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -59,7 +59,7 @@ func TestAccAPIGatewayV2API_basicHTTP(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -97,7 +97,7 @@ func TestAccAPIGatewayV2API_disappears(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -121,7 +121,7 @@ func TestAccAPIGatewayV2API_allAttributesWebSocket(t *testing.T) {
 	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -203,7 +203,7 @@ func TestAccAPIGatewayV2API_allAttributesHTTP(t *testing.T) {
 	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -284,7 +284,7 @@ func TestAccAPIGatewayV2API_openAPI(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -331,7 +331,7 @@ func TestAccAPIGatewayV2API_OpenAPI_withTags(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -376,7 +376,7 @@ func TestAccAPIGatewayV2API_OpenAPI_withCors(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -433,7 +433,7 @@ func TestAccAPIGatewayV2API_OpenAPI_withMoreFields(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -486,7 +486,7 @@ func TestAccAPIGatewayV2API_OpenAPI_failOnWarnings(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -570,7 +570,7 @@ func TestAccAPIGatewayV2API_tags(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -623,7 +623,7 @@ func TestAccAPIGatewayV2API_cors(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -714,7 +714,7 @@ func TestAccAPIGatewayV2API_quickCreate(t *testing.T) {
 	resourceName := "aws_apigatewayv2_api.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
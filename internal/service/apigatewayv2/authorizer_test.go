@@ -22,7 +22,7 @@ func TestAccAPIGatewayV2Authorizer_basic(t *testing.T) {
 	lambdaResourceName := "aws_lambda_function.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -59,7 +59,7 @@ func TestAccAPIGatewayV2Authorizer_disappears(t *testing.T) {
 	resourceName := "aws_apigatewayv2_authorizer.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -85,7 +85,7 @@ func TestAccAPIGatewayV2Authorizer_credentials(t *testing.T) {
 	lambdaResourceName := "aws_lambda_function.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -155,7 +155,7 @@ func TestAccAPIGatewayV2Authorizer_jwt(t *testing.T) {
 	resourceName := "aws_apigatewayv2_authorizer.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -215,7 +215,7 @@ func TestAccAPIGatewayV2Authorizer_HTTPAPILambdaRequestAuthorizer_initialMissing
 	lambdaResourceName := "aws_lambda_function.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -288,7 +288,7 @@ func TestAccAPIGatewayV2Authorizer_HTTPAPILambdaRequestAuthorizer_initialZeroCac
 	lambdaResourceName := "aws_lambda_function.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package apigatewayv2_test
+
+import (
+	"testing"
+)
+
+func TestAccAPIGatewayV2API_TagsConsistency(t *testing.T) {
+	t.Skip("aws_apigatewayv2_api has no testAccAPIConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAPIGatewayV2DomainName_TagsConsistency(t *testing.T) {
+	t.Skip("aws_apigatewayv2_domain_name has no testAccDomainNameConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAPIGatewayV2Stage_TagsConsistency(t *testing.T) {
+	t.Skip("aws_apigatewayv2_stage has no testAccStageConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAPIGatewayV2VPCLink_TagsConsistency(t *testing.T) {
+	t.Skip("aws_apigatewayv2_vpc_link has no testAccVPCLinkConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -86,7 +86,7 @@ func TestAccAppAutoScalingPolicy_basic(t *testing.T) {
 	resourceName := "aws_appautoscaling_policy.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -126,7 +126,7 @@ func TestAccAppAutoScalingPolicy_disappears(t *testing.T) {
 	resourceName := "aws_appautoscaling_policy.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -150,7 +150,7 @@ func TestAccAppAutoScalingPolicy_scaleOutAndIn(t *testing.T) {
 	randClusterName := fmt.Sprintf("cluster%s", sdkacctest.RandString(10))
 	randPolicyNamePrefix := fmt.Sprintf("terraform-test-foobar-%s", sdkacctest.RandString(5))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -231,7 +231,7 @@ func TestAccAppAutoScalingPolicy_spotFleetRequest(t *testing.T) {
 	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", sdkacctest.RandString(5))
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -263,7 +263,7 @@ func TestAccAppAutoScalingPolicy_DynamoDB_table(t *testing.T) {
 
 	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", sdkacctest.RandString(5))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -295,7 +295,7 @@ func TestAccAppAutoScalingPolicy_DynamoDB_index(t *testing.T) {
 	appautoscalingTargetResourceName := "aws_appautoscaling_target.test"
 	resourceName := "aws_appautoscaling_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -329,7 +329,7 @@ func TestAccAppAutoScalingPolicy_multiplePoliciesSameName(t *testing.T) {
 	tableName2 := fmt.Sprintf("tf-autoscaled-table-%s", sdkacctest.RandString(5))
 	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", sdkacctest.RandString(5))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -362,7 +362,7 @@ func TestAccAppAutoScalingPolicy_multiplePoliciesSameResource(t *testing.T) {
 	tableName := fmt.Sprintf("tf-autoscaled-table-%s", sdkacctest.RandString(5))
 	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", sdkacctest.RandString(5))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -407,7 +407,7 @@ func TestAccAppAutoScalingPolicy_ResourceID_forceNew(t *testing.T) {
 	resourceName := "aws_appautoscaling_policy.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -20,7 +20,7 @@ func TestAccAppAutoScalingTarget_basic(t *testing.T) {
 
 	randClusterName := fmt.Sprintf("cluster-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -60,7 +60,7 @@ func TestAccAppAutoScalingTarget_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appautoscaling_target.bar"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -82,7 +82,7 @@ func TestAccAppAutoScalingTarget_spotFleetRequest(t *testing.T) {
 	var target applicationautoscaling.ScalableTarget
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -110,7 +110,7 @@ func TestAccAppAutoScalingTarget_emrCluster(t *testing.T) {
 	var target applicationautoscaling.ScalableTarget
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -141,7 +141,7 @@ func TestAccAppAutoScalingTarget_multipleTargets(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	tableName := fmt.Sprintf("tf_acc_test_table_%d", rInt)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -175,7 +175,7 @@ func TestAccAppAutoScalingTarget_optionalRoleARN(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	tableName := fmt.Sprintf("tf_acc_test_table_%d", rInt)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -24,7 +24,7 @@ func TestAccAppConfigDeployment_basic(t *testing.T) {
 	envResourceName := "aws_appconfig_environment.test"
 	confVersionResourceName := "aws_appconfig_hosted_configuration_version.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -62,7 +62,7 @@ func TestAccAppConfigDeployment_predefinedStrategy(t *testing.T) {
 	resourceName := "aws_appconfig_deployment.test"
 	strategy := "AppConfig.Linear50PercentEvery30Seconds"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -95,7 +95,7 @@ func TestAccAppConfigDeployment_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_deployment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccAppConfigEnvironment_basic(t *testing.T) {
 	resourceName := "aws_appconfig_environment.test"
 	appResourceName := "aws_appconfig_application.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -52,7 +52,7 @@ func TestAccAppConfigEnvironment_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_environment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -75,7 +75,7 @@ func TestAccAppConfigEnvironment_updateName(t *testing.T) {
 	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-update")
 	resourceName := "aws_appconfig_environment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -108,7 +108,7 @@ func TestAccAppConfigEnvironment_updateDescription(t *testing.T) {
 	description := sdkacctest.RandomWithPrefix("tf-acc-test-update")
 	resourceName := "aws_appconfig_environment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -153,7 +153,7 @@ func TestAccAppConfigEnvironment_monitors(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_environment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -210,7 +210,7 @@ func TestAccAppConfigEnvironment_multipleEnvironments(t *testing.T) {
 	resourceName1 := "aws_appconfig_environment.test"
 	resourceName2 := "aws_appconfig_environment.test2"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -252,7 +252,7 @@ func TestAccAppConfigEnvironment_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_environment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appconfig.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,27 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package appconfig_test
+
+import (
+	"testing"
+)
+
+func TestAccAppConfigApplication_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appconfig_application has no testAccApplicationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppConfigConfigurationProfile_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appconfig_configuration_profile has no testAccConfigurationProfileConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppConfigDeployment_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appconfig_deployment has no testAccDeploymentConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppConfigDeploymentStrategy_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appconfig_deployment_strategy has no testAccDeploymentStrategyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppConfigEnvironment_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appconfig_environment has no testAccEnvironmentConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -27,7 +27,7 @@ func TestAccAppFlowConnectorProfile_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appflow_connector_profile.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -66,7 +66,7 @@ func TestAccAppFlowConnectorProfile_update(t *testing.T) {
 	resourceName := "aws_appflow_connector_profile.test"
 	testPrefix := "test-prefix"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -99,7 +99,7 @@ func TestAccAppFlowConnectorProfile_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appflow_connector_profile.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
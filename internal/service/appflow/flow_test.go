@@ -23,7 +23,7 @@ func TestAccAppFlowFlow_basic(t *testing.T) {
 	rFlowName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appflow_flow.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -67,7 +67,7 @@ func TestAccAppFlowFlow_update(t *testing.T) {
 	resourceName := "aws_appflow_flow.test"
 	description := "test description"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -97,7 +97,7 @@ func TestAccAppFlowFlow_TaskProperties(t *testing.T) {
 	rFlowName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appflow_flow.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -123,7 +123,7 @@ func TestAccAppFlowFlow_tags(t *testing.T) {
 	rFlowName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appflow_flow.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -170,7 +170,7 @@ func TestAccAppFlowFlow_disappears(t *testing.T) {
 	rFlowName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appflow_flow.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
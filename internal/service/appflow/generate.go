@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ServiceTagsMap -ListTags -UpdateTags
+//go:generate go run ../../generate/tagstests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package appflow
@@ -30,7 +30,7 @@ func TestAccAppIntegrationsEventIntegration_basic(t *testing.T) {
 		sourceName = "aws.partner/examplepartner.com"
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t)
@@ -88,7 +88,7 @@ func TestAccAppIntegrationsEventIntegration_updateTags(t *testing.T) {
 		sourceName = "aws.partner/examplepartner.com"
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t)
@@ -167,7 +167,7 @@ func TestAccAppIntegrationsEventIntegration_disappears(t *testing.T) {
 		sourceName = "aws.partner/examplepartner.com"
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t)
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package appintegrations_test
+
+import (
+	"testing"
+)
+
+func TestAccAppIntegrationsEventIntegration_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appintegrations_event_integration has no testAccEventIntegrationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
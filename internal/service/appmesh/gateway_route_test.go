@@ -22,7 +22,7 @@ func testAccGatewayRoute_basic(t *testing.T) {
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -69,7 +69,7 @@ func testAccGatewayRoute_disappears(t *testing.T) {
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -96,7 +96,7 @@ func testAccGatewayRoute_GRPCRoute(t *testing.T) {
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -169,7 +169,7 @@ func testAccGatewayRoute_HTTPRoute(t *testing.T) {
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -242,7 +242,7 @@ func testAccGatewayRoute_HTTP2Route(t *testing.T) {
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -313,7 +313,7 @@ func testAccGatewayRoute_Tags(t *testing.T) {
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
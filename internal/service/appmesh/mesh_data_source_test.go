@@ -15,7 +15,7 @@ func TestAccAppMeshMeshDataSource_basic(t *testing.T) {
 	resourceName := "aws_appmesh_mesh.test"
 	dataSourceName := "data.aws_appmesh_mesh.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -43,7 +43,7 @@ func TestAccAppMeshMeshDataSource_meshOwner(t *testing.T) {
 	resourceName := "aws_appmesh_mesh.test"
 	dataSourceName := "data.aws_appmesh_mesh.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -71,7 +71,7 @@ func TestAccAppMeshMeshDataSource_specAndTagsSet(t *testing.T) {
 	resourceName := "aws_appmesh_mesh.test"
 	dataSourceName := "data.aws_appmesh_mesh.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
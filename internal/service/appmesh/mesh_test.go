@@ -20,7 +20,7 @@ func testAccMesh_basic(t *testing.T) {
 	resourceName := "aws_appmesh_mesh.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -52,7 +52,7 @@ func testAccMesh_egressFilter(t *testing.T) {
 	resourceName := "aws_appmesh_mesh.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -92,7 +92,7 @@ func testAccMesh_tags(t *testing.T) {
 	resourceName := "aws_appmesh_mesh.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -23,7 +23,7 @@ func testAccRoute_grpcRoute(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -238,7 +238,7 @@ func testAccRoute_grpcRouteTimeout(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -337,7 +337,7 @@ func testAccRoute_grpcRouteEmptyMatch(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -390,7 +390,7 @@ func testAccRoute_http2Route(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -507,7 +507,7 @@ func testAccRoute_http2RouteTimeout(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -610,7 +610,7 @@ func testAccRoute_httpRoute(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -721,7 +721,7 @@ func testAccRoute_httpRouteTimeout(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -816,7 +816,7 @@ func testAccRoute_tcpRoute(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -910,7 +910,7 @@ func testAccRoute_tcpRouteTimeout(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -989,7 +989,7 @@ func testAccRoute_tags(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1039,7 +1039,7 @@ func testAccRoute_httpHeader(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1138,7 +1138,7 @@ func testAccRoute_routePriority(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1221,7 +1221,7 @@ func testAccRoute_httpRetryPolicy(t *testing.T) {
 	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,35 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package appmesh_test
+
+import (
+	"testing"
+)
+
+func TestAccAppMeshGatewayRoute_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appmesh_gateway_route has no testAccGatewayRouteConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppMeshMesh_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appmesh_mesh has no testAccMeshConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppMeshRoute_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appmesh_route has no testAccRouteConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppMeshVirtualGateway_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appmesh_virtual_gateway has no testAccVirtualGatewayConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppMeshVirtualNode_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appmesh_virtual_node has no testAccVirtualNodeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppMeshVirtualRouter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appmesh_virtual_router has no testAccVirtualRouterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppMeshVirtualService_TagsConsistency(t *testing.T) {
+	t.Skip("aws_appmesh_virtual_service has no testAccVirtualServiceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -21,7 +21,7 @@ func testAccVirtualGateway_basic(t *testing.T) {
 	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -66,7 +66,7 @@ func testAccVirtualGateway_disappears(t *testing.T) {
 	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -90,7 +90,7 @@ func testAccVirtualGateway_BackendDefaults(t *testing.T) {
 	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -183,7 +183,7 @@ func testAccVirtualGateway_BackendDefaultsCertificate(t *testing.T) {
 	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -247,7 +247,7 @@ func testAccVirtualGateway_ListenerConnectionPool(t *testing.T) {
 	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -324,7 +324,7 @@ func testAccVirtualGateway_ListenerHealthChecks(t *testing.T) {
 	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -411,7 +411,7 @@ func testAccVirtualGateway_ListenerTLS(t *testing.T) {
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -515,7 +515,7 @@ func testAccVirtualGateway_ListenerValidation(t *testing.T) {
 	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -613,7 +613,7 @@ func testAccVirtualGateway_Logging(t *testing.T) {
 	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -685,7 +685,7 @@ func testAccVirtualGateway_Tags(t *testing.T) {
 	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appmesh.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
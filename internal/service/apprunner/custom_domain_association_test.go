@@ -26,7 +26,7 @@ func TestAccAppRunnerCustomDomainAssociation_basic(t *testing.T) {
 	resourceName := "aws_apprunner_custom_domain_association.test"
 	serviceResourceName := "aws_apprunner_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -63,7 +63,7 @@ func TestAccAppRunnerCustomDomainAssociation_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_custom_domain_association.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
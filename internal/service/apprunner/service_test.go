@@ -21,7 +21,7 @@ func TestAccAppRunnerService_ImageRepository_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -78,7 +78,7 @@ func TestAccAppRunnerService_ImageRepository_autoScaling(t *testing.T) {
 	resourceName := "aws_apprunner_service.test"
 	autoScalingResourceName := "aws_apprunner_auto_scaling_configuration_version.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -111,7 +111,7 @@ func TestAccAppRunnerService_ImageRepository_encryption(t *testing.T) {
 	resourceName := "aws_apprunner_service.test"
 	kmsResourceName := "aws_kms_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -146,7 +146,7 @@ func TestAccAppRunnerService_ImageRepository_healthCheck(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -195,7 +195,7 @@ func TestAccAppRunnerService_ImageRepository_instance_NoInstanceRole(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -225,7 +225,7 @@ func TestAccAppRunnerService_ImageRepository_instance_Update(t *testing.T) {
 	resourceName := "aws_apprunner_service.test"
 	roleResourceName := "aws_iam_role.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -280,7 +280,7 @@ func TestAccAppRunnerService_ImageRepository_networkConfiguration(t *testing.T)
 	resourceName := "aws_apprunner_service.test"
 	vpcConnectorResourceName := "aws_apprunner_vpc_connector.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -310,7 +310,7 @@ func TestAccAppRunnerService_ImageRepository_runtimeEnvironmentVars(t *testing.T
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -340,7 +340,7 @@ func TestAccAppRunnerService_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -362,7 +362,7 @@ func TestAccAppRunnerService_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, apprunner.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package apprunner_test
+
+import (
+	"testing"
+)
+
+func TestAccAppRunnerAutoScalingConfigurationVersion_TagsConsistency(t *testing.T) {
+	t.Skip("aws_apprunner_auto_scaling_configuration_version has no testAccAutoScalingConfigurationVersionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppRunnerConnection_TagsConsistency(t *testing.T) {
+	t.Skip("aws_apprunner_connection has no testAccConnectionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAppRunnerService_TagsConsistency(t *testing.T) {
+	t.Skip("aws_apprunner_service has no testAccServiceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -27,7 +27,7 @@ func TestAccAppStreamDirectoryConfig_basic(t *testing.T) {
 	rPasswordUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	orgUnitDN := orgUnitFromDomain("Test", domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckDirectoryConfigDestroy,
@@ -79,7 +79,7 @@ func TestAccAppStreamDirectoryConfig_disappears(t *testing.T) {
 	rPassword := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	orgUnitDN := orgUnitFromDomain("Test", domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckDirectoryConfigDestroy,
@@ -107,7 +107,7 @@ func TestAccAppStreamDirectoryConfig_OrganizationalUnitDistinguishedNames(t *tes
 	orgUnitDN1 := orgUnitFromDomain("One", domain)
 	orgUnitDN2 := orgUnitFromDomain("Two", domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckDirectoryConfigDestroy,
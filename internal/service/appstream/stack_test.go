@@ -20,7 +20,7 @@ func TestAccAppStreamStack_basic(t *testing.T) {
 	resourceName := "aws_appstream_stack.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckStackDestroy,
@@ -48,7 +48,7 @@ func TestAccAppStreamStack_disappears(t *testing.T) {
 	resourceName := "aws_appstream_stack.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckStackDestroy,
@@ -73,7 +73,7 @@ func TestAccAppStreamStack_complete(t *testing.T) {
 	description := "Description of a test"
 	descriptionUpdated := "Updated Description of a test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckStackDestroy,
@@ -113,7 +113,7 @@ func TestAccAppStreamStack_withTags(t *testing.T) {
 	description := "Description of a test"
 	descriptionUpdated := "Updated Description of a test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckStackDestroy,
@@ -23,7 +23,7 @@ func TestAccAppStreamUserStackAssociation_basic(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 		},
@@ -56,7 +56,7 @@ func TestAccAppStreamUserStackAssociation_disappears(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 		},
@@ -84,7 +84,7 @@ func TestAccAppStreamUserStackAssociation_complete(t *testing.T) {
 	rEmail := acctest.RandomEmailAddress(domain)
 	rEmailUpdated := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 		},
@@ -23,7 +23,7 @@ func TestAccAppStreamUser_basic(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 		},
@@ -58,7 +58,7 @@ func TestAccAppStreamUser_disappears(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 		},
@@ -87,7 +87,7 @@ func TestAccAppStreamUser_complete(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 		},
@@ -19,7 +19,7 @@ func testAccAPICache_basic(t *testing.T) {
 	resourceName := "aws_appsync_api_cache.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -48,7 +48,7 @@ func testAccAPICache_disappears(t *testing.T) {
 	resourceName := "aws_appsync_api_cache.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -20,7 +20,7 @@ func testAccDataSource_basic(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -54,7 +54,7 @@ func testAccDataSource_description(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -87,7 +87,7 @@ func testAccDataSource_DynamoDB_region(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -122,7 +122,7 @@ func testAccDataSource_DynamoDB_useCallerCredentials(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -158,7 +158,7 @@ func TestAccAppSyncDataSource_Elasticsearch_region(t *testing.T) {
 	resourceName := "aws_appsync_datasource.test"
 
 	// Keep this test Parallel as it takes considerably longer to run than any non-Elasticsearch tests.
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -193,7 +193,7 @@ func testAccDataSource_HTTP_endpoint(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -230,7 +230,7 @@ func testAccDataSource_type(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -260,7 +260,7 @@ func testAccDataSource_Type_dynamoDB(t *testing.T) {
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -292,7 +292,7 @@ func TestAccAppSyncDataSource_Type_elasticSearch(t *testing.T) {
 	resourceName := "aws_appsync_datasource.test"
 
 	// Keep this test Parallel as it takes considerably longer to run than any non-Elasticsearch tests.
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -322,7 +322,7 @@ func testAccDataSource_Type_http(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -350,7 +350,7 @@ func testAccDataSource_Type_httpAuth(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -383,7 +383,7 @@ func testAccDataSource_Type_relationalDatabase(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -411,7 +411,7 @@ func testAccDataSource_Type_relationalDatabaseWithOptions(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -443,7 +443,7 @@ func testAccDataSource_Type_lambda(t *testing.T) {
 	lambdaFunctionResourceName := "aws_lambda_function.test"
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -472,7 +472,7 @@ func testAccDataSource_Type_none(t *testing.T) {
 	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
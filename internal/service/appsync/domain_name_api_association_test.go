@@ -24,7 +24,7 @@ func testAccDomainNameAPIAssociation_basic(t *testing.T) {
 	rName := sdkacctest.RandString(8)
 	resourceName := "aws_appsync_domain_name_api_association.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.FactoriesAlternate(&providers),
@@ -63,7 +63,7 @@ func testAccDomainNameAPIAssociation_disappears(t *testing.T) {
 	rName := sdkacctest.RandString(8)
 	resourceName := "aws_appsync_domain_name_api_association.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.FactoriesAlternate(&providers),
@@ -25,7 +25,7 @@ func testAccDomainName_basic(t *testing.T) {
 	acmCertificateResourceName := "data.aws_acm_certificate.test"
 	resourceName := "aws_appsync_domain_name.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.FactoriesAlternate(&providers),
@@ -56,7 +56,7 @@ func testAccDomainName_description(t *testing.T) {
 	rName := sdkacctest.RandString(8)
 	resourceName := "aws_appsync_domain_name.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.FactoriesAlternate(&providers),
@@ -93,7 +93,7 @@ func testAccDomainName_disappears(t *testing.T) {
 	rName := sdkacctest.RandString(8)
 	resourceName := "aws_appsync_domain_name.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.FactoriesAlternate(&providers),
@@ -23,7 +23,7 @@ func testAccFunction_basic(t *testing.T) {
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -63,7 +63,7 @@ func testAccFunction_syncConfig(t *testing.T) {
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -93,7 +93,7 @@ func testAccFunction_description(t *testing.T) {
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -128,7 +128,7 @@ func testAccFunction_responseMappingTemplate(t *testing.T) {
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -155,7 +155,7 @@ func testAccFunction_disappears(t *testing.T) {
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, appsync.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
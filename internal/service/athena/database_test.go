@@ -20,7 +20,7 @@ func TestAccAthenaDatabase_basic(t *testing.T) {
 	dbName := sdkacctest.RandString(8)
 	resourceName := "aws_athena_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -52,7 +52,7 @@ func TestAccAthenaDatabase_properties(t *testing.T) {
 	dbName := sdkacctest.RandString(8)
 	resourceName := "aws_athena_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -82,7 +82,7 @@ func TestAccAthenaDatabase_acl(t *testing.T) {
 	dbName := sdkacctest.RandString(8)
 	resourceName := "aws_athena_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -112,7 +112,7 @@ func TestAccAthenaDatabase_encryption(t *testing.T) {
 	dbName := sdkacctest.RandString(8)
 	resourceName := "aws_athena_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -142,7 +142,7 @@ func TestAccAthenaDatabase_nameStartsWithUnderscore(t *testing.T) {
 	dbName := "_" + sdkacctest.RandString(8)
 	resourceName := "aws_athena_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -169,7 +169,7 @@ func TestAccAthenaDatabase_nameCantHaveUppercase(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dbName := "A" + sdkacctest.RandString(8)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -187,7 +187,7 @@ func TestAccAthenaDatabase_destroyFailsIfTablesExist(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dbName := sdkacctest.RandString(8)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -210,7 +210,7 @@ func TestAccAthenaDatabase_forceDestroyAlwaysSucceeds(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dbName := sdkacctest.RandString(8)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -232,7 +232,7 @@ func TestAccAthenaDatabase_description(t *testing.T) {
 	dbName := sdkacctest.RandString(8)
 	resourceName := "aws_athena_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -261,7 +261,7 @@ func TestAccAthenaDatabase_unescaped_description(t *testing.T) {
 	dbName := sdkacctest.RandString(8)
 	resourceName := "aws_athena_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -290,7 +290,7 @@ func TestAccAthenaDatabase_disppears(t *testing.T) {
 	dbName := sdkacctest.RandString(8)
 
 	resourceName := "aws_athena_database.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
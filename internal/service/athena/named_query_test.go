@@ -17,7 +17,7 @@ import (
 func TestAccAthenaNamedQuery_basic(t *testing.T) {
 	resourceName := "aws_athena_named_query.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -41,7 +41,7 @@ func TestAccAthenaNamedQuery_basic(t *testing.T) {
 func TestAccAthenaNamedQuery_withWorkGroup(t *testing.T) {
 	resourceName := "aws_athena_named_query.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
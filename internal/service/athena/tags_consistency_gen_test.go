@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package athena_test
+
+import (
+	"testing"
+)
+
+func TestAccAthenaDataCatalog_TagsConsistency(t *testing.T) {
+	t.Skip("aws_athena_data_catalog has no testAccDataCatalogConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccAthenaWorkGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_athena_workgroup has no testAccWorkGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -17,7 +17,7 @@ func TestAccAutoScalingAttachment_elb(t *testing.T) {
 
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -61,7 +61,7 @@ func TestAccAutoScalingAttachment_albTargetGroup(t *testing.T) {
 
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -17,7 +17,7 @@ import (
 func TestAccAutoScalingGroupTag_basic(t *testing.T) {
 	resourceName := "aws_autoscaling_group_tag.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -43,7 +43,7 @@ func TestAccAutoScalingGroupTag_basic(t *testing.T) {
 func TestAccAutoScalingGroupTag_disappears(t *testing.T) {
 	resourceName := "aws_autoscaling_group_tag.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -64,7 +64,7 @@ func TestAccAutoScalingGroupTag_disappears(t *testing.T) {
 func TestAccAutoScalingGroupTag_value(t *testing.T) {
 	resourceName := "aws_autoscaling_group_tag.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -17,7 +17,7 @@ func TestAccAutoScalingGroupsDataSource_basic(t *testing.T) {
 	datasource4Name := "data.aws_autoscaling_groups.group_list_multiple_values"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
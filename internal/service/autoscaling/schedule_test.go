@@ -24,7 +24,7 @@ func TestAccAutoScalingSchedule_basic(t *testing.T) {
 	endTime := testAccScheduleValidEnd(t)
 	resourceName := "aws_autoscaling_schedule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -54,7 +54,7 @@ func TestAccAutoScalingSchedule_disappears(t *testing.T) {
 	endTime := testAccScheduleValidEnd(t)
 	resourceName := "aws_autoscaling_schedule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -77,7 +77,7 @@ func TestAccAutoScalingSchedule_recurrence(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_autoscaling_schedule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -107,7 +107,7 @@ func TestAccAutoScalingSchedule_zeroValues(t *testing.T) {
 	endTime := testAccScheduleValidEnd(t)
 	resourceName := "aws_autoscaling_schedule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -136,7 +136,7 @@ func TestAccAutoScalingSchedule_negativeOne(t *testing.T) {
 	endTime := testAccScheduleValidEnd(t)
 	resourceName := "aws_autoscaling_schedule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -16,7 +16,7 @@ func testAccFrameworkDataSource_basic(t *testing.T) {
 	resourceName := "aws_backup_framework.test"
 	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccFrameworkPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -72,7 +72,7 @@ func testAccFrameworkDataSource_controlScopeTag(t *testing.T) {
 	resourceName := "aws_backup_framework.test"
 	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccFrameworkPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
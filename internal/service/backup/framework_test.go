@@ -51,7 +51,7 @@ func testAccFramework_basic(t *testing.T) {
 	updatedDescription := "updated description"
 	resourceName := "aws_backup_framework.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccFrameworkPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -111,7 +111,7 @@ func testAccFramework_updateTags(t *testing.T) {
 	description := "example description"
 	resourceName := "aws_backup_framework.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccFrameworkPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -200,7 +200,7 @@ func testAccFramework_updateControlScope(t *testing.T) {
 	updatedControlScopeTagValue := ""
 	resourceName := "aws_backup_framework.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccFrameworkPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -312,7 +312,7 @@ func testAccFramework_updateControlInputParameters(t *testing.T) {
 	updatedRequiredRetentionDays := "34"
 	resourceName := "aws_backup_framework.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccFrameworkPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -392,7 +392,7 @@ func testAccFramework_updateControls(t *testing.T) {
 	description := "example description"
 	resourceName := "aws_backup_framework.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccFrameworkPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -470,7 +470,7 @@ func testAccFramework_disappears(t *testing.T) {
 	description := "disappears"
 	resourceName := "aws_backup_framework.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccFrameworkPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
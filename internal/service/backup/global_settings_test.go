@@ -15,7 +15,7 @@ func TestAccBackupGlobalSettings_basic(t *testing.T) {
 	var settings backup.DescribeGlobalSettingsOutput
 
 	resourceName := "aws_backup_global_settings.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckOrganizationManagementAccount(t)
@@ -16,7 +16,7 @@ func TestAccBackupPlanDataSource_basic(t *testing.T) {
 	resourceName := "aws_backup_plan.test"
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -22,7 +22,7 @@ func TestAccBackupReportPlan_basic(t *testing.T) {
 	updatedDescription := "updated description"
 	resourceName := "aws_backup_report_plan.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccReportPlanPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -80,7 +80,7 @@ func TestAccBackupReportPlan_updateTags(t *testing.T) {
 	description := "example description"
 	resourceName := "aws_backup_report_plan.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccReportPlanPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -164,7 +164,7 @@ func TestAccBackupReportPlan_updateReportDeliveryChannel(t *testing.T) {
 	description := "example description"
 	resourceName := "aws_backup_report_plan.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccReportPlanPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -223,7 +223,7 @@ func TestAccBackupReportPlan_disappears(t *testing.T) {
 	description := "disappears"
 	resourceName := "aws_backup_report_plan.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccReportPlanPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -16,7 +16,7 @@ func TestAccBackupSelectionDataSource_basic(t *testing.T) {
 	resourceName := "aws_backup_selection.test"
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
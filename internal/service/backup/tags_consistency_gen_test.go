@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package backup_test
+
+import (
+	"testing"
+)
+
+func TestAccBackupFramework_TagsConsistency(t *testing.T) {
+	t.Skip("aws_backup_framework has no testAccFrameworkConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccBackupPlan_TagsConsistency(t *testing.T) {
+	t.Skip("aws_backup_plan has no testAccPlanConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccBackupReportPlan_TagsConsistency(t *testing.T) {
+	t.Skip("aws_backup_report_plan has no testAccReportPlanConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccBackupVault_TagsConsistency(t *testing.T) {
+	t.Skip("aws_backup_vault has no testAccVaultConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -19,7 +19,7 @@ func TestAccBackupVault_basic(t *testing.T) {
 
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_backup_vault.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -45,7 +45,7 @@ func TestAccBackupVault_withKMSKey(t *testing.T) {
 
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_backup_vault.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -72,7 +72,7 @@ func TestAccBackupVault_withTags(t *testing.T) {
 
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_backup_vault.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -121,7 +121,7 @@ func TestAccBackupVault_disappears(t *testing.T) {
 
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_backup_vault.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, backup.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
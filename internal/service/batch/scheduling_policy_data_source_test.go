@@ -15,7 +15,7 @@ func TestAccBatchSchedulingPolicyDataSource_basic(t *testing.T) {
 	resourceName := "aws_batch_scheduling_policy.test"
 	datasourceName := "data.aws_batch_scheduling_policy.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, batch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
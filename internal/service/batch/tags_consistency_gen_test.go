@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package batch_test
+
+import (
+	"testing"
+)
+
+func TestAccBatchComputeEnvironment_TagsConsistency(t *testing.T) {
+	t.Skip("aws_batch_compute_environment has no testAccComputeEnvironmentConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccBatchJobDefinition_TagsConsistency(t *testing.T) {
+	t.Skip("aws_batch_job_definition has no testAccJobDefinitionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccBatchJobQueue_TagsConsistency(t *testing.T) {
+	t.Skip("aws_batch_job_queue has no testAccJobQueueConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
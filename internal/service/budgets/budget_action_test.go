@@ -20,7 +20,7 @@ func TestAccBudgetsBudgetAction_basic(t *testing.T) {
 	resourceName := "aws_budgets_budget_action.test"
 	var conf budgets.Action
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, budgets.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -60,7 +60,7 @@ func TestAccBudgetsBudgetAction_disappears(t *testing.T) {
 	resourceName := "aws_budgets_budget_action.test"
 	var conf budgets.Action
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, budgets.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -22,7 +22,7 @@ func TestAccBudgetsBudget_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_budgets_budget.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, budgets.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -65,7 +65,7 @@ func TestAccBudgetsBudget_Name_generated(t *testing.T) {
 	var budget budgets.Budget
 	resourceName := "aws_budgets_budget.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, budgets.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -107,7 +107,7 @@ func TestAccBudgetsBudget_namePrefix(t *testing.T) {
 	var budget budgets.Budget
 	resourceName := "aws_budgets_budget.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, budgets.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -144,7 +144,7 @@ func TestAccBudgetsBudget_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_budgets_budget.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, budgets.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -177,7 +177,7 @@ func TestAccBudgetsBudget_costTypes(t *testing.T) {
 	startDate2 := tfbudgets.TimePeriodTimestampToString(&ts3)
 	endDate2 := tfbudgets.TimePeriodTimestampToString(&ts4)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, budgets.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -273,7 +273,7 @@ func TestAccBudgetsBudget_notifications(t *testing.T) {
 	emailAddress2 := acctest.RandomEmailAddress(domain)
 	emailAddress3 := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, budgets.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -25,7 +25,7 @@ func TestAccCEAnomalySubscription_basic(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckAnomalySubscriptionDestroy,
@@ -61,7 +61,7 @@ func TestAccCEAnomalySubscription_disappears(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckAnomalySubscriptionDestroy,
@@ -86,7 +86,7 @@ func TestAccCEAnomalySubscription_Frequency(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckAnomalySubscriptionDestroy,
@@ -124,7 +124,7 @@ func TestAccCEAnomalySubscription_MonitorARNList(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckAnomalySubscriptionDestroy,
@@ -162,7 +162,7 @@ func TestAccCEAnomalySubscription_Subscriber(t *testing.T) {
 	address1 := acctest.RandomEmailAddress(domain)
 	address2 := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckAnomalySubscriptionDestroy,
@@ -228,7 +228,7 @@ func TestAccCEAnomalySubscription_Threshold(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckAnomalySubscriptionDestroy,
@@ -264,7 +264,7 @@ func TestAccCEAnomalySubscription_Tags(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckAnomalySubscriptionDestroy,
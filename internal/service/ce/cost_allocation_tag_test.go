@@ -20,7 +20,7 @@ func TestAccCECostAllocationTag_basic(t *testing.T) {
 	resourceName := "aws_ce_cost_allocation_tag.test"
 	rName := "Tag01"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      nil,
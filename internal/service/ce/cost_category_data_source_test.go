@@ -15,7 +15,7 @@ func TestAccCECostCategoryDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_ce_cost_category.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		ErrorCheck:        acctest.ErrorCheck(t, costexplorer.EndpointsID),
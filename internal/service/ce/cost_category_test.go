@@ -22,7 +22,7 @@ func TestAccCECostCategory_basic(t *testing.T) {
 	resourceName := "aws_ce_cost_category.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckCostCategoryDestroy,
@@ -49,7 +49,7 @@ func TestAccCECostCategory_complete(t *testing.T) {
 	resourceName := "aws_ce_cost_category.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckCostCategoryDestroy,
@@ -83,7 +83,7 @@ func TestAccCECostCategory_splitCharge(t *testing.T) {
 	resourceName := "aws_ce_cost_category.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckCostCategoryDestroy,
@@ -117,7 +117,7 @@ func TestAccCECostCategory_disappears(t *testing.T) {
 	resourceName := "aws_ce_cost_category.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckCostCategoryDestroy,
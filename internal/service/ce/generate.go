@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOutTagsElem=ResourceTags -ServiceTagsSlice -TagInTagsElem=ResourceTags -UpdateTags -UntagInTagsElem=ResourceTagKeys -UntagInTagsElem=ResourceTagKeys -TagType=ResourceTag
+//go:generate go run ../../generate/tagstests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package ce
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package ce_test
+
+import (
+	"testing"
+)
+
+func TestAccCEAnomalyMonitor_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ce_anomaly_monitor has no testAccAnomalyMonitorConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccCEAnomalySubscription_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ce_anomaly_subscription has no testAccAnomalySubscriptionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
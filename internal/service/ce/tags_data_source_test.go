@@ -23,7 +23,7 @@ func TestAccCETagsDataSource_basic(t *testing.T) {
 	startDate := monthsAgo.Format(formatDate)
 	endDate := currentTime.Format(formatDate)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		ErrorCheck:        acctest.ErrorCheck(t, costexplorer.EndpointsID),
@@ -51,7 +51,7 @@ func TestAccCETagsDataSource_filter(t *testing.T) {
 	startDate := monthsAgo.Format(formatDate)
 	endDate := currentTime.Format(formatDate)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		ErrorCheck:        acctest.ErrorCheck(t, costexplorer.EndpointsID),
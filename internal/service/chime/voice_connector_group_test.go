@@ -20,7 +20,7 @@ func TestAccChimeVoiceConnectorGroup_basic(t *testing.T) {
 	vcgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_chime_voice_connector_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, chime.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -50,7 +50,7 @@ func TestAccChimeVoiceConnectorGroup_disappears(t *testing.T) {
 	vcgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_chime_voice_connector_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, chime.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -74,7 +74,7 @@ func TestAccChimeVoiceConnectorGroup_update(t *testing.T) {
 	vcgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_chime_voice_connector_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, chime.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccCloud9EnvironmentEC2_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloud9_environment_ec2.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloud9.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloud9.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -59,7 +59,7 @@ func TestAccCloud9EnvironmentEC2_allFields(t *testing.T) {
 	description2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloud9_environment_ec2.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloud9.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloud9.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -104,7 +104,7 @@ func TestAccCloud9EnvironmentEC2_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloud9_environment_ec2.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloud9.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloud9.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -151,7 +151,7 @@ func TestAccCloud9EnvironmentEC2_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloud9_environment_ec2.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloud9.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloud9.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
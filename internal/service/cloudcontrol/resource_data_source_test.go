@@ -15,7 +15,7 @@ func TestAccCloudControlResourceDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_cloudcontrolapi_resource.test"
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -14,7 +14,7 @@ func TestAccCloudFormationExportDataSource_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_cloudformation_export.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -35,7 +35,7 @@ func TestAccCloudFormationExportDataSource_resourceReference(t *testing.T) {
 	dataSourceName := "data.aws_cloudformation_export.test"
 	resourceName := "aws_cloudformation_stack.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package cloudformation_test
+
+import (
+	"testing"
+)
+
+func TestAccCloudFormationStack_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cloudformation_stack has no testAccStackConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccCloudFormationStackSet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cloudformation_stack_set has no testAccStackSetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -18,7 +18,7 @@ func TestAccCloudFormationTypeDataSource_ARN_private(t *testing.T) {
 	resourceName := "aws_cloudformation_type.test"
 	dataSourceName := "data.aws_cloudformation_type.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -49,7 +49,7 @@ func TestAccCloudFormationTypeDataSource_ARN_private(t *testing.T) {
 func TestAccCloudFormationTypeDataSource_ARN_public(t *testing.T) {
 	dataSourceName := "data.aws_cloudformation_type.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -83,7 +83,7 @@ func TestAccCloudFormationTypeDataSource_TypeName_private(t *testing.T) {
 	resourceName := "aws_cloudformation_type.test"
 	dataSourceName := "data.aws_cloudformation_type.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -114,7 +114,7 @@ func TestAccCloudFormationTypeDataSource_TypeName_private(t *testing.T) {
 func TestAccCloudFormationTypeDataSource_TypeName_public(t *testing.T) {
 	dataSourceName := "data.aws_cloudformation_type.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -18,7 +18,7 @@ func TestAccCloudFrontCachePolicy_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudfront_cache_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -61,7 +61,7 @@ func TestAccCloudFrontCachePolicy_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudfront_cache_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -83,7 +83,7 @@ func TestAccCloudFrontCachePolicy_Items(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudfront_cache_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -164,7 +164,7 @@ func TestAccCloudFrontCachePolicy_ZeroTTLs(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudfront_cache_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -14,7 +14,7 @@ func TestAccCloudFrontDistributionDataSource_basic(t *testing.T) {
 	resourceName := "aws_cloudfront_distribution.s3_distribution"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -17,7 +17,7 @@ func TestAccCloudFrontMonitoringSubscription_basic(t *testing.T) {
 	var v cloudfront.GetMonitoringSubscriptionOutput
 	resourceName := "aws_cloudfront_monitoring_subscription.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckMonitoringSubscriptionDestroy,
@@ -46,7 +46,7 @@ func TestAccCloudFrontMonitoringSubscription_disappears(t *testing.T) {
 	var v cloudfront.GetMonitoringSubscriptionOutput
 	resourceName := "aws_cloudfront_monitoring_subscription.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckMonitoringSubscriptionDestroy,
@@ -68,7 +68,7 @@ func TestAccCloudFrontMonitoringSubscription_update(t *testing.T) {
 	var v cloudfront.GetMonitoringSubscriptionOutput
 	resourceName := "aws_cloudfront_monitoring_subscription.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckMonitoringSubscriptionDestroy,
@@ -18,7 +18,7 @@ func TestAccCloudFrontOriginAccessIdentity_basic(t *testing.T) {
 	var origin cloudfront.GetCloudFrontOriginAccessIdentityOutput
 	resourceName := "aws_cloudfront_origin_access_identity.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -49,7 +49,7 @@ func TestAccCloudFrontOriginAccessIdentity_noComment(t *testing.T) {
 	var origin cloudfront.GetCloudFrontOriginAccessIdentityOutput
 	resourceName := "aws_cloudfront_origin_access_identity.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -79,7 +79,7 @@ func TestAccCloudFrontOriginAccessIdentity_disappears(t *testing.T) {
 	var origin cloudfront.GetCloudFrontOriginAccessIdentityOutput
 	resourceName := "aws_cloudfront_origin_access_identity.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
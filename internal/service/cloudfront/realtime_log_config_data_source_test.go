@@ -16,7 +16,7 @@ func TestAccCloudFrontRealtimeLogConfigDataSource_basic(t *testing.T) {
 	resourceName := "aws_cloudfront_realtime_log_config.test"
 	dataSourceName := "data.aws_cloudfront_realtime_log_config.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
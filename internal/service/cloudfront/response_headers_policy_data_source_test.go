@@ -16,7 +16,7 @@ func TestAccCloudFrontResponseHeadersPolicyDataSource_basic(t *testing.T) {
 	dataSource2Name := "data.aws_cloudfront_response_headers_policy.by_name"
 	resourceName := "aws_cloudfront_response_headers_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
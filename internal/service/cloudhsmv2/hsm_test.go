@@ -17,7 +17,7 @@ import (
 func testAccHSM_basic(t *testing.T) {
 	resourceName := "aws_cloudhsm_v2_hsm.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -48,7 +48,7 @@ func testAccHSM_basic(t *testing.T) {
 func testAccHSM_disappears(t *testing.T) {
 	resourceName := "aws_cloudhsm_v2_hsm.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -72,7 +72,7 @@ func testAccHSM_disappears_Cluster(t *testing.T) {
 	clusterResourceName := "aws_cloudhsm_v2_cluster.test"
 	resourceName := "aws_cloudhsm_v2_hsm.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -94,7 +94,7 @@ func testAccHSM_disappears_Cluster(t *testing.T) {
 func testAccHSM_AvailabilityZone(t *testing.T) {
 	resourceName := "aws_cloudhsm_v2_hsm.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -119,7 +119,7 @@ func testAccHSM_AvailabilityZone(t *testing.T) {
 func testAccHSM_IPAddress(t *testing.T) {
 	resourceName := "aws_cloudhsm_v2_hsm.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
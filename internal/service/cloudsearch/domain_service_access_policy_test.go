@@ -18,7 +18,7 @@ func TestAccCloudSearchDomainServiceAccessPolicy_basic(t *testing.T) {
 	resourceName := "aws_cloudsearch_domain_service_access_policy.test"
 	rName := acctest.ResourcePrefix + "-" + sdkacctest.RandString(28-(len(acctest.ResourcePrefix)+1))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudsearch.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudsearch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -44,7 +44,7 @@ func TestAccCloudSearchDomainServiceAccessPolicy_update(t *testing.T) {
 	resourceName := "aws_cloudsearch_domain_service_access_policy.test"
 	rName := acctest.ResourcePrefix + "-" + sdkacctest.RandString(28-(len(acctest.ResourcePrefix)+1))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudsearch.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudsearch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -19,7 +19,7 @@ func TestAccCloudSearchDomain_basic(t *testing.T) {
 	resourceName := "aws_cloudsearch_domain.test"
 	rName := testAccDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudsearch.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudsearch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -57,7 +57,7 @@ func TestAccCloudSearchDomain_disappears(t *testing.T) {
 	resourceName := "aws_cloudsearch_domain.test"
 	rName := testAccDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudsearch.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudsearch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -80,7 +80,7 @@ func TestAccCloudSearchDomain_indexFields(t *testing.T) {
 	resourceName := "aws_cloudsearch_domain.test"
 	rName := testAccDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudsearch.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudsearch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -144,7 +144,7 @@ func TestAccCloudSearchDomain_sourceFields(t *testing.T) {
 	resourceName := "aws_cloudsearch_domain.test"
 	rName := testAccDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudsearch.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudsearch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -218,7 +218,7 @@ func TestAccCloudSearchDomain_update(t *testing.T) {
 	resourceName := "aws_cloudsearch_domain.test"
 	rName := testAccDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudsearch.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudsearch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
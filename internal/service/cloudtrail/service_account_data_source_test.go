@@ -13,7 +13,7 @@ func TestAccCloudTrailServiceAccountDataSource_basic(t *testing.T) {
 
 	dataSourceName := "data.aws_cloudtrail_service_account.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t),
 		ProviderFactories: acctest.ProviderFactories,
@@ -34,7 +34,7 @@ func TestAccCloudTrailServiceAccountDataSource_region(t *testing.T) {
 
 	dataSourceName := "data.aws_cloudtrail_service_account.regional"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package cloudtrail_test
+
+import (
+	"testing"
+)
+
+func TestAccCloudTrailCloudTrail_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cloudtrail has no testAccCloudTrailConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccCloudTrailEventDataStore_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cloudtrail_event_data_store has no testAccEventDataStoreConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
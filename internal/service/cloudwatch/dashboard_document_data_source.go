@@ -0,0 +1,445 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceDashboardDocument() *schema.Resource {
+	periodSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			ValidateFunc: validation.Any(
+				validation.IntInSlice([]int{1, 5, 10, 30}),
+				validation.IntDivisibleBy(60),
+			),
+		}
+	}
+
+	return &schema.Resource{
+		Read: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_status": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedTimestamp", "timestamp"}, false),
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"log_query": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 10000),
+									},
+									"region": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "table",
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "table", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_query": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"color": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringMatch(dashboardColorRegexp, "must be a hex color code, e.g. #1f77b4"),
+												},
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"expression": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"metric_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"namespace": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"period": periodSchema(),
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"visible": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"y_axis": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice([]string{"left", "right"}, false),
+												},
+											},
+										},
+									},
+									"period": func() *schema.Schema {
+										v := periodSchema()
+										v.Default = 300
+										return v
+									}(),
+									"region": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "Average",
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "timeSeries",
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "singleValue", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"solid", "transparent"}, false),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, dashboardGridColumns),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	region := meta.(*conns.AWSClient).Region
+
+	body := &dashboardBody{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+	}
+
+	for i, tfMapRaw := range d.Get("widget").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		widget, err := expandDashboardWidget(tfMap, region)
+
+		if err != nil {
+			return fmt.Errorf("widget %d: %w", i, err)
+		}
+
+		body.Widgets = append(body.Widgets, widget)
+	}
+
+	layoutDashboardWidgets(body.Widgets)
+
+	jsonDoc, err := json.MarshalIndent(body, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error marshaling CloudWatch Dashboard document: %w", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return nil
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, region string) (*dashboardWidget, error) {
+	widget := &dashboardWidget{
+		Height: tfMap["height"].(int),
+		Width:  tfMap["width"].(int),
+	}
+
+	var n int
+
+	if v, ok := tfMap["alarm_status"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = "alarm"
+		widget.Properties = expandDashboardAlarmWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log_query"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = "log"
+		widget.Properties = expandDashboardLogWidgetProperties(v[0].(map[string]interface{}), region)
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		properties, err := expandDashboardMetricWidgetProperties(v[0].(map[string]interface{}), region)
+
+		if err != nil {
+			return nil, err
+		}
+
+		n++
+		widget.Type = "metric"
+		widget.Properties = properties
+	}
+
+	if v, ok := tfMap["text"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = "text"
+		widget.Properties = expandDashboardTextWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("exactly one of alarm_status, log_query, metric or text must be specified")
+	}
+
+	return widget, nil
+}
+
+func expandDashboardAlarmWidgetProperties(tfMap map[string]interface{}) *dashboardAlarmWidgetProperties {
+	properties := &dashboardAlarmWidgetProperties{
+		SortBy: tfMap["sort_by"].(string),
+		Title:  tfMap["title"].(string),
+	}
+
+	for _, v := range tfMap["alarms"].([]interface{}) {
+		if v, ok := v.(string); ok && v != "" {
+			properties.Alarms = append(properties.Alarms, v)
+		}
+	}
+
+	return properties
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, region string) *dashboardLogWidgetProperties {
+	properties := &dashboardLogWidgetProperties{
+		Region:  region,
+		Stacked: tfMap["stacked"].(bool),
+		Title:   tfMap["title"].(string),
+		View:    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		properties.Region = v
+	}
+
+	// Log groups are selected with SOURCE commands ahead of the query itself.
+	var query string
+
+	for _, v := range tfMap["log_group_names"].([]interface{}) {
+		if v, ok := v.(string); ok && v != "" {
+			query += fmt.Sprintf("SOURCE '%s' | ", v)
+		}
+	}
+
+	properties.Query = query + tfMap["query"].(string)
+
+	return properties
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, region string) (*dashboardMetricWidgetProperties, error) {
+	properties := &dashboardMetricWidgetProperties{
+		Period:  tfMap["period"].(int),
+		Region:  region,
+		Stacked: tfMap["stacked"].(bool),
+		Stat:    tfMap["stat"].(string),
+		Title:   tfMap["title"].(string),
+		View:    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		properties.Region = v
+	}
+
+	for _, tfMapRaw := range tfMap["metric_query"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		metric := dashboardMetric{
+			MetricName: tfMap["metric_name"].(string),
+			Namespace:  tfMap["namespace"].(string),
+			Options: dashboardMetricOptions{
+				Color:      tfMap["color"].(string),
+				Expression: tfMap["expression"].(string),
+				ID:         tfMap["id"].(string),
+				Label:      tfMap["label"].(string),
+				Period:     tfMap["period"].(int),
+				Stat:       tfMap["stat"].(string),
+				YAxis:      tfMap["y_axis"].(string),
+			},
+		}
+
+		if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+			metric.Dimensions = make(map[string]string, len(v))
+
+			for name, value := range v {
+				metric.Dimensions[name] = value.(string)
+			}
+		}
+
+		if v, ok := tfMap["visible"].(bool); ok && !v {
+			metric.Options.Visible = aws.Bool(false)
+		}
+
+		properties.Metrics = append(properties.Metrics, metric)
+	}
+
+	if err := validateDashboardMetrics(properties.Metrics, properties.Period); err != nil {
+		return nil, err
+	}
+
+	return properties, nil
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) *dashboardTextWidgetProperties {
+	return &dashboardTextWidgetProperties{
+		Background: tfMap["background"].(string),
+		Markdown:   tfMap["markdown"].(string),
+	}
+}
@@ -0,0 +1,271 @@
+package cloudwatch_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccDashboardDocumentExpectedJSON(acctest.Partition(), acctest.Region())),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(resourceName, &dashboard),
+				),
+			},
+			{
+				Config:   testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_validation(t *testing.T) {
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_highResolutionAWSMetric,
+				ExpectError: regexp.MustCompile(`period \(10\) below 60 seconds`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_multipleWidgetTypes,
+				ExpectError: regexp.MustCompile(`exactly one of alarm_status, log_query, metric or text`),
+			},
+		},
+	})
+}
+
+func testAccDashboardDocumentExpectedJSON(partition, region string) string {
+	return fmt.Sprintf(`{
+  "start": "-PT6H",
+  "periodOverride": "inherit",
+  "widgets": [
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 24,
+      "height": 2,
+      "properties": {
+        "markdown": "# Example"
+      }
+    },
+    {
+      "type": "metric",
+      "x": 0,
+      "y": 2,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/Lambda", "Errors", "FunctionName", "example", {"id": "m1", "visible": false}],
+          ["AWS/Lambda", "Invocations", "FunctionName", "example", {"id": "m2", "visible": false}],
+          [{"expression": "100 * m1 / m2", "id": "e1", "label": "Error rate", "yAxis": "right"}]
+        ],
+        "period": 300,
+        "region": %[1]q,
+        "stacked": false,
+        "stat": "Sum",
+        "title": "Errors",
+        "view": "timeSeries"
+      }
+    },
+    {
+      "type": "log",
+      "x": 12,
+      "y": 2,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "query": "SOURCE '/aws/lambda/example' | fields @timestamp, @message | sort @timestamp desc | limit 20",
+        "region": %[1]q,
+        "stacked": false,
+        "title": "Recent events",
+        "view": "table"
+      }
+    },
+    {
+      "type": "alarm",
+      "x": 0,
+      "y": 8,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "alarms": ["arn:%[2]s:cloudwatch:%[1]s:123456789012:alarm:example"],
+        "sortBy": "stateUpdatedTimestamp"
+      }
+    }
+  ]
+}`, region, partition)
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_cloudwatch_dashboard_document" "test" {
+  start           = "-PT6H"
+  period_override = "inherit"
+
+  widget {
+    width  = 24
+    height = 2
+
+    text {
+      markdown = "# Example"
+    }
+  }
+
+  widget {
+    width = 12
+
+    metric {
+      title = "Errors"
+      stat  = "Sum"
+
+      metric_query {
+        id          = "m1"
+        namespace   = "AWS/Lambda"
+        metric_name = "Errors"
+        dimensions  = { FunctionName = "example" }
+        visible     = false
+      }
+
+      metric_query {
+        id          = "m2"
+        namespace   = "AWS/Lambda"
+        metric_name = "Invocations"
+        dimensions  = { FunctionName = "example" }
+        visible     = false
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "100 * m1 / m2"
+        label      = "Error rate"
+        y_axis     = "right"
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    log_query {
+      title           = "Recent events"
+      log_group_names = ["/aws/lambda/example"]
+      query           = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+    }
+  }
+
+  widget {
+    alarm_status {
+      alarms  = ["arn:${data.aws_partition.current.partition}:cloudwatch:${data.aws_region.current.name}:123456789012:alarm:example"]
+      sort_by = "stateUpdatedTimestamp"
+    }
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 12
+
+    metric {
+      title = "CPU"
+
+      metric_query {
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+        dimensions  = { InstanceId = "i-012345" }
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    text {
+      markdown = "Hello world"
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
+
+const testAccDashboardDocumentDataSourceConfig_highResolutionAWSMetric = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      period = 10
+
+      metric_query {
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_multipleWidgetTypes = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    text {
+      markdown = "Hello world"
+    }
+
+    metric {
+      metric_query {
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+    }
+  }
+}
+`
@@ -0,0 +1,178 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Dashboard body structure.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+
+const (
+	dashboardGridColumns = 24
+)
+
+type dashboardBody struct {
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Start          string             `json:"start,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Height     int         `json:"height"`
+	Properties interface{} `json:"properties"`
+	Type       string      `json:"type"`
+	Width      int         `json:"width"`
+	X          int         `json:"x"`
+	Y          int         `json:"y"`
+}
+
+type dashboardAlarmWidgetProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type dashboardLogWidgetProperties struct {
+	Query   string `json:"query"`
+	Region  string `json:"region"`
+	Stacked bool   `json:"stacked"`
+	Title   string `json:"title,omitempty"`
+	View    string `json:"view"`
+}
+
+type dashboardMetricWidgetProperties struct {
+	Metrics []dashboardMetric `json:"metrics"`
+	Period  int               `json:"period"`
+	Region  string            `json:"region"`
+	Stacked bool              `json:"stacked"`
+	Stat    string            `json:"stat"`
+	Title   string            `json:"title,omitempty"`
+	View    string            `json:"view"`
+}
+
+type dashboardTextWidgetProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
+
+// dashboardMetric is a single entry of a metric widget's "metrics" array.
+type dashboardMetric struct {
+	Dimensions map[string]string
+	MetricName string
+	Namespace  string
+	Options    dashboardMetricOptions
+}
+
+type dashboardMetricOptions struct {
+	Color      string `json:"color,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Label      string `json:"label,omitempty"`
+	Period     int    `json:"period,omitempty"`
+	Stat       string `json:"stat,omitempty"`
+	Visible    *bool  `json:"visible,omitempty"`
+	YAxis      string `json:"yAxis,omitempty"`
+}
+
+// MarshalJSON encodes a metric as an array of namespace, metric name and dimension name/value pairs
+// followed by the rendering options, or as an array with only the options for an expression.
+func (m dashboardMetric) MarshalJSON() ([]byte, error) {
+	var v []interface{}
+
+	if m.Options.Expression == "" {
+		v = append(v, m.Namespace, m.MetricName)
+
+		names := make([]string, 0, len(m.Dimensions))
+		for name := range m.Dimensions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			v = append(v, name, m.Dimensions[name])
+		}
+	}
+
+	if m.Options != (dashboardMetricOptions{}) {
+		v = append(v, m.Options)
+	}
+
+	return json.Marshal(v)
+}
+
+// layoutDashboardWidgets places widgets left to right on the dashboard grid in the order given,
+// starting a new row when a widget doesn't fit in the remaining columns.
+// Each row is as tall as its tallest widget.
+func layoutDashboardWidgets(widgets []*dashboardWidget) {
+	var x, y, rowHeight int
+
+	for _, widget := range widgets {
+		if x > 0 && x+widget.Width > dashboardGridColumns {
+			x = 0
+			y += rowHeight
+			rowHeight = 0
+		}
+
+		widget.X = x
+		widget.Y = y
+
+		x += widget.Width
+		if widget.Height > rowHeight {
+			rowHeight = widget.Height
+		}
+	}
+}
+
+var (
+	dashboardColorRegexp    = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	dashboardMetricIDRegexp = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]*$`)
+)
+
+// validateDashboardMetrics checks the metrics of a metric widget for combinations that
+// CloudWatch rejects or can't render.
+func validateDashboardMetrics(metrics []dashboardMetric, widgetPeriod int) error {
+	ids := make(map[string]struct{})
+
+	for i, m := range metrics {
+		if m.Options.Expression != "" {
+			if m.Namespace != "" || m.MetricName != "" || len(m.Dimensions) > 0 {
+				return fmt.Errorf("metric_query %d: expression cannot be combined with namespace, metric_name or dimensions", i)
+			}
+
+			if m.Options.Stat != "" {
+				return fmt.Errorf("metric_query %d: stat cannot be set for an expression", i)
+			}
+		} else if m.Namespace == "" || m.MetricName == "" {
+			return fmt.Errorf("metric_query %d: either expression or both namespace and metric_name must be set", i)
+		}
+
+		if id := m.Options.ID; id != "" {
+			if !dashboardMetricIDRegexp.MatchString(id) {
+				return fmt.Errorf("metric_query %d: id (%s) must start with a lowercase letter and contain only letters, numbers and underscores", i, id)
+			}
+
+			if _, ok := ids[id]; ok {
+				return fmt.Errorf("metric_query %d: duplicate id (%s)", i, id)
+			}
+
+			ids[id] = struct{}{}
+		}
+
+		period := m.Options.Period
+		if period == 0 {
+			period = widgetPeriod
+		}
+
+		// Metrics published by AWS services have a resolution of at most one minute.
+		if period < 60 && strings.HasPrefix(m.Namespace, "AWS/") {
+			return fmt.Errorf("metric_query %d: period (%d) below 60 seconds is only supported for high-resolution custom metrics, not %s", i, period, m.Namespace)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestLayoutDashboardWidgets(t *testing.T) {
+	widgets := []*dashboardWidget{
+		{Width: 12, Height: 6},
+		{Width: 12, Height: 3},
+		{Width: 8, Height: 4},
+		{Width: 24, Height: 2},
+		{Width: 6, Height: 6},
+		{Width: 6, Height: 6},
+		{Width: 6, Height: 6},
+		{Width: 6, Height: 6},
+		{Width: 1, Height: 1},
+	}
+
+	layoutDashboardWidgets(widgets)
+
+	expected := [][2]int{
+		{0, 0},
+		{12, 0},
+		{0, 6},
+		{0, 10},
+		{0, 12},
+		{6, 12},
+		{12, 12},
+		{18, 12},
+		{0, 18},
+	}
+
+	for i, widget := range widgets {
+		if got := [2]int{widget.X, widget.Y}; got != expected[i] {
+			t.Errorf("widget %d: expected (x, y) %v, got %v", i, expected[i], got)
+		}
+	}
+}
+
+func TestDashboardMetricMarshalJSON(t *testing.T) {
+	testCases := []struct {
+		metric   dashboardMetric
+		expected string
+	}{
+		{
+			metric: dashboardMetric{
+				Namespace:  "AWS/EC2",
+				MetricName: "CPUUtilization",
+			},
+			expected: `["AWS/EC2","CPUUtilization"]`,
+		},
+		{
+			metric: dashboardMetric{
+				Dimensions: map[string]string{"InstanceId": "i-1234567890abcdef0", "AutoScalingGroupName": "example"},
+				Namespace:  "AWS/EC2",
+				MetricName: "CPUUtilization",
+				Options:    dashboardMetricOptions{ID: "m1", Stat: "Maximum", Visible: aws.Bool(false)},
+			},
+			expected: `["AWS/EC2","CPUUtilization","AutoScalingGroupName","example","InstanceId","i-1234567890abcdef0",{"id":"m1","stat":"Maximum","visible":false}]`,
+		},
+		{
+			metric: dashboardMetric{
+				Options: dashboardMetricOptions{Expression: "SUM(METRICS())", Label: "Total"},
+			},
+			expected: `[{"expression":"SUM(METRICS())","label":"Total"}]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		b, err := json.Marshal(testCase.metric)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := string(b); got != testCase.expected {
+			t.Errorf("expected %s, got %s", testCase.expected, got)
+		}
+	}
+}
+
+func TestValidateDashboardMetrics(t *testing.T) {
+	testCases := []struct {
+		name    string
+		metrics []dashboardMetric
+		period  int
+		err     string
+	}{
+		{
+			name: "valid",
+			metrics: []dashboardMetric{
+				{Namespace: "AWS/Lambda", MetricName: "Errors", Options: dashboardMetricOptions{ID: "m1"}},
+				{Namespace: "AWS/Lambda", MetricName: "Invocations", Options: dashboardMetricOptions{ID: "m2"}},
+				{Options: dashboardMetricOptions{Expression: "100 * m1 / m2", ID: "e1"}},
+			},
+			period: 300,
+		},
+		{
+			name: "high-resolution custom metric",
+			metrics: []dashboardMetric{
+				{Namespace: "Custom", MetricName: "Latency"},
+			},
+			period: 10,
+		},
+		{
+			name: "high-resolution AWS metric",
+			metrics: []dashboardMetric{
+				{Namespace: "AWS/Lambda", MetricName: "Errors", Options: dashboardMetricOptions{Period: 10}},
+			},
+			period: 300,
+			err:    "period (10)",
+		},
+		{
+			name: "missing metric name",
+			metrics: []dashboardMetric{
+				{Namespace: "AWS/Lambda"},
+			},
+			period: 300,
+			err:    "either expression or both namespace and metric_name",
+		},
+		{
+			name: "expression with namespace",
+			metrics: []dashboardMetric{
+				{Namespace: "AWS/Lambda", Options: dashboardMetricOptions{Expression: "SUM(METRICS())"}},
+			},
+			period: 300,
+			err:    "expression cannot be combined",
+		},
+		{
+			name: "duplicate id",
+			metrics: []dashboardMetric{
+				{Namespace: "AWS/Lambda", MetricName: "Errors", Options: dashboardMetricOptions{ID: "m1"}},
+				{Namespace: "AWS/Lambda", MetricName: "Invocations", Options: dashboardMetricOptions{ID: "m1"}},
+			},
+			period: 300,
+			err:    "duplicate id",
+		},
+		{
+			name: "invalid id",
+			metrics: []dashboardMetric{
+				{Namespace: "AWS/Lambda", MetricName: "Errors", Options: dashboardMetricOptions{ID: "M1"}},
+			},
+			period: 300,
+			err:    "must start with a lowercase letter",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateDashboardMetrics(testCase.metrics, testCase.period)
+
+			if testCase.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.err) {
+				t.Errorf("expected error containing %q, got %v", testCase.err, err)
+			}
+		})
+	}
+}
@@ -22,7 +22,7 @@ func TestAccCloudWatchDashboard_basic(t *testing.T) {
 	resourceName := "aws_cloudwatch_dashboard.test"
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -49,7 +49,7 @@ func TestAccCloudWatchDashboard_update(t *testing.T) {
 	resourceName := "aws_cloudwatch_dashboard.test"
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -85,7 +85,7 @@ func TestAccCloudWatchDashboard_updateName(t *testing.T) {
 	resourceName := "aws_cloudwatch_dashboard.test"
 	rInt := sdkacctest.RandInt()
 	rInt2 := sdkacctest.RandInt()
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
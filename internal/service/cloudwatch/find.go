@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func FindCompositeAlarmByName(ctx context.Context, conn *cloudwatch.CloudWatch, name string) (*cloudwatch.CompositeAlarm, error) {
@@ -42,3 +43,63 @@ func FindMetricAlarmByName(conn *cloudwatch.CloudWatch, name string) (*cloudwatc
 
 	return output.MetricAlarms[0], nil
 }
+
+// FindAnomalyDetectorByID returns the anomaly detector with the identifier returned by AnomalyDetectorID.
+// Single metric anomaly detectors are looked up by their metric. Metric math anomaly detectors can't be filtered
+// by definition, so all of them are compared.
+func FindAnomalyDetectorByID(ctx context.Context, conn *cloudwatch.CloudWatch, id string) (*cloudwatch.AnomalyDetector, error) {
+	single, err := AnomalyDetectorParseID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &cloudwatch.DescribeAnomalyDetectorsInput{
+		AnomalyDetectorTypes: aws.StringSlice([]string{cloudwatch.AnomalyDetectorTypeMetricMath}),
+	}
+
+	if single != nil {
+		input.AnomalyDetectorTypes = aws.StringSlice([]string{cloudwatch.AnomalyDetectorTypeSingleMetric})
+		input.Dimensions = single.Dimensions
+		input.MetricName = single.MetricName
+		input.Namespace = single.Namespace
+	}
+
+	for {
+		output, err := conn.DescribeAnomalyDetectorsWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, v := range output.AnomalyDetectors {
+			if v == nil {
+				continue
+			}
+
+			detectorID, err := AnomalyDetectorID(v.SingleMetricAnomalyDetector, v.MetricMathAnomalyDetector)
+
+			if err != nil {
+				continue
+			}
+
+			if detectorID == id {
+				return v, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,602 @@
+package cloudwatch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMetricAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMetricAnomalyDetectorPut,
+		ReadContext:   resourceMetricAnomalyDetectorRead,
+		UpdateContext: resourceMetricAnomalyDetectorPut,
+		DeleteContext: resourceMetricAnomalyDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_time_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+									"start_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+								},
+							},
+						},
+						"metric_timezone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+					},
+				},
+			},
+			"metric_math_anomaly_detector": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metric_math_anomaly_detector", "single_metric_anomaly_detector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_query": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"expression": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"label": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"metric_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"namespace": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validateAnomalyDetectorNamespace,
+												},
+												"period": {
+													Type:     schema.TypeInt,
+													Required: true,
+													ForceNew: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"unit": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(cloudwatch.StandardUnit_Values(), false),
+												},
+											},
+										},
+									},
+									"return_data": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"single_metric_anomaly_detector": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metric_math_anomaly_detector", "single_metric_anomaly_detector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"metric_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAnomalyDetectorNamespace,
+						},
+						"stat": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"state_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var validateAnomalyDetectorNamespace = validation.All(
+	validation.StringLenBetween(1, 255),
+	validation.StringMatch(regexp.MustCompile(`[^:].*`), "must not contain colon characters"),
+)
+
+func resourceMetricAnomalyDetectorPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		Configuration: &cloudwatch.AnomalyDetectorConfiguration{
+			// An empty list removes any previously excluded time ranges.
+			ExcludedTimeRanges: []*cloudwatch.Range{},
+		},
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		configuration, err := expandAnomalyDetectorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input.Configuration = configuration
+	}
+
+	if v, ok := d.GetOk("metric_math_anomaly_detector"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MetricMathAnomalyDetector = expandMetricMathAnomalyDetector(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("single_metric_anomaly_detector"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SingleMetricAnomalyDetector = expandSingleMetricAnomalyDetector(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	id, err := AnomalyDetectorID(input.SingleMetricAnomalyDetector, input.MetricMathAnomalyDetector)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Putting CloudWatch Metric Anomaly Detector: %s", input)
+	_, err = conn.PutAnomalyDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting CloudWatch Metric Anomaly Detector (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceMetricAnomalyDetectorRead(ctx, d, meta)
+}
+
+func resourceMetricAnomalyDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	detector, err := FindAnomalyDetectorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Metric Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("configuration", flattenAnomalyDetectorConfiguration(detector.Configuration)); err != nil {
+		return diag.Errorf("error setting configuration: %s", err)
+	}
+
+	if detector.MetricMathAnomalyDetector != nil {
+		if err := d.Set("metric_math_anomaly_detector", []interface{}{flattenMetricMathAnomalyDetector(detector.MetricMathAnomalyDetector)}); err != nil {
+			return diag.Errorf("error setting metric_math_anomaly_detector: %s", err)
+		}
+	} else {
+		d.Set("metric_math_anomaly_detector", nil)
+	}
+
+	if detector.SingleMetricAnomalyDetector != nil {
+		if err := d.Set("single_metric_anomaly_detector", []interface{}{flattenSingleMetricAnomalyDetector(detector.SingleMetricAnomalyDetector)}); err != nil {
+			return diag.Errorf("error setting single_metric_anomaly_detector: %s", err)
+		}
+	} else {
+		d.Set("single_metric_anomaly_detector", nil)
+	}
+
+	d.Set("state_value", detector.StateValue)
+
+	return nil
+}
+
+func resourceMetricAnomalyDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	input := &cloudwatch.DeleteAnomalyDetectorInput{}
+
+	if v, ok := d.GetOk("metric_math_anomaly_detector"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MetricMathAnomalyDetector = expandMetricMathAnomalyDetector(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("single_metric_anomaly_detector"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SingleMetricAnomalyDetector = expandSingleMetricAnomalyDetector(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch Metric Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteAnomalyDetectorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatch.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const anomalyDetectorIDSeparator = "|"
+
+// anomalyDetectorIDEscaper escapes the characters that separate the parts of a single metric anomaly detector's ID.
+var anomalyDetectorIDEscaper = strings.NewReplacer("%", "%25", anomalyDetectorIDSeparator, "%7C", ",", "%2C", "=", "%3D")
+
+// AnomalyDetectorID returns an identifier derived from an anomaly detector's definition.
+// Anomaly detectors have no name or ARN of their own.
+// A single metric anomaly detector is identified by its namespace, metric name, statistic and dimensions,
+// e.g. "AWS/Lambda|Errors|Sum|FunctionName=example", which AnomalyDetectorParseID turns back into its definition.
+// A metric math anomaly detector is identified by a hash of its metric queries.
+// Two definitions that differ only in the order of dimensions or metric queries yield the same identifier.
+func AnomalyDetectorID(single *cloudwatch.SingleMetricAnomalyDetector, math *cloudwatch.MetricMathAnomalyDetector) (string, error) {
+	type metric struct {
+		Dimensions [][2]string `json:"dimensions,omitempty"`
+		MetricName string      `json:"metricName"`
+		Namespace  string      `json:"namespace"`
+		Period     int64       `json:"period,omitempty"`
+		Stat       string      `json:"stat"`
+		Unit       string      `json:"unit,omitempty"`
+	}
+	type query struct {
+		AccountID  string  `json:"accountId,omitempty"`
+		Expression string  `json:"expression,omitempty"`
+		ID         string  `json:"id"`
+		Label      string  `json:"label,omitempty"`
+		Metric     *metric `json:"metric,omitempty"`
+		ReturnData bool    `json:"returnData"`
+	}
+
+	dimensions := func(apiObjects []*cloudwatch.Dimension) [][2]string {
+		var v [][2]string
+
+		for _, apiObject := range apiObjects {
+			v = append(v, [2]string{aws.StringValue(apiObject.Name), aws.StringValue(apiObject.Value)})
+		}
+
+		sort.Slice(v, func(i, j int) bool {
+			return v[i][0] < v[j][0]
+		})
+
+		return v
+	}
+
+	switch {
+	case single != nil:
+		var dims []string
+
+		for _, v := range dimensions(single.Dimensions) {
+			dims = append(dims, anomalyDetectorIDEscaper.Replace(v[0])+"="+anomalyDetectorIDEscaper.Replace(v[1]))
+		}
+
+		parts := []string{
+			anomalyDetectorIDEscaper.Replace(aws.StringValue(single.Namespace)),
+			anomalyDetectorIDEscaper.Replace(aws.StringValue(single.MetricName)),
+			anomalyDetectorIDEscaper.Replace(aws.StringValue(single.Stat)),
+			strings.Join(dims, ","),
+		}
+
+		return strings.Join(parts, anomalyDetectorIDSeparator), nil
+	case math != nil:
+		var queries []query
+
+		for _, apiObject := range math.MetricDataQueries {
+			q := query{
+				AccountID:  aws.StringValue(apiObject.AccountId),
+				Expression: aws.StringValue(apiObject.Expression),
+				ID:         aws.StringValue(apiObject.Id),
+				Label:      aws.StringValue(apiObject.Label),
+				ReturnData: aws.BoolValue(apiObject.ReturnData),
+			}
+
+			if v := apiObject.MetricStat; v != nil && v.Metric != nil {
+				q.Metric = &metric{
+					Dimensions: dimensions(v.Metric.Dimensions),
+					MetricName: aws.StringValue(v.Metric.MetricName),
+					Namespace:  aws.StringValue(v.Metric.Namespace),
+					Period:     aws.Int64Value(v.Period),
+					Stat:       aws.StringValue(v.Stat),
+					Unit:       aws.StringValue(v.Unit),
+				}
+			}
+
+			queries = append(queries, q)
+		}
+
+		sort.Slice(queries, func(i, j int) bool {
+			return queries[i].ID < queries[j].ID
+		})
+
+		b, err := json.Marshal(struct {
+			MetricMath []query `json:"metricMath,omitempty"`
+		}{queries})
+
+		if err != nil {
+			return "", err
+		}
+
+		hash := sha256.Sum256(b)
+
+		return hex.EncodeToString(hash[:]), nil
+	default:
+		return "", fmt.Errorf("one of single metric or metric math anomaly detector must be specified")
+	}
+}
+
+// AnomalyDetectorParseID returns the definition of the single metric anomaly detector with the specified identifier,
+// or nil if the identifier is that of a metric math anomaly detector.
+func AnomalyDetectorParseID(id string) (*cloudwatch.SingleMetricAnomalyDetector, error) {
+	if !strings.Contains(id, anomalyDetectorIDSeparator) {
+		return nil, nil
+	}
+
+	parts := strings.Split(id, anomalyDetectorIDSeparator)
+
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected NAMESPACE%[2]sMETRIC_NAME%[2]sSTAT%[2]sDIMENSIONS", id, anomalyDetectorIDSeparator)
+	}
+
+	unescape := func(s string) (string, error) {
+		v, err := url.PathUnescape(s)
+
+		if err != nil {
+			return "", fmt.Errorf("unexpected format for ID (%s): %w", id, err)
+		}
+
+		return v, nil
+	}
+
+	var values [3]string
+
+	for i := range values {
+		v, err := unescape(parts[i])
+
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = v
+	}
+
+	apiObject := &cloudwatch.SingleMetricAnomalyDetector{
+		Namespace:  aws.String(values[0]),
+		MetricName: aws.String(values[1]),
+		Stat:       aws.String(values[2]),
+	}
+
+	if parts[3] == "" {
+		return apiObject, nil
+	}
+
+	for _, dim := range strings.Split(parts[3], ",") {
+		nameValue := strings.SplitN(dim, "=", 2)
+
+		if len(nameValue) != 2 || nameValue[0] == "" {
+			return nil, fmt.Errorf("unexpected format for ID (%s), expected dimensions as NAME=VALUE,...", id)
+		}
+
+		name, err := unescape(nameValue[0])
+
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := unescape(nameValue[1])
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.Dimensions = append(apiObject.Dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		})
+	}
+
+	return apiObject, nil
+}
+
+func expandAnomalyDetectorConfiguration(tfMap map[string]interface{}) (*cloudwatch.AnomalyDetectorConfiguration, error) {
+	apiObject := &cloudwatch.AnomalyDetectorConfiguration{
+		ExcludedTimeRanges: []*cloudwatch.Range{},
+	}
+
+	for _, tfMapRaw := range tfMap["excluded_time_range"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		startTime, err := time.Parse(time.RFC3339, tfMap["start_time"].(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing excluded_time_range start_time: %w", err)
+		}
+
+		endTime, err := time.Parse(time.RFC3339, tfMap["end_time"].(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing excluded_time_range end_time: %w", err)
+		}
+
+		apiObject.ExcludedTimeRanges = append(apiObject.ExcludedTimeRanges, &cloudwatch.Range{
+			EndTime:   aws.Time(endTime),
+			StartTime: aws.Time(startTime),
+		})
+	}
+
+	if v, ok := tfMap["metric_timezone"].(string); ok && v != "" {
+		apiObject.MetricTimezone = aws.String(v)
+	}
+
+	return apiObject, nil
+}
+
+func expandMetricMathAnomalyDetector(tfMap map[string]interface{}) *cloudwatch.MetricMathAnomalyDetector {
+	apiObject := &cloudwatch.MetricMathAnomalyDetector{}
+
+	if v, ok := tfMap["metric_query"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MetricDataQueries = expandMetricAlarmMetrics(v)
+	}
+
+	return apiObject
+}
+
+func expandSingleMetricAnomalyDetector(tfMap map[string]interface{}) *cloudwatch.SingleMetricAnomalyDetector {
+	apiObject := &cloudwatch.SingleMetricAnomalyDetector{
+		MetricName: aws.String(tfMap["metric_name"].(string)),
+		Namespace:  aws.String(tfMap["namespace"].(string)),
+		Stat:       aws.String(tfMap["stat"].(string)),
+	}
+
+	if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Dimensions = expandMetricAlarmDimensions(v)
+	}
+
+	return apiObject
+}
+
+func flattenAnomalyDetectorConfiguration(apiObject *cloudwatch.AnomalyDetectorConfiguration) []interface{} {
+	if apiObject == nil || (len(apiObject.ExcludedTimeRanges) == 0 && aws.StringValue(apiObject.MetricTimezone) == "") {
+		return nil
+	}
+
+	var excludedTimeRanges []interface{}
+
+	for _, v := range apiObject.ExcludedTimeRanges {
+		if v == nil {
+			continue
+		}
+
+		excludedTimeRanges = append(excludedTimeRanges, map[string]interface{}{
+			"end_time":   aws.TimeValue(v.EndTime).UTC().Format(time.RFC3339),
+			"start_time": aws.TimeValue(v.StartTime).UTC().Format(time.RFC3339),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"excluded_time_range": excludedTimeRanges,
+		"metric_timezone":     aws.StringValue(apiObject.MetricTimezone),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMetricMathAnomalyDetector(apiObject *cloudwatch.MetricMathAnomalyDetector) map[string]interface{} {
+	return map[string]interface{}{
+		"metric_query": flattenMetricAlarmMetrics(apiObject.MetricDataQueries),
+	}
+}
+
+func flattenSingleMetricAnomalyDetector(apiObject *cloudwatch.SingleMetricAnomalyDetector) map[string]interface{} {
+	return map[string]interface{}{
+		"dimensions":  flattenMetricAlarmDimensions(apiObject.Dimensions),
+		"metric_name": aws.StringValue(apiObject.MetricName),
+		"namespace":   aws.StringValue(apiObject.Namespace),
+		"stat":        aws.StringValue(apiObject.Stat),
+	}
+}
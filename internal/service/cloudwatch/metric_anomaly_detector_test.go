@@ -0,0 +1,507 @@
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAnomalyDetectorID(t *testing.T) {
+	single1 := &cloudwatch.SingleMetricAnomalyDetector{
+		Dimensions: []*cloudwatch.Dimension{
+			{Name: aws.String("FunctionName"), Value: aws.String("example")},
+			{Name: aws.String("Resource"), Value: aws.String("example:live")},
+		},
+		MetricName: aws.String("Errors"),
+		Namespace:  aws.String("AWS/Lambda"),
+		Stat:       aws.String("Sum"),
+	}
+	single2 := &cloudwatch.SingleMetricAnomalyDetector{
+		Dimensions: []*cloudwatch.Dimension{
+			{Name: aws.String("Resource"), Value: aws.String("example:live")},
+			{Name: aws.String("FunctionName"), Value: aws.String("example")},
+		},
+		MetricName: aws.String("Errors"),
+		Namespace:  aws.String("AWS/Lambda"),
+		Stat:       aws.String("Sum"),
+	}
+	single3 := &cloudwatch.SingleMetricAnomalyDetector{
+		MetricName: aws.String("Errors"),
+		Namespace:  aws.String("AWS/Lambda"),
+		Stat:       aws.String("Average"),
+	}
+	math1 := &cloudwatch.MetricMathAnomalyDetector{
+		MetricDataQueries: []*cloudwatch.MetricDataQuery{
+			{
+				Id: aws.String("m1"),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{MetricName: aws.String("Errors"), Namespace: aws.String("AWS/Lambda")},
+					Period: aws.Int64(300),
+					Stat:   aws.String("Sum"),
+				},
+			},
+			{Id: aws.String("e1"), Expression: aws.String("m1 * 2"), ReturnData: aws.Bool(true)},
+		},
+	}
+	math2 := &cloudwatch.MetricMathAnomalyDetector{
+		MetricDataQueries: []*cloudwatch.MetricDataQuery{
+			{Id: aws.String("e1"), Expression: aws.String("m1 * 2"), ReturnData: aws.Bool(true)},
+			{
+				Id: aws.String("m1"),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{MetricName: aws.String("Errors"), Namespace: aws.String("AWS/Lambda")},
+					Period: aws.Int64(300),
+					Stat:   aws.String("Sum"),
+				},
+				ReturnData: aws.Bool(false),
+			},
+		},
+	}
+
+	id := func(single *cloudwatch.SingleMetricAnomalyDetector, math *cloudwatch.MetricMathAnomalyDetector) string {
+		v, err := tfcloudwatch.AnomalyDetectorID(single, math)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return v
+	}
+
+	if got, want := id(single1, nil), "AWS/Lambda|Errors|Sum|FunctionName=example,Resource=example:live"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if id(single1, nil) != id(single2, nil) {
+		t.Error("expected dimension order not to change the ID")
+	}
+
+	if id(single1, nil) == id(single3, nil) {
+		t.Error("expected different definitions to have different IDs")
+	}
+
+	if id(nil, math1) != id(nil, math2) {
+		t.Error("expected metric query order and unset return_data not to change the ID")
+	}
+
+	if _, err := tfcloudwatch.AnomalyDetectorID(nil, nil); err == nil {
+		t.Error("expected error for empty definition")
+	}
+}
+
+func TestAnomalyDetectorParseID(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Detector *cloudwatch.SingleMetricAnomalyDetector
+	}{
+		{
+			Name: "no dimensions",
+			Detector: &cloudwatch.SingleMetricAnomalyDetector{
+				MetricName: aws.String("Errors"),
+				Namespace:  aws.String("AWS/Lambda"),
+				Stat:       aws.String("Sum"),
+			},
+		},
+		{
+			Name: "dimensions",
+			Detector: &cloudwatch.SingleMetricAnomalyDetector{
+				Dimensions: []*cloudwatch.Dimension{
+					{Name: aws.String("FunctionName"), Value: aws.String("example")},
+					{Name: aws.String("Resource"), Value: aws.String("example:live")},
+				},
+				MetricName: aws.String("Errors"),
+				Namespace:  aws.String("AWS/Lambda"),
+				Stat:       aws.String("Sum"),
+			},
+		},
+		{
+			Name: "separators",
+			Detector: &cloudwatch.SingleMetricAnomalyDetector{
+				Dimensions: []*cloudwatch.Dimension{
+					{Name: aws.String("a=b"), Value: aws.String("c,d|e%20")},
+				},
+				MetricName: aws.String("a|b"),
+				Namespace:  aws.String("Custom/a|b"),
+				Stat:       aws.String("TM(10%:90%)"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			id, err := tfcloudwatch.AnomalyDetectorID(testCase.Detector, nil)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tfcloudwatch.AnomalyDetectorParseID(id)
+
+			if err != nil {
+				t.Fatalf("parsing %q: %s", id, err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Detector) {
+				t.Errorf("got %s, want %s", got, testCase.Detector)
+			}
+		})
+	}
+
+	for _, id := range []string{"AWS/Lambda|Errors", "AWS/Lambda||Sum|", "AWS/Lambda|Errors|Sum|FunctionName", "AWS/Lambda|Errors|Sum|%zz=a"} {
+		if _, err := tfcloudwatch.AnomalyDetectorParseID(id); err == nil {
+			t.Errorf("expected error parsing %q", id)
+		}
+	}
+
+	if got, err := tfcloudwatch.AnomalyDetectorParseID("0123456789abcdef"); got != nil || err != nil {
+		t.Errorf("got %s, %v, want nil for metric math ID", got, err)
+	}
+}
+
+func TestAccCloudWatchMetricAnomalyDetector_basic(t *testing.T) {
+	var detector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_metric_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMetricAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAnomalyDetectorConfig_singleMetric(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.dimensions.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.dimensions.FunctionName", rName),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.metric_name", "Invocations"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.namespace", "AWS/Lambda"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.stat", "Sum"),
+					resource.TestCheckResourceAttrSet(resourceName, "state_value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricAnomalyDetector_disappears(t *testing.T) {
+	var detector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_metric_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMetricAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAnomalyDetectorConfig_singleMetric(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricAnomalyDetectorExists(resourceName, &detector),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudwatch.ResourceMetricAnomalyDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricAnomalyDetector_configuration(t *testing.T) {
+	var detector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_metric_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMetricAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAnomalyDetectorConfig_configuration(rName, "2022-01-01T00:00:00Z", "2022-01-02T00:00:00Z", "Europe/London"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2022-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2022-01-02T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "Europe/London"),
+				),
+			},
+			{
+				Config: testAccMetricAnomalyDetectorConfig_configuration(rName, "2022-02-01T00:00:00Z", "2022-02-03T00:00:00Z", "UTC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2022-02-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2022-02-03T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "UTC"),
+				),
+			},
+			{
+				Config: testAccMetricAnomalyDetectorConfig_singleMetric(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricAnomalyDetector_metricMath(t *testing.T) {
+	var detector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_metric_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMetricAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAnomalyDetectorConfig_metricMath(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_query.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_math_anomaly_detector.0.metric_query.*", map[string]string{
+						"id":          "e1",
+						"expression":  "100 * m2 / m1",
+						"return_data": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_math_anomaly_detector.0.metric_query.*", map[string]string{
+						"id":                    "m1",
+						"metric.#":              "1",
+						"metric.0.metric_name":  "Invocations",
+						"metric.0.namespace":    "AWS/Lambda",
+						"metric.0.period":       "300",
+						"metric.0.stat":         "Sum",
+						"metric.0.dimensions.%": "1",
+						"return_data":           "false",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricAnomalyDetector_alarm(t *testing.T) {
+	var detector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_metric_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMetricAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAnomalyDetectorConfig_alarm(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr("aws_cloudwatch_metric_alarm.test", "threshold_metric_id", "ad1"),
+				),
+			},
+			{
+				Config:   testAccMetricAnomalyDetectorConfig_alarm(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMetricAnomalyDetectorExists(n string, v *cloudwatch.AnomalyDetector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Metric Anomaly Detector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+		output, err := tfcloudwatch.FindAnomalyDetectorByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckMetricAnomalyDetectorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_metric_anomaly_detector" {
+			continue
+		}
+
+		_, err := tfcloudwatch.FindAnomalyDetectorByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Metric Anomaly Detector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMetricAnomalyDetectorConfig_singleMetric(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_anomaly_detector" "test" {
+  single_metric_anomaly_detector {
+    namespace   = "AWS/Lambda"
+    metric_name = "Invocations"
+    stat        = "Sum"
+
+    dimensions = {
+      FunctionName = %[1]q
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMetricAnomalyDetectorConfig_configuration(rName, startTime, endTime, timezone string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_anomaly_detector" "test" {
+  single_metric_anomaly_detector {
+    namespace   = "AWS/Lambda"
+    metric_name = "Invocations"
+    stat        = "Sum"
+
+    dimensions = {
+      FunctionName = %[1]q
+    }
+  }
+
+  configuration {
+    excluded_time_range {
+      start_time = %[2]q
+      end_time   = %[3]q
+    }
+
+    metric_timezone = %[4]q
+  }
+}
+`, rName, startTime, endTime, timezone)
+}
+
+func testAccMetricAnomalyDetectorConfig_metricMath(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_anomaly_detector" "test" {
+  metric_math_anomaly_detector {
+    metric_query {
+      id          = "e1"
+      expression  = "100 * m2 / m1"
+      label       = "Error Rate"
+      return_data = true
+    }
+
+    metric_query {
+      id = "m1"
+
+      metric {
+        namespace   = "AWS/Lambda"
+        metric_name = "Invocations"
+        period      = 300
+        stat        = "Sum"
+
+        dimensions = {
+          FunctionName = %[1]q
+        }
+      }
+    }
+
+    metric_query {
+      id = "m2"
+
+      metric {
+        namespace   = "AWS/Lambda"
+        metric_name = "Errors"
+        period      = 300
+        stat        = "Sum"
+
+        dimensions = {
+          FunctionName = %[1]q
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMetricAnomalyDetectorConfig_alarm(rName string) string {
+	return acctest.ConfigCompose(testAccMetricAnomalyDetectorConfig_singleMetric(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanUpperThreshold"
+  evaluation_periods  = 2
+  threshold_metric_id = "ad1"
+
+  metric_query {
+    id          = "ad1"
+    expression  = "ANOMALY_DETECTION_BAND(m1)"
+    label       = "Invocations (expected)"
+    return_data = true
+  }
+
+  metric_query {
+    id          = "m1"
+    return_data = true
+
+    metric {
+      namespace   = aws_cloudwatch_metric_anomaly_detector.test.single_metric_anomaly_detector[0].namespace
+      metric_name = aws_cloudwatch_metric_anomaly_detector.test.single_metric_anomaly_detector[0].metric_name
+      period      = 300
+      stat        = aws_cloudwatch_metric_anomaly_detector.test.single_metric_anomaly_detector[0].stat
+
+      dimensions = aws_cloudwatch_metric_anomaly_detector.test.single_metric_anomaly_detector[0].dimensions
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package cloudwatch_test
+
+import (
+	"testing"
+)
+
+func TestAccCloudWatchCompositeAlarm_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cloudwatch_composite_alarm has no testAccCompositeAlarmConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccCloudWatchMetricAlarm_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cloudwatch_metric_alarm has no testAccMetricAlarmConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccCloudWatchMetricStream_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cloudwatch_metric_stream has no testAccMetricStreamConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
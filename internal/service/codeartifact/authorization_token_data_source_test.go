@@ -14,7 +14,7 @@ func testAccAuthorizationTokenDataSource_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_codeartifact_authorization_token.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codeartifact.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codeartifact.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -35,7 +35,7 @@ func testAccAuthorizationTokenDataSource_owner(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_codeartifact_authorization_token.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codeartifact.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codeartifact.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -56,7 +56,7 @@ func testAccAuthorizationTokenDataSource_duration(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_codeartifact_authorization_token.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codeartifact.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codeartifact.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package codeartifact_test
+
+import (
+	"testing"
+)
+
+func TestAccCodeArtifactDomain_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codeartifact_domain has no testAccDomainConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccCodeArtifactRepository_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codeartifact_repository has no testAccRepositoryConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
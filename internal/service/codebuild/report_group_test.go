@@ -18,7 +18,7 @@ func TestAccCodeBuildReportGroup_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckReportGroup(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codebuild.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -50,7 +50,7 @@ func TestAccCodeBuildReportGroup_Export_s3(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckReportGroup(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codebuild.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -100,7 +100,7 @@ func TestAccCodeBuildReportGroup_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckReportGroup(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codebuild.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -146,7 +146,7 @@ func TestAccCodeBuildReportGroup_deleteReports(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckReportGroup(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codebuild.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -174,7 +174,7 @@ func TestAccCodeBuildReportGroup_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckReportGroup(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codebuild.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package codebuild_test
+
+import (
+	"testing"
+)
+
+func TestAccCodeBuildProject_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codebuild_project has no testAccProjectConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccCodeBuildReportGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codebuild_report_group has no testAccReportGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -15,7 +15,7 @@ func TestAccCodeCommitApprovalRuleTemplateDataSource_basic(t *testing.T) {
 	resourceName := "aws_codecommit_approval_rule_template.test"
 	datasourceName := "data.aws_codecommit_approval_rule_template.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codecommit.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -15,7 +15,7 @@ func TestAccCodeCommitRepositoryDataSource_basic(t *testing.T) {
 	resourceName := "aws_codecommit_repository.default"
 	datasourceName := "data.aws_codecommit_repository.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codecommit.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
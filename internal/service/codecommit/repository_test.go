@@ -18,7 +18,7 @@ func TestAccCodeCommitRepository_basic(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_codecommit_repository.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codecommit.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -43,7 +43,7 @@ func TestAccCodeCommitRepository_withChanges(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_codecommit_repository.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codecommit.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -78,7 +78,7 @@ func TestAccCodeCommitRepository_CreateDefault_branch(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_codecommit_repository.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codecommit.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -106,7 +106,7 @@ func TestAccCodeCommitRepository_CreateAndUpdateDefault_branch(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	resourceName := "aws_codecommit_repository.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codecommit.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -141,7 +141,7 @@ func TestAccCodeCommitRepository_tags(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codecommit_repository.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codecommit.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -25,7 +25,7 @@ func TestAccCodePipeline_basic(t *testing.T) {
 	resourceName := "aws_codepipeline.test"
 	codestarConnectionResourceName := "aws_codestarconnections_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSupported(t)
@@ -130,7 +130,7 @@ func TestAccCodePipeline_disappears(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSupported(t)
@@ -157,7 +157,7 @@ func TestAccCodePipeline_emptyStageArtifacts(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSupported(t)
@@ -199,7 +199,7 @@ func TestAccCodePipeline_deployWithServiceRole(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSupported(t)
@@ -232,7 +232,7 @@ func TestAccCodePipeline_tags(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSupported(t)
@@ -290,7 +290,7 @@ func TestAccCodePipeline_MultiRegion_basic(t *testing.T) {
 
 	name := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckMultipleRegion(t, 2)
@@ -332,7 +332,7 @@ func TestAccCodePipeline_MultiRegion_update(t *testing.T) {
 
 	name := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckMultipleRegion(t, 2)
@@ -388,7 +388,7 @@ func TestAccCodePipeline_MultiRegion_convertSingleRegion(t *testing.T) {
 
 	name := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckMultipleRegion(t, 2)
@@ -452,7 +452,7 @@ func TestAccCodePipeline_withNamespace(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSupported(t)
@@ -486,7 +486,7 @@ func TestAccCodePipeline_withGitHubV1SourceAction(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSupported(t)
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package codepipeline_test
+
+import (
+	"testing"
+)
+
+func TestAccCodePipelineCodePipeline_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codepipeline has no testAccCodePipelineConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccCodePipelineWebhook_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codepipeline_webhook has no testAccWebhookConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
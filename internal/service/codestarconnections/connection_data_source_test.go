@@ -16,7 +16,7 @@ func TestAccCodeStarConnectionsConnectionDataSource_basic(t *testing.T) {
 	dataSourceName2 := "data.aws_codestarconnections_connection.test_name"
 	resourceName := "aws_codestarconnections_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codestarconnections.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -47,7 +47,7 @@ func TestAccCodeStarConnectionsConnectionDataSource_tags(t *testing.T) {
 	dataSourceName := "data.aws_codestarconnections_connection.test"
 	resourceName := "aws_codestarconnections_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codestarconnections.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
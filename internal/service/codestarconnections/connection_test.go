@@ -21,7 +21,7 @@ func TestAccCodeStarConnectionsConnection_basic(t *testing.T) {
 	resourceName := "aws_codestarconnections_connection.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codestarconnections.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -52,7 +52,7 @@ func TestAccCodeStarConnectionsConnection_hostARN(t *testing.T) {
 	resourceName := "aws_codestarconnections_connection.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codestarconnections.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -84,7 +84,7 @@ func TestAccCodeStarConnectionsConnection_disappears(t *testing.T) {
 	resourceName := "aws_codestarconnections_connection.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codestarconnections.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -107,7 +107,7 @@ func TestAccCodeStarConnectionsConnection_tags(t *testing.T) {
 	resourceName := "aws_codestarconnections_connection.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codestarconnections.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
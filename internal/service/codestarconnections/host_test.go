@@ -21,7 +21,7 @@ func TestAccCodeStarConnectionsHost_basic(t *testing.T) {
 	resourceName := "aws_codestarconnections_host.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codestarconnections.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -52,7 +52,7 @@ func TestAccCodeStarConnectionsHost_disappears(t *testing.T) {
 	resourceName := "aws_codestarconnections_host.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codestarconnections.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -75,7 +75,7 @@ func TestAccCodeStarConnectionsHost_vpc(t *testing.T) {
 	resourceName := "aws_codestarconnections_host.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codestarconnections.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
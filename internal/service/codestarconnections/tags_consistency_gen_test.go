@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package codestarconnections_test
+
+import (
+	"testing"
+)
+
+func TestAccCodeStarConnectionsConnection_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codestarconnections_connection has no testAccConnectionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package codestarnotifications_test
+
+import (
+	"testing"
+)
+
+func TestAccCodeStarNotificationsNotificationRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codestarnotifications_notification_rule has no testAccNotificationRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
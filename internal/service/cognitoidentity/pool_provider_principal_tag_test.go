@@ -20,7 +20,7 @@ func TestAccCognitoIdentityPoolProviderPrincipalTags_basic(t *testing.T) {
 	resourceName := "aws_cognito_identity_pool_provider_principal_tag.test"
 	name := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -41,7 +41,7 @@ func TestAccCognitoIdentityPoolProviderPrincipalTags_updated(t *testing.T) {
 	resourceName := "aws_cognito_identity_pool_provider_principal_tag.test"
 	name := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -77,7 +77,7 @@ func TestAccCognitoIdentityPoolProviderPrincipalTags_disappears(t *testing.T) {
 	resourceName := "aws_cognito_identity_pool_provider_principal_tag.test"
 	name := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
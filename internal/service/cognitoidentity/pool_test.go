@@ -22,7 +22,7 @@ func TestAccCognitoIdentityPool_basic(t *testing.T) {
 	updatedName := sdkacctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -62,7 +62,7 @@ func TestAccCognitoIdentityPool_DeveloperProviderName(t *testing.T) {
 	developerProviderNameUpdated := sdkacctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -99,7 +99,7 @@ func TestAccCognitoIdentityPool_supportedLoginProviders(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -148,7 +148,7 @@ func TestAccCognitoIdentityPool_openidConnectProviderARNs(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -196,7 +196,7 @@ func TestAccCognitoIdentityPool_samlProviderARNs(t *testing.T) {
 	secondaryIdpEntityId := fmt.Sprintf("https://%s", acctest.RandomDomainName())
 	resourceName := "aws_cognito_identity_pool.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -244,7 +244,7 @@ func TestAccCognitoIdentityPool_cognitoIdentityProviders(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -305,7 +305,7 @@ func TestAccCognitoIdentityPool_addingNewProviderKeepsOldProvider(t *testing.T)
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -353,7 +353,7 @@ func TestAccCognitoIdentityPool_tags(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package cognitoidentity_test
+
+import (
+	"testing"
+)
+
+func TestAccCognitoIdentityPool_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cognito_identity_pool has no testAccPoolConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
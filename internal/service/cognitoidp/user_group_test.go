@@ -21,7 +21,7 @@ func TestAccCognitoIDPUserGroup_basic(t *testing.T) {
 	updatedGroupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
 	resourceName := "aws_cognito_user_group.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -56,7 +56,7 @@ func TestAccCognitoIDPUserGroup_complex(t *testing.T) {
 	updatedGroupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
 	resourceName := "aws_cognito_user_group.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -95,7 +95,7 @@ func TestAccCognitoIDPUserGroup_roleARN(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_cognito_user_group.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
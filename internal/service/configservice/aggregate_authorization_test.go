@@ -5,7 +5,7 @@ package configservice_test
 // 	resourceName := "aws_config_aggregate_authorization.example"
 // 	dataSourceName := "data.aws_region.current"
 
-// 	resource.ParallelTest(t, resource.TestCase{
+// 	acctest.ParallelTest(t, resource.TestCase{
 // 		PreCheck:     func() { acctest.PreCheck(t) },
 // 		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
 // 		ProviderFactories:acctest.ProviderFactories,
@@ -32,7 +32,7 @@ package configservice_test
 // 	rString := sdkacctest.RandStringFromCharSet(12, "0123456789")
 // 	resourceName := "aws_config_aggregate_authorization.example"
 
-// 	resource.ParallelTest(t, resource.TestCase{
+// 	acctest.ParallelTest(t, resource.TestCase{
 // 		PreCheck:     func() { acctest.PreCheck(t) },
 // 		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
 // 		ProviderFactories:acctest.ProviderFactories,
@@ -20,7 +20,7 @@ func testAccConfigRule_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -46,7 +46,7 @@ func testAccConfigRule_ownerAws(t *testing.T) { // nosemgrep:aws-in-func-name
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -88,7 +88,7 @@ func testAccConfigRule_customlambda(t *testing.T) {
 	expectedName := fmt.Sprintf("tf-acc-test-%d", rInt)
 	path := "test-fixtures/lambdatest.zip"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -132,7 +132,7 @@ func testAccConfigRule_ownerPolicy(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -170,7 +170,7 @@ func testAccConfigRule_Scope_TagKey(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -201,7 +201,7 @@ func testAccConfigRule_Scope_TagKey_Empty(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -222,7 +222,7 @@ func testAccConfigRule_Scope_TagValue(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -253,7 +253,7 @@ func testAccConfigRule_tags(t *testing.T) {
 	resourceName := "aws_config_config_rule.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -300,7 +300,7 @@ func testAccConfigRule_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccConfigServiceConfigurationAggregator_account(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_configuration_aggregator.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -56,7 +56,7 @@ func TestAccConfigServiceConfigurationAggregator_organization(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_configuration_aggregator.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -86,7 +86,7 @@ func TestAccConfigServiceConfigurationAggregator_switch(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_configuration_aggregator.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -115,7 +115,7 @@ func TestAccConfigServiceConfigurationAggregator_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_configuration_aggregator.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -163,7 +163,7 @@ func TestAccConfigServiceConfigurationAggregator_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_configuration_aggregator.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
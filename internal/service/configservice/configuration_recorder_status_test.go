@@ -19,7 +19,7 @@ func testAccConfigurationRecorderStatus_basic(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	expectedName := fmt.Sprintf("tf-acc-test-%d", rInt)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -45,7 +45,7 @@ func testAccConfigurationRecorderStatus_startEnabled(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	expectedName := fmt.Sprintf("tf-acc-test-%d", rInt)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -89,7 +89,7 @@ func testAccConfigurationRecorderStatus_importBasic(t *testing.T) {
 	resourceName := "aws_config_configuration_recorder_status.foo"
 	rInt := sdkacctest.RandInt()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func testAccConfigurationRecorder_basic(t *testing.T) {
 
 	resourceName := "aws_config_configuration_recorder.foo"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -48,7 +48,7 @@ func testAccConfigurationRecorder_allParams(t *testing.T) {
 
 	resourceName := "aws_config_configuration_recorder.foo"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -75,7 +75,7 @@ func testAccConfigurationRecorder_importBasic(t *testing.T) {
 	resourceName := "aws_config_configuration_recorder.foo"
 	rInt := sdkacctest.RandInt()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, configservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
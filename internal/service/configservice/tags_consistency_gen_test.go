@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package configservice_test
+
+import (
+	"testing"
+)
+
+func TestAccConfigServiceAggregateAuthorization_TagsConsistency(t *testing.T) {
+	t.Skip("aws_config_aggregate_authorization has no testAccAggregateAuthorizationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccConfigServiceConfigRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_config_config_rule has no testAccConfigRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccConfigServiceConfigurationAggregator_TagsConsistency(t *testing.T) {
+	t.Skip("aws_config_configuration_aggregator has no testAccConfigurationAggregatorConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -16,7 +16,7 @@ func TestAccConnectBotAssociationDataSource_basic(t *testing.T) {
 	resourceName := "aws_connect_bot_association.test"
 	datasourceName := "data.aws_connect_bot_association.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -15,7 +15,7 @@ func TestAccConnectContactFlowDataSource_contactFlowID(t *testing.T) {
 	resourceName := "aws_connect_contact_flow.test"
 	datasourceName := "data.aws_connect_contact_flow.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -44,7 +44,7 @@ func TestAccConnectContactFlowDataSource_name(t *testing.T) {
 	resourceName := "aws_connect_contact_flow.test"
 	datasourceName := "data.aws_connect_contact_flow.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
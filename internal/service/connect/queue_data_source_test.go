@@ -16,7 +16,7 @@ func TestAccConnectQueueDataSource_queueID(t *testing.T) {
 	datasourceName := "data.aws_connect_queue.test"
 	outboundCallerConfigName := "exampleOutboundCallerConfigName"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -48,7 +48,7 @@ func TestAccConnectQueueDataSource_name(t *testing.T) {
 	datasourceName := "data.aws_connect_queue.test"
 	outboundCallerConfigName := "exampleOutboundCallerConfigName"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
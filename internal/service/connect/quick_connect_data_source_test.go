@@ -16,7 +16,7 @@ func TestAccConnectQuickConnectDataSource_id(t *testing.T) {
 	datasourceName := "data.aws_connect_quick_connect.test"
 	phoneNumber := "+12345678912"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -47,7 +47,7 @@ func TestAccConnectQuickConnectDataSource_name(t *testing.T) {
 	datasourceName := "data.aws_connect_quick_connect.test"
 	phoneNumber := "+12345678912"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
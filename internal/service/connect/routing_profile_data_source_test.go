@@ -18,7 +18,7 @@ func TestAccConnectRoutingProfileDataSource_routingProfileID(t *testing.T) {
 	resourceName := "aws_connect_routing_profile.test"
 	datasourceName := "data.aws_connect_routing_profile.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -70,7 +70,7 @@ func TestAccConnectRoutingProfileDataSource_name(t *testing.T) {
 	resourceName := "aws_connect_routing_profile.test"
 	datasourceName := "data.aws_connect_routing_profile.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
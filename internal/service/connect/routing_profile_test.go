@@ -42,7 +42,7 @@ func testAccRoutingProfile_basic(t *testing.T) {
 	originalDescription := "Created"
 	updatedDescription := "Updated"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -97,7 +97,7 @@ func testAccRoutingProfile_disappears(t *testing.T) {
 	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
 	resourceName := "aws_connect_routing_profile.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -123,7 +123,7 @@ func testAccRoutingProfile_updateConcurrency(t *testing.T) {
 	resourceName := "aws_connect_routing_profile.test"
 	description := "testMediaConcurrencies"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -176,7 +176,7 @@ func testAccRoutingProfile_updateDefaultOutboundQueue(t *testing.T) {
 	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
 	resourceName := "aws_connect_routing_profile.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -232,7 +232,7 @@ func testAccRoutingProfile_updateQueues(t *testing.T) {
 	resourceName := "aws_connect_routing_profile.test"
 	description := "testQueueConfigs"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
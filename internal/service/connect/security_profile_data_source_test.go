@@ -16,7 +16,7 @@ func TestAccConnectSecurityProfileDataSource_securityProfileID(t *testing.T) {
 	resourceName := "aws_connect_security_profile.test"
 	datasourceName := "data.aws_connect_security_profile.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -44,7 +44,7 @@ func TestAccConnectSecurityProfileDataSource_name(t *testing.T) {
 	resourceName := "aws_connect_security_profile.test"
 	datasourceName := "data.aws_connect_security_profile.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package connect_test
+
+import (
+	"testing"
+)
+
+func TestAccConnectContactFlow_TagsConsistency(t *testing.T) {
+	t.Skip("aws_connect_contact_flow has no testAccContactFlowConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccConnectContactFlowModule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_connect_contact_flow_module has no testAccContactFlowModuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
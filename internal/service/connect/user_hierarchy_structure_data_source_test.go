@@ -15,7 +15,7 @@ func TestAccConnectUserHierarchyStructureDataSource_instanceID(t *testing.T) {
 	resourceName := "aws_connect_user_hierarchy_structure.test"
 	datasourceName := "data.aws_connect_user_hierarchy_structure.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -40,7 +40,7 @@ func testAccUserHierarchyStructure_basic(t *testing.T) {
 	levelFiveName := sdkacctest.RandomWithPrefix("resource-test-terraform")
 	resourceName := "aws_connect_user_hierarchy_structure.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -200,7 +200,7 @@ func testAccUserHierarchyStructure_disappears(t *testing.T) {
 	resourceName := "aws_connect_user_hierarchy_structure.test"
 	levelOneName := sdkacctest.RandomWithPrefix("resource-test-terraform")
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, connect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
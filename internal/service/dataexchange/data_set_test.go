@@ -21,7 +21,7 @@ func TestAccDataExchangeDataSet_basic(t *testing.T) {
 	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
 	resourceName := "aws_dataexchange_data_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(dataexchange.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dataexchange.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -60,7 +60,7 @@ func TestAccDataExchangeDataSet_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_dataexchange_data_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(dataexchange.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dataexchange.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -105,7 +105,7 @@ func TestAccDataExchangeDataSet_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_dataexchange_data_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(dataexchange.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dataexchange.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -20,7 +20,7 @@ func TestAccDataExchangeRevision_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_dataexchange_revision.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(dataexchange.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dataexchange.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -49,7 +49,7 @@ func TestAccDataExchangeRevision_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_dataexchange_revision.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(dataexchange.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dataexchange.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -94,7 +94,7 @@ func TestAccDataExchangeRevision_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_dataexchange_revision.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(dataexchange.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dataexchange.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -118,7 +118,7 @@ func TestAccDataExchangeRevision_disappears_dataSet(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_dataexchange_revision.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(dataexchange.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dataexchange.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package dataexchange_test
+
+import (
+	"testing"
+)
+
+func TestAccDataExchangeDataSet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dataexchange_data_set has no testAccDataSetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataExchangeRevision_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dataexchange_revision has no testAccRevisionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -15,7 +15,7 @@ func TestAccDataPipelinePipelineDataSource_basic(t *testing.T) {
 	resourceName := "aws_datapipeline_pipeline.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckPipelineDefinitionDestroy,
@@ -21,7 +21,7 @@ func TestAccDataPipelinePipeline_basic(t *testing.T) {
 	rName2 := fmt.Sprintf("tf-datapipeline-%s", sdkacctest.RandString(5))
 	resourceName := "aws_datapipeline_pipeline.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, datapipeline.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -56,7 +56,7 @@ func TestAccDataPipelinePipeline_description(t *testing.T) {
 	rName := fmt.Sprintf("tf-datapipeline-%s", sdkacctest.RandString(5))
 	resourceName := "aws_datapipeline_pipeline.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, datapipeline.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -91,7 +91,7 @@ func TestAccDataPipelinePipeline_disappears(t *testing.T) {
 	rName := fmt.Sprintf("tf-datapipeline-%s", sdkacctest.RandString(5))
 	resourceName := "aws_datapipeline_pipeline.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, datapipeline.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -114,7 +114,7 @@ func TestAccDataPipelinePipeline_tags(t *testing.T) {
 	rName := fmt.Sprintf("tf-datapipeline-%s", sdkacctest.RandString(5))
 	resourceName := "aws_datapipeline_pipeline.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, datapipeline.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
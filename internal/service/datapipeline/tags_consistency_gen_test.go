@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package datapipeline_test
+
+import (
+	"testing"
+)
+
+func TestAccDataPipelinePipeline_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datapipeline_pipeline has no testAccPipelineConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
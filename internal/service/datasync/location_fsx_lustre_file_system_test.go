@@ -20,7 +20,7 @@ func TestAccDataSyncLocationFSxLustreFileSystem_basic(t *testing.T) {
 	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
 	fsResourceName := "aws_fsx_lustre_file_system.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(fsx.EndpointsID, t)
@@ -56,7 +56,7 @@ func TestAccDataSyncLocationFSxLustreFileSystem_disappears(t *testing.T) {
 	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
 	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(fsx.EndpointsID, t)
@@ -83,7 +83,7 @@ func TestAccDataSyncLocationFSxLustreFileSystem_subdirectory(t *testing.T) {
 	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
 	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(fsx.EndpointsID, t)
@@ -114,7 +114,7 @@ func TestAccDataSyncLocationFSxLustreFileSystem_tags(t *testing.T) {
 	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
 	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(fsx.EndpointsID, t)
@@ -0,0 +1,47 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package datasync_test
+
+import (
+	"testing"
+)
+
+func TestAccDataSyncAgent_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_agent has no testAccAgentConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataSyncLocationEFS_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_location_efs has no testAccLocationEFSConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataSyncLocationFSxLustreFileSystem_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_location_fsx_lustre_file_system has no testAccLocationFSxLustreFileSystemConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataSyncLocationFSxOpenZFSFileSystem_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_location_fsx_openzfs_file_system has no testAccLocationFSxOpenZFSFileSystemConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataSyncLocationFSxWindowsFileSystem_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_location_fsx_windows_file_system has no testAccLocationFSxWindowsFileSystemConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataSyncLocationHDFS_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_location_hdfs has no testAccLocationHDFSConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataSyncLocationNFS_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_location_nfs has no testAccLocationNFSConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataSyncLocationS3_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_location_s3 has no testAccLocationS3Config_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataSyncLocationSMB_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_location_smb has no testAccLocationSMBConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDataSyncTask_TagsConsistency(t *testing.T) {
+	t.Skip("aws_datasync_task has no testAccTaskConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
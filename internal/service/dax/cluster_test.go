@@ -21,7 +21,7 @@ func TestAccDAXCluster_basic(t *testing.T) {
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_dax_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dax.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -77,7 +77,7 @@ func TestAccDAXCluster_resize(t *testing.T) {
 	rString := sdkacctest.RandString(10)
 	resourceName := "aws_dax_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dax.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -121,7 +121,7 @@ func TestAccDAXCluster_Encryption_disabled(t *testing.T) {
 	rString := sdkacctest.RandString(10)
 	resourceName := "aws_dax_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dax.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -155,7 +155,7 @@ func TestAccDAXCluster_Encryption_enabled(t *testing.T) {
 	rString := sdkacctest.RandString(10)
 	resourceName := "aws_dax_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dax.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -190,7 +190,7 @@ func TestAccDAXCluster_EndpointEncryption_disabled(t *testing.T) {
 	resourceName := "aws_dax_cluster.test"
 	clusterEndpointEncryptionType := "NONE"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dax.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -224,7 +224,7 @@ func TestAccDAXCluster_EndpointEncryption_enabled(t *testing.T) {
 	resourceName := "aws_dax_cluster.test"
 	clusterEndpointEncryptionType := "TLS"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dax.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
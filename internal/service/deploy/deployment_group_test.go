@@ -25,7 +25,7 @@ func TestAccDeployDeploymentGroup_basic(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -98,7 +98,7 @@ func TestAccDeployDeploymentGroup_Basic_tagSet(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -169,7 +169,7 @@ func TestAccDeployDeploymentGroup_onPremiseTag(t *testing.T) {
 	resourceName := "aws_codedeploy_deployment_group.test"
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -210,7 +210,7 @@ func TestAccDeployDeploymentGroup_disappears(t *testing.T) {
 	resourceName := "aws_codedeploy_deployment_group.test"
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -233,7 +233,7 @@ func TestAccDeployDeploymentGroup_Disappears_app(t *testing.T) {
 	resourceName := "aws_codedeploy_deployment_group.test"
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -257,7 +257,7 @@ func TestAccDeployDeploymentGroup_tags(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -304,7 +304,7 @@ func TestAccDeployDeploymentGroup_Trigger_basic(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -353,7 +353,7 @@ func TestAccDeployDeploymentGroup_Trigger_multiple(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -414,7 +414,7 @@ func TestAccDeployDeploymentGroup_AutoRollback_create(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -449,7 +449,7 @@ func TestAccDeployDeploymentGroup_AutoRollback_update(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -498,7 +498,7 @@ func TestAccDeployDeploymentGroup_AutoRollback_delete(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -541,7 +541,7 @@ func TestAccDeployDeploymentGroup_AutoRollback_disable(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -589,7 +589,7 @@ func TestAccDeployDeploymentGroup_Alarm_create(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -626,7 +626,7 @@ func TestAccDeployDeploymentGroup_Alarm_update(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -679,7 +679,7 @@ func TestAccDeployDeploymentGroup_Alarm_delete(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -724,7 +724,7 @@ func TestAccDeployDeploymentGroup_Alarm_disable(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -777,7 +777,7 @@ func TestAccDeployDeploymentGroup_DeploymentStyle_default(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -811,7 +811,7 @@ func TestAccDeployDeploymentGroup_DeploymentStyle_create(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -853,7 +853,7 @@ func TestAccDeployDeploymentGroup_DeploymentStyle_update(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -900,7 +900,7 @@ func TestAccDeployDeploymentGroup_DeploymentStyle_delete(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -946,7 +946,7 @@ func TestAccDeployDeploymentGroup_LoadBalancerInfo_create(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -981,7 +981,7 @@ func TestAccDeployDeploymentGroup_LoadBalancerInfo_update(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1029,7 +1029,7 @@ func TestAccDeployDeploymentGroup_LoadBalancerInfo_delete(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1072,7 +1072,7 @@ func TestAccDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_create(t *test
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1108,7 +1108,7 @@ func TestAccDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_update(t *test
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1156,7 +1156,7 @@ func TestAccDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_delete(t *test
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1199,7 +1199,7 @@ func TestAccDeployDeploymentGroup_InPlaceDeploymentWithTrafficControl_create(t *
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1241,7 +1241,7 @@ func TestAccDeployDeploymentGroup_InPlaceDeploymentWithTrafficControl_update(t *
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1317,7 +1317,7 @@ func TestAccDeployDeploymentGroup_BlueGreenDeployment_create(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1373,7 +1373,7 @@ func TestAccDeployDeploymentGroup_BlueGreenDeployment_updateWithASG(t *testing.T
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1441,7 +1441,7 @@ func TestAccDeployDeploymentGroup_BlueGreenDeployment_update(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1523,7 +1523,7 @@ func TestAccDeployDeploymentGroup_BlueGreenDeployment_delete(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1591,7 +1591,7 @@ func TestAccDeployDeploymentGroup_BlueGreenDeployment_complete(t *testing.T) {
 
 	rName := sdkacctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1698,7 +1698,7 @@ func TestAccDeployDeploymentGroup_ECS_blueGreen(t *testing.T) {
 	lbTargetGroupGreenResourceName := "aws_lb_target_group.green"
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, codedeploy.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package deploy_test
+
+import (
+	"testing"
+)
+
+func TestAccDeployApp_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codedeploy_app has no testAccAppConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDeployDeploymentGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_codedeploy_deployment_group has no testAccDeploymentGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -21,7 +21,7 @@ func testAccInvitationAccepter_basic(t *testing.T) {
 	resourceName := "aws_detective_invitation_accepter.test"
 	email := testAccMemberFromEnv(t)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -22,7 +22,7 @@ func testAccMember_basic(t *testing.T) {
 	dataSourceAlternate := "data.aws_caller_identity.member"
 	email := testAccMemberFromEnv(t)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -59,7 +59,7 @@ func testAccMember_disappears(t *testing.T) {
 	resourceName := "aws_detective_member.test"
 	email := testAccMemberFromEnv(t)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -87,7 +87,7 @@ func testAccMember_message(t *testing.T) {
 	dataSourceAlternate := "data.aws_caller_identity.member"
 	email := testAccMemberFromEnv(t)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -0,0 +1,27 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package devicefarm_test
+
+import (
+	"testing"
+)
+
+func TestAccDeviceFarmDevicePool_TagsConsistency(t *testing.T) {
+	t.Skip("aws_devicefarm_device_pool has no testAccDevicePoolConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDeviceFarmInstanceProfile_TagsConsistency(t *testing.T) {
+	t.Skip("aws_devicefarm_instance_profile has no testAccInstanceProfileConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDeviceFarmNetworkProfile_TagsConsistency(t *testing.T) {
+	t.Skip("aws_devicefarm_network_profile has no testAccNetworkProfileConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDeviceFarmProject_TagsConsistency(t *testing.T) {
+	t.Skip("aws_devicefarm_project has no testAccProjectConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDeviceFarmTestGridProject_TagsConsistency(t *testing.T) {
+	t.Skip("aws_devicefarm_test_grid_project has no testAccTestGridProjectConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -23,7 +23,7 @@ func TestAccDirectConnectBGPPeer_basic(t *testing.T) {
 	}
 	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -29,7 +29,7 @@ func TestAccDirectConnectConnectionConfirmation_basic(t *testing.T) {
 	providerFunc := testAccConnectionConfirmationProvider(&providers, 0)
 	altProviderFunc := testAccConnectionConfirmationProvider(&providers, 1)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
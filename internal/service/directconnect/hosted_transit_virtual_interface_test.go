@@ -47,7 +47,7 @@ func testAccHostedTransitVirtualInterface_basic(t *testing.T) {
 	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	vlan := sdkacctest.RandIntRange(2049, 4094)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -107,7 +107,7 @@ func testAccHostedTransitVirtualInterface_accepterTags(t *testing.T) {
 	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	vlan := sdkacctest.RandIntRange(2049, 4094)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -11,7 +11,7 @@ import (
 func TestAccDirectConnectLocationDataSource_basic(t *testing.T) {
 	dsResourceName := "data.aws_dx_location.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -29,7 +29,7 @@ func TestAccDirectConnectPrivateVirtualInterface_basic(t *testing.T) {
 	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	vlan := sdkacctest.RandIntRange(2049, 4094)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -101,7 +101,7 @@ func TestAccDirectConnectPrivateVirtualInterface_tags(t *testing.T) {
 	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	vlan := sdkacctest.RandIntRange(2049, 4094)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -180,7 +180,7 @@ func TestAccDirectConnectPrivateVirtualInterface_dxGateway(t *testing.T) {
 	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	vlan := sdkacctest.RandIntRange(2049, 4094)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -232,7 +232,7 @@ func TestAccDirectConnectPrivateVirtualInterface_siteLink(t *testing.T) {
 	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	vlan := sdkacctest.RandIntRange(2049, 4094)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
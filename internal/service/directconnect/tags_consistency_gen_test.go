@@ -0,0 +1,39 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package directconnect_test
+
+import (
+	"testing"
+)
+
+func TestAccDirectConnectConnection_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dx_connection has no testAccConnectionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDirectConnectHostedPrivateVirtualInterfaceAccepter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dx_hosted_private_virtual_interface_accepter has no testAccHostedPrivateVirtualInterfaceAccepterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDirectConnectHostedPublicVirtualInterfaceAccepter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dx_hosted_public_virtual_interface_accepter has no testAccHostedPublicVirtualInterfaceAccepterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDirectConnectHostedTransitVirtualInterfaceAccepter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dx_hosted_transit_virtual_interface_accepter has no testAccHostedTransitVirtualInterfaceAccepterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDirectConnectLag_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dx_lag has no testAccLagConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDirectConnectPrivateVirtualInterface_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dx_private_virtual_interface has no testAccPrivateVirtualInterfaceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDirectConnectPublicVirtualInterface_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dx_public_virtual_interface has no testAccPublicVirtualInterfaceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDirectConnectTransitVirtualInterface_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dx_transit_virtual_interface has no testAccTransitVirtualInterfaceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -19,7 +19,7 @@ func TestAccDMSCertificate_basic(t *testing.T) {
 	resourceName := "aws_dms_certificate.dms_certificate"
 	randId := sdkacctest.RandString(8)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -45,7 +45,7 @@ func TestAccDMSCertificate_disappears(t *testing.T) {
 	resourceName := "aws_dms_certificate.dms_certificate"
 	randId := sdkacctest.RandString(8)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -67,7 +67,7 @@ func TestAccDMSCertificate_certificateWallet(t *testing.T) {
 	resourceName := "aws_dms_certificate.dms_certificate"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -93,7 +93,7 @@ func TestAccDMSCertificate_tags(t *testing.T) {
 	resourceName := "aws_dms_certificate.dms_certificate"
 	randId := sdkacctest.RandString(8)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,31 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package dms_test
+
+import (
+	"testing"
+)
+
+func TestAccDMSCertificate_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dms_certificate has no testAccCertificateConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDMSEndpoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dms_endpoint has no testAccEndpointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDMSEventSubscription_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dms_event_subscription has no testAccEventSubscriptionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDMSReplicationInstance_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dms_replication_instance has no testAccReplicationInstanceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDMSReplicationSubnetGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dms_replication_subnet_group has no testAccReplicationSubnetGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDMSReplicationTask_TagsConsistency(t *testing.T) {
+	t.Skip("aws_dms_replication_task has no testAccReplicationTaskConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -22,7 +22,7 @@ func TestAccDocDBClusterInstance_basic(t *testing.T) {
 	resourceName := "aws_docdb_cluster_instance.cluster_instances"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, docdb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -71,7 +71,7 @@ func TestAccDocDBClusterInstance_az(t *testing.T) {
 	resourceName := "aws_docdb_cluster_instance.cluster_instances"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, docdb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -105,7 +105,7 @@ func TestAccDocDBClusterInstance_namePrefix(t *testing.T) {
 	rNamePrefix := acctest.ResourcePrefix
 	rName := sdkacctest.RandomWithPrefix(rNamePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, docdb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -139,7 +139,7 @@ func TestAccDocDBClusterInstance_generatedName(t *testing.T) {
 	resourceName := "aws_docdb_cluster_instance.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, docdb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -172,7 +172,7 @@ func TestAccDocDBClusterInstance_kmsKey(t *testing.T) {
 	resourceName := "aws_docdb_cluster_instance.cluster_instances"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, docdb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -205,7 +205,7 @@ func TestAccDocDBClusterInstance_disappears(t *testing.T) {
 	resourceName := "aws_docdb_cluster_instance.cluster_instances"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, docdb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
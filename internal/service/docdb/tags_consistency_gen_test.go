@@ -0,0 +1,27 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package docdb_test
+
+import (
+	"testing"
+)
+
+func TestAccDocDBCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_docdb_cluster has no testAccClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDocDBClusterInstance_TagsConsistency(t *testing.T) {
+	t.Skip("aws_docdb_cluster_instance has no testAccClusterInstanceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDocDBClusterParameterGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_docdb_cluster_parameter_group has no testAccClusterParameterGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDocDBEventSubscription_TagsConsistency(t *testing.T) {
+	t.Skip("aws_docdb_event_subscription has no testAccEventSubscriptionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccDocDBSubnetGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_docdb_subnet_group has no testAccSubnetGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -58,7 +59,7 @@ func validateTableItem(v interface{}, k string) (ws []string, errors []error) {
 }
 
 func resourceTableItemCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBAPI()
 
 	tableName := d.Get("table_name").(string)
 	hashKey := d.Get("hash_key").(string)
@@ -94,7 +95,7 @@ func resourceTableItemCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceTableItemUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Updating DynamoDB table %s", d.Id())
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBAPI()
 
 	if d.HasChange("item") {
 		tableName := d.Get("table_name").(string)
@@ -169,7 +170,7 @@ func resourceTableItemUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableItemRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBAPI()
 
 	log.Printf("[DEBUG] Loading data for DynamoDB table item '%s'", d.Id())
 
@@ -209,7 +210,7 @@ func resourceTableItemRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableItemDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBAPI()
 
 	attributes, err := ExpandTableItemAttributes(d.Get("item").(string))
 	if err != nil {
@@ -233,7 +234,7 @@ func resourceTableItemDelete(d *schema.ResourceData, meta interface{}) error {
 
 // Helpers
 
-func FindTableItem(conn *dynamodb.DynamoDB, tableName string, key map[string]*dynamodb.AttributeValue) (*dynamodb.GetItemOutput, error) {
+func FindTableItem(conn dynamodbiface.DynamoDBAPI, tableName string, key map[string]*dynamodb.AttributeValue) (*dynamodb.GetItemOutput, error) {
 	in := &dynamodb.GetItemInput{
 		TableName:      aws.String(tableName),
 		ConsistentRead: aws.Bool(true),
@@ -8,11 +8,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAccDynamoDBTableItem_basic(t *testing.T) {
@@ -28,7 +31,7 @@ func TestAccDynamoDBTableItem_basic(t *testing.T) {
 	"four": {"N": "44444"}
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -63,7 +66,7 @@ func TestAccDynamoDBTableItem_rangeKey(t *testing.T) {
 	"four": {"N": "44444"}
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -107,7 +110,7 @@ func TestAccDynamoDBTableItem_withMultipleItems(t *testing.T) {
 	"four": {"S": "four"}
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -150,7 +153,7 @@ func TestAccDynamoDBTableItem_wonkyItems(t *testing.T) {
 	"three3": {"N": "33333"}
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -192,7 +195,7 @@ func TestAccDynamoDBTableItem_update(t *testing.T) {
 	"new": {"S": "shiny new one"}
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -240,7 +243,7 @@ func TestAccDynamoDBTableItem_updateWithRangeKey(t *testing.T) {
 	"value": {"S": "valueAfter"}
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -286,7 +289,7 @@ func TestAccDynamoDBTableItem_disappears(t *testing.T) {
 	"four": {"N": "44444"}
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -518,3 +521,64 @@ ITEM
 }
 `, tableName, hashKey, rangeKey, item)
 }
+
+func TestDynamoDBTableItem_fake(t *testing.T) {
+	tableName := "tf-fake-table"
+	dynamoDBFake := fake.NewDynamoDB()
+	client := acctest.FakeClient(t, map[string]interface{}{
+		names.DynamoDB: dynamoDBFake,
+	})
+
+	_, err := dynamoDBFake.CreateTable(&dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{{
+			AttributeName: aws.String("hashKey"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		}},
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		KeySchema: []*dynamodb.KeySchemaElement{{
+			AttributeName: aws.String("hashKey"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		}},
+		TableName: aws.String(tableName),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acctest.FakeResourceTest(t, tfdynamodb.ResourceTableItem(), client,
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"table_name": tableName,
+				"hash_key":   "hashKey",
+				"item":       `{"hashKey": {"S": "something"}, "one": {"N": "11111"}, "two": {"N": "22222"}}`,
+			},
+		},
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"table_name": tableName,
+				"hash_key":   "hashKey",
+				"item":       `{"hashKey": {"S": "something"}, "one": {"N": "11111"}, "three": {"S": "33333"}}`,
+			},
+			Check: func(d *schema.ResourceData) error {
+				output, err := tfdynamodb.FindTableItem(client.DynamoDBAPI(), tableName, map[string]*dynamodb.AttributeValue{
+					"hashKey": {S: aws.String("something")},
+				})
+
+				if err != nil {
+					return err
+				}
+
+				if _, ok := output.Item["two"]; ok {
+					return fmt.Errorf("attribute %q not removed", "two")
+				}
+
+				if got, want := aws.StringValue(output.Item["three"].S), "33333"; got != want {
+					return fmt.Errorf("three = %q, want %q", got, want)
+				}
+
+				return nil
+			},
+		},
+	)
+}
@@ -18,7 +18,7 @@ func TestAccEC2AvailabilityZoneGroup_optInStatus(t *testing.T) {
 	// (Not including in config to avoid lintignoring entire config.)
 	localZone := "us-west-2-lax-1" // lintignore:AWSAT003
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckRegion(t, endpoints.UsWest2RegionID) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
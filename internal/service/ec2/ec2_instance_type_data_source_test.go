@@ -11,7 +11,7 @@ import (
 func TestAccEC2InstanceTypeDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_ec2_instance_type.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -72,7 +72,7 @@ func TestAccEC2InstanceTypeDataSource_basic(t *testing.T) {
 func TestAccEC2InstanceTypeDataSource_metal(t *testing.T) {
 	dataSourceName := "data.aws_ec2_instance_type.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -100,7 +100,7 @@ func TestAccEC2InstanceTypeDataSource_metal(t *testing.T) {
 func TestAccEC2InstanceTypeDataSource_gpu(t *testing.T) {
 	dataSourceName := "data.aws_ec2_instance_type.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -122,7 +122,7 @@ func TestAccEC2InstanceTypeDataSource_gpu(t *testing.T) {
 func TestAccEC2InstanceTypeDataSource_fpga(t *testing.T) {
 	dataSourceName := "data.aws_ec2_instance_type.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
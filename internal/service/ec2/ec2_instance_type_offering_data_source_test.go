@@ -11,7 +11,7 @@ import (
 func TestAccEC2InstanceTypeOfferingDataSource_filter(t *testing.T) {
 	dataSourceName := "data.aws_ec2_instance_type_offering.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckInstanceTypeOfferings(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -30,7 +30,7 @@ func TestAccEC2InstanceTypeOfferingDataSource_filter(t *testing.T) {
 func TestAccEC2InstanceTypeOfferingDataSource_locationType(t *testing.T) {
 	dataSourceName := "data.aws_ec2_instance_type_offering.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckInstanceTypeOfferings(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -49,7 +49,7 @@ func TestAccEC2InstanceTypeOfferingDataSource_locationType(t *testing.T) {
 func TestAccEC2InstanceTypeOfferingDataSource_preferredInstanceTypes(t *testing.T) {
 	dataSourceName := "data.aws_ec2_instance_type_offering.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckInstanceTypeOfferings(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
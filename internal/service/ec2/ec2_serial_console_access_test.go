@@ -15,7 +15,7 @@ import (
 func TestAccEC2SerialConsoleAccess_basic(t *testing.T) {
 	resourceName := "aws_ec2_serial_console_access.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
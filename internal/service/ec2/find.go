@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -1617,6 +1618,26 @@ func FindRouteTableByID(conn *ec2.EC2, routeTableID string) (*ec2.RouteTable, er
 	return FindRouteTable(conn, input)
 }
 
+// FindRouteTableByIDCached returns the route table corresponding to the specified identifier,
+// sharing the result with other readers of the same route table through the specified read cache.
+// It must only be used to read state, never to wait for changes.
+// Returns NotFoundError if no route table is found.
+func FindRouteTableByIDCached(conn *ec2.EC2, cache *conns.ReadCache, routeTableID string) (*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		RouteTableIds: aws.StringSlice([]string{routeTableID}),
+	}
+
+	output, err := cache.Fetch("DescribeRouteTables", input, []string{routeTableID}, func() (interface{}, error) {
+		return FindRouteTable(conn, input)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.(*ec2.RouteTable), nil
+}
+
 func FindRouteTable(conn *ec2.EC2, input *ec2.DescribeRouteTablesInput) (*ec2.RouteTable, error) {
 	output, err := FindRouteTables(conn, input)
 
@@ -1672,6 +1693,10 @@ func FindRouteTables(conn *ec2.EC2, input *ec2.DescribeRouteTablesInput) ([]*ec2
 // Returns NotFoundError if no route is found.
 type RouteFinder func(*ec2.EC2, string, string) (*ec2.Route, error)
 
+// RouteSelector returns the route in a route table corresponding to the specified destination.
+// Returns NotFoundError if no route is found.
+type RouteSelector func(*ec2.RouteTable, string) (*ec2.Route, error)
+
 // FindRouteByIPv4Destination returns the route corresponding to the specified IPv4 destination.
 // Returns NotFoundError if no route is found.
 func FindRouteByIPv4Destination(conn *ec2.EC2, routeTableID, destinationCidr string) (*ec2.Route, error) {
@@ -1681,6 +1706,12 @@ func FindRouteByIPv4Destination(conn *ec2.EC2, routeTableID, destinationCidr str
 		return nil, err
 	}
 
+	return SelectRouteByIPv4Destination(routeTable, destinationCidr)
+}
+
+// SelectRouteByIPv4Destination returns the route in the specified route table corresponding to the specified IPv4 destination.
+// Returns NotFoundError if no route is found.
+func SelectRouteByIPv4Destination(routeTable *ec2.RouteTable, destinationCidr string) (*ec2.Route, error) {
 	for _, route := range routeTable.Routes {
 		if verify.CIDRBlocksEqual(aws.StringValue(route.DestinationCidrBlock), destinationCidr) {
 			return route, nil
@@ -1688,7 +1719,7 @@ func FindRouteByIPv4Destination(conn *ec2.EC2, routeTableID, destinationCidr str
 	}
 
 	return nil, &resource.NotFoundError{
-		LastError: fmt.Errorf("Route in Route Table (%s) with IPv4 destination (%s) not found", routeTableID, destinationCidr),
+		LastError: fmt.Errorf("Route in Route Table (%s) with IPv4 destination (%s) not found", aws.StringValue(routeTable.RouteTableId), destinationCidr),
 	}
 }
 
@@ -1701,6 +1732,12 @@ func FindRouteByIPv6Destination(conn *ec2.EC2, routeTableID, destinationIpv6Cidr
 		return nil, err
 	}
 
+	return SelectRouteByIPv6Destination(routeTable, destinationIpv6Cidr)
+}
+
+// SelectRouteByIPv6Destination returns the route in the specified route table corresponding to the specified IPv6 destination.
+// Returns NotFoundError if no route is found.
+func SelectRouteByIPv6Destination(routeTable *ec2.RouteTable, destinationIpv6Cidr string) (*ec2.Route, error) {
 	for _, route := range routeTable.Routes {
 		if verify.CIDRBlocksEqual(aws.StringValue(route.DestinationIpv6CidrBlock), destinationIpv6Cidr) {
 			return route, nil
@@ -1708,7 +1745,7 @@ func FindRouteByIPv6Destination(conn *ec2.EC2, routeTableID, destinationIpv6Cidr
 	}
 
 	return nil, &resource.NotFoundError{
-		LastError: fmt.Errorf("Route in Route Table (%s) with IPv6 destination (%s) not found", routeTableID, destinationIpv6Cidr),
+		LastError: fmt.Errorf("Route in Route Table (%s) with IPv6 destination (%s) not found", aws.StringValue(routeTable.RouteTableId), destinationIpv6Cidr),
 	}
 }
 
@@ -1720,6 +1757,12 @@ func FindRouteByPrefixListIDDestination(conn *ec2.EC2, routeTableID, prefixListI
 		return nil, err
 	}
 
+	return SelectRouteByPrefixListIDDestination(routeTable, prefixListID)
+}
+
+// SelectRouteByPrefixListIDDestination returns the route in the specified route table corresponding to the specified prefix list destination.
+// Returns NotFoundError if no route is found.
+func SelectRouteByPrefixListIDDestination(routeTable *ec2.RouteTable, prefixListID string) (*ec2.Route, error) {
 	for _, route := range routeTable.Routes {
 		if aws.StringValue(route.DestinationPrefixListId) == prefixListID {
 			return route, nil
@@ -1727,7 +1770,7 @@ func FindRouteByPrefixListIDDestination(conn *ec2.EC2, routeTableID, prefixListI
 	}
 
 	return nil, &resource.NotFoundError{
-		LastError: fmt.Errorf("Route in Route Table (%s) with Prefix List ID destination (%s) not found", routeTableID, prefixListID),
+		LastError: fmt.Errorf("Route in Route Table (%s) with Prefix List ID destination (%s) not found", aws.StringValue(routeTable.RouteTableId), prefixListID),
 	}
 }
 
@@ -1752,6 +1795,25 @@ func FindSecurityGroupByID(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error)
 	return output, nil
 }
 
+// FindSecurityGroupByIDCached is FindSecurityGroupByID, sharing the result with other readers
+// of the same security group through the specified read cache.
+// It must only be used to read state, never to wait for changes.
+func FindSecurityGroupByIDCached(conn *ec2.EC2, cache *conns.ReadCache, id string) (*ec2.SecurityGroup, error) {
+	input := &ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := cache.Fetch("DescribeSecurityGroups", input, []string{id}, func() (interface{}, error) {
+		return FindSecurityGroupByID(conn, id)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.(*ec2.SecurityGroup), nil
+}
+
 // FindSecurityGroupByNameAndVPCID looks up a security group by name and VPC ID. Returns a resource.NotFoundError if not found.
 func FindSecurityGroupByNameAndVPCID(conn *ec2.EC2, name, vpcID string) (*ec2.SecurityGroup, error) {
 	input := &ec2.DescribeSecurityGroupsInput{
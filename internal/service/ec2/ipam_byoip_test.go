@@ -47,7 +47,7 @@ func TestAccIPAM_byoipIPv6(t *testing.T) {
 	var associationIPv6 ec2.VpcIpv6CidrBlockAssociation
 	netmaskLength := 56
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccIPAMPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
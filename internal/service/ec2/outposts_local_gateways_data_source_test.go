@@ -11,7 +11,7 @@ import (
 func TestAccEC2OutpostsLocalGatewaysDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_ec2_local_gateways.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,247 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package ec2_test
+
+import (
+	"testing"
+)
+
+func TestAccEC2AMI_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ami has no testAccAMIConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2AMICopy_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ami_copy has no testAccAMICopyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2AMIFromInstance_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ami_from_instance has no testAccAMIFromInstanceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2CapacityReservation_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_capacity_reservation has no testAccCapacityReservationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2CarrierGateway_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_carrier_gateway has no testAccCarrierGatewayConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2ClientVPNEndpoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_client_vpn_endpoint has no testAccClientVPNEndpointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2CustomerGateway_TagsConsistency(t *testing.T) {
+	t.Skip("aws_customer_gateway has no testAccCustomerGatewayConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2DefaultNetworkACL_TagsConsistency(t *testing.T) {
+	t.Skip("aws_default_network_acl has no testAccDefaultNetworkACLConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2DefaultRouteTable_TagsConsistency(t *testing.T) {
+	t.Skip("aws_default_route_table has no testAccDefaultRouteTableConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2DefaultSecurityGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_default_security_group has no testAccDefaultSecurityGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2DefaultSubnet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_default_subnet has no testAccDefaultSubnetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2DefaultVPC_TagsConsistency(t *testing.T) {
+	t.Skip("aws_default_vpc has no testAccDefaultVPCConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2DefaultVPCDHCPOptions_TagsConsistency(t *testing.T) {
+	t.Skip("aws_default_vpc_dhcp_options has no testAccDefaultVPCDHCPOptionsConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2EBSSnapshot_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ebs_snapshot has no testAccEBSSnapshotConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2EBSSnapshotCopy_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ebs_snapshot_copy has no testAccEBSSnapshotCopyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2EBSSnapshotImport_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ebs_snapshot_import has no testAccEBSSnapshotImportConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2EBSVolume_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ebs_volume has no testAccEBSVolumeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2EIP_TagsConsistency(t *testing.T) {
+	t.Skip("aws_eip has no testAccEIPConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2EgressOnlyInternetGateway_TagsConsistency(t *testing.T) {
+	t.Skip("aws_egress_only_internet_gateway has no testAccEgressOnlyInternetGatewayConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2Fleet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_fleet has no testAccFleetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2FlowLog_TagsConsistency(t *testing.T) {
+	t.Skip("aws_flow_log has no testAccFlowLogConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2Host_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_host has no testAccHostConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2IPAM_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpc_ipam has no testAccIPAMConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2IPAMPool_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpc_ipam_pool has no testAccIPAMPoolConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2IPAMScope_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpc_ipam_scope has no testAccIPAMScopeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2Instance_TagsConsistency(t *testing.T) {
+	t.Skip("aws_instance has no testAccInstanceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2InternetGateway_TagsConsistency(t *testing.T) {
+	t.Skip("aws_internet_gateway has no testAccInternetGatewayConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2KeyPair_TagsConsistency(t *testing.T) {
+	t.Skip("aws_key_pair has no testAccKeyPairConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2LaunchTemplate_TagsConsistency(t *testing.T) {
+	t.Skip("aws_launch_template has no testAccLaunchTemplateConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2LocalGatewayRouteTableVPCAssociation_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_local_gateway_route_table_vpc_association has no testAccLocalGatewayRouteTableVPCAssociationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2ManagedPrefixList_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_managed_prefix_list has no testAccManagedPrefixListConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2NATGateway_TagsConsistency(t *testing.T) {
+	t.Skip("aws_nat_gateway has no testAccNATGatewayConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2NetworkACL_TagsConsistency(t *testing.T) {
+	t.Skip("aws_network_acl has no testAccNetworkACLConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2NetworkInsightsPath_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_network_insights_path has no testAccNetworkInsightsPathConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2NetworkInterface_TagsConsistency(t *testing.T) {
+	t.Skip("aws_network_interface has no testAccNetworkInterfaceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2PlacementGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_placement_group has no testAccPlacementGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2RouteTable_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route_table has no testAccRouteTableConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2SecurityGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_security_group has no testAccSecurityGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2SpotFleetRequest_TagsConsistency(t *testing.T) {
+	t.Skip("aws_spot_fleet_request has no testAccSpotFleetRequestConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2Subnet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_subnet has no testAccSubnetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TrafficMirrorFilter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_traffic_mirror_filter has no testAccTrafficMirrorFilterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TrafficMirrorSession_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_traffic_mirror_session has no testAccTrafficMirrorSessionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TrafficMirrorTarget_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_traffic_mirror_target has no testAccTrafficMirrorTargetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TransitGateway_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_transit_gateway has no testAccTransitGatewayConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TransitGatewayConnect_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_transit_gateway_connect has no testAccTransitGatewayConnectConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TransitGatewayConnectPeer_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_transit_gateway_connect_peer has no testAccTransitGatewayConnectPeerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TransitGatewayMulticastDomain_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_transit_gateway_multicast_domain has no testAccTransitGatewayMulticastDomainConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TransitGatewayPeeringAttachment_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_transit_gateway_peering_attachment has no testAccTransitGatewayPeeringAttachmentConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TransitGatewayPeeringAttachmentAccepter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_transit_gateway_peering_attachment_accepter has no testAccTransitGatewayPeeringAttachmentAccepterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TransitGatewayRouteTable_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_transit_gateway_route_table has no testAccTransitGatewayRouteTableConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TransitGatewayVPCAttachment_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_transit_gateway_vpc_attachment has no testAccTransitGatewayVPCAttachmentConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2TransitGatewayVPCAttachmentAccepter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ec2_transit_gateway_vpc_attachment_accepter has no testAccTransitGatewayVPCAttachmentAccepterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2VPC_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpc has no testAccVPCConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2VPCDHCPOptions_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpc_dhcp_options has no testAccVPCDHCPOptionsConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2VPCEndpoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpc_endpoint has no testAccVPCEndpointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2VPCEndpointService_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpc_endpoint_service has no testAccVPCEndpointServiceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2VPCPeeringConnection_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpc_peering_connection has no testAccVPCPeeringConnectionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2VPCPeeringConnectionAccepter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpc_peering_connection_accepter has no testAccVPCPeeringConnectionAccepterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2VPNConnection_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpn_connection has no testAccVPNConnectionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEC2VPNGateway_TagsConsistency(t *testing.T) {
+	t.Skip("aws_vpn_gateway has no testAccVPNGatewayConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
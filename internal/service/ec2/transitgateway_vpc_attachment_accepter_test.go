@@ -21,7 +21,7 @@ func testAccTransitGatewayVPCAttachmentAccepter_basic(t *testing.T) {
 	callerIdentityDatasourceName := "data.aws_caller_identity.creator"
 	rName := fmt.Sprintf("tf-testacc-tgwvpcattach-%s", sdkacctest.RandString(8))
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -68,7 +68,7 @@ func testAccTransitGatewayVPCAttachmentAccepter_Tags(t *testing.T) {
 	callerIdentityDatasourceName := "data.aws_caller_identity.creator"
 	rName := fmt.Sprintf("tf-testacc-tgwvpcattach-%s", sdkacctest.RandString(8))
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -138,7 +138,7 @@ func testAccTransitGatewayVPCAttachmentAccepter_TransitGatewayDefaultRouteTableA
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
 	rName := fmt.Sprintf("tf-testacc-tgwvpcattach-%s", sdkacctest.RandString(8))
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -18,7 +18,7 @@ func TestAccVPCEndpointServiceAllowedPrincipal_basic(t *testing.T) {
 	resourceName := "aws_vpc_endpoint_service_allowed_principal.test"
 	rName := sdkacctest.RandomWithPrefix("tfacctest") // 32 character limit
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
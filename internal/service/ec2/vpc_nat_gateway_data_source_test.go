@@ -17,7 +17,7 @@ func TestAccVPCNATGatewayDataSource_basic(t *testing.T) {
 	dataSourceNameByTags := "data.aws_nat_gateway.test_by_tags"
 	resourceName := "aws_nat_gateway.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
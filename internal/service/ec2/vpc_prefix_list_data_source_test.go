@@ -13,7 +13,7 @@ func TestAccVPCPrefixListDataSource_basic(t *testing.T) {
 	ds1Name := "data.aws_prefix_list.s3_by_id"
 	ds2Name := "data.aws_prefix_list.s3_by_name"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -35,7 +35,7 @@ func TestAccVPCPrefixListDataSource_filter(t *testing.T) {
 	ds1Name := "data.aws_prefix_list.s3_by_id"
 	ds2Name := "data.aws_prefix_list.s3_by_name"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -54,7 +54,7 @@ func TestAccVPCPrefixListDataSource_filter(t *testing.T) {
 }
 
 func TestAccVPCPrefixListDataSource_nameDoesNotOverrideFilter(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
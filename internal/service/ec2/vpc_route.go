@@ -267,22 +267,34 @@ func resourceRouteRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading Route: %w", err)
 	}
 
-	var routeFinder RouteFinder
+	var routeSelector RouteSelector
 
 	switch destinationAttributeKey {
 	case "destination_cidr_block":
-		routeFinder = FindRouteByIPv4Destination
+		routeSelector = SelectRouteByIPv4Destination
 	case "destination_ipv6_cidr_block":
-		routeFinder = FindRouteByIPv6Destination
+		routeSelector = SelectRouteByIPv6Destination
 	case "destination_prefix_list_id":
-		routeFinder = FindRouteByPrefixListIDDestination
+		routeSelector = SelectRouteByPrefixListIDDestination
 	default:
 		return fmt.Errorf("error reading Route: unexpected route destination attribute: %q", destinationAttributeKey)
 	}
 
 	routeTableID := d.Get("route_table_id").(string)
 
-	route, err := routeFinder(conn, routeTableID, destination)
+	var route *ec2.Route
+	var routeTable *ec2.RouteTable
+
+	// A route created moments ago may be missing from a route table that another reader cached.
+	if d.IsNewResource() {
+		routeTable, err = FindRouteTableByID(conn, routeTableID)
+	} else {
+		routeTable, err = FindRouteTableByIDCached(conn, meta.(*conns.AWSClient).ReadCache, routeTableID)
+	}
+
+	if err == nil {
+		route, err = routeSelector(routeTable, destination)
+	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route in Route Table (%s) with destination (%s) not found, removing from state", routeTableID, destination)
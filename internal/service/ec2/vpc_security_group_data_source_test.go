@@ -14,7 +14,7 @@ import (
 
 func TestAccVPCSecurityGroupDataSource_basic(t *testing.T) {
 	rInt := sdkacctest.RandInt()
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
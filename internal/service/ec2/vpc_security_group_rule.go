@@ -281,7 +281,17 @@ information and instructions for recovery. Error: %w`, sg_id, autherr)
 func resourceSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	sg_id := d.Get("security_group_id").(string)
-	sg, err := FindSecurityGroupByID(conn, sg_id)
+
+	var sg *ec2.SecurityGroup
+	var err error
+
+	// A rule created moments ago may be missing from a security group that another reader cached.
+	if d.IsNewResource() {
+		sg, err = FindSecurityGroupByID(conn, sg_id)
+	} else {
+		sg, err = FindSecurityGroupByIDCached(conn, meta.(*conns.AWSClient).ReadCache, sg_id)
+	}
+
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Group (%s) not found, removing Rule (%s) from state", sg_id, d.Id())
 		d.SetId("")
@@ -30,7 +30,7 @@ func TestAccVPCTrafficMirrorFilterRule_basic(t *testing.T) {
 	dstPortFrom := 10000
 	dstPortTo := 10001
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorFilterRule(t)
@@ -113,7 +113,7 @@ func TestAccVPCTrafficMirrorFilterRule_disappears(t *testing.T) {
 	action := "accept"
 	direction := "ingress"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorFilterRule(t)
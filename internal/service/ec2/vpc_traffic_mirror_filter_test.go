@@ -20,7 +20,7 @@ func TestAccVPCTrafficMirrorFilter_basic(t *testing.T) {
 	resourceName := "aws_ec2_traffic_mirror_filter.test"
 	description := "test filter"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorFilter(t)
@@ -70,7 +70,7 @@ func TestAccVPCTrafficMirrorFilter_tags(t *testing.T) {
 	var v ec2.TrafficMirrorFilter
 	resourceName := "aws_ec2_traffic_mirror_filter.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorFilter(t)
@@ -118,7 +118,7 @@ func TestAccVPCTrafficMirrorFilter_disappears(t *testing.T) {
 	resourceName := "aws_ec2_traffic_mirror_filter.test"
 	description := "test filter"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorFilter(t)
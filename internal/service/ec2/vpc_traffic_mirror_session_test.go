@@ -26,7 +26,7 @@ func TestAccVPCTrafficMirrorSession_basic(t *testing.T) {
 	pLen := sdkacctest.RandIntRange(1, 255)
 	vni := sdkacctest.RandIntRange(1, 16777216)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorSession(t)
@@ -87,7 +87,7 @@ func TestAccVPCTrafficMirrorSession_tags(t *testing.T) {
 	session := sdkacctest.RandIntRange(1, 32766)
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorSession(t)
@@ -136,7 +136,7 @@ func TestAccVPCTrafficMirrorSession_disappears(t *testing.T) {
 	session := sdkacctest.RandIntRange(1, 32766)
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorSession(t)
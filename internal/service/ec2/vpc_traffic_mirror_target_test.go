@@ -22,7 +22,7 @@ func TestAccVPCTrafficMirrorTarget_nlb(t *testing.T) {
 	description := "test nlb target"
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorTarget(t)
@@ -57,7 +57,7 @@ func TestAccVPCTrafficMirrorTarget_eni(t *testing.T) {
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 	description := "test eni target"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorTarget(t)
@@ -90,7 +90,7 @@ func TestAccVPCTrafficMirrorTarget_tags(t *testing.T) {
 	description := "test nlb target"
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorTarget(t)
@@ -139,7 +139,7 @@ func TestAccVPCTrafficMirrorTarget_disappears(t *testing.T) {
 	description := "test nlb target"
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckTrafficMirrorTarget(t)
@@ -17,7 +17,7 @@ func TestAccSiteVPNCustomerGatewayDataSource_filter(t *testing.T) {
 	asn := sdkacctest.RandIntRange(64512, 65534)
 	hostOctet := sdkacctest.RandIntRange(1, 254)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -46,7 +46,7 @@ func TestAccSiteVPNCustomerGatewayDataSource_id(t *testing.T) {
 	asn := sdkacctest.RandIntRange(64512, 65534)
 	hostOctet := sdkacctest.RandIntRange(1, 254)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -16,7 +16,7 @@ func TestAccECRImageDataSource_ecrImage(t *testing.T) {
 	resourceByTag := "data.aws_ecr_image.by_tag"
 	resourceByDigest := "data.aws_ecr_image.by_digest"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ecr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
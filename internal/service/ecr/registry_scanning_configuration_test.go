@@ -30,7 +30,7 @@ func testAccRegistryScanningConfiguration_basic(t *testing.T) {
 	var v ecr.GetRegistryScanningConfigurationOutput
 	resourceName := "aws_ecr_registry_scanning_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ecr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -58,7 +58,7 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	var v ecr.GetRegistryScanningConfigurationOutput
 	resourceName := "aws_ecr_registry_scanning_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ecr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -18,7 +18,7 @@ func TestAccECSAccountSettingDefault_containerInstanceLongARNFormat(t *testing.T
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameContainerInstanceLongArnFormat
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -46,7 +46,7 @@ func TestAccECSAccountSettingDefault_serviceLongARNFormat(t *testing.T) {
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameServiceLongArnFormat
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -74,7 +74,7 @@ func TestAccECSAccountSettingDefault_taskLongARNFormat(t *testing.T) {
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameTaskLongArnFormat
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -102,7 +102,7 @@ func TestAccECSAccountSettingDefault_vpcTrunking(t *testing.T) {
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameAwsvpcTrunking
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -130,7 +130,7 @@ func TestAccECSAccountSettingDefault_containerInsights(t *testing.T) {
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameContainerInsights
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
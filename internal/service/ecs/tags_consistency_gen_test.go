@@ -0,0 +1,27 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package ecs_test
+
+import (
+	"testing"
+)
+
+func TestAccECSCapacityProvider_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ecs_capacity_provider has no testAccCapacityProviderConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccECSCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ecs_cluster has no testAccClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccECSService_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ecs_service has no testAccServiceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccECSTaskDefinition_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ecs_task_definition has no testAccTaskDefinitionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccECSTaskSet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ecs_task_set has no testAccTaskSetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -15,7 +15,7 @@ func TestAccEFSAccessPointDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_efs_access_point.test"
 	resourceName := "aws_efs_access_point.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, efs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -11,7 +11,7 @@ import (
 func TestAccEFSAccessPointsDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_efs_access_points.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, efs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -31,7 +31,7 @@ func TestAccEFSAccessPointsDataSource_basic(t *testing.T) {
 func TestAccEFSAccessPointsDataSource_empty(t *testing.T) {
 	dataSourceName := "data.aws_efs_access_points.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, efs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
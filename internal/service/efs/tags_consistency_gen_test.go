@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package efs_test
+
+import (
+	"testing"
+)
+
+func TestAccEFSAccessPoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_efs_access_point has no testAccAccessPointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEFSFileSystem_TagsConsistency(t *testing.T) {
+	t.Skip("aws_efs_file_system has no testAccFileSystemConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -19,7 +19,7 @@ func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
 	addonName := "vpc-cni"
 	ctx := context.TODO()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckAddon(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
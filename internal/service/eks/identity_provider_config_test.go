@@ -23,7 +23,7 @@ func TestAccEKSIdentityProviderConfig_basic(t *testing.T) {
 	resourceName := "aws_eks_identity_provider_config.test"
 	ctx := context.TODO()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -66,7 +66,7 @@ func TestAccEKSIdentityProviderConfig_disappears(t *testing.T) {
 	resourceName := "aws_eks_identity_provider_config.test"
 	ctx := context.TODO()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -90,7 +90,7 @@ func TestAccEKSIdentityProviderConfig_allOIDCOptions(t *testing.T) {
 	resourceName := "aws_eks_identity_provider_config.test"
 	ctx := context.TODO()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -128,7 +128,7 @@ func TestAccEKSIdentityProviderConfig_tags(t *testing.T) {
 	resourceName := "aws_eks_identity_provider_config.test"
 	ctx := context.TODO()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
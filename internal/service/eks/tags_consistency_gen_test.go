@@ -0,0 +1,27 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package eks_test
+
+import (
+	"testing"
+)
+
+func TestAccEKSAddon_TagsConsistency(t *testing.T) {
+	t.Skip("aws_eks_addon has no testAccAddonConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEKSCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_eks_cluster has no testAccClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEKSFargateProfile_TagsConsistency(t *testing.T) {
+	t.Skip("aws_eks_fargate_profile has no testAccFargateProfileConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEKSIdentityProviderConfig_TagsConsistency(t *testing.T) {
+	t.Skip("aws_eks_identity_provider_config has no testAccIdentityProviderConfigConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEKSNodeGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_eks_node_group has no testAccNodeGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
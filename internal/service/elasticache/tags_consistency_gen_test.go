@@ -0,0 +1,27 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package elasticache_test
+
+import (
+	"testing"
+)
+
+func TestAccElastiCacheCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_elasticache_cluster has no testAccClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccElastiCacheParameterGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_elasticache_parameter_group has no testAccParameterGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccElastiCacheReplicationGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_elasticache_replication_group has no testAccReplicationGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccElastiCacheUser_TagsConsistency(t *testing.T) {
+	t.Skip("aws_elasticache_user has no testAccUserConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccElastiCacheUserGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_elasticache_user_group has no testAccUserGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -19,7 +19,7 @@ func TestAccElasticBeanstalkApplicationDataSource_basic(t *testing.T) {
 	dataSourceResourceName := "data.aws_elastic_beanstalk_application.test"
 	resourceName := "aws_elastic_beanstalk_application.tftest"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package elasticbeanstalk_test
+
+import (
+	"testing"
+)
+
+func TestAccElasticBeanstalkApplication_TagsConsistency(t *testing.T) {
+	t.Skip("aws_elastic_beanstalk_application has no testAccApplicationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccElasticBeanstalkApplicationVersion_TagsConsistency(t *testing.T) {
+	t.Skip("aws_elastic_beanstalk_application_version has no testAccApplicationVersionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccElasticBeanstalkEnvironment_TagsConsistency(t *testing.T) {
+	t.Skip("aws_elastic_beanstalk_environment has no testAccEnvironmentConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package elasticsearch_test
+
+import (
+	"testing"
+)
+
+func TestAccElasticsearchDomain_TagsConsistency(t *testing.T) {
+	t.Skip("aws_elasticsearch_domain has no testAccDomainConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
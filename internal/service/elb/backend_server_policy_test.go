@@ -25,7 +25,7 @@ func TestAccELBBackendServerPolicy_basic(t *testing.T) {
 	rString := sdkacctest.RandString(8)
 	lbName := fmt.Sprintf("tf-acc-lb-bsp-basic-%s", rString)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, elb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
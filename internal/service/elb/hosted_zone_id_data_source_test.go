@@ -10,7 +10,7 @@ import (
 )
 
 func TestAccELBHostedZoneIDDataSource_basic(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, elb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -18,7 +18,7 @@ import (
 func TestAccELBCookieStickinessPolicy_basic(t *testing.T) {
 	lbName := fmt.Sprintf("tf-test-lb-%s", sdkacctest.RandString(5))
 	resourceName := "aws_lb_cookie_stickiness_policy.foo"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, elb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -109,7 +109,7 @@ func TestAccELBCookieStickinessPolicy_disappears(t *testing.T) {
 	elbResourceName := "aws_elb.lb"
 	resourceName := "aws_lb_cookie_stickiness_policy.foo"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, elb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -132,7 +132,7 @@ func TestAccELBCookieStickinessPolicy_Disappears_elb(t *testing.T) {
 	elbResourceName := "aws_elb.lb"
 	resourceName := "aws_lb_cookie_stickiness_policy.foo"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, elb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccELBListenerPolicy_basic(t *testing.T) {
 	rChar := sdkacctest.RandStringFromCharSet(6, sdkacctest.CharSetAlpha)
 	lbName := rChar
 	mcName := rChar
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, elb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package elbv2_test
+
+import (
+	"testing"
+)
+
+func TestAccELBV2Listener_TagsConsistency(t *testing.T) {
+	t.Skip("aws_lb_listener has no testAccListenerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccELBV2ListenerRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_lb_listener_rule has no testAccListenerRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccELBV2LoadBalancer_TagsConsistency(t *testing.T) {
+	t.Skip("aws_lb has no testAccLoadBalancerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccELBV2TargetGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_lb_target_group has no testAccTargetGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
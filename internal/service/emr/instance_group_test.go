@@ -21,7 +21,7 @@ func TestAccEMRInstanceGroup_basic(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
 	resourceName := "aws_emr_instance_group.task"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -52,7 +52,7 @@ func TestAccEMRInstanceGroup_bidPrice(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
 	resourceName := "aws_emr_instance_group.task"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -104,7 +104,7 @@ func TestAccEMRInstanceGroup_sJSON(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
 	resourceName := "aws_emr_instance_group.task"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -147,7 +147,7 @@ func TestAccEMRInstanceGroup_autoScalingPolicy(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
 	resourceName := "aws_emr_instance_group.task"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -192,7 +192,7 @@ func TestAccEMRInstanceGroup_instanceCount(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
 	resourceName := "aws_emr_instance_group.task"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -225,7 +225,7 @@ func TestAccEMRInstanceGroup_Disappears_emrCluster(t *testing.T) {
 	emrClusterResourceName := "aws_emr_cluster.tf-test-cluster"
 	resourceName := "aws_emr_instance_group.task"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -249,7 +249,7 @@ func TestAccEMRInstanceGroup_EBS_ebsOptimized(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
 	resourceName := "aws_emr_instance_group.task"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -11,7 +11,7 @@ import (
 func TestAccEMRReleaseLabels_basic(t *testing.T) {
 	dataSourceResourceName := "data.aws_emr_release_labels.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -30,7 +30,7 @@ func TestAccEMRReleaseLabels_basic(t *testing.T) {
 func TestAccEMRReleaseLabels_prefix(t *testing.T) {
 	dataSourceResourceName := "data.aws_emr_release_labels.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -49,7 +49,7 @@ func TestAccEMRReleaseLabels_prefix(t *testing.T) {
 func TestAccEMRReleaseLabels_application(t *testing.T) {
 	dataSourceResourceName := "data.aws_emr_release_labels.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -68,7 +68,7 @@ func TestAccEMRReleaseLabels_application(t *testing.T) {
 func TestAccEMRReleaseLabels_full(t *testing.T) {
 	dataSourceResourceName := "data.aws_emr_release_labels.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -87,7 +87,7 @@ func TestAccEMRReleaseLabels_full(t *testing.T) {
 func TestAccEMRReleaseLabels_empty(t *testing.T) {
 	dataSourceResourceName := "data.aws_emr_release_labels.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, emr.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
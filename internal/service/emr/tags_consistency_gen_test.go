@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package emr_test
+
+import (
+	"testing"
+)
+
+func TestAccEMRCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_emr_cluster has no testAccClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEMRStudio_TagsConsistency(t *testing.T) {
+	t.Skip("aws_emr_studio has no testAccStudioConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package emrcontainers_test
+
+import (
+	"testing"
+)
+
+func TestAccEMRContainersVirtualCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_emrcontainers_virtual_cluster has no testAccVirtualClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -6,13 +6,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/aws/aws-sdk-go/service/emrcontainers/emrcontainersiface"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // ListTags lists emrcontainers service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ListTags(conn *emrcontainers.EMRContainers, identifier string) (tftags.KeyValueTags, error) {
+func ListTags(conn emrcontainersiface.EMRContainersAPI, identifier string) (tftags.KeyValueTags, error) {
 	input := &emrcontainers.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
@@ -41,7 +42,7 @@ func KeyValueTags(tags map[string]*string) tftags.KeyValueTags {
 // UpdateTags updates emrcontainers service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func UpdateTags(conn *emrcontainers.EMRContainers, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+func UpdateTags(conn emrcontainersiface.EMRContainersAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package emrserverless_test
+
+import (
+	"testing"
+)
+
+func TestAccEMRServerlessApplication_TagsConsistency(t *testing.T) {
+	t.Skip("aws_emrserverless_application has no testAccApplicationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -29,7 +29,7 @@ func TestAccEventsAPIDestination_basic(t *testing.T) {
 
 	resourceName := "aws_cloudwatch_event_api_destination.basic"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -102,7 +102,7 @@ func TestAccEventsAPIDestination_optional(t *testing.T) {
 
 	resourceName := "aws_cloudwatch_event_api_destination.optional"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -178,7 +178,7 @@ func TestAccEventsAPIDestination_disappears(t *testing.T) {
 
 	resourceName := "aws_cloudwatch_event_api_destination.basic"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
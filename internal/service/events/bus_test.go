@@ -23,7 +23,7 @@ func TestAccEventsBus_basic(t *testing.T) {
 
 	resourceName := "aws_cloudwatch_event_bus.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -74,7 +74,7 @@ func TestAccEventsBus_tags(t *testing.T) {
 
 	resourceName := "aws_cloudwatch_event_bus.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -125,7 +125,7 @@ func TestAccEventsBus_tags(t *testing.T) {
 }
 
 func TestAccEventsBus_default(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -145,7 +145,7 @@ func TestAccEventsBus_disappears(t *testing.T) {
 
 	resourceName := "aws_cloudwatch_event_bus.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -173,7 +173,7 @@ func TestAccEventsBus_partnerEventSource(t *testing.T) {
 	var busOutput eventbridge.DescribeEventBusOutput
 	resourceName := "aws_cloudwatch_event_bus.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
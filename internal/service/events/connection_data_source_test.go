@@ -18,7 +18,7 @@ func TestAccEventsConnectionDataSource_Connection_basic(t *testing.T) {
 	key := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	value := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,723 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Event pattern grammar.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html
+// and https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns-content-based-filtering.html.
+
+const (
+	eventPatternOr = "$or"
+
+	eventPatternMatchAnythingBut      = "anything-but"
+	eventPatternMatchCIDR             = "cidr"
+	eventPatternMatchEqualsIgnoreCase = "equals-ignore-case"
+	eventPatternMatchExists           = "exists"
+	eventPatternMatchNumeric          = "numeric"
+	eventPatternMatchPrefix           = "prefix"
+	eventPatternMatchSuffix           = "suffix"
+	eventPatternMatchWildcard         = "wildcard"
+
+	// Numeric matching only works with values in this range.
+	eventPatternNumericMax = 5.0e9
+)
+
+// eventPattern is a parsed event pattern.
+// An event matches the pattern if every field matches and at least one alternative of every $or matches.
+type eventPattern struct {
+	fields []*eventPatternField
+	ors    [][]*eventPattern
+}
+
+// eventPatternField holds the match values for a single field, identified by its path from the root of the event.
+// The field matches if any of the match values matches.
+type eventPatternField struct {
+	matchers []eventPatternMatcher
+	path     []string
+}
+
+// eventPatternMatcher matches the leaf values found at a field's path in an event.
+// values is empty if the field isn't present in the event.
+type eventPatternMatcher func(values []interface{}) bool
+
+// eventPatternUnsupportedError is returned for a match type that the parser doesn't recognize.
+// EventBridge may support match types added after this parser was written, so the pattern isn't necessarily invalid.
+type eventPatternUnsupportedError struct {
+	anythingBut bool
+	matchType   string
+}
+
+func (e *eventPatternUnsupportedError) Error() string {
+	if e.anythingBut {
+		return fmt.Sprintf("unsupported %q match type %q", eventPatternMatchAnythingBut, e.matchType)
+	}
+
+	return fmt.Sprintf("unsupported match type %q", e.matchType)
+}
+
+// isEventPatternUnsupportedError returns whether the error is due to a match type that the parser doesn't recognize.
+func isEventPatternUnsupportedError(err error) bool {
+	var unsupportedErr *eventPatternUnsupportedError
+
+	return errors.As(err, &unsupportedErr)
+}
+
+// parseEventPattern parses and validates an event pattern.
+func parseEventPattern(s string) (*eventPattern, error) {
+	v, err := decodeEventPatternJSON(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("event pattern must be a JSON object")
+	}
+
+	return parseEventPatternObject(tfMap, nil)
+}
+
+func parseEventPatternObject(tfMap map[string]interface{}, path []string) (*eventPattern, error) {
+	if len(path) > 0 && len(tfMap) == 0 {
+		return nil, fmt.Errorf("%s: object cannot be empty", eventPatternPath(path))
+	}
+
+	pattern := &eventPattern{}
+
+	for _, key := range eventPatternSortedKeys(tfMap) {
+		switch v := tfMap[key].(type) {
+		case []interface{}:
+			if key == eventPatternOr {
+				alternatives, err := parseEventPatternOr(v, path)
+
+				if err != nil {
+					return nil, err
+				}
+
+				pattern.ors = append(pattern.ors, alternatives)
+
+				continue
+			}
+
+			field, err := parseEventPatternField(v, eventPatternAppendPath(path, key))
+
+			if err != nil {
+				return nil, err
+			}
+
+			pattern.fields = append(pattern.fields, field)
+
+		case map[string]interface{}:
+			if key == eventPatternOr {
+				return nil, fmt.Errorf("%s: must be an array of objects", eventPatternPath(eventPatternAppendPath(path, key)))
+			}
+
+			nested, err := parseEventPatternObject(v, eventPatternAppendPath(path, key))
+
+			if err != nil {
+				return nil, err
+			}
+
+			pattern.fields = append(pattern.fields, nested.fields...)
+			pattern.ors = append(pattern.ors, nested.ors...)
+
+		default:
+			return nil, fmt.Errorf("%s: must be an object or an array of match values, got %s", eventPatternPath(eventPatternAppendPath(path, key)), eventPatternJSON(v))
+		}
+	}
+
+	return pattern, nil
+}
+
+func parseEventPatternOr(tfList []interface{}, path []string) ([]*eventPattern, error) {
+	orPath := eventPatternAppendPath(path, eventPatternOr)
+
+	if len(tfList) < 2 {
+		return nil, fmt.Errorf("%s: must contain at least 2 objects", eventPatternPath(orPath))
+	}
+
+	var alternatives []*eventPattern
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			return nil, fmt.Errorf("%s[%d]: must be an object, got %s", eventPatternPath(orPath), i, eventPatternJSON(v))
+		}
+
+		if len(tfMap) == 0 {
+			return nil, fmt.Errorf("%s[%d]: object cannot be empty", eventPatternPath(orPath), i)
+		}
+
+		// Fields in each alternative are relative to the object containing the $or.
+		alternative, err := parseEventPatternObject(tfMap, path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		alternatives = append(alternatives, alternative)
+	}
+
+	return alternatives, nil
+}
+
+func parseEventPatternField(tfList []interface{}, path []string) (*eventPatternField, error) {
+	if len(tfList) == 0 {
+		return nil, fmt.Errorf("%s: array of match values cannot be empty", eventPatternPath(path))
+	}
+
+	field := &eventPatternField{
+		path: path,
+	}
+
+	for i, v := range tfList {
+		var matcher eventPatternMatcher
+		var err error
+
+		switch v := v.(type) {
+		case nil, bool, json.Number, string:
+			matcher = eventPatternExactMatcher(v)
+		case map[string]interface{}:
+			matcher, err = parseEventPatternMatchObject(v)
+		default:
+			err = fmt.Errorf("must be a string, number, boolean, null or object, got %s", eventPatternJSON(v))
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", eventPatternPath(path), i, err)
+		}
+
+		field.matchers = append(field.matchers, matcher)
+	}
+
+	return field, nil
+}
+
+func parseEventPatternMatchObject(tfMap map[string]interface{}) (eventPatternMatcher, error) {
+	if len(tfMap) != 1 {
+		return nil, fmt.Errorf("match object must contain exactly one of %q, %q, %q, %q, %q, %q, %q or %q, got %s",
+			eventPatternMatchAnythingBut, eventPatternMatchCIDR, eventPatternMatchEqualsIgnoreCase, eventPatternMatchExists, eventPatternMatchNumeric, eventPatternMatchPrefix, eventPatternMatchSuffix, eventPatternMatchWildcard, eventPatternJSON(tfMap))
+	}
+
+	for key, v := range tfMap {
+		switch key {
+		case eventPatternMatchAnythingBut:
+			return parseEventPatternAnythingBut(v)
+
+		case eventPatternMatchCIDR:
+			s, ok := v.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("%q must be a string, got %s", key, eventPatternJSON(v))
+			}
+
+			_, ipNet, err := net.ParseCIDR(s)
+
+			if err != nil {
+				return nil, fmt.Errorf("%q must be a valid CIDR block, got %q", key, s)
+			}
+
+			return eventPatternCIDRMatcher(ipNet), nil
+
+		case eventPatternMatchExists:
+			exists, ok := v.(bool)
+
+			if !ok {
+				return nil, fmt.Errorf("%q must be true or false, got %s", key, eventPatternJSON(v))
+			}
+
+			return func(values []interface{}) bool {
+				return (len(values) > 0) == exists
+			}, nil
+
+		case eventPatternMatchNumeric:
+			return parseEventPatternNumeric(v)
+
+		case eventPatternMatchEqualsIgnoreCase, eventPatternMatchPrefix, eventPatternMatchSuffix, eventPatternMatchWildcard:
+			s, ok := v.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("%q must be a string, got %s", key, eventPatternJSON(v))
+			}
+
+			return eventPatternStringMatchTypeMatcher(key, s)
+
+		default:
+			return nil, &eventPatternUnsupportedError{matchType: key}
+		}
+	}
+
+	return nil, nil
+}
+
+func parseEventPatternAnythingBut(v interface{}) (eventPatternMatcher, error) {
+	switch v := v.(type) {
+	case json.Number, string:
+		return eventPatternNotMatcher(eventPatternExactMatcher(v)), nil
+
+	case []interface{}:
+		if len(v) == 0 {
+			return nil, fmt.Errorf("%q array cannot be empty", eventPatternMatchAnythingBut)
+		}
+
+		var matchers []eventPatternMatcher
+		_, isString := v[0].(string)
+
+		for _, v := range v {
+			switch v.(type) {
+			case json.Number, string:
+				if _, ok := v.(string); ok != isString {
+					return nil, fmt.Errorf("%q array must contain only strings or only numbers, got %s", eventPatternMatchAnythingBut, eventPatternJSON(v))
+				}
+			default:
+				return nil, fmt.Errorf("%q array must contain only strings or only numbers, got %s", eventPatternMatchAnythingBut, eventPatternJSON(v))
+			}
+
+			matchers = append(matchers, eventPatternExactMatcher(v))
+		}
+
+		return eventPatternNotMatcher(eventPatternAnyMatcher(matchers)), nil
+
+	case map[string]interface{}:
+		if len(v) != 1 {
+			return nil, fmt.Errorf("%q object must contain exactly one of %q, %q, %q or %q, got %s",
+				eventPatternMatchAnythingBut, eventPatternMatchEqualsIgnoreCase, eventPatternMatchPrefix, eventPatternMatchSuffix, eventPatternMatchWildcard, eventPatternJSON(v))
+		}
+
+		for key, v := range v {
+			switch key {
+			case eventPatternMatchEqualsIgnoreCase, eventPatternMatchPrefix, eventPatternMatchSuffix, eventPatternMatchWildcard:
+			default:
+				return nil, &eventPatternUnsupportedError{anythingBut: true, matchType: key}
+			}
+
+			var values []string
+
+			switch v := v.(type) {
+			case string:
+				values = []string{v}
+			case []interface{}:
+				// Only equals-ignore-case and wildcard accept an array of strings.
+				if key != eventPatternMatchEqualsIgnoreCase && key != eventPatternMatchWildcard {
+					return nil, fmt.Errorf("%q %q must be a string, got %s", eventPatternMatchAnythingBut, key, eventPatternJSON(v))
+				}
+
+				if len(v) == 0 {
+					return nil, fmt.Errorf("%q %q array cannot be empty", eventPatternMatchAnythingBut, key)
+				}
+
+				for _, v := range v {
+					s, ok := v.(string)
+
+					if !ok {
+						return nil, fmt.Errorf("%q %q array must contain only strings, got %s", eventPatternMatchAnythingBut, key, eventPatternJSON(v))
+					}
+
+					values = append(values, s)
+				}
+			default:
+				return nil, fmt.Errorf("%q %q must be a string, got %s", eventPatternMatchAnythingBut, key, eventPatternJSON(v))
+			}
+
+			var matchers []eventPatternMatcher
+
+			for _, s := range values {
+				matcher, err := eventPatternStringMatchTypeMatcher(key, s)
+
+				if err != nil {
+					return nil, fmt.Errorf("%q %w", eventPatternMatchAnythingBut, err)
+				}
+
+				matchers = append(matchers, matcher)
+			}
+
+			return eventPatternNotMatcher(eventPatternAnyMatcher(matchers)), nil
+		}
+
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("%q must be a string, number, array or object, got %s", eventPatternMatchAnythingBut, eventPatternJSON(v))
+	}
+}
+
+func parseEventPatternNumeric(v interface{}) (eventPatternMatcher, error) {
+	tfList, ok := v.([]interface{})
+
+	if !ok || (len(tfList) != 2 && len(tfList) != 4) {
+		return nil, fmt.Errorf("%q must be an array of one or two operator and number pairs, got %s", eventPatternMatchNumeric, eventPatternJSON(v))
+	}
+
+	type comparison struct {
+		operator string
+		value    float64
+	}
+
+	var comparisons []comparison
+
+	for i := 0; i < len(tfList); i += 2 {
+		operator, ok := tfList[i].(string)
+
+		if !ok {
+			return nil, fmt.Errorf("%q operator must be a string, got %s", eventPatternMatchNumeric, eventPatternJSON(tfList[i]))
+		}
+
+		switch operator {
+		case "<", "<=", "=", ">", ">=":
+		default:
+			return nil, fmt.Errorf("%q operator must be one of \"<\", \"<=\", \"=\", \">\" or \">=\", got %q", eventPatternMatchNumeric, operator)
+		}
+
+		n, ok := tfList[i+1].(json.Number)
+
+		if !ok {
+			return nil, fmt.Errorf("%q value for %q must be a number, got %s", eventPatternMatchNumeric, operator, eventPatternJSON(tfList[i+1]))
+		}
+
+		value, err := n.Float64()
+
+		if err != nil || math.Abs(value) > eventPatternNumericMax {
+			return nil, fmt.Errorf("%q value for %q must be between %g and %g, got %s", eventPatternMatchNumeric, operator, -eventPatternNumericMax, eventPatternNumericMax, n)
+		}
+
+		comparisons = append(comparisons, comparison{operator: operator, value: value})
+	}
+
+	if len(comparisons) == 2 {
+		lower, upper := comparisons[0], comparisons[1]
+
+		if lower.operator != ">" && lower.operator != ">=" {
+			return nil, fmt.Errorf("%q range must start with \">\" or \">=\", got %q", eventPatternMatchNumeric, lower.operator)
+		}
+
+		if upper.operator != "<" && upper.operator != "<=" {
+			return nil, fmt.Errorf("%q range must end with \"<\" or \"<=\", got %q", eventPatternMatchNumeric, upper.operator)
+		}
+
+		if lower.value >= upper.value {
+			return nil, fmt.Errorf("%q range lower bound (%g) must be less than its upper bound (%g)", eventPatternMatchNumeric, lower.value, upper.value)
+		}
+	}
+
+	return eventPatternNumberMatcher(func(value float64) bool {
+		for _, c := range comparisons {
+			var ok bool
+
+			switch c.operator {
+			case "<":
+				ok = value < c.value
+			case "<=":
+				ok = value <= c.value
+			case "=":
+				ok = value == c.value
+			case ">":
+				ok = value > c.value
+			case ">=":
+				ok = value >= c.value
+			}
+
+			if !ok {
+				return false
+			}
+		}
+
+		return true
+	}), nil
+}
+
+// eventPatternExactMatcher matches values equal to the specified value.
+// Numbers are compared by value so that, for example, 300 matches 3.0e2.
+func eventPatternExactMatcher(expected interface{}) eventPatternMatcher {
+	return eventPatternValueMatcher(func(value interface{}) bool {
+		switch expected := expected.(type) {
+		case json.Number:
+			actual, ok := value.(json.Number)
+
+			if !ok {
+				return false
+			}
+
+			x, err1 := expected.Float64()
+			y, err2 := actual.Float64()
+
+			if err1 != nil || err2 != nil {
+				return expected == actual
+			}
+
+			return x == y
+		default:
+			return expected == value
+		}
+	})
+}
+
+// eventPatternStringMatchTypeMatcher returns the matcher for a match type whose operand is a string.
+func eventPatternStringMatchTypeMatcher(matchType, s string) (eventPatternMatcher, error) {
+	switch matchType {
+	case eventPatternMatchEqualsIgnoreCase:
+		return eventPatternStringMatcher(func(value string) bool {
+			return strings.EqualFold(value, s)
+		}), nil
+	case eventPatternMatchPrefix:
+		return eventPatternStringMatcher(func(value string) bool {
+			return strings.HasPrefix(value, s)
+		}), nil
+	case eventPatternMatchSuffix:
+		return eventPatternStringMatcher(func(value string) bool {
+			return strings.HasSuffix(value, s)
+		}), nil
+	case eventPatternMatchWildcard:
+		re, err := eventPatternWildcardRegexp(s)
+
+		if err != nil {
+			return nil, fmt.Errorf("%q %w, got %q", matchType, err, s)
+		}
+
+		return eventPatternStringMatcher(re.MatchString), nil
+	}
+
+	return nil, &eventPatternUnsupportedError{matchType: matchType}
+}
+
+// eventPatternWildcardRegexp returns the regular expression for a wildcard pattern,
+// in which "*" matches any sequence of characters and "\" escapes "*" or "\".
+func eventPatternWildcardRegexp(s string) (*regexp.Regexp, error) {
+	var b strings.Builder
+
+	b.WriteString(`^(?s)`)
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '\\':
+			if i+1 == len(s) || (s[i+1] != '*' && s[i+1] != '\\') {
+				return nil, errors.New(`must only escape "*" or "\"`)
+			}
+
+			i++
+			b.WriteString(regexp.QuoteMeta(s[i : i+1]))
+		default:
+			b.WriteString(regexp.QuoteMeta(s[i : i+1]))
+		}
+	}
+
+	b.WriteString(`$`)
+
+	return regexp.Compile(b.String())
+}
+
+func eventPatternCIDRMatcher(ipNet *net.IPNet) eventPatternMatcher {
+	return eventPatternStringMatcher(func(value string) bool {
+		ip := net.ParseIP(value)
+
+		return ip != nil && ipNet.Contains(ip)
+	})
+}
+
+// eventPatternNotMatcher matches any value present in the event that the specified matcher doesn't match.
+func eventPatternNotMatcher(m eventPatternMatcher) eventPatternMatcher {
+	return eventPatternValueMatcher(func(value interface{}) bool {
+		return !m([]interface{}{value})
+	})
+}
+
+func eventPatternAnyMatcher(matchers []eventPatternMatcher) eventPatternMatcher {
+	return func(values []interface{}) bool {
+		for _, m := range matchers {
+			if m(values) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func eventPatternNumberMatcher(f func(float64) bool) eventPatternMatcher {
+	return eventPatternValueMatcher(func(value interface{}) bool {
+		n, ok := value.(json.Number)
+
+		if !ok {
+			return false
+		}
+
+		v, err := n.Float64()
+
+		return err == nil && f(v)
+	})
+}
+
+func eventPatternStringMatcher(f func(string) bool) eventPatternMatcher {
+	return eventPatternValueMatcher(func(value interface{}) bool {
+		s, ok := value.(string)
+
+		return ok && f(s)
+	})
+}
+
+// eventPatternValueMatcher matches if any of the values satisfies the predicate.
+func eventPatternValueMatcher(f func(interface{}) bool) eventPatternMatcher {
+	return func(values []interface{}) bool {
+		for _, v := range values {
+			if f(v) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// match reports whether the event, a JSON object, matches the pattern.
+func (p *eventPattern) match(event interface{}) bool {
+	for _, field := range p.fields {
+		values := eventPatternValuesAt(event, field.path)
+
+		if !eventPatternAnyMatcher(field.matchers)(values) {
+			return false
+		}
+	}
+
+	for _, alternatives := range p.ors {
+		matched := false
+
+		for _, alternative := range alternatives {
+			if alternative.match(event) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchEventPattern reports whether the event matches the pattern.
+func matchEventPattern(pattern, event string) (bool, error) {
+	p, err := parseEventPattern(pattern)
+
+	if err != nil {
+		return false, err
+	}
+
+	v, err := decodeEventPatternJSON(event)
+
+	if err != nil {
+		return false, err
+	}
+
+	if _, ok := v.(map[string]interface{}); !ok {
+		return false, fmt.Errorf("event must be a JSON object")
+	}
+
+	return p.match(v), nil
+}
+
+// eventPatternValuesAt returns the leaf values found at the specified path in an event.
+// Arrays in the event are flattened, so a path matches a value in any element.
+func eventPatternValuesAt(v interface{}, path []string) []interface{} {
+	if tfList, ok := v.([]interface{}); ok {
+		var values []interface{}
+
+		for _, v := range tfList {
+			values = append(values, eventPatternValuesAt(v, path)...)
+		}
+
+		return values
+	}
+
+	if len(path) == 0 {
+		if _, ok := v.(map[string]interface{}); ok {
+			return nil
+		}
+
+		return []interface{}{v}
+	}
+
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	v, ok = tfMap[path[0]]
+
+	if !ok {
+		return nil
+	}
+
+	return eventPatternValuesAt(v, path[1:])
+}
+
+func decodeEventPatternJSON(s string) (interface{}, error) {
+	var v interface{}
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+
+	return v, nil
+}
+
+func eventPatternAppendPath(path []string, key string) []string {
+	return append(path[:len(path):len(path)], key)
+}
+
+func eventPatternPath(path []string) string {
+	if len(path) == 0 {
+		return "event pattern"
+	}
+
+	return strings.Join(path, ".")
+}
+
+func eventPatternJSON(v interface{}) string {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return strings.TrimSpace(buf.String())
+}
+
+func eventPatternSortedKeys(tfMap map[string]interface{}) []string {
+	keys := make([]string, 0, len(tfMap))
+
+	for key := range tfMap {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,503 @@
+package events
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseEventPattern(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Pattern       string
+		ExpectedError string
+	}{
+		{
+			Name:    "exact",
+			Pattern: `{"source": ["aws.ec2"], "detail-type": ["EC2 Instance State-change Notification"]}`,
+		},
+		{
+			Name:    "nested",
+			Pattern: `{"detail": {"state": ["running", "stopped"], "count": [1, 2.5], "flag": [true, null]}}`,
+		},
+		{
+			Name:    "content filters",
+			Pattern: `{"detail": {"a": [{"prefix": "i-"}], "b": [{"suffix": ".png"}], "c": [{"anything-but": ["x", "y"]}], "d": [{"anything-but": {"prefix": "init"}}], "e": [{"numeric": [">", 0, "<=", 5]}], "f": [{"exists": false}], "g": [{"cidr": "10.0.0.0/24"}], "h": [{"anything-but": 42}]}}`,
+		},
+		{
+			Name:    "string content filters",
+			Pattern: `{"detail": {"a": [{"equals-ignore-case": "Running"}], "b": [{"wildcard": "arn:*:instance/*"}], "c": [{"anything-but": {"suffix": ".tmp"}}], "d": [{"anything-but": {"wildcard": ["*.tmp", "*.bak"]}}], "e": [{"anything-but": {"equals-ignore-case": ["a", "B"]}}], "f": [{"wildcard": "a\\*b\\\\"}]}}`,
+		},
+		{
+			Name:    "or",
+			Pattern: `{"source": ["aws.ec2"], "$or": [{"detail-type": ["a"]}, {"detail": {"state": ["b"]}}]}`,
+		},
+		{
+			Name:    "nested or",
+			Pattern: `{"detail": {"$or": [{"a": [1]}, {"b": [{"exists": true}]}]}}`,
+		},
+		{
+			Name:    "empty",
+			Pattern: `{}`,
+		},
+		{
+			Name:          "invalid JSON",
+			Pattern:       `{"source": [}`,
+			ExpectedError: "invalid character",
+		},
+		{
+			Name:          "trailing data",
+			Pattern:       `{"source": ["a"]} {}`,
+			ExpectedError: "unexpected data after JSON value",
+		},
+		{
+			Name:          "not an object",
+			Pattern:       `["aws.ec2"]`,
+			ExpectedError: "event pattern must be a JSON object",
+		},
+		{
+			Name:          "scalar value",
+			Pattern:       `{"source": "aws.ec2"}`,
+			ExpectedError: `source: must be an object or an array of match values, got "aws.ec2"`,
+		},
+		{
+			Name:          "empty array",
+			Pattern:       `{"detail": {"state": []}}`,
+			ExpectedError: "detail.state: array of match values cannot be empty",
+		},
+		{
+			Name:          "empty nested object",
+			Pattern:       `{"detail": {}}`,
+			ExpectedError: "detail: object cannot be empty",
+		},
+		{
+			Name:          "nested array",
+			Pattern:       `{"source": [["aws.ec2"]]}`,
+			ExpectedError: `source[0]: must be a string, number, boolean, null or object, got ["aws.ec2"]`,
+		},
+		{
+			Name:          "unsupported match type",
+			Pattern:       `{"source": [{"contains": "ec2"}]}`,
+			ExpectedError: `source[0]: unsupported match type "contains"`,
+		},
+		{
+			Name:          "multiple match types",
+			Pattern:       `{"source": [{"prefix": "aws.", "suffix": "ec2"}]}`,
+			ExpectedError: "source[0]: match object must contain exactly one of",
+		},
+		{
+			Name:          "prefix not string",
+			Pattern:       `{"source": [{"prefix": 1}]}`,
+			ExpectedError: `source[0]: "prefix" must be a string, got 1`,
+		},
+		{
+			Name:          "exists not boolean",
+			Pattern:       `{"detail": {"a": [{"exists": "true"}]}}`,
+			ExpectedError: `detail.a[0]: "exists" must be true or false, got "true"`,
+		},
+		{
+			Name:          "invalid cidr",
+			Pattern:       `{"detail": {"ip": [{"cidr": "10.0.0.0/33"}]}}`,
+			ExpectedError: `detail.ip[0]: "cidr" must be a valid CIDR block, got "10.0.0.0/33"`,
+		},
+		{
+			Name:          "anything-but mixed types",
+			Pattern:       `{"detail": {"a": [{"anything-but": ["x", 1]}]}}`,
+			ExpectedError: `"anything-but" array must contain only strings or only numbers, got 1`,
+		},
+		{
+			Name:          "anything-but empty",
+			Pattern:       `{"detail": {"a": [{"anything-but": []}]}}`,
+			ExpectedError: `"anything-but" array cannot be empty`,
+		},
+		{
+			Name:          "anything-but unsupported match type",
+			Pattern:       `{"detail": {"a": [{"anything-but": {"contains": "x"}}]}}`,
+			ExpectedError: `unsupported "anything-but" match type "contains"`,
+		},
+		{
+			Name:          "anything-but multiple match types",
+			Pattern:       `{"detail": {"a": [{"anything-but": {"prefix": "x", "suffix": "y"}}]}}`,
+			ExpectedError: `"anything-but" object must contain exactly one of`,
+		},
+		{
+			Name:          "anything-but prefix array",
+			Pattern:       `{"detail": {"a": [{"anything-but": {"prefix": ["x"]}}]}}`,
+			ExpectedError: `"anything-but" "prefix" must be a string, got ["x"]`,
+		},
+		{
+			Name:          "anything-but equals-ignore-case empty",
+			Pattern:       `{"detail": {"a": [{"anything-but": {"equals-ignore-case": []}}]}}`,
+			ExpectedError: `"anything-but" "equals-ignore-case" array cannot be empty`,
+		},
+		{
+			Name:          "anything-but wildcard array number",
+			Pattern:       `{"detail": {"a": [{"anything-but": {"wildcard": ["x", 1]}}]}}`,
+			ExpectedError: `"anything-but" "wildcard" array must contain only strings, got 1`,
+		},
+		{
+			Name:          "equals-ignore-case not string",
+			Pattern:       `{"detail": {"a": [{"equals-ignore-case": 1}]}}`,
+			ExpectedError: `"equals-ignore-case" must be a string, got 1`,
+		},
+		{
+			Name:          "wildcard invalid escape",
+			Pattern:       `{"detail": {"a": [{"wildcard": "a\\b"}]}}`,
+			ExpectedError: `"wildcard" must only escape "*" or "\", got "a\\b"`,
+		},
+		{
+			Name:          "numeric odd length",
+			Pattern:       `{"detail": {"a": [{"numeric": [">", 0, "<"]}]}}`,
+			ExpectedError: `"numeric" must be an array of one or two operator and number pairs`,
+		},
+		{
+			Name:          "numeric bad operator",
+			Pattern:       `{"detail": {"a": [{"numeric": ["!=", 0]}]}}`,
+			ExpectedError: `"numeric" operator must be one of`,
+		},
+		{
+			Name:          "numeric string value",
+			Pattern:       `{"detail": {"a": [{"numeric": [">", "0"]}]}}`,
+			ExpectedError: `"numeric" value for ">" must be a number, got "0"`,
+		},
+		{
+			Name:          "numeric out of range",
+			Pattern:       `{"detail": {"a": [{"numeric": [">", 1e10]}]}}`,
+			ExpectedError: `"numeric" value for ">" must be between -5e+09 and 5e+09, got 1e10`,
+		},
+		{
+			Name:          "numeric range order",
+			Pattern:       `{"detail": {"a": [{"numeric": ["<", 10, ">", 0]}]}}`,
+			ExpectedError: `"numeric" range must start with ">" or ">="`,
+		},
+		{
+			Name:          "numeric range bounds",
+			Pattern:       `{"detail": {"a": [{"numeric": [">", 10, "<", 0]}]}}`,
+			ExpectedError: `"numeric" range lower bound (10) must be less than its upper bound (0)`,
+		},
+		{
+			Name:          "or single",
+			Pattern:       `{"$or": [{"source": ["a"]}]}`,
+			ExpectedError: "$or: must contain at least 2 objects",
+		},
+		{
+			Name:          "or not array",
+			Pattern:       `{"detail": {"$or": {"a": [1]}}}`,
+			ExpectedError: "detail.$or: must be an array of objects",
+		},
+		{
+			Name:          "or element not object",
+			Pattern:       `{"$or": [{"source": ["a"]}, "b"]}`,
+			ExpectedError: `$or[1]: must be an object, got "b"`,
+		},
+		{
+			Name:          "or invalid alternative",
+			Pattern:       `{"detail": {"$or": [{"a": [1]}, {"b": []}]}}`,
+			ExpectedError: "detail.b: array of match values cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := parseEventPattern(testCase.Pattern)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got no error", testCase.ExpectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("expected error containing %q, got %q", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestMatchEventPattern(t *testing.T) {
+	const event = `{
+  "source": "aws.ec2",
+  "detail-type": "EC2 Instance State-change Notification",
+  "resources": ["arn:aws:ec2:us-east-1:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "running",
+    "count": 300,
+    "enabled": true,
+    "nothing": null,
+    "source-ip": "10.0.0.27",
+    "file": "image.png",
+    "tags": [{"key": "env", "value": "prod"}, {"key": "team", "value": "blue"}],
+    "scores": [1, 5, 9]
+  }
+}`
+
+	testCases := []struct {
+		Name     string
+		Pattern  string
+		Expected bool
+	}{
+		{
+			Name:     "exact",
+			Pattern:  `{"source": ["aws.ec2"]}`,
+			Expected: true,
+		},
+		{
+			Name:     "exact mismatch",
+			Pattern:  `{"source": ["aws.s3"]}`,
+			Expected: false,
+		},
+		{
+			Name:     "all fields must match",
+			Pattern:  `{"source": ["aws.ec2"], "detail": {"state": ["stopped"]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "any value in array",
+			Pattern:  `{"detail": {"state": ["pending", "running"]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "number by value",
+			Pattern:  `{"detail": {"count": [3.0e2]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "number does not match string",
+			Pattern:  `{"detail": {"count": ["300"]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "boolean",
+			Pattern:  `{"detail": {"enabled": [true]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "null",
+			Pattern:  `{"detail": {"nothing": [null]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "null does not match missing field",
+			Pattern:  `{"detail": {"missing": [null]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "event array",
+			Pattern:  `{"resources": [{"prefix": "arn:aws:ec2:"}]}`,
+			Expected: true,
+		},
+		{
+			Name:     "array of objects",
+			Pattern:  `{"detail": {"tags": {"value": ["blue"]}}}`,
+			Expected: true,
+		},
+		{
+			Name:     "prefix",
+			Pattern:  `{"detail": {"instance-id": [{"prefix": "i-"}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "prefix mismatch",
+			Pattern:  `{"detail": {"instance-id": [{"prefix": "vol-"}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "suffix",
+			Pattern:  `{"detail": {"file": [{"suffix": ".png"}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "anything-but",
+			Pattern:  `{"detail": {"state": [{"anything-but": ["stopped", "terminated"]}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "anything-but mismatch",
+			Pattern:  `{"detail": {"state": [{"anything-but": "running"}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "anything-but number",
+			Pattern:  `{"detail": {"count": [{"anything-but": [300]}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "anything-but prefix",
+			Pattern:  `{"detail": {"state": [{"anything-but": {"prefix": "stop"}}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "anything-but suffix",
+			Pattern:  `{"detail": {"file": [{"anything-but": {"suffix": ".png"}}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "anything-but wildcard",
+			Pattern:  `{"detail": {"file": [{"anything-but": {"wildcard": ["*.jpg", "*.gif"]}}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "anything-but equals-ignore-case",
+			Pattern:  `{"detail": {"state": [{"anything-but": {"equals-ignore-case": ["RUNNING", "Stopped"]}}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "equals-ignore-case",
+			Pattern:  `{"detail": {"state": [{"equals-ignore-case": "RUNNING"}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "equals-ignore-case mismatch",
+			Pattern:  `{"detail": {"state": [{"equals-ignore-case": "RUN"}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "wildcard",
+			Pattern:  `{"resources": [{"wildcard": "arn:aws:ec2:*:instance/i-*"}]}`,
+			Expected: true,
+		},
+		{
+			Name:     "wildcard mismatch",
+			Pattern:  `{"detail": {"file": [{"wildcard": "image.*.png"}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "wildcard escaped",
+			Pattern:  `{"detail": {"file": [{"wildcard": "image\\*png"}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "anything-but missing field",
+			Pattern:  `{"detail": {"missing": [{"anything-but": "x"}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "numeric range",
+			Pattern:  `{"detail": {"count": [{"numeric": [">", 0, "<=", 300]}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "numeric range mismatch",
+			Pattern:  `{"detail": {"count": [{"numeric": [">", 0, "<", 300]}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "numeric equals",
+			Pattern:  `{"detail": {"count": [{"numeric": ["=", 300]}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "numeric any array element",
+			Pattern:  `{"detail": {"scores": [{"numeric": [">=", 9]}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "numeric string value",
+			Pattern:  `{"detail": {"state": [{"numeric": [">", 0]}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "exists",
+			Pattern:  `{"detail": {"instance-id": [{"exists": true}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "exists missing",
+			Pattern:  `{"detail": {"missing": [{"exists": true}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "not exists",
+			Pattern:  `{"detail": {"missing": [{"exists": false}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "not exists present",
+			Pattern:  `{"detail": {"state": [{"exists": false}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "exists object",
+			Pattern:  `{"detail": [{"exists": true}]}`,
+			Expected: false,
+		},
+		{
+			Name:     "cidr",
+			Pattern:  `{"detail": {"source-ip": [{"cidr": "10.0.0.0/24"}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "cidr mismatch",
+			Pattern:  `{"detail": {"source-ip": [{"cidr": "10.0.1.0/24"}]}}`,
+			Expected: false,
+		},
+		{
+			Name:     "or",
+			Pattern:  `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["running"]}}]}`,
+			Expected: true,
+		},
+		{
+			Name:     "or mismatch",
+			Pattern:  `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["stopped"]}}]}`,
+			Expected: false,
+		},
+		{
+			Name:     "or with fields",
+			Pattern:  `{"source": ["aws.s3"], "$or": [{"detail-type": ["x"]}, {"detail": {"state": ["running"]}}]}`,
+			Expected: false,
+		},
+		{
+			Name:     "nested or",
+			Pattern:  `{"detail": {"$or": [{"count": [{"numeric": ["<", 10]}]}, {"enabled": [true]}]}}`,
+			Expected: true,
+		},
+		{
+			Name:     "empty pattern",
+			Pattern:  `{}`,
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := matchEventPattern(testCase.Pattern, event)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("expected %t, got %t", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestIsEventPatternUnsupportedError(t *testing.T) {
+	for _, pattern := range []string{
+		`{"source": [{"contains": "ec2"}]}`,
+		`{"detail": {"a": [{"anything-but": {"contains": "x"}}]}}`,
+	} {
+		if _, err := parseEventPattern(pattern); !isEventPatternUnsupportedError(err) {
+			t.Errorf("expected unsupported match type error for %s, got %v", pattern, err)
+		}
+	}
+
+	if _, err := parseEventPattern(`{"source": "aws.ec2"}`); isEventPatternUnsupportedError(err) {
+		t.Errorf("expected other error, got %v", err)
+	}
+}
+
+func TestMatchEventPattern_invalidEvent(t *testing.T) {
+	for _, event := range []string{`[]`, `"event"`, `{"source":`} {
+		if _, err := matchEventPattern(`{"source": ["aws.ec2"]}`, event); err == nil {
+			t.Errorf("expected error for event %s", event)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourcePatternMatch() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePatternMatchRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_match": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEventPatternValue(),
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"matches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+			},
+		},
+	}
+}
+
+func dataSourcePatternMatchRead(d *schema.ResourceData, meta interface{}) error {
+	eventPattern, err := structure.NormalizeJsonString(d.Get("event_pattern").(string))
+
+	if err != nil {
+		return fmt.Errorf("event pattern (%s) is invalid JSON: %w", d.Get("event_pattern").(string), err)
+	}
+
+	pattern, err := parseEventPattern(eventPattern)
+
+	if err != nil {
+		return fmt.Errorf("event pattern is invalid: %w", err)
+	}
+
+	allMatch := true
+	var matches []bool
+	hashes := []string{eventPattern}
+
+	for i, v := range d.Get("events").([]interface{}) {
+		event, err := decodeEventPatternJSON(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("events[%d] is invalid JSON: %w", i, err)
+		}
+
+		if _, ok := event.(map[string]interface{}); !ok {
+			return fmt.Errorf("events[%d] must be a JSON object", i)
+		}
+
+		matched := pattern.match(event)
+
+		allMatch = allMatch && matched
+		matches = append(matches, matched)
+		hashes = append(hashes, v.(string))
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join(hashes, "\n"))))
+	d.Set("all_match", allMatch)
+	d.Set("matches", matches)
+
+	return nil
+}
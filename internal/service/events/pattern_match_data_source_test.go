@@ -0,0 +1,122 @@
+package events_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEventsPatternMatchDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_cloudwatch_event_pattern_match.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternMatchDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_match", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.0", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.1", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.2", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternMatchDataSource_allMatch(t *testing.T) {
+	dataSourceName := "data.aws_cloudwatch_event_pattern_match.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternMatchDataSourceConfig_allMatch,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_match", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternMatchDataSource_invalidEvent(t *testing.T) {
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternMatchDataSourceConfig_invalidEvent,
+				ExpectError: regexp.MustCompile(`events\[0\] must be a JSON object`),
+			},
+		},
+	})
+}
+
+const testAccPatternMatchDataSourceConfig_basic = `
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+    detail = {
+      state = [{ "anything-but" = ["pending", "stopping"] }]
+    }
+  })
+
+  events = [
+    jsonencode({
+      source = "aws.ec2"
+      detail = { state = "running" }
+    }),
+    jsonencode({
+      source = "aws.ec2"
+      detail = { state = "pending" }
+    }),
+    jsonencode({
+      source = "aws.ec2"
+      detail = { state = "stopped" }
+    }),
+  ]
+}
+`
+
+const testAccPatternMatchDataSourceConfig_allMatch = `
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = jsonencode({
+    "$or" = [
+      { source = ["aws.s3"] },
+      { detail = { size = [{ numeric = [">", 0, "<=", 1024] }] } },
+    ]
+  })
+
+  events = [
+    jsonencode({
+      source = "aws.s3"
+    }),
+    jsonencode({
+      source = "custom.app"
+      detail = { size = 512 }
+    }),
+  ]
+}
+`
+
+const testAccPatternMatchDataSourceConfig_invalidEvent = `
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+  })
+
+  events = [jsonencode(["aws.ec2"])]
+}
+`
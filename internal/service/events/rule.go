@@ -408,6 +408,15 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		if len(json) > maxJSONLength {
 			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxJSONLength, json))
 		}
+
+		if _, err := parseEventPattern(json); err != nil {
+			// Match types added to EventBridge since the parser was written are left for the API to validate.
+			if isEventPatternUnsupportedError(err) {
+				ws = append(ws, fmt.Sprintf("%q could not be fully validated: %s", k, err))
+			} else {
+				errors = append(errors, fmt.Errorf("%q is not a valid event pattern: %w", k, err))
+			}
+		}
 		return
 	}
 }
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package events_test
+
+import (
+	"testing"
+)
+
+func TestAccEventsBus_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cloudwatch_event_bus has no testAccBusConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccEventsRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_cloudwatch_event_rule has no testAccRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -149,3 +149,25 @@ func TestValidRuleName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateEventPatternValue(t *testing.T) {
+	validate := validateEventPatternValue()
+
+	ws, errs := validate(`{"source": [{"equals-ignore-case": "AWS.EC2"}]}`, "event_pattern")
+
+	if len(ws) != 0 || len(errs) != 0 {
+		t.Errorf("expected no warnings or errors, got %v, %v", ws, errs)
+	}
+
+	ws, errs = validate(`{"source": [{"contains": "ec2"}]}`, "event_pattern")
+
+	if len(ws) != 1 || len(errs) != 0 {
+		t.Errorf("expected a warning for an unsupported match type, got %v, %v", ws, errs)
+	}
+
+	ws, errs = validate(`{"source": "aws.ec2"}`, "event_pattern")
+
+	if len(ws) != 0 || len(errs) != 1 {
+		t.Errorf("expected an error for an invalid pattern, got %v, %v", ws, errs)
+	}
+}
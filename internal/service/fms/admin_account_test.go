@@ -16,7 +16,7 @@ import (
 func testAccAdminAccount_basic(t *testing.T) {
 	resourceName := "aws_fms_admin_account.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckAdmin(t)
@@ -21,7 +21,7 @@ func TestAccFSxBackup_basic(t *testing.T) {
 	resourceName := "aws_fsx_backup.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -52,7 +52,7 @@ func TestAccFSxBackup_ontapBasic(t *testing.T) {
 	//FSX ONTAP Volume Names can't use dash only underscore
 	vName := strings.Replace(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_", -1)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -81,7 +81,7 @@ func TestAccFSxBackup_openzfsBasic(t *testing.T) {
 	resourceName := "aws_fsx_backup.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -110,7 +110,7 @@ func TestAccFSxBackup_windowsBasic(t *testing.T) {
 	resourceName := "aws_fsx_backup.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -139,7 +139,7 @@ func TestAccFSxBackup_disappears(t *testing.T) {
 	resourceName := "aws_fsx_backup.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -162,7 +162,7 @@ func TestAccFSxBackup_Disappears_filesystem(t *testing.T) {
 	resourceName := "aws_fsx_backup.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -185,7 +185,7 @@ func TestAccFSxBackup_tags(t *testing.T) {
 	resourceName := "aws_fsx_backup.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -229,7 +229,7 @@ func TestAccFSxBackup_implicitTags(t *testing.T) {
 	var backup fsx.Backup
 	resourceName := "aws_fsx_backup.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,39 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package fsx_test
+
+import (
+	"testing"
+)
+
+func TestAccFSxDataRepositoryAssociation_TagsConsistency(t *testing.T) {
+	t.Skip("aws_fsx_data_repository_association has no testAccDataRepositoryAssociationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccFSxLustreFileSystem_TagsConsistency(t *testing.T) {
+	t.Skip("aws_fsx_lustre_file_system has no testAccLustreFileSystemConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccFSxOntapFileSystem_TagsConsistency(t *testing.T) {
+	t.Skip("aws_fsx_ontap_file_system has no testAccOntapFileSystemConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccFSxOntapStorageVirtualMachine_TagsConsistency(t *testing.T) {
+	t.Skip("aws_fsx_ontap_storage_virtual_machine has no testAccOntapStorageVirtualMachineConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccFSxOntapVolume_TagsConsistency(t *testing.T) {
+	t.Skip("aws_fsx_ontap_volume has no testAccOntapVolumeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccFSxOpenzfsFileSystem_TagsConsistency(t *testing.T) {
+	t.Skip("aws_fsx_openzfs_file_system has no testAccOpenzfsFileSystemConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccFSxOpenzfsVolume_TagsConsistency(t *testing.T) {
+	t.Skip("aws_fsx_openzfs_volume has no testAccOpenzfsVolumeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccFSxWindowsFileSystem_TagsConsistency(t *testing.T) {
+	t.Skip("aws_fsx_windows_file_system has no testAccWindowsFileSystemConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -179,7 +179,7 @@ func TestAccGameLiftFleet_basic(t *testing.T) {
 	params := g.Parameters(33435)
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
@@ -272,7 +272,7 @@ func TestAccGameLiftFleet_tags(t *testing.T) {
 
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
@@ -352,7 +352,7 @@ func TestAccGameLiftFleet_allFields(t *testing.T) {
 	}
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
@@ -492,7 +492,7 @@ func TestAccGameLiftFleet_cert(t *testing.T) {
 	params := g.Parameters(33435)
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
@@ -531,7 +531,7 @@ func TestAccGameLiftFleet_script(t *testing.T) {
 
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
@@ -602,7 +602,7 @@ func TestAccGameLiftFleet_disappears(t *testing.T) {
 	params := g.Parameters(33435)
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
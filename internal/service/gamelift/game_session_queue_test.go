@@ -48,7 +48,7 @@ func TestAccGameLiftGameSessionQueue_basic(t *testing.T) {
 	}
 	uTimeoutInSeconds := int64(600)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
@@ -112,7 +112,7 @@ func TestAccGameLiftGameSessionQueue_tags(t *testing.T) {
 	resourceName := "aws_gamelift_game_session_queue.test"
 	queueName := testAccGameSessionQueuePrefix + sdkacctest.RandString(8)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
@@ -173,7 +173,7 @@ func TestAccGameLiftGameSessionQueue_disappears(t *testing.T) {
 	}
 	timeoutInSeconds := int64(124)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
@@ -0,0 +1,27 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package gamelift_test
+
+import (
+	"testing"
+)
+
+func TestAccGameLiftAlias_TagsConsistency(t *testing.T) {
+	t.Skip("aws_gamelift_alias has no testAccAliasConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGameLiftBuild_TagsConsistency(t *testing.T) {
+	t.Skip("aws_gamelift_build has no testAccBuildConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGameLiftFleet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_gamelift_fleet has no testAccFleetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGameLiftGameSessionQueue_TagsConsistency(t *testing.T) {
+	t.Skip("aws_gamelift_game_session_queue has no testAccGameSessionQueueConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGameLiftScript_TagsConsistency(t *testing.T) {
+	t.Skip("aws_gamelift_script has no testAccScriptConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
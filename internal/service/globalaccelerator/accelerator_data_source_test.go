@@ -16,7 +16,7 @@ func TestAccGlobalAcceleratorAcceleratorDataSource_Data_basic(t *testing.T) {
 	dataSourceName := "data.aws_globalaccelerator_accelerator.test_by_arn"
 	dataSourceName2 := "data.aws_globalaccelerator_accelerator.test_by_name"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
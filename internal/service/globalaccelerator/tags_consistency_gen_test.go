@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package globalaccelerator_test
+
+import (
+	"testing"
+)
+
+func TestAccGlobalAcceleratorAccelerator_TagsConsistency(t *testing.T) {
+	t.Skip("aws_globalaccelerator_accelerator has no testAccAcceleratorConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -18,7 +18,7 @@ func TestAccGlueConnectionDataSource_basic(t *testing.T) {
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, glue.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
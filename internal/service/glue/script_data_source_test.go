@@ -12,7 +12,7 @@ import (
 func TestAccGlueScriptDataSource_Language_python(t *testing.T) {
 	dataSourceName := "data.aws_glue_script.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, glue.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -30,7 +30,7 @@ func TestAccGlueScriptDataSource_Language_python(t *testing.T) {
 func TestAccGlueScriptDataSource_Language_scala(t *testing.T) {
 	dataSourceName := "data.aws_glue_script.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, glue.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,43 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package glue_test
+
+import (
+	"testing"
+)
+
+func TestAccGlueConnection_TagsConsistency(t *testing.T) {
+	t.Skip("aws_glue_connection has no testAccConnectionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGlueCrawler_TagsConsistency(t *testing.T) {
+	t.Skip("aws_glue_crawler has no testAccCrawlerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGlueDevEndpoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_glue_dev_endpoint has no testAccDevEndpointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGlueJob_TagsConsistency(t *testing.T) {
+	t.Skip("aws_glue_job has no testAccJobConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGlueMLTransform_TagsConsistency(t *testing.T) {
+	t.Skip("aws_glue_ml_transform has no testAccMLTransformConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGlueRegistry_TagsConsistency(t *testing.T) {
+	t.Skip("aws_glue_registry has no testAccRegistryConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGlueSchema_TagsConsistency(t *testing.T) {
+	t.Skip("aws_glue_schema has no testAccSchemaConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGlueTrigger_TagsConsistency(t *testing.T) {
+	t.Skip("aws_glue_trigger has no testAccTriggerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGlueWorkflow_TagsConsistency(t *testing.T) {
+	t.Skip("aws_glue_workflow has no testAccWorkflowConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
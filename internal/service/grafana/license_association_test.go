@@ -19,7 +19,7 @@ func testAccLicenseAssociation_freeTrial(t *testing.T) {
 	resourceName := "aws_grafana_license_association.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, managedgrafana.EndpointsID),
 		CheckDestroy:      testAccCheckLicenseAssociationDestroy,
@@ -27,7 +27,7 @@ func testAccRoleAssociation_usersAdmin(t *testing.T) {
 	resourceName := "aws_grafana_role_association.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t,
+	acctest.Test(t,
 		resource.TestCase{
 			PreCheck: func() {
 				acctest.PreCheck(t)
@@ -64,7 +64,7 @@ func testAccRoleAssociation_usersEditor(t *testing.T) {
 	resourceName := "aws_grafana_role_association.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t,
+	acctest.Test(t,
 		resource.TestCase{
 			PreCheck: func() {
 				acctest.PreCheck(t)
@@ -101,7 +101,7 @@ func testAccRoleAssociation_groupsAdmin(t *testing.T) {
 	resourceName := "aws_grafana_role_association.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t,
+	acctest.Test(t,
 		resource.TestCase{
 			PreCheck: func() {
 				acctest.PreCheck(t)
@@ -138,7 +138,7 @@ func testAccRoleAssociation_groupsEditor(t *testing.T) {
 	resourceName := "aws_grafana_role_association.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t,
+	acctest.Test(t,
 		resource.TestCase{
 			PreCheck: func() {
 				acctest.PreCheck(t)
@@ -180,7 +180,7 @@ func testAccRoleAssociation_usersAndGroupsAdmin(t *testing.T) {
 	resourceName := "aws_grafana_role_association.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t,
+	acctest.Test(t,
 		resource.TestCase{
 			PreCheck: func() {
 				acctest.PreCheck(t)
@@ -224,7 +224,7 @@ func testAccRoleAssociation_usersAndGroupsEditor(t *testing.T) {
 	resourceName := "aws_grafana_role_association.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t,
+	acctest.Test(t,
 		resource.TestCase{
 			PreCheck: func() {
 				acctest.PreCheck(t)
@@ -14,7 +14,7 @@ func testAccWorkspaceDataSource_basic(t *testing.T) {
 	resourceName := "aws_grafana_workspace.test"
 	dataSourceName := "data.aws_grafana_workspace.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, managedgrafana.EndpointsID),
 		CheckDestroy:      nil,
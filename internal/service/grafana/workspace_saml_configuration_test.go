@@ -18,7 +18,7 @@ func testAccWorkspaceSAMLConfiguration_basic(t *testing.T) {
 	resourceName := "aws_grafana_workspace_saml_configuration.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, managedgrafana.EndpointsID),
 		CheckDestroy:      nil,
@@ -46,7 +46,7 @@ func testAccWorkspaceSAMLConfiguration_loginValidity(t *testing.T) {
 	resourceName := "aws_grafana_workspace_saml_configuration.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, managedgrafana.EndpointsID),
 		CheckDestroy:      nil,
@@ -75,7 +75,7 @@ func testAccWorkspaceSAMLConfiguration_assertions(t *testing.T) {
 	resourceName := "aws_grafana_workspace_saml_configuration.test"
 	workspaceResourceName := "aws_grafana_workspace.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, managedgrafana.EndpointsID),
 		CheckDestroy:      nil,
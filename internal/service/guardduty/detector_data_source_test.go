@@ -9,7 +9,7 @@ import (
 )
 
 func testAccDetectorDataSource_basic(t *testing.T) {
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, guardduty.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -33,7 +33,7 @@ func testAccDetectorDataSource_basic(t *testing.T) {
 }
 
 func testAccDetectorDataSource_ID(t *testing.T) {
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, guardduty.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
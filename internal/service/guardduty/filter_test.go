@@ -24,7 +24,7 @@ func testAccFilter_basic(t *testing.T) {
 	startDate := "2020-01-01T00:00:00Z"
 	endDate := "2020-02-01T00:00:00Z"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, guardduty.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -90,7 +90,7 @@ func testAccFilter_update(t *testing.T) {
 	startDate := "2020-01-01T00:00:00Z"
 	endDate := "2020-02-01T00:00:00Z"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, guardduty.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -134,7 +134,7 @@ func testAccFilter_tags(t *testing.T) {
 	startDate := "2020-01-01T00:00:00Z"
 	endDate := "2020-02-01T00:00:00Z"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, guardduty.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -175,7 +175,7 @@ func testAccFilter_disappears(t *testing.T) {
 	startDate := "2020-01-01T00:00:00Z"
 	endDate := "2020-02-01T00:00:00Z"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, guardduty.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
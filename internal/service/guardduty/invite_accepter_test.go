@@ -21,7 +21,7 @@ func testAccInviteAccepter_basic(t *testing.T) {
 	resourceName := "aws_guardduty_invite_accepter.test"
 	_, email := testAccMemberFromEnv(t)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
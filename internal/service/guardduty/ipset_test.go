@@ -24,7 +24,7 @@ func testAccIPSet_basic(t *testing.T) {
 	ipsetName2 := fmt.Sprintf("tf-%s", sdkacctest.RandString(5))
 	resourceName := "aws_guardduty_ipset.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, guardduty.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -63,7 +63,7 @@ func testAccIPSet_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_guardduty_ipset.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, guardduty.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -16,7 +16,7 @@ import (
 func testAccOrganizationAdminAccount_basic(t *testing.T) {
 	resourceName := "aws_guardduty_organization_admin_account.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckOrganizationsAccount(t)
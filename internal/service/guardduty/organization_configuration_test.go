@@ -13,7 +13,7 @@ func testAccOrganizationConfiguration_basic(t *testing.T) {
 	detectorResourceName := "aws_guardduty_detector.test"
 	resourceName := "aws_guardduty_organization_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckOrganizationsAccount(t)
@@ -51,7 +51,7 @@ func testAccOrganizationConfiguration_s3logs(t *testing.T) {
 	detectorResourceName := "aws_guardduty_detector.test"
 	resourceName := "aws_guardduty_organization_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckOrganizationsAccount(t)
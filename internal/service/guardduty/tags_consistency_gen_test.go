@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package guardduty_test
+
+import (
+	"testing"
+)
+
+func TestAccGuardDutyDetector_TagsConsistency(t *testing.T) {
+	t.Skip("aws_guardduty_detector has no testAccDetectorConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGuardDutyFilter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_guardduty_filter has no testAccFilterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGuardDutyIPSet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_guardduty_ipset has no testAccIPSetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccGuardDutyThreatintelset_TagsConsistency(t *testing.T) {
+	t.Skip("aws_guardduty_threatintelset has no testAccThreatintelsetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
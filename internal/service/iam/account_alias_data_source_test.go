@@ -16,7 +16,7 @@ func testAccAccountAliasDataSource_basic(t *testing.T) {
 
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -52,7 +53,7 @@ func ResourceGroup() *schema.Resource {
 }
 
 func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMAPI()
 	name := d.Get("name").(string)
 	path := d.Get("path").(string)
 
@@ -71,7 +72,7 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMAPI()
 
 	request := &iam.GetGroupInput{
 		GroupName: aws.String(d.Id()),
@@ -132,7 +133,7 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChanges("name", "path") {
-		conn := meta.(*conns.AWSClient).IAMConn
+		conn := meta.(*conns.AWSClient).IAMAPI()
 		on, nn := d.GetChange("name")
 		_, np := d.GetChange("path")
 
@@ -152,7 +153,7 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMAPI()
 
 	request := &iam.DeleteGroupInput{
 		GroupName: aws.String(d.Id()),
@@ -164,7 +165,7 @@ func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func DeleteGroupPolicyAttachments(conn *iam.IAM, groupName string) error {
+func DeleteGroupPolicyAttachments(conn iamiface.IAMAPI, groupName string) error {
 	var attachedPolicies []*iam.AttachedPolicy
 	input := &iam.ListAttachedGroupPoliciesInput{
 		GroupName: aws.String(groupName),
@@ -204,7 +205,7 @@ func DeleteGroupPolicyAttachments(conn *iam.IAM, groupName string) error {
 	return nil
 }
 
-func DeleteGroupPolicies(conn *iam.IAM, groupName string) error {
+func DeleteGroupPolicies(conn iamiface.IAMAPI, groupName string) error {
 	var inlinePolicies []*string
 	input := &iam.ListGroupPoliciesInput{
 		GroupName: aws.String(groupName),
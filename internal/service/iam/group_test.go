@@ -10,9 +10,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAccIAMGroup_basic(t *testing.T) {
@@ -23,7 +27,7 @@ func TestAccIAMGroup_basic(t *testing.T) {
 	groupName := fmt.Sprintf("tf-acc-group-basic-%s", rString)
 	groupName2 := fmt.Sprintf("tf-acc-group-basic-2-%s", rString)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -59,7 +63,7 @@ func TestAccIAMGroup_nameChange(t *testing.T) {
 	groupName := fmt.Sprintf("tf-acc-group-basic-%s", rString)
 	groupName2 := fmt.Sprintf("tf-acc-group-basic-2-%s", rString)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -169,3 +173,38 @@ resource "aws_iam_group" "test2" {
 }
 `, groupName)
 }
+
+func TestIAMGroup_fake(t *testing.T) {
+	client := acctest.FakeClient(t, map[string]interface{}{
+		names.IAM: fake.NewIAM(),
+	})
+
+	acctest.FakeResourceTest(t, tfiam.ResourceGroup(), client,
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"name": "tf-fake-group",
+			},
+			Check: func(d *schema.ResourceData) error {
+				if got, want := d.Get("arn").(string), fmt.Sprintf("arn:%s:iam::%s:group/tf-fake-group", fake.Partition, fake.AccountID); got != want {
+					return fmt.Errorf("arn = %q, want %q", got, want)
+				}
+				return nil
+			},
+		},
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"name": "tf-fake-group-renamed",
+				"path": "/fake/",
+			},
+			Check: func(d *schema.ResourceData) error {
+				if got, want := d.Id(), "tf-fake-group-renamed"; got != want {
+					return fmt.Errorf("id = %q, want %q", got, want)
+				}
+				if got, want := d.Get("arn").(string), fmt.Sprintf("arn:%s:iam::%s:group/fake/tf-fake-group-renamed", fake.Partition, fake.AccountID); got != want {
+					return fmt.Errorf("arn = %q, want %q", got, want)
+				}
+				return nil
+			},
+		},
+	)
+}
@@ -15,7 +15,7 @@ func TestAccIAMOpenidConnectProviderDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_iam_openid_connect_provider.test"
 	resourceName := "aws_iam_openid_connect_provider.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -41,7 +41,7 @@ func TestAccIAMOpenidConnectProviderDataSource_url(t *testing.T) {
 	dataSourceName := "data.aws_iam_openid_connect_provider.test"
 	resourceName := "aws_iam_openid_connect_provider.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -67,7 +67,7 @@ func TestAccIAMOpenidConnectProviderDataSource_tags(t *testing.T) {
 	dataSourceName := "data.aws_iam_openid_connect_provider.test"
 	resourceName := "aws_iam_openid_connect_provider.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
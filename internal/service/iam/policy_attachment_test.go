@@ -29,7 +29,7 @@ func TestAccIAMPolicyAttachment_basic(t *testing.T) {
 	policyName := fmt.Sprintf("tf-acc-policy-pa-basic-%s", rString)
 	attachmentName := fmt.Sprintf("tf-acc-attachment-pa-basic-%s", rString)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -65,7 +65,7 @@ func TestAccIAMPolicyAttachment_paginatedEntities(t *testing.T) {
 	policyName := fmt.Sprintf("tf-acc-policy-pa-pe-%s-", rString)
 	attachmentName := fmt.Sprintf("tf-acc-attachment-pa-pe-%s-", rString)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -89,7 +89,7 @@ func TestAccIAMPolicyAttachment_Groups_renamedGroup(t *testing.T) {
 	groupName2 := fmt.Sprintf("%s-2", rName)
 	resourceName := "aws_iam_policy_attachment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -121,7 +121,7 @@ func TestAccIAMPolicyAttachment_Roles_renamedRole(t *testing.T) {
 	roleName2 := fmt.Sprintf("%s-2", rName)
 	resourceName := "aws_iam_policy_attachment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -153,7 +153,7 @@ func TestAccIAMPolicyAttachment_Users_renamedUser(t *testing.T) {
 	userName2 := fmt.Sprintf("%s-2", rName)
 	resourceName := "aws_iam_policy_attachment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -85,7 +85,7 @@ func TestAccIAMPolicyDataSource_arn(t *testing.T) {
 	resourceName := "aws_iam_policy.test"
 	policyName := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -111,7 +111,7 @@ func TestAccIAMPolicyDataSource_name(t *testing.T) {
 	resourceName := "aws_iam_policy.test"
 	policyName := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -139,7 +139,7 @@ func TestAccIAMPolicyDataSource_nameAndPathPrefix(t *testing.T) {
 	policyName := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
 	policyPath := "/test-path/"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -164,7 +164,7 @@ func TestAccIAMPolicyDataSource_nonExistent(t *testing.T) {
 	policyName := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
 	policyPath := "/test-path/"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -67,7 +67,11 @@ func resourceRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{})
 	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
 		var err error
 
-		hasPolicyAttachment, err = RoleHasPolicyARNAttachment(conn, role, policyARN)
+		if d.IsNewResource() {
+			hasPolicyAttachment, err = RoleHasPolicyARNAttachment(conn, role, policyARN)
+		} else {
+			hasPolicyAttachment, err = roleHasPolicyARNAttachmentCached(conn, meta.(*conns.AWSClient).ReadCache, role, policyARN)
+		}
 
 		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 			return resource.RetryableError(err)
@@ -180,3 +184,37 @@ func RoleHasPolicyARNAttachment(conn *iam.IAM, role string, policyARN string) (b
 
 	return hasPolicyAttachment, err
 }
+
+// roleHasPolicyARNAttachmentCached is RoleHasPolicyARNAttachment, sharing the role's
+// attached policies with other readers of the same role through the specified read cache.
+func roleHasPolicyARNAttachmentCached(conn *iam.IAM, cache *conns.ReadCache, role string, policyARN string) (bool, error) {
+	input := &iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(role),
+	}
+
+	output, err := cache.Fetch("ListAttachedRolePolicies", input, []string{role}, func() (interface{}, error) {
+		var policyARNs []string
+
+		err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+			for _, p := range page.AttachedPolicies {
+				policyARNs = append(policyARNs, aws.StringValue(p.PolicyArn))
+			}
+
+			return !lastPage
+		})
+
+		return policyARNs, err
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range output.([]string) {
+		if v == policyARN {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
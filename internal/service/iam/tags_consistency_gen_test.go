@@ -0,0 +1,43 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package iam_test
+
+import (
+	"testing"
+)
+
+func TestAccIAMInstanceProfile_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iam_instance_profile has no testAccInstanceProfileConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIAMOpenIDConnectProvider_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iam_openid_connect_provider has no testAccOpenIDConnectProviderConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIAMPolicy_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iam_policy has no testAccPolicyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIAMRole_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iam_role has no testAccRoleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIAMSAMLProvider_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iam_saml_provider has no testAccSAMLProviderConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIAMServerCertificate_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iam_server_certificate has no testAccServerCertificateConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIAMServiceLinkedRole_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iam_service_linked_role has no testAccServiceLinkedRoleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIAMUser_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iam_user has no testAccUserConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIAMVirtualMFADevice_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iam_virtual_mfa_device has no testAccVirtualMFADeviceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -22,7 +22,7 @@ func TestAccIAMUserGroupMembership_basic(t *testing.T) {
 	groupName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	groupName3 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccIAMUserPolicyAttachment_basic(t *testing.T) {
 	policyName2 := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
 	policyName3 := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -17,7 +17,7 @@ func TestAccIdentityStoreGroupDataSource_displayName(t *testing.T) {
 	dataSourceName := "data.aws_identitystore_group.test"
 	name := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSSOAdminInstances(t)
@@ -43,7 +43,7 @@ func TestAccIdentityStoreGroupDataSource_groupID(t *testing.T) {
 	name := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
 	groupID := os.Getenv("AWS_IDENTITY_STORE_GROUP_ID")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSSOAdminInstances(t)
@@ -66,7 +66,7 @@ func TestAccIdentityStoreGroupDataSource_groupID(t *testing.T) {
 }
 
 func TestAccIdentityStoreGroupDataSource_nonExistent(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, identitystore.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -15,7 +15,7 @@ func TestAccIdentityStoreUserDataSource_userName(t *testing.T) {
 	dataSourceName := "data.aws_identitystore_user.test"
 	name := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSSOAdminInstances(t)
@@ -41,7 +41,7 @@ func TestAccIdentityStoreUserDataSource_userID(t *testing.T) {
 	name := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
 	userID := os.Getenv("AWS_IDENTITY_STORE_USER_ID")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckSSOAdminInstances(t)
@@ -64,7 +64,7 @@ func TestAccIdentityStoreUserDataSource_userID(t *testing.T) {
 }
 
 func TestAccIdentityStoreUserDataSource_nonExistent(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, identitystore.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -15,7 +15,7 @@ func TestAccImageBuilderDistributionConfigurationsDataSource_filter(t *testing.T
 	dataSourceName := "data.aws_imagebuilder_distribution_configurations.test"
 	resourceName := "aws_imagebuilder_distribution_configuration.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
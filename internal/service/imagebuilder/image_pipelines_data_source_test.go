@@ -15,7 +15,7 @@ func TestAccImageBuilderImagePipelinesDataSource_filter(t *testing.T) {
 	dataSourceName := "data.aws_imagebuilder_image_pipelines.test"
 	resourceName := "aws_imagebuilder_image_pipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
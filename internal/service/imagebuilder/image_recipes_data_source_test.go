@@ -18,7 +18,7 @@ func TestAccImageBuilderImageRecipesDataSource_owner(t *testing.T) {
 
 	// Not a good test since it is susceptible to fail with parallel tests or if anything else
 	// ImageBuilder is going on in the account
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -44,7 +44,7 @@ func TestAccImageBuilderImageRecipesDataSource_filter(t *testing.T) {
 	dataSourceName := "data.aws_imagebuilder_image_recipes.test"
 	resourceName := "aws_imagebuilder_image_recipe.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -15,7 +15,7 @@ func TestAccImageBuilderInfrastructureConfigurationsDataSource_filter(t *testing
 	dataSourceName := "data.aws_imagebuilder_infrastructure_configurations.test"
 	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
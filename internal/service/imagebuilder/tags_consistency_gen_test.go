@@ -0,0 +1,35 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package imagebuilder_test
+
+import (
+	"testing"
+)
+
+func TestAccImageBuilderComponent_TagsConsistency(t *testing.T) {
+	t.Skip("aws_imagebuilder_component has no testAccComponentConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccImageBuilderContainerRecipe_TagsConsistency(t *testing.T) {
+	t.Skip("aws_imagebuilder_container_recipe has no testAccContainerRecipeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccImageBuilderDistributionConfiguration_TagsConsistency(t *testing.T) {
+	t.Skip("aws_imagebuilder_distribution_configuration has no testAccDistributionConfigurationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccImageBuilderImage_TagsConsistency(t *testing.T) {
+	t.Skip("aws_imagebuilder_image has no testAccImageConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccImageBuilderImagePipeline_TagsConsistency(t *testing.T) {
+	t.Skip("aws_imagebuilder_image_pipeline has no testAccImagePipelineConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccImageBuilderImageRecipe_TagsConsistency(t *testing.T) {
+	t.Skip("aws_imagebuilder_image_recipe has no testAccImageRecipeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccImageBuilderInfrastructureConfiguration_TagsConsistency(t *testing.T) {
+	t.Skip("aws_imagebuilder_infrastructure_configuration has no testAccInfrastructureConfigurationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -17,7 +17,7 @@ func TestAccInspectorResourceGroup_basic(t *testing.T) {
 	var v1, v2 inspector.ResourceGroup
 	resourceName := "aws_inspector_resource_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, inspector.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -9,7 +9,7 @@ import (
 )
 
 func TestAccInspectorRulesPackagesDataSource_basic(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, inspector.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -13,7 +13,7 @@ import (
 func TestAccIoTEndpointDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_iot_endpoint.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -31,7 +31,7 @@ func TestAccIoTEndpointDataSource_basic(t *testing.T) {
 func TestAccIoTEndpointDataSource_EndpointType_iotCredentialProvider(t *testing.T) {
 	dataSourceName := "data.aws_iot_endpoint.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -49,7 +49,7 @@ func TestAccIoTEndpointDataSource_EndpointType_iotCredentialProvider(t *testing.
 func TestAccIoTEndpointDataSource_EndpointType_iotData(t *testing.T) {
 	dataSourceName := "data.aws_iot_endpoint.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -67,7 +67,7 @@ func TestAccIoTEndpointDataSource_EndpointType_iotData(t *testing.T) {
 func TestAccIoTEndpointDataSource_EndpointType_iotDataATS(t *testing.T) {
 	dataSourceName := "data.aws_iot_endpoint.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -85,7 +85,7 @@ func TestAccIoTEndpointDataSource_EndpointType_iotDataATS(t *testing.T) {
 func TestAccIoTEndpointDataSource_EndpointType_iotJobs(t *testing.T) {
 	dataSourceName := "data.aws_iot_endpoint.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
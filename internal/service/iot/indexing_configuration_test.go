@@ -25,7 +25,7 @@ func TestAccIoTIndexingConfiguration_serial(t *testing.T) {
 func testAccIndexingConfiguration_basic(t *testing.T) {
 	resourceName := "aws_iot_indexing_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -59,7 +59,7 @@ func testAccIndexingConfiguration_basic(t *testing.T) {
 func testAccIndexingConfiguration_allAttributes(t *testing.T) {
 	resourceName := "aws_iot_indexing_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -19,7 +19,7 @@ func TestAccIoTPolicyAttachment_basic(t *testing.T) {
 	policyName := sdkacctest.RandomWithPrefix("PolicyName-")
 	policyName2 := sdkacctest.RandomWithPrefix("PolicyName2-")
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -22,7 +22,7 @@ func TestAccIoTRoleAlias_basic(t *testing.T) {
 	resourceName := "aws_iot_role_alias.ra"
 	resourceName2 := "aws_iot_role_alias.ra2"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package iot_test
+
+import (
+	"testing"
+)
+
+func TestAccIoTProvisioningTemplate_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iot_provisioning_template has no testAccProvisioningTemplateConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIoTThingGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iot_thing_group has no testAccThingGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIoTThingType_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iot_thing_type has no testAccThingTypeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccIoTTopicRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_iot_topic_rule has no testAccTopicRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
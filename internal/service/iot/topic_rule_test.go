@@ -28,7 +28,7 @@ func TestAccIoTTopicRule_basic(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -80,7 +80,7 @@ func TestAccIoTTopicRule_disappears(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -102,7 +102,7 @@ func TestAccIoTTopicRule_tags(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -146,7 +146,7 @@ func TestAccIoTTopicRule_cloudWatchAlarm(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -197,7 +197,7 @@ func TestAccIoTTopicRule_cloudWatchLogs(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -249,7 +249,7 @@ func TestAccIoTTopicRule_cloudWatchMetric(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -300,7 +300,7 @@ func TestAccIoTTopicRule_dynamoDB(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -389,7 +389,7 @@ func TestAccIoTTopicRule_dynamoDBv2(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -433,7 +433,7 @@ func TestAccIoTTopicRule_elasticSearch(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -483,7 +483,7 @@ func TestAccIoTTopicRule_firehose(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -537,7 +537,7 @@ func TestAccIoTTopicRule_Firehose_separator(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -616,7 +616,7 @@ func TestAccIoTTopicRule_http(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -783,7 +783,7 @@ func TestAccIoTTopicRule_IoT_analytics(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -826,7 +826,7 @@ func TestAccIoTTopicRule_IoT_events(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -874,7 +874,7 @@ func TestAccIoTTopicRule_kafka(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -934,7 +934,7 @@ func TestAccIoTTopicRule_kinesis(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -982,7 +982,7 @@ func TestAccIoTTopicRule_lambda(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1027,7 +1027,7 @@ func TestAccIoTTopicRule_republish(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1076,7 +1076,7 @@ func TestAccIoTTopicRule_republishWithQos(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1125,7 +1125,7 @@ func TestAccIoTTopicRule_s3(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1175,7 +1175,7 @@ func TestAccIoTTopicRule_sns(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1220,7 +1220,7 @@ func TestAccIoTTopicRule_sqs(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1269,7 +1269,7 @@ func TestAccIoTTopicRule_Step_functions(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1318,7 +1318,7 @@ func TestAccIoTTopicRule_Timestream(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1375,7 +1375,7 @@ func TestAccIoTTopicRule_errorAction(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1444,7 +1444,7 @@ func TestAccIoTTopicRule_updateKinesisErrorAction(t *testing.T) {
 	rName := testAccTopicRuleName()
 	resourceName := "aws_iot_topic_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -15,7 +15,7 @@ func TestAccKafkaClusterDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_msk_cluster.test"
 	resourceName := "aws_msk_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafka.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -15,7 +15,7 @@ func TestAccKafkaConfigurationDataSource_name(t *testing.T) {
 	dataSourceName := "data.aws_msk_configuration.test"
 	resourceName := "aws_msk_configuration.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafka.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
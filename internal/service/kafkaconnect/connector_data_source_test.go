@@ -14,7 +14,7 @@ func TestAccKafkaConnectConnectorDataSource_basic(t *testing.T) {
 	resourceName := "aws_mskconnect_connector.test"
 	dataSourceName := "data.aws_mskconnect_connector.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
 		CheckDestroy:      nil,
@@ -19,7 +19,7 @@ func TestAccKafkaConnectConnector_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mskconnect_connector.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
 		CheckDestroy:      testAccCheckConnectorDestroy,
@@ -80,7 +80,7 @@ func TestAccKafkaConnectConnector_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mskconnect_connector.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
 		CheckDestroy:      testAccCheckConnectorDestroy,
@@ -102,7 +102,7 @@ func TestAccKafkaConnectConnector_update(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mskconnect_connector.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
 		CheckDestroy:      testAccCheckConnectorDestroy,
@@ -19,7 +19,7 @@ func TestAccKafkaConnectCustomPlugin_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mskconnect_custom_plugin.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
 		CheckDestroy:      testAccCheckCustomPluginDestroy,
@@ -55,7 +55,7 @@ func TestAccKafkaConnectCustomPlugin_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mskconnect_custom_plugin.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
 		CheckDestroy:      testAccCheckCustomPluginDestroy,
@@ -77,7 +77,7 @@ func TestAccKafkaConnectCustomPlugin_description(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mskconnect_custom_plugin.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
 		CheckDestroy:      testAccCheckCustomPluginDestroy,
@@ -103,7 +103,7 @@ func TestAccKafkaConnectCustomPlugin_objectVersion(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mskconnect_custom_plugin.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
 		CheckDestroy:      testAccCheckCustomPluginDestroy,
@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package kendra_test
+
+import (
+	"testing"
+)
+
+func TestAccKendraFaq_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kendra_faq has no testAccFaqConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccKendraIndex_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kendra_index has no testAccIndexConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccKendraQuerySuggestionsBlockList_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kendra_query_suggestions_block_list has no testAccQuerySuggestionsBlockListConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccKendraThesaurus_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kendra_thesaurus has no testAccThesaurusConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
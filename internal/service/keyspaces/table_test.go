@@ -23,7 +23,7 @@ func TestAccKeyspacesTable_basic(t *testing.T) {
 	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
 	resourceName := "aws_keyspaces_table.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, keyspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -76,7 +76,7 @@ func TestAccKeyspacesTable_disappears(t *testing.T) {
 	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
 	resourceName := "aws_keyspaces_table.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, keyspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -100,7 +100,7 @@ func TestAccKeyspacesTable_tags(t *testing.T) {
 	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
 	resourceName := "aws_keyspaces_table.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, keyspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -146,7 +146,7 @@ func TestAccKeyspacesTable_multipleColumns(t *testing.T) {
 	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
 	resourceName := "aws_keyspaces_table.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, keyspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -240,7 +240,7 @@ func TestAccKeyspacesTable_update(t *testing.T) {
 	resourceName := "aws_keyspaces_table.test"
 	kmsKeyResourceName := "aws_kms_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, keyspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -312,7 +312,7 @@ func TestAccKeyspacesTable_addColumns(t *testing.T) {
 	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
 	resourceName := "aws_keyspaces_table.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, keyspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -378,7 +378,7 @@ func TestAccKeyspacesTable_delColumns(t *testing.T) {
 	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
 	resourceName := "aws_keyspaces_table.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, keyspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
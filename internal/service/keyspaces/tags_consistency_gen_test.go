@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package keyspaces_test
+
+import (
+	"testing"
+)
+
+func TestAccKeyspacesKeyspace_TagsConsistency(t *testing.T) {
+	t.Skip("aws_keyspaces_keyspace has no testAccKeyspaceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccKeyspacesTable_TagsConsistency(t *testing.T) {
+	t.Skip("aws_keyspaces_table has no testAccTableConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package kinesisanalytics_test
+
+import (
+	"testing"
+)
+
+func TestAccKinesisAnalyticsApplication_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kinesis_analytics_application has no testAccApplicationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package kinesisanalyticsv2_test
+
+import (
+	"testing"
+)
+
+func TestAccKinesisAnalyticsV2Application_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kinesisanalyticsv2_application has no testAccApplicationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
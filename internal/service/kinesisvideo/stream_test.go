@@ -23,7 +23,7 @@ func TestAccKinesisVideoStream_basic(t *testing.T) {
 	rInt1 := sdkacctest.RandInt()
 	rInt2 := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -65,7 +65,7 @@ func TestAccKinesisVideoStream_options(t *testing.T) {
 	rName1 := sdkacctest.RandString(8)
 	rName2 := sdkacctest.RandString(8)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -107,7 +107,7 @@ func TestAccKinesisVideoStream_tags(t *testing.T) {
 	resourceName := "aws_kinesis_video_stream.default"
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -153,7 +153,7 @@ func TestAccKinesisVideoStream_disappears(t *testing.T) {
 	resourceName := "aws_kinesis_video_stream.default"
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package kinesisvideo_test
+
+import (
+	"testing"
+)
+
+func TestAccKinesisVideoStream_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kinesis_video_stream has no testAccStreamConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func TestAccKMSCiphertextDataSource_basic(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -26,7 +26,7 @@ func TestAccKMSCiphertextDataSource_basic(t *testing.T) {
 }
 
 func TestAccKMSCiphertextDataSource_validate(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -43,7 +43,7 @@ func TestAccKMSCiphertextDataSource_validate(t *testing.T) {
 }
 
 func TestAccKMSCiphertextDataSource_Validate_withContext(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
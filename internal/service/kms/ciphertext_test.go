@@ -9,7 +9,7 @@ import (
 )
 
 func TestAccKMSCiphertext_Resource_basic(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -30,7 +30,7 @@ func TestAccKMSCiphertext_Resource_validate(t *testing.T) {
 	kmsSecretsDataSource := "data.aws_kms_secrets.foo"
 	resourceName := "aws_kms_ciphertext.foo"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -51,7 +51,7 @@ func TestAccKMSCiphertext_ResourceValidate_withContext(t *testing.T) {
 	kmsSecretsDataSource := "data.aws_kms_secrets.foo"
 	resourceName := "aws_kms_ciphertext.foo"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,291 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCustomKeyStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomKeyStoreCreate,
+		Read:   resourceCustomKeyStoreRead,
+		Update: resourceCustomKeyStoreUpdate,
+		Delete: resourceCustomKeyStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(CustomKeyStoreConnectedTimeout),
+			Update: schema.DefaultTimeout(CustomKeyStoreConnectedTimeout),
+			Delete: schema.DefaultTimeout(CustomKeyStoreDisconnectedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloud_hsm_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(19, 24),
+			},
+			"connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"connection_error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_key_store_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"key_store_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(7, 32),
+				// The password cannot be read, so it is empty after import.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			"trust_anchor_certificate": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+		},
+	}
+}
+
+func resourceCustomKeyStoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	name := d.Get("custom_key_store_name").(string)
+	input := &kms.CreateCustomKeyStoreInput{
+		CloudHsmClusterId:      aws.String(d.Get("cloud_hsm_cluster_id").(string)),
+		CustomKeyStoreName:     aws.String(name),
+		KeyStorePassword:       aws.String(d.Get("key_store_password").(string)),
+		TrustAnchorCertificate: aws.String(d.Get("trust_anchor_certificate").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating KMS Custom Key Store: %s", name)
+	output, err := conn.CreateCustomKeyStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating KMS Custom Key Store (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CustomKeyStoreId))
+
+	if d.Get("connected").(bool) {
+		if err := connectCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomKeyStoreRead(d, meta)
+}
+
+func resourceCustomKeyStoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	output, err := FindCustomKeyStoreByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Custom Key Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cloud_hsm_cluster_id", output.CloudHsmClusterId)
+	d.Set("connected", aws.StringValue(output.ConnectionState) == kms.ConnectionStateTypeConnected)
+	d.Set("connection_error_code", output.ConnectionErrorCode)
+	d.Set("connection_state", output.ConnectionState)
+	d.Set("custom_key_store_name", output.CustomKeyStoreName)
+	d.Set("trust_anchor_certificate", output.TrustAnchorCertificate)
+
+	return nil
+}
+
+func resourceCustomKeyStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("custom_key_store_name") {
+		input := &kms.UpdateCustomKeyStoreInput{
+			CustomKeyStoreId:      aws.String(d.Id()),
+			NewCustomKeyStoreName: aws.String(d.Get("custom_key_store_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating KMS Custom Key Store name: %s", d.Id())
+		if _, err := conn.UpdateCustomKeyStore(input); err != nil {
+			return fmt.Errorf("error updating KMS Custom Key Store (%s) name: %w", d.Id(), err)
+		}
+	}
+
+	// The password of an imported key store is unknown, so it is never changed.
+	o, _ := d.GetChange("key_store_password")
+	passwordChanged := d.HasChange("key_store_password") && o.(string) != ""
+
+	// The cluster and password can only be changed while the key store is disconnected.
+	if d.HasChange("cloud_hsm_cluster_id") || passwordChanged {
+		if err := disconnectCustomKeyStore(conn, d.Id(), timeout); err != nil {
+			return err
+		}
+
+		input := &kms.UpdateCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("cloud_hsm_cluster_id") {
+			input.CloudHsmClusterId = aws.String(d.Get("cloud_hsm_cluster_id").(string))
+		}
+
+		if passwordChanged {
+			input.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
+		}
+
+		log.Printf("[DEBUG] Updating KMS Custom Key Store: %s", d.Id())
+		if _, err := conn.UpdateCustomKeyStore(input); err != nil {
+			return fmt.Errorf("error updating KMS Custom Key Store (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.Get("connected").(bool) {
+		if err := connectCustomKeyStore(conn, d.Id(), timeout); err != nil {
+			return err
+		}
+	} else if d.HasChange("connected") {
+		if err := disconnectCustomKeyStore(conn, d.Id(), timeout); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomKeyStoreRead(d, meta)
+}
+
+func resourceCustomKeyStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	if err := disconnectCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting KMS Custom Key Store: %s", d.Id())
+	_, err := conn.DeleteCustomKeyStore(&kms.DeleteCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting KMS Custom Key Store (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// connectCustomKeyStore connects a custom key store to its CloudHSM cluster, if it isn't already connected.
+func connectCustomKeyStore(conn *kms.KMS, id string, timeout time.Duration) error {
+	output, err := FindCustomKeyStoreByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", id, err)
+	}
+
+	switch state := aws.StringValue(output.ConnectionState); state {
+	case kms.ConnectionStateTypeConnected:
+		return nil
+	case kms.ConnectionStateTypeConnecting:
+	default:
+		// A key store that failed to connect must be disconnected before connecting again.
+		if state == kms.ConnectionStateTypeDisconnecting || state == kms.ConnectionStateTypeFailed {
+			if err := disconnectCustomKeyStore(conn, id, timeout); err != nil {
+				return err
+			}
+		}
+
+		log.Printf("[DEBUG] Connecting KMS Custom Key Store: %s", id)
+		_, err := conn.ConnectCustomKeyStore(&kms.ConnectCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(id),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error connecting KMS Custom Key Store (%s): %w", id, err)
+		}
+	}
+
+	if _, err := WaitCustomKeyStoreConnected(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for KMS Custom Key Store (%s) to connect: %w", id, err)
+	}
+
+	return nil
+}
+
+// disconnectCustomKeyStore disconnects a custom key store from its CloudHSM cluster, if it isn't already disconnected.
+func disconnectCustomKeyStore(conn *kms.KMS, id string, timeout time.Duration) error {
+	output, err := FindCustomKeyStoreByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", id, err)
+	}
+
+	switch aws.StringValue(output.ConnectionState) {
+	case kms.ConnectionStateTypeDisconnected:
+		return nil
+	case kms.ConnectionStateTypeConnecting:
+		// A key store can't be disconnected while it is connecting.
+		if _, err := WaitCustomKeyStoreConnected(conn, id, timeout); err != nil && !tfresource.NotFound(err) {
+			log.Printf("[WARN] KMS Custom Key Store (%s) failed to connect: %s", id, err)
+		}
+
+		fallthrough
+	case kms.ConnectionStateTypeConnected, kms.ConnectionStateTypeFailed:
+		log.Printf("[DEBUG] Disconnecting KMS Custom Key Store: %s", id)
+		_, err := conn.DisconnectCustomKeyStore(&kms.DisconnectCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(id),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error disconnecting KMS Custom Key Store (%s): %w", id, err)
+		}
+	}
+
+	if _, err := WaitCustomKeyStoreDisconnected(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for KMS Custom Key Store (%s) to disconnect: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKMSCustomKeyStore_basic(t *testing.T) {
+	var customKeyStore kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+	clusterID, trustAnchorCertificate, password := testAccCustomKeyStorePreCheck(t)
+
+	acctest.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rName, clusterID, trustAnchorCertificate, password, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "connected", "false"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_store_password"},
+				ImportStateCheck:        testAccCheckCustomKeyStoreImportedNoChanges(rName, clusterID, trustAnchorCertificate, password),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rNameUpdated, clusterID, trustAnchorCertificate, password, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_connected(t *testing.T) {
+	var customKeyStore kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+	clusterID, trustAnchorCertificate, password := testAccCustomKeyStorePreCheck(t)
+
+	acctest.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rName, clusterID, trustAnchorCertificate, password, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "connected", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeConnected),
+					resource.TestCheckResourceAttr(resourceName, "connection_error_code", ""),
+				),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rName, clusterID, trustAnchorCertificate, password, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "connected", "false"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_disappears(t *testing.T) {
+	var customKeyStore kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+	clusterID, trustAnchorCertificate, password := testAccCustomKeyStorePreCheck(t)
+
+	acctest.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rName, clusterID, trustAnchorCertificate, password, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkms.ResourceCustomKeyStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCustomKeyStorePreCheck skips the test unless an initialized CloudHSM cluster with an active HSM
+// and a kmsuser crypto user is available. It returns the cluster ID, trust anchor certificate and kmsuser password.
+// Only one custom key store can be associated with a cluster, so these tests must not be run in parallel with each other.
+func testAccCustomKeyStorePreCheck(t *testing.T) (string, string, string) {
+	clusterID := os.Getenv("KMS_CUSTOM_KEY_STORE_CLUSTER_ID")
+	trustAnchorCertificateFile := os.Getenv("KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_CERTIFICATE_FILE")
+	password := os.Getenv("KMS_CUSTOM_KEY_STORE_PASSWORD")
+
+	if clusterID == "" || trustAnchorCertificateFile == "" || password == "" {
+		t.Skip("Environment variables KMS_CUSTOM_KEY_STORE_CLUSTER_ID, KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_CERTIFICATE_FILE and KMS_CUSTOM_KEY_STORE_PASSWORD are not set")
+	}
+
+	trustAnchorCertificate, err := os.ReadFile(trustAnchorCertificateFile)
+
+	if err != nil {
+		t.Fatalf("error reading trust anchor certificate: %s", err)
+	}
+
+	return clusterID, string(trustAnchorCertificate), password
+}
+
+func testAccCheckCustomKeyStoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_custom_key_store" {
+			continue
+		}
+
+		_, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Custom Key Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCustomKeyStoreExists(name string, customKeyStore *kms.CustomKeyStoresListEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Custom Key Store ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+		output, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*customKeyStore = *output
+
+		return nil
+	}
+}
+
+// testAccCheckCustomKeyStoreImportedNoChanges verifies that planning the configuration against the imported state,
+// which has no password, produces no changes.
+func testAccCheckCustomKeyStoreImportedNoChanges(rName, clusterID, trustAnchorCertificate, password string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported state, got %d", len(states))
+		}
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"cloud_hsm_cluster_id":     clusterID,
+			"connected":                false,
+			"custom_key_store_name":    rName,
+			"key_store_password":       password,
+			"trust_anchor_certificate": trustAnchorCertificate,
+		})
+
+		diff, err := tfkms.ResourceCustomKeyStore().Diff(context.Background(), states[0], config, acctest.Provider.Meta())
+
+		if err != nil {
+			return err
+		}
+
+		if !diff.Empty() {
+			var attributes []string
+
+			for k := range diff.Attributes {
+				attributes = append(attributes, k)
+			}
+
+			return fmt.Errorf("expected no changes after import, got changes to %s", strings.Join(attributes, ", "))
+		}
+
+		return nil
+	}
+}
+
+func testAccCustomKeyStoreConfig_basic(rName, clusterID, trustAnchorCertificate, password string, connected bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name    = %[1]q
+  cloud_hsm_cluster_id     = %[2]q
+  trust_anchor_certificate = %[3]q
+  key_store_password       = %[4]q
+  connected                = %[5]t
+}
+`, rName, clusterID, trustAnchorCertificate, password, connected)
+}
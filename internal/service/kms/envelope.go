@@ -0,0 +1,144 @@
+package kms
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	envelopeAlgorithmAES256GCM = "AES_256_GCM"
+	envelopeNonceSize          = 12
+	envelopeTagSize            = 16
+	envelopeVersion            = 1
+)
+
+// envelope is an envelope-encrypted payload. The plaintext is encrypted locally with a KMS data key using
+// AES-256-GCM, without additional authenticated data, and the data key is encrypted with a KMS key.
+// Byte slices are base64 encoded in JSON. The ciphertext includes the 16 byte GCM authentication tag.
+type envelope struct {
+	Algorithm         string            `json:"algorithm"`
+	Ciphertext        []byte            `json:"ciphertext"`
+	EncryptedDataKey  []byte            `json:"encrypted_data_key"`
+	EncryptionContext map[string]string `json:"encryption_context,omitempty"`
+	KeyID             string            `json:"key_id"`
+	Nonce             []byte            `json:"nonce"`
+	Version           int               `json:"version"`
+}
+
+// sealEnvelope encrypts plaintext with a 256-bit data key and returns an envelope containing the encrypted data key.
+func sealEnvelope(keyID string, dataKey, encryptedDataKey, plaintext []byte, encryptionContext map[string]string) (*envelope, error) {
+	aead, err := newEnvelopeAEAD(dataKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+
+	return &envelope{
+		Algorithm:         envelopeAlgorithmAES256GCM,
+		Ciphertext:        aead.Seal(nil, nonce, plaintext, nil),
+		EncryptedDataKey:  encryptedDataKey,
+		EncryptionContext: encryptionContext,
+		KeyID:             keyID,
+		Nonce:             nonce,
+		Version:           envelopeVersion,
+	}, nil
+}
+
+// open decrypts the envelope's ciphertext with the decrypted data key.
+func (e *envelope) open(dataKey []byte) ([]byte, error) {
+	aead, err := newEnvelopeAEAD(dataKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// GCM panics on a nonce of the wrong length.
+	if len(e.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("nonce must be %d bytes, got %d", aead.NonceSize(), len(e.Nonce))
+	}
+
+	plaintext, err := aead.Open(nil, e.Nonce, e.Ciphertext, nil)
+
+	if err != nil {
+		return nil, errors.New("ciphertext could not be authenticated")
+	}
+
+	return plaintext, nil
+}
+
+func (e *envelope) String() (string, error) {
+	b, err := json.Marshal(e)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// parseEnvelope parses and validates a JSON envelope.
+func parseEnvelope(s string) (*envelope, error) {
+	var e envelope
+
+	if err := json.Unmarshal([]byte(s), &e); err != nil {
+		return nil, fmt.Errorf("invalid envelope: %w", err)
+	}
+
+	if e.Version != envelopeVersion {
+		return nil, fmt.Errorf("unsupported envelope version: %d", e.Version)
+	}
+
+	if e.Algorithm != envelopeAlgorithmAES256GCM {
+		return nil, fmt.Errorf("unsupported envelope algorithm: %s", e.Algorithm)
+	}
+
+	if len(e.EncryptedDataKey) == 0 {
+		return nil, errors.New("envelope is missing the encrypted data key")
+	}
+
+	if len(e.Nonce) == 0 {
+		return nil, errors.New("envelope is missing the nonce")
+	}
+
+	if len(e.Nonce) != envelopeNonceSize {
+		return nil, fmt.Errorf("envelope nonce must be %d bytes, got %d", envelopeNonceSize, len(e.Nonce))
+	}
+
+	if len(e.Ciphertext) < envelopeTagSize {
+		return nil, fmt.Errorf("envelope ciphertext must be at least %d bytes, got %d", envelopeTagSize, len(e.Ciphertext))
+	}
+
+	return &e, nil
+}
+
+func newEnvelopeAEAD(dataKey []byte) (cipher.AEAD, error) {
+	if len(dataKey) != 32 {
+		return nil, fmt.Errorf("data key must be 32 bytes, got %d", len(dataKey))
+	}
+
+	block, err := aes.NewCipher(dataKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// clearDataKey overwrites a plaintext data key once it is no longer needed.
+func clearDataKey(dataKey []byte) {
+	for i := range dataKey {
+		dataKey[i] = 0
+	}
+}
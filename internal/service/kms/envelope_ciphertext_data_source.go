@@ -0,0 +1,94 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceEnvelopeCiphertext() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEnvelopeCiphertextRead,
+
+		Schema: map[string]*schema.Schema{
+			"context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"envelope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grant_tokens": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"plaintext": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceEnvelopeCiphertextRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	keyID := d.Get("key_id").(string)
+	input := &kms.GenerateDataKeyInput{
+		KeyId:   aws.String(keyID),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	}
+
+	if v, ok := d.GetOk("context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.EncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("grant_tokens"); ok && len(v.([]interface{})) > 0 {
+		input.GrantTokens = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Generating KMS data key for key: %s", keyID)
+	output, err := conn.GenerateDataKey(input)
+
+	if err != nil {
+		return fmt.Errorf("error generating KMS data key (%s): %w", keyID, err)
+	}
+
+	defer clearDataKey(output.Plaintext)
+
+	keyARN := aws.StringValue(output.KeyId)
+	e, err := sealEnvelope(keyARN, output.Plaintext, output.CiphertextBlob, []byte(d.Get("plaintext").(string)), aws.StringValueMap(input.EncryptionContext))
+
+	if err != nil {
+		return fmt.Errorf("error encrypting plaintext with KMS data key (%s): %w", keyID, err)
+	}
+
+	v, err := e.String()
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(keyARN)
+	d.Set("envelope", v)
+	d.Set("key_arn", keyARN)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSEnvelopeCiphertextDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kms_envelope_ciphertext.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvelopeCiphertextDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_arn", "aws_kms_key.test", "arn"),
+					resource.TestMatchResourceAttr(dataSourceName, "envelope", regexp.MustCompile(`"algorithm":"AES_256_GCM"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "envelope", regexp.MustCompile(`"encryption_context":\{"purpose":"test"\}`)),
+					resource.TestMatchResourceAttr(dataSourceName, "envelope", regexp.MustCompile(`"version":1`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvelopeCiphertextDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+data "aws_kms_envelope_ciphertext" "test" {
+  key_id    = aws_kms_key.test.key_id
+  plaintext = strrep("larger than 4 KB ", 1024)
+
+  context = {
+    purpose = "test"
+  }
+}
+`, rName)
+}
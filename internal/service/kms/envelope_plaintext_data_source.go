@@ -0,0 +1,98 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceEnvelopePlaintext() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEnvelopePlaintextRead,
+
+		Schema: map[string]*schema.Schema{
+			"context": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"envelope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"grant_tokens": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plaintext": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceEnvelopePlaintextRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	e, err := parseEnvelope(d.Get("envelope").(string))
+
+	if err != nil {
+		return err
+	}
+
+	// The key ID is only required for data keys encrypted with asymmetric keys,
+	// but it ensures that the data key is decrypted with the expected KMS key.
+	input := &kms.DecryptInput{
+		CiphertextBlob: e.EncryptedDataKey,
+	}
+
+	if e.KeyID != "" {
+		input.KeyId = aws.String(e.KeyID)
+	}
+
+	if len(e.EncryptionContext) > 0 {
+		input.EncryptionContext = aws.StringMap(e.EncryptionContext)
+	}
+
+	if v, ok := d.GetOk("grant_tokens"); ok && len(v.([]interface{})) > 0 {
+		input.GrantTokens = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Decrypting KMS data key for key: %s", e.KeyID)
+	output, err := conn.Decrypt(input)
+
+	if err != nil {
+		return fmt.Errorf("error decrypting KMS data key (%s): %w", e.KeyID, err)
+	}
+
+	defer clearDataKey(output.Plaintext)
+
+	plaintext, err := e.open(output.Plaintext)
+
+	if err != nil {
+		return fmt.Errorf("error decrypting envelope: %w", err)
+	}
+
+	keyARN := aws.StringValue(output.KeyId)
+
+	d.SetId(keyARN)
+	d.Set("context", e.EncryptionContext)
+	d.Set("key_arn", keyARN)
+	d.Set("plaintext", string(plaintext))
+
+	return nil
+}
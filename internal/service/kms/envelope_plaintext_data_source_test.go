@@ -0,0 +1,90 @@
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSEnvelopePlaintextDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kms_envelope_plaintext.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvelopePlaintextDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "plaintext", "data.aws_kms_envelope_ciphertext.test", "plaintext"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_arn", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "context.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "context.purpose", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSEnvelopePlaintextDataSource_tampered(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvelopePlaintextDataSourceConfig_tampered(rName, `ciphertext = base64encode("tampered")`),
+				ExpectError: regexp.MustCompile(`ciphertext could not be authenticated`),
+			},
+			{
+				Config:      testAccEnvelopePlaintextDataSourceConfig_tampered(rName, `encryption_context = { purpose = "other" }`),
+				ExpectError: regexp.MustCompile(`InvalidCiphertextException`),
+			},
+		},
+	})
+}
+
+func testAccEnvelopePlaintextDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+data "aws_kms_envelope_ciphertext" "test" {
+  key_id    = aws_kms_key.test.key_id
+  plaintext = strrep("larger than 4 KB ", 1024)
+
+  context = {
+    purpose = "test"
+  }
+}
+`, rName)
+}
+
+func testAccEnvelopePlaintextDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvelopePlaintextDataSourceConfig_base(rName), `
+data "aws_kms_envelope_plaintext" "test" {
+  envelope = data.aws_kms_envelope_ciphertext.test.envelope
+}
+`)
+}
+
+func testAccEnvelopePlaintextDataSourceConfig_tampered(rName, override string) string {
+	return acctest.ConfigCompose(testAccEnvelopePlaintextDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_kms_envelope_plaintext" "test" {
+  envelope = jsonencode(merge(jsondecode(data.aws_kms_envelope_ciphertext.test.envelope), {
+    %[1]s
+  }))
+}
+`, override))
+}
@@ -0,0 +1,182 @@
+package kms
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	dataKey := bytes.Repeat([]byte{0x42}, 32)
+	plaintext := []byte(strings.Repeat("larger than 4 KB ", 512))
+	encryptionContext := map[string]string{"purpose": "test"}
+
+	e, err := sealEnvelope("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", dataKey, []byte("wrapped"), plaintext, encryptionContext)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(e.Nonce), 12; got != expected {
+		t.Errorf("got nonce length %d, expected %d", got, expected)
+	}
+
+	if got, expected := len(e.Ciphertext), len(plaintext)+16; got != expected {
+		t.Errorf("got ciphertext length %d, expected %d", got, expected)
+	}
+
+	s, err := e.String()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var fields map[string]interface{}
+
+	if err := json.Unmarshal([]byte(s), &fields); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, k := range []string{"algorithm", "ciphertext", "encrypted_data_key", "encryption_context", "key_id", "nonce", "version"} {
+		if _, ok := fields[k]; !ok {
+			t.Errorf("envelope is missing field %s: %s", k, s)
+		}
+	}
+
+	parsed, err := parseEnvelope(s)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := string(parsed.EncryptedDataKey), "wrapped"; got != expected {
+		t.Errorf("got encrypted data key %q, expected %q", got, expected)
+	}
+
+	if got, expected := parsed.EncryptionContext["purpose"], "test"; got != expected {
+		t.Errorf("got encryption context %q, expected %q", got, expected)
+	}
+
+	got, err := parsed.open(dataKey)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(got, plaintext) {
+		t.Errorf("decrypted plaintext does not match")
+	}
+}
+
+func TestEnvelopeOpenInvalid(t *testing.T) {
+	dataKey := bytes.Repeat([]byte{0x42}, 32)
+
+	e, err := sealEnvelope("key", dataKey, []byte("wrapped"), []byte("secret"), nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := e.open(bytes.Repeat([]byte{0x24}, 32)); err == nil {
+		t.Errorf("expected error opening envelope with wrong data key")
+	}
+
+	if _, err := e.open(dataKey[:16]); err == nil {
+		t.Errorf("expected error opening envelope with short data key")
+	}
+
+	nonce := e.Nonce
+	e.Nonce = nonce[:8]
+
+	if _, err := e.open(dataKey); err == nil {
+		t.Errorf("expected error opening envelope with short nonce")
+	}
+
+	e.Nonce = nonce
+	e.Ciphertext[0] ^= 0xff
+
+	if _, err := e.open(dataKey); err == nil {
+		t.Errorf("expected error opening tampered envelope")
+	}
+}
+
+func TestParseEnvelope(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Input         string
+		ExpectedError string
+	}{
+		{
+			TestName:      "invalid JSON",
+			Input:         `{`,
+			ExpectedError: "invalid envelope",
+		},
+		{
+			TestName:      "unsupported version",
+			Input:         `{"version":2,"algorithm":"AES_256_GCM","encrypted_data_key":"AA==","nonce":"AA=="}`,
+			ExpectedError: "unsupported envelope version: 2",
+		},
+		{
+			TestName:      "unsupported algorithm",
+			Input:         `{"version":1,"algorithm":"AES_128_CBC","encrypted_data_key":"AA==","nonce":"AA=="}`,
+			ExpectedError: "unsupported envelope algorithm: AES_128_CBC",
+		},
+		{
+			TestName:      "missing data key",
+			Input:         `{"version":1,"algorithm":"AES_256_GCM","nonce":"AA=="}`,
+			ExpectedError: "missing the encrypted data key",
+		},
+		{
+			TestName:      "missing nonce",
+			Input:         `{"version":1,"algorithm":"AES_256_GCM","encrypted_data_key":"AA=="}`,
+			ExpectedError: "missing the nonce",
+		},
+		{
+			TestName:      "short nonce",
+			Input:         `{"version":1,"algorithm":"AES_256_GCM","encrypted_data_key":"AA==","nonce":"AA==","ciphertext":"AAAAAAAAAAAAAAAAAAAAAA=="}`,
+			ExpectedError: "nonce must be 12 bytes, got 1",
+		},
+		{
+			TestName:      "long nonce",
+			Input:         `{"version":1,"algorithm":"AES_256_GCM","encrypted_data_key":"AA==","nonce":"AAAAAAAAAAAAAAAAAAAAAA==","ciphertext":"AAAAAAAAAAAAAAAAAAAAAA=="}`,
+			ExpectedError: "nonce must be 12 bytes, got 16",
+		},
+		{
+			TestName:      "missing ciphertext",
+			Input:         `{"version":1,"algorithm":"AES_256_GCM","encrypted_data_key":"AA==","nonce":"AAAAAAAAAAAAAAAA"}`,
+			ExpectedError: "ciphertext must be at least 16 bytes, got 0",
+		},
+		{
+			TestName:      "truncated ciphertext",
+			Input:         `{"version":1,"algorithm":"AES_256_GCM","encrypted_data_key":"AA==","nonce":"AAAAAAAAAAAAAAAA","ciphertext":"AAAAAAAAAAAAAAAAAAAA"}`,
+			ExpectedError: "ciphertext must be at least 16 bytes, got 15",
+		},
+		{
+			TestName: "valid",
+			Input:    `{"version":1,"algorithm":"AES_256_GCM","encrypted_data_key":"AA==","nonce":"AAAAAAAAAAAAAAAA","ciphertext":"AAAAAAAAAAAAAAAAAAAAAA=="}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			_, err := parseEnvelope(testCase.Input)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q", testCase.ExpectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("got error %q, expected error containing %q", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
@@ -40,6 +40,12 @@ func ResourceKey() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"custom_key_store_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
 			"customer_master_key_spec": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -109,6 +115,12 @@ func resourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		KeyUsage:                       aws.String(d.Get("key_usage").(string)),
 	}
 
+	// Key material for keys in a custom key store is generated in the associated CloudHSM cluster.
+	if v, ok := d.GetOk("custom_key_store_id"); ok {
+		input.CustomKeyStoreId = aws.String(v.(string))
+		input.Origin = aws.String(kms.OriginTypeAwsCloudhsm)
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -192,6 +204,7 @@ func resourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("arn", key.metadata.Arn)
+	d.Set("custom_key_store_id", key.metadata.CustomKeyStoreId)
 	d.Set("customer_master_key_spec", key.metadata.CustomerMasterKeySpec)
 	d.Set("description", key.metadata.Description)
 	d.Set("enable_key_rotation", key.rotation)
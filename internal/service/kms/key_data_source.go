@@ -23,10 +23,18 @@ func DataSourceKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cloud_hsm_cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"creation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"custom_key_store_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"customer_master_key_spec": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -151,7 +159,9 @@ func dataSourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(aws.StringValue(keyMetadata.KeyId))
 	d.Set("arn", keyMetadata.Arn)
 	d.Set("aws_account_id", keyMetadata.AWSAccountId)
+	d.Set("cloud_hsm_cluster_id", keyMetadata.CloudHsmClusterId)
 	d.Set("creation_date", aws.TimeValue(keyMetadata.CreationDate).Format(time.RFC3339))
+	d.Set("custom_key_store_id", keyMetadata.CustomKeyStoreId)
 	d.Set("customer_master_key_spec", keyMetadata.CustomerMasterKeySpec)
 	if keyMetadata.DeletionDate != nil {
 		d.Set("deletion_date", aws.TimeValue(keyMetadata.DeletionDate).Format(time.RFC3339))
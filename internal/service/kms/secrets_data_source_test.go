@@ -21,7 +21,7 @@ func TestAccKMSSecretsDataSource_basic(t *testing.T) {
 	resourceName := "aws_kms_key.test"
 
 	// Run a resource test to setup our KMS key
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -66,7 +66,7 @@ func testAccSecretsDecryptDataSource(t *testing.T, plaintext string, encryptedPa
 	return func(s *terraform.State) error {
 		dataSourceName := "data.aws_kms_secrets.test"
 
-		resource.Test(t, resource.TestCase{
+		acctest.Test(t, resource.TestCase{
 			PreCheck:          func() { acctest.PreCheck(t) },
 			ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
 			ProviderFactories: acctest.ProviderFactories,
@@ -22,3 +22,19 @@ func StatusKeyState(conn *kms.KMS, id string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.KeyState), nil
 	}
 }
+
+func StatusCustomKeyStoreConnectionState(conn *kms.KMS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCustomKeyStoreByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ConnectionState), nil
+	}
+}
@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package kms_test
+
+import (
+	"testing"
+)
+
+func TestAccKMSExternalKey_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kms_external_key has no testAccExternalKeyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccKMSKey_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kms_key has no testAccKeyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccKMSReplicaExternalKey_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kms_replica_external_key has no testAccReplicaExternalKeyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccKMSReplicaKey_TagsConsistency(t *testing.T) {
+	t.Skip("aws_kms_replica_key has no testAccReplicaKeyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -1,6 +1,7 @@
 package kms
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +14,10 @@ import (
 )
 
 const (
+	// Connecting to a CloudHSM cluster can take up to 20 minutes.
+	CustomKeyStoreConnectedTimeout    = 30 * time.Minute
+	CustomKeyStoreDisconnectedTimeout = 20 * time.Minute
+
 	// Maximum amount of time to wait for StatusKeyState to return PendingDeletion
 	KeyStatePendingDeletionTimeout = 20 * time.Minute
 
@@ -37,6 +42,45 @@ func WaitIAMPropagation(f func() (interface{}, error)) (interface{}, error) {
 	return tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, f, kms.ErrCodeMalformedPolicyDocumentException)
 }
 
+func WaitCustomKeyStoreConnected(conn *kms.KMS, id string, timeout time.Duration) (*kms.CustomKeyStoresListEntry, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.ConnectionStateTypeConnecting},
+		Target:  []string{kms.ConnectionStateTypeConnected},
+		Refresh: StatusCustomKeyStoreConnectionState(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok {
+		if state := aws.StringValue(output.ConnectionState); state == kms.ConnectionStateTypeFailed {
+			tfresource.SetLastError(err, fmt.Errorf("connection error code: %s", aws.StringValue(output.ConnectionErrorCode)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCustomKeyStoreDisconnected(conn *kms.KMS, id string, timeout time.Duration) (*kms.CustomKeyStoresListEntry, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.ConnectionStateTypeConnected, kms.ConnectionStateTypeDisconnecting},
+		Target:  []string{kms.ConnectionStateTypeDisconnected},
+		Refresh: StatusCustomKeyStoreConnectionState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitKeyDeleted(conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kms.KeyStateDisabled, kms.KeyStateEnabled},
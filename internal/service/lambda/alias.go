@@ -1,14 +1,17 @@
 package lambda
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
@@ -22,7 +25,44 @@ func ResourceAlias() *schema.Resource {
 			State: resourceAliasImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"deployment_strategy": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"routing_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validAlarmARN(),
+							},
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"percentage": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0.1, 99.9),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(aliasDeploymentStrategyTypeValues(), false),
+						},
+					},
+				},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -71,7 +111,27 @@ func ResourceAlias() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: checkDeploymentStrategyFunctionVersion,
+	}
+}
+
+// checkDeploymentStrategyFunctionVersion rejects a deployment strategy for an alias of $LATEST,
+// as weighted aliases can only route traffic to published versions.
+func checkDeploymentStrategyFunctionVersion(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("deployment_strategy"); !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	if !d.NewValueKnown("function_version") {
+		return nil
+	}
+
+	if d.Get("function_version").(string) == FunctionVersionLatest {
+		return fmt.Errorf("deployment_strategy requires function_version to be a published version, not %s; publish versions with the publish argument of aws_lambda_function", FunctionVersionLatest)
 	}
+
+	return nil
 }
 
 // resourceAliasCreate maps to:
@@ -176,7 +236,32 @@ func resourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 		RoutingConfig:   expandAliasRoutingConfiguration(d.Get("routing_config").([]interface{})),
 	}
 
-	_, err := conn.UpdateAlias(params)
+	strategy, err := expandAliasDeploymentStrategy(d.Get("deployment_strategy").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error updating Lambda alias: %s", err)
+	}
+
+	if o, n := d.GetChange("function_version"); strategy != nil && o.(string) == FunctionVersionLatest && o.(string) != n.(string) {
+		// Weighted aliases can't route traffic to $LATEST, so there is nothing to shift traffic away from gradually.
+		log.Printf("[WARN] Lambda alias (%s:%s) routes traffic to %s, routing all traffic to version %s", d.Get("function_name"), d.Get("name"), FunctionVersionLatest, n)
+	} else if strategy != nil && o.(string) != "" && o.(string) != n.(string) {
+		if v, timeout := strategy.duration(), d.Timeout(schema.TimeoutUpdate); v > timeout {
+			return fmt.Errorf("Error updating Lambda alias: deployment takes at least %s, longer than the update timeout (%s)", v, timeout)
+		}
+
+		log.Printf("[INFO] Deploying Lambda alias (%s:%s) version %s using %s strategy", d.Get("function_name"), d.Get("name"), n, strategy.strategy)
+
+		if err := deployAlias(conn, meta.(*conns.AWSClient).CloudWatchConn, params, o.(string), strategy); err != nil {
+			// Keep the previous function version in state.
+			d.Partial(true)
+
+			return fmt.Errorf("Error updating Lambda alias: %w", err)
+		}
+
+		return nil
+	}
+
+	_, err = conn.UpdateAlias(params)
 	if err != nil {
 		return fmt.Errorf("Error updating Lambda alias: %s", err)
 	}
@@ -16,7 +16,7 @@ func TestAccLambdaAliasDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_lambda_alias.test"
 	resourceName := "aws_lambda_alias.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,242 @@
+package lambda
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	aliasDeploymentStrategyTypeCanary = "Canary"
+	aliasDeploymentStrategyTypeLinear = "Linear"
+)
+
+func aliasDeploymentStrategyTypeValues() []string {
+	return []string{
+		aliasDeploymentStrategyTypeCanary,
+		aliasDeploymentStrategyTypeLinear,
+	}
+}
+
+const (
+	// aliasDeploymentAlarmPollInterval is how often alarms are checked while traffic is shifted.
+	aliasDeploymentAlarmPollInterval = 30 * time.Second
+)
+
+type aliasDeploymentStrategy struct {
+	alarmNames []string
+	interval   time.Duration
+	percentage float64
+	strategy   string
+}
+
+func expandAliasDeploymentStrategy(tfList []interface{}) (*aliasDeploymentStrategy, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &aliasDeploymentStrategy{
+		interval:   time.Duration(tfMap["interval"].(int)) * time.Minute,
+		percentage: tfMap["percentage"].(float64),
+		strategy:   tfMap["type"].(string),
+	}
+
+	if v, ok := tfMap["alarm_arns"].(*schema.Set); ok && v.Len() > 0 {
+		for _, v := range aws.StringValueSlice(flex.ExpandStringSet(v)) {
+			name, err := alarmNameFromARN(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			apiObject.alarmNames = append(apiObject.alarmNames, name)
+		}
+	}
+
+	return apiObject, nil
+}
+
+// weights returns the percentage of traffic routed to the new version at each step of the deployment,
+// excluding the final step which routes all traffic to the new version.
+func (s *aliasDeploymentStrategy) weights() []float64 {
+	if s.strategy == aliasDeploymentStrategyTypeCanary {
+		return []float64{s.percentage}
+	}
+
+	var weights []float64
+
+	for i := 1; ; i++ {
+		// Avoid floating point artifacts such as 30.000000000000004.
+		weight := math.Round(float64(i)*s.percentage*1000) / 1000
+
+		if weight >= 100 {
+			break
+		}
+
+		weights = append(weights, weight)
+	}
+
+	return weights
+}
+
+// duration returns the minimum time taken by the deployment.
+func (s *aliasDeploymentStrategy) duration() time.Duration {
+	return time.Duration(len(s.weights())) * s.interval
+}
+
+// deployAlias shifts an alias's traffic from its current version to a new version in steps.
+// Between steps the alarms are checked and, if any is in the ALARM state, all traffic is routed
+// back to the current version and an error returned. Traffic is also routed back to the current
+// version if any step fails, so that the alias is never left with a partial weight.
+func deployAlias(conn *lambda.Lambda, cwConn *cloudwatch.CloudWatch, input *lambda.UpdateAliasInput, currentVersion string, strategy *aliasDeploymentStrategy) error {
+	functionName := aws.StringValue(input.FunctionName)
+	aliasName := aws.StringValue(input.Name)
+	newVersion := aws.StringValue(input.FunctionVersion)
+
+	if err := checkAliasDeploymentAlarms(cwConn, strategy.alarmNames); err != nil {
+		return fmt.Errorf("not deploying version %s: %w", newVersion, err)
+	}
+
+	rollback := func(err error) error {
+		log.Printf("[WARN] Lambda alias (%s:%s) deployment: %s, rolling back to version %s", functionName, aliasName, err, currentVersion)
+
+		_, rollbackErr := conn.UpdateAlias(&lambda.UpdateAliasInput{
+			Description:     input.Description,
+			FunctionName:    input.FunctionName,
+			FunctionVersion: aws.String(currentVersion),
+			Name:            input.Name,
+			RoutingConfig:   &lambda.AliasRoutingConfiguration{},
+		})
+
+		if rollbackErr != nil {
+			return fmt.Errorf("deploying version %s: %s; rolling back to version %s: %w", newVersion, err, currentVersion, rollbackErr)
+		}
+
+		return fmt.Errorf("deploying version %s: %w; rolled back to version %s", newVersion, err, currentVersion)
+	}
+
+	for _, weight := range strategy.weights() {
+		log.Printf("[INFO] Lambda alias (%s:%s) deployment: routing %g%% of traffic to version %s", functionName, aliasName, weight, newVersion)
+
+		_, err := conn.UpdateAlias(&lambda.UpdateAliasInput{
+			Description:     input.Description,
+			FunctionName:    input.FunctionName,
+			FunctionVersion: aws.String(currentVersion),
+			Name:            input.Name,
+			RoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: aws.Float64Map(map[string]float64{
+					newVersion: weight / 100,
+				}),
+			},
+		})
+
+		if err != nil {
+			return rollback(fmt.Errorf("routing %g%% of traffic to version %s: %w", weight, newVersion, err))
+		}
+
+		if err := waitAliasDeploymentStep(cwConn, strategy); err != nil {
+			return rollback(err)
+		}
+	}
+
+	log.Printf("[INFO] Lambda alias (%s:%s) deployment: routing all traffic to version %s", functionName, aliasName, newVersion)
+
+	if _, err := conn.UpdateAlias(input); err != nil {
+		return rollback(fmt.Errorf("routing all traffic to version %s: %w", newVersion, err))
+	}
+
+	return nil
+}
+
+// waitAliasDeploymentStep waits for a deployment step's interval, checking the alarms periodically.
+func waitAliasDeploymentStep(cwConn *cloudwatch.CloudWatch, strategy *aliasDeploymentStrategy) error {
+	deadline := time.Now().Add(strategy.interval)
+
+	for {
+		if err := checkAliasDeploymentAlarms(cwConn, strategy.alarmNames); err != nil {
+			return err
+		}
+
+		remaining := time.Until(deadline)
+
+		if remaining <= 0 {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Waiting %s until next deployment step", remaining.Round(time.Second))
+
+		if remaining > aliasDeploymentAlarmPollInterval {
+			remaining = aliasDeploymentAlarmPollInterval
+		}
+
+		time.Sleep(remaining)
+	}
+}
+
+// checkAliasDeploymentAlarms returns an error if any of the specified alarms is in the ALARM state.
+func checkAliasDeploymentAlarms(conn *cloudwatch.CloudWatch, alarmNames []string) error {
+	if len(alarmNames) == 0 {
+		return nil
+	}
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: aws.StringSlice(alarmNames),
+		AlarmTypes: aws.StringSlice(cloudwatch.AlarmType_Values()),
+		StateValue: aws.String(cloudwatch.StateValueAlarm),
+	}
+	var firing []string
+
+	err := conn.DescribeAlarmsPages(input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricAlarms {
+			firing = append(firing, aws.StringValue(v.AlarmName))
+		}
+
+		for _, v := range page.CompositeAlarms {
+			firing = append(firing, aws.StringValue(v.AlarmName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading CloudWatch Metric Alarms: %w", err)
+	}
+
+	if len(firing) > 0 {
+		return fmt.Errorf("CloudWatch alarms in %s state: %s", cloudwatch.StateValueAlarm, strings.Join(firing, ", "))
+	}
+
+	return nil
+}
+
+// alarmNameFromARN returns the name of the CloudWatch alarm with the specified ARN.
+func alarmNameFromARN(v string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing CloudWatch alarm ARN (%s): %w", v, err)
+	}
+
+	name := strings.TrimPrefix(parsedARN.Resource, "alarm:")
+
+	if parsedARN.Service != "cloudwatch" || name == parsedARN.Resource || name == "" {
+		return "", fmt.Errorf("invalid CloudWatch alarm ARN (%s)", v)
+	}
+
+	return name, nil
+}
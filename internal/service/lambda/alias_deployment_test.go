@@ -0,0 +1,89 @@
+package lambda
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAliasDeploymentStrategyWeights(t *testing.T) {
+	testCases := []struct {
+		strategy         *aliasDeploymentStrategy
+		expectedWeights  []float64
+		expectedDuration time.Duration
+	}{
+		{
+			strategy:         &aliasDeploymentStrategy{interval: 5 * time.Minute, percentage: 10, strategy: aliasDeploymentStrategyTypeCanary},
+			expectedWeights:  []float64{10},
+			expectedDuration: 5 * time.Minute,
+		},
+		{
+			strategy:         &aliasDeploymentStrategy{interval: 1 * time.Minute, percentage: 10, strategy: aliasDeploymentStrategyTypeLinear},
+			expectedWeights:  []float64{10, 20, 30, 40, 50, 60, 70, 80, 90},
+			expectedDuration: 9 * time.Minute,
+		},
+		{
+			strategy:         &aliasDeploymentStrategy{interval: 2 * time.Minute, percentage: 30, strategy: aliasDeploymentStrategyTypeLinear},
+			expectedWeights:  []float64{30, 60, 90},
+			expectedDuration: 6 * time.Minute,
+		},
+		{
+			strategy:         &aliasDeploymentStrategy{interval: 1 * time.Minute, percentage: 0.1, strategy: aliasDeploymentStrategyTypeLinear},
+			expectedDuration: 999 * time.Minute,
+		},
+	}
+
+	for _, testCase := range testCases {
+		weights := testCase.strategy.weights()
+
+		if testCase.expectedWeights != nil && !reflect.DeepEqual(weights, testCase.expectedWeights) {
+			t.Errorf("%+v: expected weights %v, got %v", testCase.strategy, testCase.expectedWeights, weights)
+		}
+
+		for _, v := range weights {
+			if v <= 0 || v >= 100 {
+				t.Errorf("%+v: weight %g out of range", testCase.strategy, v)
+			}
+		}
+
+		if got := testCase.strategy.duration(); got != testCase.expectedDuration {
+			t.Errorf("%+v: expected duration %s, got %s", testCase.strategy, testCase.expectedDuration, got)
+		}
+	}
+}
+
+func TestAlarmNameFromARN(t *testing.T) {
+	testCases := []struct {
+		arn      string
+		expected string
+		err      bool
+	}{
+		{arn: "arn:aws:cloudwatch:us-west-2:123456789012:alarm:my-alarm", expected: "my-alarm"},        //lintignore:AWSAT003,AWSAT005
+		{arn: "arn:aws:cloudwatch:us-west-2:123456789012:alarm:my:alarm", expected: "my:alarm"},        //lintignore:AWSAT003,AWSAT005
+		{arn: "arn:aws-us-gov:cloudwatch:us-gov-west-1:123456789012:alarm:Errors", expected: "Errors"}, //lintignore:AWSAT003,AWSAT005
+		{arn: "my-alarm", err: true},
+		{arn: "arn:aws:cloudwatch:us-west-2:123456789012:dashboard/my-dashboard", err: true}, //lintignore:AWSAT003,AWSAT005
+		{arn: "arn:aws:sns:us-west-2:123456789012:alarm:my-alarm", err: true},                //lintignore:AWSAT003,AWSAT005
+		{arn: "arn:aws:cloudwatch:us-west-2:123456789012:alarm:", err: true},                 //lintignore:AWSAT003,AWSAT005
+	}
+
+	for _, testCase := range testCases {
+		got, err := alarmNameFromARN(testCase.arn)
+
+		if testCase.err {
+			if err == nil {
+				t.Errorf("%s: expected error", testCase.arn)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: %s", testCase.arn, err)
+		}
+
+		if got != testCase.expected {
+			t.Errorf("%s: expected %s, got %s", testCase.arn, testCase.expected, got)
+		}
+	}
+}
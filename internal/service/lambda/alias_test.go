@@ -2,9 +2,11 @@ package lambda_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -26,7 +28,7 @@ func TestAccLambdaAlias_basic(t *testing.T) {
 
 	functionArnResourcePart := fmt.Sprintf("function:%s:%s", funcName, aliasName)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -62,7 +64,7 @@ func TestAccLambdaAlias_FunctionName_name(t *testing.T) {
 	resourceName := "aws_lambda_alias.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -103,7 +105,7 @@ func TestAccLambdaAlias_nameUpdate(t *testing.T) {
 	functionArnResourcePart := fmt.Sprintf("function:%s:%s", funcName, aliasName)
 	functionArnResourcePartUpdate := fmt.Sprintf("function:%s:%s", funcName, aliasNameUpdate)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -148,7 +150,7 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 
 	functionArnResourcePart := fmt.Sprintf("function:%s:%s", funcName, aliasName)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -190,6 +192,148 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 	})
 }
 
+func TestAccLambdaAlias_deploymentStrategy(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf lambda.AliasConfiguration
+	resourceName := "aws_lambda_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_deploymentStrategy(rName, "test-fixtures/lambdatest.zip", "Canary", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment_strategy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_strategy.0.alarm_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_strategy.0.interval", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_strategy.0.percentage", "10"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_strategy.0.type", "Canary"),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAliasImportStateIDFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deployment_strategy"},
+			},
+			{
+				Config: testAccAliasConfig_deploymentStrategy(rName, "test-fixtures/lambdatest_modified.zip", "Linear", 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment_strategy.0.type", "Linear"),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaAlias_DeploymentStrategy_rollback(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf lambda.AliasConfiguration
+	resourceName := "aws_lambda_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_deploymentStrategy(rName, "test-fixtures/lambdatest.zip", "Canary", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccSetAlarmState(t, rName, cloudwatch.StateValueAlarm)
+				},
+				Config:      testAccAliasConfig_deploymentStrategy(rName, "test-fixtures/lambdatest_modified.zip", "Canary", 10),
+				ExpectError: regexp.MustCompile(`CloudWatch alarms in ALARM state`),
+			},
+			{
+				PreConfig: func() {
+					// The alias still routes all traffic to version 1.
+					conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+					output, err := conn.GetAlias(&lambda.GetAliasInput{
+						FunctionName: aws.String(rName),
+						Name:         aws.String(rName),
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					if v := aws.StringValue(output.FunctionVersion); v != "1" {
+						t.Fatalf("expected function version 1, got %s", v)
+					}
+
+					if output.RoutingConfig != nil && len(output.RoutingConfig.AdditionalVersionWeights) > 0 {
+						t.Fatalf("expected no additional version weights, got %v", aws.Float64ValueMap(output.RoutingConfig.AdditionalVersionWeights))
+					}
+
+					testAccSetAlarmState(t, rName, cloudwatch.StateValueOk)
+				},
+				Config: testAccAliasConfig_deploymentStrategy(rName, "test-fixtures/lambdatest_modified.zip", "Canary", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaAlias_DeploymentStrategy_latest(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAliasConfig_deploymentStrategyLatest(rName),
+				ExpectError: regexp.MustCompile(`deployment_strategy requires function_version to be a published version`),
+			},
+		},
+	})
+}
+
+func testAccSetAlarmState(t *testing.T, alarmName, state string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+	_, err := conn.SetAlarmState(&cloudwatch.SetAlarmStateInput{
+		AlarmName:   aws.String(alarmName),
+		StateReason: aws.String("acceptance test"),
+		StateValue:  aws.String(state),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccCheckAliasDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
 
@@ -422,3 +566,73 @@ resource "aws_lambda_alias" "test" {
 }
 `, funcName, aliasName))
 }
+
+func testAccAliasConfig_deploymentStrategy(rName, filename, strategy string, percentage int) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_base(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Errors"
+  namespace           = "AWS/Lambda"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 0
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+  }
+}
+
+resource "aws_lambda_function" "test" {
+  filename         = %[2]q
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs12.x"
+  source_code_hash = filebase64sha256(%[2]q)
+  publish          = true
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+
+  deployment_strategy {
+    alarm_arns = [aws_cloudwatch_metric_alarm.test.arn]
+    interval   = 1
+    percentage = %[4]d
+    type       = %[3]q
+  }
+}
+`, rName, filename, strategy, percentage))
+}
+
+func testAccAliasConfig_deploymentStrategyLatest(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_base(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "$LATEST"
+
+  deployment_strategy {
+    interval   = 1
+    percentage = 10
+    type       = "Canary"
+  }
+}
+`, rName))
+}
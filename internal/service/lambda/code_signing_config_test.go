@@ -19,7 +19,7 @@ func TestAccLambdaCodeSigningConfig_basic(t *testing.T) {
 	signingProfile2 := "aws_signer_signing_profile.test2"
 	var conf lambda.GetCodeSigningConfigOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -49,7 +49,7 @@ func TestAccLambdaCodeSigningConfig_updatePolicy(t *testing.T) {
 	resourceName := "aws_lambda_code_signing_config.code_signing_config"
 	var conf lambda.GetCodeSigningConfigOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -85,7 +85,7 @@ func TestAccLambdaCodeSigningConfig_updatePublishers(t *testing.T) {
 	signingProfile2 := "aws_signer_signing_profile.test2"
 	var conf lambda.GetCodeSigningConfigOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
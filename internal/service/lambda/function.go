@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -62,7 +64,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_dir", "source_files"},
 			},
 			"s3_bucket": {
 				Type:          schema.TypeString,
@@ -77,12 +79,28 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir", "source_files"},
 			},
 			"image_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_dir", "source_files"},
+			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "image_uri", "s3_object_version", "source_code_hash", "source_files"},
+			},
+			"source_excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"source_files": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"filename", "image_uri", "s3_object_version", "source_code_hash", "source_dir"},
 			},
 			"package_type": {
 				Type:         schema.TypeString,
@@ -349,6 +367,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateSourceCodeHashForPackage,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -371,6 +390,33 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// updateSourceCodeHashForPackage builds the deployment package from source_dir or source_files
+// so that the plan only contains a code change when the package contents change.
+func updateSourceCodeHashForPackage(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_files") || !d.NewValueKnown("source_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	sourceDir := d.Get("source_dir").(string)
+	sourceFiles := d.Get("source_files").(*schema.Set)
+
+	if sourceDir == "" && sourceFiles.Len() == 0 {
+		return nil
+	}
+
+	v, err := buildFunctionPackage(sourceDir, aws.StringValueSlice(flex.ExpandStringSet(sourceFiles)), aws.StringValueSlice(flex.ExpandStringSet(d.Get("source_excludes").(*schema.Set))))
+
+	if err != nil {
+		return fmt.Errorf("error building Lambda Function deployment package: %w", err)
+	}
+
+	if hash := functionPackageHash(v); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := hasConfigChanges(d)
 	functionCodeUpdated := needsFunctionCodeUpdate(d)
@@ -424,13 +470,24 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 	imageUri, hasImageUri := d.GetOk("image_uri")
+	_, hasSourceDir := d.GetOk("source_dir")
+	_, hasSourceFiles := d.GetOk("source_files")
+	hasSource := hasSourceDir || hasSourceFiles
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri {
-		return errors.New("filename, s3_* or image_uri attributes must be set")
+	if !hasFilename && !hasSource && !bucketOk && !keyOk && !versionOk && !hasImageUri {
+		return errors.New("filename, source_dir, source_files, s3_* or image_uri attributes must be set")
 	}
 
 	var functionCode *lambda.FunctionCode
-	if hasFilename {
+	if hasSource {
+		conns.GlobalMutexKV.Lock(keyMutex)
+		defer conns.GlobalMutexKV.Unlock(keyMutex)
+		code, err := functionPackageCode(d, meta)
+		if err != nil {
+			return fmt.Errorf("error creating Lambda Function (%s): %w", functionName, err)
+		}
+		functionCode = code
+	} else if hasFilename {
 		// Grab an exclusive lock so that we're only reading one function into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
@@ -1210,7 +1267,21 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if v, ok := d.GetOk("filename"); ok {
+		_, hasSourceDir := d.GetOk("source_dir")
+		_, hasSourceFiles := d.GetOk("source_files")
+
+		if hasSourceDir || hasSourceFiles {
+			conns.GlobalMutexKV.Lock(keyMutex)
+			defer conns.GlobalMutexKV.Unlock(keyMutex)
+			code, err := functionPackageCode(d, meta)
+			if err != nil {
+				return fmt.Errorf("error modifying Lambda Function (%s) Code: %w", d.Id(), err)
+			}
+			codeReq.ZipFile = code.ZipFile
+			codeReq.S3Bucket = code.S3Bucket
+			codeReq.S3Key = code.S3Key
+			codeReq.S3ObjectVersion = code.S3ObjectVersion
+		} else if v, ok := d.GetOk("filename"); ok {
 			// Grab an exclusive lock so that we're only reading one function into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
@@ -1319,6 +1390,56 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceFunctionRead(d, meta)
 }
 
+// functionPackageCode builds the deployment package from source_dir or source_files.
+// The package is uploaded to S3 if s3_bucket is set, otherwise it is uploaded directly.
+func functionPackageCode(d *schema.ResourceData, meta interface{}) (*lambda.FunctionCode, error) {
+	v, err := buildFunctionPackage(d.Get("source_dir").(string), aws.StringValueSlice(flex.ExpandStringSet(d.Get("source_files").(*schema.Set))), aws.StringValueSlice(flex.ExpandStringSet(d.Get("source_excludes").(*schema.Set))))
+
+	if err != nil {
+		return nil, fmt.Errorf("building deployment package: %w", err)
+	}
+
+	// The hash is unknown during planning if any source argument is unknown.
+	if planned, hash := d.Get("source_code_hash").(string), functionPackageHash(v); planned != "" && planned != hash {
+		return nil, fmt.Errorf("deployment package has changed since the plan was created (source_code_hash %s, now %s)", planned, hash)
+	}
+
+	s3Bucket, ok := d.GetOk("s3_bucket")
+
+	if !ok {
+		if len(v) > functionPackageMaxDirectUploadSize {
+			return nil, fmt.Errorf("deployment package size (%d bytes) exceeds the maximum for direct upload (%d bytes), set s3_bucket and s3_key to upload it via S3", len(v), functionPackageMaxDirectUploadSize)
+		}
+
+		return &lambda.FunctionCode{
+			ZipFile: v,
+		}, nil
+	}
+
+	s3Key, ok := d.GetOk("s3_key")
+
+	if !ok {
+		return nil, errors.New("s3_key must be set when s3_bucket is used with source_dir or source_files")
+	}
+
+	log.Printf("[DEBUG] Uploading Lambda Function deployment package to s3://%s/%s", s3Bucket, s3Key)
+	output, err := meta.(*conns.AWSClient).S3Conn.PutObject(&s3.PutObjectInput{
+		Body:   bytes.NewReader(v),
+		Bucket: aws.String(s3Bucket.(string)),
+		Key:    aws.String(s3Key.(string)),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading deployment package to S3 Bucket (%s): %w", s3Bucket, err)
+	}
+
+	return &lambda.FunctionCode{
+		S3Bucket:        aws.String(s3Bucket.(string)),
+		S3Key:           aws.String(s3Key.(string)),
+		S3ObjectVersion: output.VersionId,
+	}, nil
+}
+
 // loadFileContent returns contents of a file in a given path
 func loadFileContent(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
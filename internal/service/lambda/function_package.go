@@ -0,0 +1,263 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// functionPackageMaxDirectUploadSize is the largest deployment package that is uploaded
+	// directly to Lambda. Larger packages must be uploaded via S3.
+	functionPackageMaxDirectUploadSize = 50 * 1024 * 1024
+)
+
+var (
+	// functionPackageModTime is the modification time of every deployment package entry.
+	// It is the earliest time that can be represented in the ZIP format.
+	functionPackageModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type functionPackageEntry struct {
+	name string // Slash-separated name in the archive.
+	path string // Path on the local file system.
+	mode fs.FileMode
+}
+
+// buildFunctionPackage returns a reproducible ZIP deployment package containing either the
+// contents of sourceDir or the files in sourceFiles, less any entries matching the exclude patterns.
+// Entries are sorted by name, have a fixed modification time and have permissions of 0644,
+// or 0755 if the source file is executable, so that the package only changes when file contents change.
+func buildFunctionPackage(sourceDir string, sourceFiles []string, excludes []string) ([]byte, error) {
+	var entries []functionPackageEntry
+	var err error
+
+	if sourceDir != "" {
+		entries, err = functionPackageDirEntries(sourceDir, excludes)
+	} else {
+		entries, err = functionPackageFileEntries(sourceFiles, excludes)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("deployment package contains no files")
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	for _, entry := range entries {
+		if err := writeFunctionPackageEntry(w, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// functionPackageHash returns the base64-encoded SHA256 hash of a deployment package,
+// the same format as the function's CodeSha256.
+func functionPackageHash(b []byte) string {
+	sum := sha256.Sum256(b)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func functionPackageDirEntries(sourceDir string, excludes []string) ([]functionPackageEntry, error) {
+	root, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []functionPackageEntry
+
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		name := filepath.ToSlash(rel)
+
+		if excluded, err := functionPackageExcluded(name, excludes); err != nil {
+			return err
+		} else if excluded {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		// Follow symbolic links to files.
+		fi, err := os.Stat(p)
+
+		if err != nil {
+			return err
+		}
+
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		entries = append(entries, functionPackageEntry{
+			name: name,
+			path: p,
+			mode: fi.Mode(),
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", sourceDir, err)
+	}
+
+	return entries, nil
+}
+
+func functionPackageFileEntries(sourceFiles []string, excludes []string) ([]functionPackageEntry, error) {
+	var entries []functionPackageEntry
+	names := make(map[string]string)
+
+	for _, sourceFile := range sourceFiles {
+		p, err := homedir.Expand(sourceFile)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Files are stored at the root of the package.
+		name := filepath.Base(p)
+
+		if v, ok := names[name]; ok {
+			return nil, fmt.Errorf("source files %s and %s have the same name", v, sourceFile)
+		}
+
+		names[name] = sourceFile
+
+		if excluded, err := functionPackageExcluded(name, excludes); err != nil {
+			return nil, err
+		} else if excluded {
+			continue
+		}
+
+		fi, err := os.Stat(p)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading source file (%s): %w", sourceFile, err)
+		}
+
+		if !fi.Mode().IsRegular() {
+			return nil, fmt.Errorf("source file (%s) is not a regular file", sourceFile)
+		}
+
+		entries = append(entries, functionPackageEntry{
+			name: name,
+			path: p,
+			mode: fi.Mode(),
+		})
+	}
+
+	return entries, nil
+}
+
+// functionPackageExcluded returns whether the specified slash-separated name, or any of its
+// parent directories, matches one of the exclude patterns.
+// Patterns containing a slash are matched against the path relative to the source directory,
+// other patterns are matched against the base name at any depth.
+func functionPackageExcluded(name string, excludes []string) (bool, error) {
+	for _, pattern := range excludes {
+		pattern = strings.TrimSuffix(pattern, "/")
+		anyDepth := !strings.Contains(pattern, "/")
+		pattern = strings.TrimPrefix(pattern, "/")
+
+		for v := name; v != "."; v = path.Dir(v) {
+			target := v
+
+			if anyDepth {
+				target = path.Base(v)
+			}
+
+			matched, err := path.Match(pattern, target)
+
+			if err != nil {
+				return false, fmt.Errorf("invalid exclude pattern (%s): %w", pattern, err)
+			}
+
+			if matched {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func writeFunctionPackageEntry(w *zip.Writer, entry functionPackageEntry) error {
+	mode := fs.FileMode(0644)
+
+	if entry.mode&0111 != 0 {
+		mode = 0755
+	}
+
+	header := &zip.FileHeader{
+		Name:     entry.name,
+		Method:   zip.Deflate,
+		Modified: functionPackageModTime,
+	}
+	header.SetMode(mode)
+
+	f, err := os.Open(entry.path)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	dst, err := w.CreateHeader(header)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(dst, f); err != nil {
+		return fmt.Errorf("reading %s: %w", entry.path, err)
+	}
+
+	return nil
+}
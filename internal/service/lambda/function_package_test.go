@@ -0,0 +1,179 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildFunctionPackage(t *testing.T) {
+	dir := t.TempDir()
+
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "index.js"), "exports.handler = function() {}", 0600)
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "bootstrap"), "#!/bin/sh", 0700)
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "README.md"), "readme", 0644)
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "lib", "util.js"), "module.exports = {}", 0644)
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "lib", "notes.md"), "notes", 0644)
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "node_modules", "dep", "index.js"), "module.exports = {}", 0644)
+
+	v1, err := buildFunctionPackage(dir, nil, []string{"*.md", "node_modules/"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(v1), int64(len(v1)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	modes := make(map[string]os.FileMode)
+
+	for _, f := range r.File {
+		names = append(names, f.Name)
+		modes[f.Name] = f.Mode()
+
+		if !f.Modified.Equal(functionPackageModTime) {
+			t.Errorf("%s: expected modification time %s, got %s", f.Name, functionPackageModTime, f.Modified)
+		}
+	}
+
+	if expected := []string{"bootstrap", "index.js", "lib/util.js"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected entries %v, got %v", expected, names)
+	}
+
+	if got := modes["index.js"]; got != 0644 {
+		t.Errorf("index.js: expected mode 0644, got %s", got)
+	}
+
+	if got := modes["bootstrap"]; got != 0755 {
+		t.Errorf("bootstrap: expected mode 0755, got %s", got)
+	}
+
+	// Modification times and excluded files don't change the package.
+	later := time.Now().Add(1 * time.Hour)
+
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "README.md"), "updated readme", 0644)
+
+	v2, err := buildFunctionPackage(dir, nil, []string{"*.md", "node_modules/"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if functionPackageHash(v1) != functionPackageHash(v2) {
+		t.Errorf("expected package to be unchanged")
+	}
+
+	// Content changes do.
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "lib", "util.js"), "module.exports = { updated: true }", 0644)
+
+	v3, err := buildFunctionPackage(dir, nil, []string{"*.md", "node_modules/"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if functionPackageHash(v1) == functionPackageHash(v3) {
+		t.Errorf("expected package to change")
+	}
+}
+
+func TestBuildFunctionPackage_sourceFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "a", "index.js"), "a", 0644)
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "b", "index.js"), "b", 0644)
+	testFunctionPackageWriteFile(t, filepath.Join(dir, "b", "util.js"), "b", 0644)
+
+	v, err := buildFunctionPackage("", []string{filepath.Join(dir, "b", "util.js"), filepath.Join(dir, "a", "index.js")}, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(v), int64(len(v)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+
+	if expected := []string{"index.js", "util.js"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected entries %v, got %v", expected, names)
+	}
+
+	if _, err := buildFunctionPackage("", []string{filepath.Join(dir, "a", "index.js"), filepath.Join(dir, "b", "index.js")}, nil); err == nil {
+		t.Errorf("expected error for duplicate file names")
+	}
+
+	if _, err := buildFunctionPackage("", []string{filepath.Join(dir, "a", "index.js")}, []string{"*.js"}); err == nil {
+		t.Errorf("expected error for empty package")
+	}
+}
+
+func TestFunctionPackageExcluded(t *testing.T) {
+	testCases := []struct {
+		name     string
+		excludes []string
+		expected bool
+	}{
+		{name: "index.js", excludes: nil, expected: false},
+		{name: "index.js", excludes: []string{"*.md"}, expected: false},
+		{name: "README.md", excludes: []string{"*.md"}, expected: true},
+		{name: "docs/README.md", excludes: []string{"*.md"}, expected: true},
+		{name: "docs/README.md", excludes: []string{"README.md"}, expected: true},
+		{name: "README.md", excludes: []string{"/README.md"}, expected: true},
+		{name: "docs/README.md", excludes: []string{"/README.md"}, expected: false},
+		{name: "tests/unit/test.js", excludes: []string{"tests"}, expected: true},
+		{name: "src/tests/unit/test.js", excludes: []string{"src/tests/"}, expected: true},
+		{name: "tests/unit/test.js", excludes: []string{"src/tests/"}, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		got, err := functionPackageExcluded(testCase.name, testCase.excludes)
+
+		if err != nil {
+			t.Fatalf("%s %v: %s", testCase.name, testCase.excludes, err)
+		}
+
+		if got != testCase.expected {
+			t.Errorf("%s %v: expected %t, got %t", testCase.name, testCase.excludes, testCase.expected, got)
+		}
+	}
+
+	if _, err := functionPackageExcluded("index.js", []string{"[-"}); err == nil {
+		t.Errorf("expected error for invalid pattern")
+	}
+}
+
+func testFunctionPackageWriteFile(t *testing.T, name, content string, perm os.FileMode) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(name, []byte(content), perm); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chmod(name, perm); err != nil {
+		t.Fatal(err)
+	}
+}
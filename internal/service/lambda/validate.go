@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,3 +46,19 @@ func validPolicyStatementID() schema.SchemaValidateFunc {
 		validation.StringLenBetween(1, 100),
 	)
 }
+
+func validAlarmARN() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if _, err := alarmNameFromARN(value); err != nil {
+			errors = append(errors, fmt.Errorf("%q %s", k, err))
+		}
+
+		return
+	}
+}
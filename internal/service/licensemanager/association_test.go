@@ -16,7 +16,7 @@ func TestAccLicenseManagerAssociation_basic(t *testing.T) {
 	var licenseSpecification licensemanager.LicenseSpecification
 	resourceName := "aws_licensemanager_association.example"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, licensemanager.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
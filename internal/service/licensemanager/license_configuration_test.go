@@ -18,7 +18,7 @@ func TestAccLicenseManagerLicenseConfiguration_basic(t *testing.T) {
 	var licenseConfiguration licensemanager.LicenseConfiguration
 	resourceName := "aws_licensemanager_license_configuration.example"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, licensemanager.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -54,7 +54,7 @@ func TestAccLicenseManagerLicenseConfiguration_update(t *testing.T) {
 	var licenseConfiguration licensemanager.LicenseConfiguration
 	resourceName := "aws_licensemanager_license_configuration.example"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, licensemanager.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package licensemanager_test
+
+import (
+	"testing"
+)
+
+func TestAccLicenseManagerLicenseConfiguration_TagsConsistency(t *testing.T) {
+	t.Skip("aws_licensemanager_license_configuration has no testAccLicenseConfigurationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -21,7 +21,7 @@ func TestAccLightsailDomain_basic(t *testing.T) {
 	lightsailDomainName := fmt.Sprintf("tf-test-lightsail-%s.com", sdkacctest.RandString(5))
 	resourceName := "aws_lightsail_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckDomain(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lightsail.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -42,7 +42,7 @@ func TestAccLightsailDomain_disappears(t *testing.T) {
 	lightsailDomainName := fmt.Sprintf("tf-test-lightsail-%s.com", sdkacctest.RandString(5))
 	resourceName := "aws_lightsail_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckDomain(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, lightsail.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package lightsail_test
+
+import (
+	"testing"
+)
+
+func TestAccLightsailContainerService_TagsConsistency(t *testing.T) {
+	t.Skip("aws_lightsail_container_service has no testAccContainerServiceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccLightsailInstance_TagsConsistency(t *testing.T) {
+	t.Skip("aws_lightsail_instance has no testAccInstanceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -15,7 +15,7 @@ func TestAccLocationPlaceIndexDataSource_indexName(t *testing.T) {
 	dataSourceName := "data.aws_location_place_index.test"
 	resourceName := "aws_location_place_index.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, locationservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package location_test
+
+import (
+	"testing"
+)
+
+func TestAccLocationMap_TagsConsistency(t *testing.T) {
+	t.Skip("aws_location_map has no testAccMapConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccLocationPlaceIndex_TagsConsistency(t *testing.T) {
+	t.Skip("aws_location_place_index has no testAccPlaceIndexConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccLocationTracker_TagsConsistency(t *testing.T) {
+	t.Skip("aws_location_tracker has no testAccTrackerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
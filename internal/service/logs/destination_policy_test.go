@@ -18,7 +18,7 @@ func TestAccLogsDestinationPolicy_basic(t *testing.T) {
 	resourceName := "aws_cloudwatch_log_destination_policy.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -65,3 +65,28 @@ func FindSubscriptionFilter(conn *cloudwatchlogs.CloudWatchLogs, logGroupName, n
 
 	return filters[0], nil
 }
+
+func FindQueryResultsByID(conn *cloudwatchlogs.CloudWatchLogs, id string) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	input := &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryResults(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,224 @@
+package logs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	insightsQueryReadTimeout = 5 * time.Minute
+
+	// insightsQueryPointerField is the field CloudWatch Logs Insights adds to each result
+	// to identify the log event. It's only useful as input to GetLogRecord.
+	insightsQueryPointerField = "@ptr"
+)
+
+func DataSourceInsightsQuery() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceInsightsQueryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(insightsQueryReadTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"relative_time"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 10000),
+			},
+			"log_group_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validLogGroupName,
+				},
+			},
+			"query_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10000),
+			},
+			"relative_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"relative_time", "start_time"},
+				ValidateFunc: verify.ValidDuration,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"relative_time", "start_time"},
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_scanned": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"records_matched": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"records_scanned": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceInsightsQueryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LogsConn
+
+	endTime := time.Now()
+
+	if v, ok := d.GetOk("end_time"); ok {
+		endTime, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	var startTime time.Time
+
+	if v, ok := d.GetOk("relative_time"); ok {
+		duration, _ := time.ParseDuration(v.(string))
+		startTime = endTime.Add(-duration)
+	} else {
+		startTime, _ = time.Parse(time.RFC3339, d.Get("start_time").(string))
+	}
+
+	if !startTime.Before(endTime) {
+		return fmt.Errorf("start of the CloudWatch Logs Insights query time range (%s) must be before its end (%s)", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	}
+
+	input := &cloudwatchlogs.StartQueryInput{
+		EndTime:       aws.Int64(endTime.Unix()),
+		LogGroupNames: flex.ExpandStringList(d.Get("log_group_names").([]interface{})),
+		QueryString:   aws.String(d.Get("query_string").(string)),
+		StartTime:     aws.Int64(startTime.Unix()),
+	}
+
+	if v, ok := d.GetOk("limit"); ok {
+		input.Limit = aws.Int64(int64(v.(int)))
+	}
+
+	timeout := d.Timeout(schema.TimeoutRead)
+
+	log.Printf("[DEBUG] Starting CloudWatch Logs Insights query: %s", input)
+	// Only a limited number of queries can run concurrently in an account.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(timeout, func() (interface{}, error) {
+		return conn.StartQuery(input)
+	}, cloudwatchlogs.ErrCodeLimitExceededException)
+
+	if err != nil {
+		return fmt.Errorf("error starting CloudWatch Logs Insights query: %w", err)
+	}
+
+	queryID := aws.StringValue(outputRaw.(*cloudwatchlogs.StartQueryOutput).QueryId)
+
+	output, err := waitQueryCompleted(conn, queryID, timeout)
+
+	if err != nil {
+		// Don't leave the query running and counting against the concurrency limit.
+		if _, err := conn.StopQuery(&cloudwatchlogs.StopQueryInput{QueryId: aws.String(queryID)}); err != nil {
+			log.Printf("[WARN] Error stopping CloudWatch Logs Insights query (%s): %s", queryID, err)
+		}
+
+		return fmt.Errorf("error waiting for CloudWatch Logs Insights query (%s) to complete: %w", queryID, err)
+	}
+
+	d.SetId(queryID)
+	d.Set("query_id", queryID)
+	d.Set("status", output.Status)
+
+	if err := d.Set("results", flattenInsightsQueryResults(output.Results)); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	if err := d.Set("statistics", flattenInsightsQueryStatistics(output.Statistics)); err != nil {
+		return fmt.Errorf("error setting statistics: %w", err)
+	}
+
+	return nil
+}
+
+func flattenInsightsQueryResults(apiObjects [][]*cloudwatchlogs.ResultField) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, row := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		for _, apiObject := range row {
+			if apiObject == nil {
+				continue
+			}
+
+			field := aws.StringValue(apiObject.Field)
+
+			if field == insightsQueryPointerField {
+				continue
+			}
+
+			tfMap[field] = aws.StringValue(apiObject.Value)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenInsightsQueryStatistics(apiObject *cloudwatchlogs.QueryStatistics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bytes_scanned":   aws.Float64Value(apiObject.BytesScanned),
+		"records_matched": aws.Float64Value(apiObject.RecordsMatched),
+		"records_scanned": aws.Float64Value(apiObject.RecordsScanned),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,36 @@
+package logs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+func TestFlattenInsightsQueryResults(t *testing.T) {
+	apiObjects := [][]*cloudwatchlogs.ResultField{
+		{
+			{Field: aws.String("@timestamp"), Value: aws.String("2022-06-01 00:00:00.000")},
+			{Field: aws.String("@message"), Value: aws.String("hello")},
+			{Field: aws.String("@ptr"), Value: aws.String("CmAKJwoj")},
+		},
+		{
+			{Field: aws.String("count()"), Value: aws.String("2")},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"@message":   "hello",
+			"@timestamp": "2022-06-01 00:00:00.000",
+		},
+		map[string]interface{}{
+			"count()": "2",
+		},
+	}
+
+	if got := flattenInsightsQueryResults(apiObjects); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,156 @@
+package logs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccLogsInsightsQueryDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_insights_query.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightsQueryDataSourceConfig_relativeTime(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", cloudwatchlogs.QueryStatusComplete),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsInsightsQueryDataSource_results(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_insights_query.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightsQueryDataSourceConfig_base(rName),
+			},
+			{
+				PreConfig: func() {
+					testAccPutLogEvents(t, rName, rName, "hello", "ERROR something failed", "world")
+
+					// Allow time for the events to be indexed by CloudWatch Logs Insights.
+					time.Sleep(30 * time.Second)
+				},
+				Config: testAccInsightsQueryDataSourceConfig_results(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.@message", "ERROR something failed"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics.0.records_matched", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", cloudwatchlogs.QueryStatusComplete),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsInsightsQueryDataSource_invalidTimeRange(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInsightsQueryDataSourceConfig_absoluteTime(rName, "2022-06-02T00:00:00Z", "2022-06-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`must be before its end`),
+			},
+		},
+	})
+}
+
+func testAccPutLogEvents(t *testing.T, logGroupName, logStreamName string, messages ...string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn
+
+	var events []*cloudwatchlogs.InputLogEvent
+	now := time.Now()
+
+	for i, message := range messages {
+		events = append(events, &cloudwatchlogs.InputLogEvent{
+			Message:   aws.String(message),
+			Timestamp: aws.Int64(now.Add(time.Duration(i)*time.Millisecond).UnixNano() / int64(time.Millisecond)),
+		})
+	}
+
+	_, err := conn.PutLogEvents(&cloudwatchlogs.PutLogEventsInput{
+		LogEvents:     events,
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(logStreamName),
+	})
+
+	if err != nil {
+		t.Fatalf("error putting CloudWatch Logs events: %s", err)
+	}
+}
+
+func testAccInsightsQueryDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_stream" "test" {
+  name           = %[1]q
+  log_group_name = aws_cloudwatch_log_group.test.name
+}
+`, rName)
+}
+
+func testAccInsightsQueryDataSourceConfig_relativeTime(rName string) string {
+	return acctest.ConfigCompose(testAccInsightsQueryDataSourceConfig_base(rName), `
+data "aws_cloudwatch_log_insights_query" "test" {
+  log_group_names = [aws_cloudwatch_log_group.test.name]
+  query_string    = "fields @timestamp, @message | sort @timestamp desc"
+  relative_time   = "15m"
+}
+`)
+}
+
+func testAccInsightsQueryDataSourceConfig_results(rName string) string {
+	return acctest.ConfigCompose(testAccInsightsQueryDataSourceConfig_base(rName), `
+data "aws_cloudwatch_log_insights_query" "test" {
+  log_group_names = [aws_cloudwatch_log_group.test.name]
+  query_string    = "fields @message | filter @message like /ERROR/"
+  relative_time   = "1h"
+  limit           = 10
+
+  depends_on = [aws_cloudwatch_log_stream.test]
+}
+`)
+}
+
+func testAccInsightsQueryDataSourceConfig_absoluteTime(rName, startTime, endTime string) string {
+	return acctest.ConfigCompose(testAccInsightsQueryDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_cloudwatch_log_insights_query" "test" {
+  log_group_names = [aws_cloudwatch_log_group.test.name]
+  query_string    = "fields @timestamp, @message"
+  start_time      = %[1]q
+  end_time        = %[2]q
+}
+`, startTime, endTime))
+}
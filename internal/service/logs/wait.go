@@ -0,0 +1,27 @@
+package logs
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitQueryCompleted(conn *cloudwatchlogs.CloudWatchLogs, id string, timeout time.Duration) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{cloudwatchlogs.QueryStatusScheduled, cloudwatchlogs.QueryStatusRunning},
+		Target:     []string{cloudwatchlogs.QueryStatusComplete},
+		Refresh:    statusQuery(conn, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*cloudwatchlogs.GetQueryResultsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
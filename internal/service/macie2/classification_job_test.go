@@ -21,7 +21,7 @@ func testAccClassificationJob_basic(t *testing.T) {
 	resourceName := "aws_macie2_classification_job.test"
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckClassificationJobDestroy,
@@ -51,7 +51,7 @@ func testAccClassificationJob_Name_Generated(t *testing.T) {
 	resourceName := "aws_macie2_classification_job.test"
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckClassificationJobDestroy,
@@ -80,7 +80,7 @@ func testAccClassificationJob_NamePrefix(t *testing.T) {
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	namePrefix := "tf-acc-test-prefix-"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckClassificationJobDestroy,
@@ -108,7 +108,7 @@ func testAccClassificationJob_disappears(t *testing.T) {
 	resourceName := "aws_macie2_classification_job.test"
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckClassificationJobDestroy,
@@ -130,7 +130,7 @@ func testAccClassificationJob_Status(t *testing.T) {
 	resourceName := "aws_macie2_classification_job.test"
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckClassificationJobDestroy,
@@ -173,7 +173,7 @@ func testAccClassificationJob_complete(t *testing.T) {
 	description := "Description of a test"
 	descriptionUpdated := "Updated Description of a test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckClassificationJobDestroy,
@@ -230,7 +230,7 @@ func testAccClassificationJob_WithTags(t *testing.T) {
 	resourceName := "aws_macie2_classification_job.test"
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckClassificationJobDestroy,
@@ -20,7 +20,7 @@ func testAccFindingsFilter_basic(t *testing.T) {
 	var macie2Output macie2.GetFindingsFilterOutput
 	resourceName := "aws_macie2_findings_filter.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckFindingsFilterDestroy,
@@ -49,7 +49,7 @@ func testAccFindingsFilter_Name_Generated(t *testing.T) {
 	var macie2Output macie2.GetFindingsFilterOutput
 	resourceName := "aws_macie2_findings_filter.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckFindingsFilterDestroy,
@@ -77,7 +77,7 @@ func testAccFindingsFilter_NamePrefix(t *testing.T) {
 	resourceName := "aws_macie2_findings_filter.test"
 	namePrefix := "tf-acc-test-prefix-"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckFindingsFilterDestroy,
@@ -104,7 +104,7 @@ func testAccFindingsFilter_disappears(t *testing.T) {
 	var macie2Output macie2.GetFindingsFilterOutput
 	resourceName := "aws_macie2_findings_filter.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckFindingsFilterDestroy,
@@ -131,7 +131,7 @@ func testAccFindingsFilter_complete(t *testing.T) {
 	description := "this is a description"
 	descriptionUpdated := "this is a description updated"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckFindingsFilterDestroy,
@@ -212,7 +212,7 @@ func testAccFindingsFilter_WithDate(t *testing.T) {
 	startDate := "2020-01-01T00:00:00Z"
 	endDate := "2020-02-01T00:00:00Z"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckFindingsFilterDestroy,
@@ -288,7 +288,7 @@ func testAccFindingsFilter_WithNumber(t *testing.T) {
 	firstNumber := "12"
 	secondNumber := "13"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckFindingsFilterDestroy,
@@ -355,7 +355,7 @@ func testAccFindingsFilter_withTags(t *testing.T) {
 	resourceName := "aws_macie2_findings_filter.test"
 	description := "this is a description"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckFindingsFilterDestroy,
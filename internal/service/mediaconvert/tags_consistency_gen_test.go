@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package mediaconvert_test
+
+import (
+	"testing"
+)
+
+func TestAccMediaConvertQueue_TagsConsistency(t *testing.T) {
+	t.Skip("aws_media_convert_queue has no testAccQueueConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package mediapackage_test
+
+import (
+	"testing"
+)
+
+func TestAccMediaPackageChannel_TagsConsistency(t *testing.T) {
+	t.Skip("aws_media_package_channel has no testAccChannelConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
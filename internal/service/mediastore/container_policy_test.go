@@ -21,7 +21,7 @@ func TestAccMediaStoreContainerPolicy_basic(t *testing.T) {
 
 	rName = strings.ReplaceAll(rName, "-", "_")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, mediastore.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -15,7 +15,7 @@ func TestAccMemoryDBClusterDataSource_basic(t *testing.T) {
 	resourceName := "aws_memorydb_cluster.test"
 	dataSourceName := "data.aws_memorydb_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
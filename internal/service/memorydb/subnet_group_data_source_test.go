@@ -15,7 +15,7 @@ func TestAccMemoryDBSubnetGroupDataSource_basic(t *testing.T) {
 	resourceName := "aws_memorydb_subnet_group.test"
 	dataSourceName := "data.aws_memorydb_subnet_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,31 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package memorydb_test
+
+import (
+	"testing"
+)
+
+func TestAccMemoryDBACL_TagsConsistency(t *testing.T) {
+	t.Skip("aws_memorydb_acl has no testAccACLConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccMemoryDBCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_memorydb_cluster has no testAccClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccMemoryDBParameterGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_memorydb_parameter_group has no testAccParameterGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccMemoryDBSnapshot_TagsConsistency(t *testing.T) {
+	t.Skip("aws_memorydb_snapshot has no testAccSnapshotConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccMemoryDBSubnetGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_memorydb_subnet_group has no testAccSubnetGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccMemoryDBUser_TagsConsistency(t *testing.T) {
+	t.Skip("aws_memorydb_user has no testAccUserConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -23,7 +23,7 @@ func TestAccMetaARNDataSource_basic(t *testing.T) {
 		Service:   "rds",
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
 		ProviderFactories: acctest.ProviderFactories,
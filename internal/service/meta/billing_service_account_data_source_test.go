@@ -13,7 +13,7 @@ func TestAccMetaBillingServiceAccountDataSource_basic(t *testing.T) {
 
 	billingAccountID := "386209384616"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -14,7 +14,7 @@ func TestAccMetaDefaultTagsDataSource_basic(t *testing.T) {
 
 	dataSourceName := "data.aws_default_tags.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
 		ProviderFactories: acctest.FactoriesInternal(&providers),
@@ -39,7 +39,7 @@ func TestAccMetaDefaultTagsDataSource_empty(t *testing.T) {
 
 	dataSourceName := "data.aws_default_tags.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
 		ProviderFactories: acctest.FactoriesInternal(&providers),
@@ -63,7 +63,7 @@ func TestAccMetaDefaultTagsDataSource_multiple(t *testing.T) {
 
 	dataSourceName := "data.aws_default_tags.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
 		ProviderFactories: acctest.FactoriesInternal(&providers),
@@ -89,7 +89,7 @@ func TestAccMetaDefaultTagsDataSource_ignore(t *testing.T) {
 
 	dataSourceName := "data.aws_default_tags.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
 		ProviderFactories: acctest.FactoriesInternal(&providers),
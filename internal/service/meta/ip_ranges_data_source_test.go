@@ -16,7 +16,7 @@ import (
 )
 
 func TestAccMetaIPRangesDataSource_basic(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -36,7 +36,7 @@ func TestAccMetaIPRangesDataSource_basic(t *testing.T) {
 }
 
 func TestAccMetaIPRangesDataSource_url(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccMQBrokerDataSource_basic(t *testing.T) {
 	dataSourceByIdName := "data.aws_mq_broker.by_id"
 	dataSourceByNameName := "data.aws_mq_broker.by_name"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mq.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, mq.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -9,7 +9,7 @@ import (
 )
 
 func TestAccMQBrokerInstanceTypeOfferingsDataSource_basic(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mq.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, mq.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package mq_test
+
+import (
+	"testing"
+)
+
+func TestAccMQBroker_TagsConsistency(t *testing.T) {
+	t.Skip("aws_mq_broker has no testAccBrokerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccMQConfiguration_TagsConsistency(t *testing.T) {
+	t.Skip("aws_mq_configuration has no testAccConfigurationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
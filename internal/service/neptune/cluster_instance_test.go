@@ -27,7 +27,7 @@ func TestAccNeptuneClusterInstance_basic(t *testing.T) {
 
 	clusterInstanceName := fmt.Sprintf("tf-cluster-instance-%d", rInt)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -79,7 +79,7 @@ func TestAccNeptuneClusterInstance_withAZ(t *testing.T) {
 	resourceName := "aws_neptune_cluster_instance.cluster_instances"
 	availabiltyZonesDataSourceName := "data.aws_availability_zones.available"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -106,7 +106,7 @@ func TestAccNeptuneClusterInstance_namePrefix(t *testing.T) {
 
 	namePrefix := "tf-cluster-instance-"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -131,7 +131,7 @@ func TestAccNeptuneClusterInstance_withSubnetGroup(t *testing.T) {
 	resourceName := "aws_neptune_cluster_instance.test"
 	subnetGroupResourceName := "aws_neptune_subnet_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -155,7 +155,7 @@ func TestAccNeptuneClusterInstance_generatedName(t *testing.T) {
 
 	resourceName := "aws_neptune_cluster_instance.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -180,7 +180,7 @@ func TestAccNeptuneClusterInstance_kmsKey(t *testing.T) {
 	resourceName := "aws_neptune_cluster_instance.cluster_instances"
 	kmsKeyResourceName := "aws_kms_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
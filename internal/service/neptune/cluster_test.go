@@ -24,7 +24,7 @@ func TestAccNeptuneCluster_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -67,7 +67,7 @@ func TestAccNeptuneCluster_copyTagsToSnapshot(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -115,7 +115,7 @@ func TestAccNeptuneCluster_namePrefix(t *testing.T) {
 	rName := "tf-test-"
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -149,7 +149,7 @@ func TestAccNeptuneCluster_takeFinalSnapshot(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -182,7 +182,7 @@ func TestAccNeptuneCluster_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -234,7 +234,7 @@ func TestAccNeptuneCluster_updateIAMRoles(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -282,7 +282,7 @@ func TestAccNeptuneCluster_kmsKey(t *testing.T) {
 	keyResourceName := "aws_kms_key.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -316,7 +316,7 @@ func TestAccNeptuneCluster_encrypted(t *testing.T) {
 	resourceName := "aws_neptune_cluster.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -350,7 +350,7 @@ func TestAccNeptuneCluster_backupsUpdate(t *testing.T) {
 	resourceName := "aws_neptune_cluster.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -395,7 +395,7 @@ func TestAccNeptuneCluster_iamAuth(t *testing.T) {
 	resourceName := "aws_neptune_cluster.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -429,7 +429,7 @@ func TestAccNeptuneCluster_updateCloudWatchLogsExports(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -478,7 +478,7 @@ func TestAccNeptuneCluster_updateEngineVersion(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -531,7 +531,7 @@ func TestAccNeptuneCluster_updateEngineMajorVersion(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -584,7 +584,7 @@ func TestAccNeptuneCluster_deleteProtection(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -632,7 +632,7 @@ func TestAccNeptuneCluster_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, neptune.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
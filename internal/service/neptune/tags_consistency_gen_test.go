@@ -0,0 +1,35 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package neptune_test
+
+import (
+	"testing"
+)
+
+func TestAccNeptuneCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_neptune_cluster has no testAccClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNeptuneClusterEndpoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_neptune_cluster_endpoint has no testAccClusterEndpointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNeptuneClusterInstance_TagsConsistency(t *testing.T) {
+	t.Skip("aws_neptune_cluster_instance has no testAccClusterInstanceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNeptuneClusterParameterGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_neptune_cluster_parameter_group has no testAccClusterParameterGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNeptuneEventSubscription_TagsConsistency(t *testing.T) {
+	t.Skip("aws_neptune_event_subscription has no testAccEventSubscriptionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNeptuneParameterGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_neptune_parameter_group has no testAccParameterGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNeptuneSubnetGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_neptune_subnet_group has no testAccSubnetGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package networkfirewall_test
+
+import (
+	"testing"
+)
+
+func TestAccNetworkFirewallFirewall_TagsConsistency(t *testing.T) {
+	t.Skip("aws_networkfirewall_firewall has no testAccFirewallConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNetworkFirewallFirewallPolicy_TagsConsistency(t *testing.T) {
+	t.Skip("aws_networkfirewall_firewall_policy has no testAccFirewallPolicyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNetworkFirewallRuleGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_networkfirewall_rule_group has no testAccRuleGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -14,7 +14,7 @@ func TestAccNetworkManagerConnectionDataSource_basic(t *testing.T) {
 	resourceName := "aws_networkmanager_connection.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, networkmanager.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
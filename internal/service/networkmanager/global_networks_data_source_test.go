@@ -15,7 +15,7 @@ func TestAccNetworkManagerGlobalNetworksDataSource_basic(t *testing.T) {
 	dataSourceByTagsName := "data.aws_networkmanager_global_networks.by_tags"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, networkmanager.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
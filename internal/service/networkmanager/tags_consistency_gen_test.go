@@ -0,0 +1,27 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package networkmanager_test
+
+import (
+	"testing"
+)
+
+func TestAccNetworkManagerConnection_TagsConsistency(t *testing.T) {
+	t.Skip("aws_networkmanager_connection has no testAccConnectionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNetworkManagerDevice_TagsConsistency(t *testing.T) {
+	t.Skip("aws_networkmanager_device has no testAccDeviceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNetworkManagerGlobalNetwork_TagsConsistency(t *testing.T) {
+	t.Skip("aws_networkmanager_global_network has no testAccGlobalNetworkConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNetworkManagerLink_TagsConsistency(t *testing.T) {
+	t.Skip("aws_networkmanager_link has no testAccLinkConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccNetworkManagerSite_TagsConsistency(t *testing.T) {
+	t.Skip("aws_networkmanager_site has no testAccSiteConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -24,7 +24,7 @@ func TestAccOpenSearchDomainSAMLOptions_basic(t *testing.T) {
 	resourceName := "aws_opensearch_domain_saml_options.test"
 	esDomainResourceName := "aws_opensearch_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opensearchservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -58,7 +58,7 @@ func TestAccOpenSearchDomainSAMLOptions_disappears(t *testing.T) {
 	resourceName := "aws_opensearch_domain_saml_options.test"
 	esDomainResourceName := "aws_opensearch_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opensearchservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -83,7 +83,7 @@ func TestAccOpenSearchDomainSAMLOptions_disappears_Domain(t *testing.T) {
 	resourceName := "aws_opensearch_domain_saml_options.test"
 	esDomainResourceName := "aws_opensearch_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opensearchservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -109,7 +109,7 @@ func TestAccOpenSearchDomainSAMLOptions_Update(t *testing.T) {
 	resourceName := "aws_opensearch_domain_saml_options.test"
 	esDomainResourceName := "aws_opensearch_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opensearchservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -143,7 +143,7 @@ func TestAccOpenSearchDomainSAMLOptions_Disabled(t *testing.T) {
 	resourceName := "aws_opensearch_domain_saml_options.test"
 	esDomainResourceName := "aws_opensearch_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opensearchservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
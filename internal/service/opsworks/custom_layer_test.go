@@ -23,7 +23,7 @@ func TestAccOpsWorksCustomLayer_basic(t *testing.T) {
 	var opslayer opsworks.Layer
 	resourceName := "aws_opsworks_custom_layer.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opsworks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -66,7 +66,7 @@ func TestAccOpsWorksCustomLayer_tags(t *testing.T) {
 	var opslayer opsworks.Layer
 	resourceName := "aws_opsworks_custom_layer.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opsworks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -111,7 +111,7 @@ func TestAccOpsWorksCustomLayer_noVPC(t *testing.T) {
 	var opslayer opsworks.Layer
 	resourceName := "aws_opsworks_custom_layer.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opsworks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -185,7 +185,7 @@ func TestAccOpsWorksCustomLayer_cloudwatch(t *testing.T) {
 	resourceName := "aws_opsworks_custom_layer.test"
 	logGroupResourceName := "aws_cloudwatch_log_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opsworks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -249,7 +249,7 @@ func TestAccOpsWorksCustomLayer_disappears(t *testing.T) {
 	var opslayer opsworks.Layer
 	resourceName := "aws_opsworks_custom_layer.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, opsworks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
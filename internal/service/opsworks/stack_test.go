@@ -24,7 +24,7 @@ func TestAccOpsWorksStack_noVPCBasic(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var opsstack opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t)
@@ -55,7 +55,7 @@ func TestAccOpsWorksStack_noVPCChangeServiceRoleForceNew(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var before, after opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t)
@@ -92,7 +92,7 @@ func TestAccOpsWorksStack_vpc(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var opsstack opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t)
@@ -141,7 +141,7 @@ func TestAccOpsWorksStack_noVPCCreateTags(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var opsstack opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t)
@@ -185,7 +185,7 @@ func TestAccOpsWorksStack_CustomCookbooks_setPrivateProperties(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	var opsstack opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t)
@@ -229,7 +229,7 @@ func TestAccOpsWorksStack_classicEndpoints(t *testing.T) {
 
 	// This test cannot be parallel with other tests, because it changes the provider region in a non-standard way
 	// https://github.com/hashicorp/terraform-provider-aws/issues/21887
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckRegion(t, endpoints.UsWest2RegionID) },
 		ErrorCheck:        acctest.ErrorCheck(t, opsworks.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -19,7 +19,7 @@ func testAccOrganization_basic(t *testing.T) {
 	var organization organizations.Organization
 	resourceName := "aws_organizations_organization.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -60,7 +60,7 @@ func testAccOrganization_serviceAccessPrincipals(t *testing.T) {
 	var organization organizations.Organization
 	resourceName := "aws_organizations_organization.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -104,7 +104,7 @@ func testAccOrganization_EnabledPolicyTypes(t *testing.T) {
 	var organization organizations.Organization
 	resourceName := "aws_organizations_organization.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -189,7 +189,7 @@ func testAccOrganization_FeatureSet(t *testing.T) {
 	var organization organizations.Organization
 	resourceName := "aws_organizations_organization.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -215,7 +215,7 @@ func testAccOrganization_FeatureSetForcesNew(t *testing.T) {
 	var beforeValue, afterValue organizations.Organization
 	resourceName := "aws_organizations_organization.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -249,7 +249,7 @@ func testAccOrganization_FeatureSetUpdate(t *testing.T) {
 	var beforeValue, afterValue organizations.Organization
 	resourceName := "aws_organizations_organization.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -22,7 +22,7 @@ func testAccOrganizationalUnit_basic(t *testing.T) {
 	name := fmt.Sprintf("tf_outest_%d", rInt)
 	resourceName := "aws_organizations_organizational_unit.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -54,7 +54,7 @@ func testAccOrganizationalUnit_disappears(t *testing.T) {
 	name := fmt.Sprintf("tf_outest_%d", rInt)
 	resourceName := "aws_organizations_organizational_unit.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -80,7 +80,7 @@ func testAccOrganizationalUnit_Name(t *testing.T) {
 	name2 := fmt.Sprintf("tf_outest_%d", rInt+1)
 	resourceName := "aws_organizations_organizational_unit.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -116,7 +116,7 @@ func testAccOrganizationalUnit_Tags(t *testing.T) {
 	name := fmt.Sprintf("tf_outest_%d", rInt)
 	resourceName := "aws_organizations_organizational_unit.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
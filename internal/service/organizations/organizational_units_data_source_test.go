@@ -11,7 +11,7 @@ import (
 func testAccOrganizationalUnitsDataSource_basic(t *testing.T) {
 	resourceName := "aws_organizations_organizational_unit.test"
 	dataSourceName := "data.aws_organizations_organizational_units.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckOrganizationsAccount(t)
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package organizations_test
+
+import (
+	"testing"
+)
+
+func TestAccOrganizationsAccount_TagsConsistency(t *testing.T) {
+	t.Skip("aws_organizations_account has no testAccAccountConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccOrganizationsOrganizationalUnit_TagsConsistency(t *testing.T) {
+	t.Skip("aws_organizations_organizational_unit has no testAccOrganizationalUnitConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccOrganizationsPolicy_TagsConsistency(t *testing.T) {
+	t.Skip("aws_organizations_policy has no testAccPolicyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -12,7 +12,7 @@ import (
 func TestAccOutpostsAssetsDataSource_id(t *testing.T) {
 	dataSourceName := "data.aws_outposts_assets.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, outposts.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -49,7 +49,7 @@ func TestAccPinpointADMChannel_basic(t *testing.T) {
 
 	config := testAccADMChannelConfigurationFromEnv(t)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
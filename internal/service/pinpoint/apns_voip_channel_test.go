@@ -96,7 +96,7 @@ func TestAccPinpointAPNSVoIPChannel_basicCertificate(t *testing.T) {
 
 	configuration := testAccAPNSVoIPChannelCertConfigurationFromEnv(t)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -130,7 +130,7 @@ func TestAccPinpointAPNSVoIPChannel_basicToken(t *testing.T) {
 
 	configuration := testAccAPNSVoIPChannelTokenConfigurationFromEnv(t)
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccPinpointBaiduChannel_basic(t *testing.T) {
 	apikeyUpdated := "234"
 	secretKey := "456"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
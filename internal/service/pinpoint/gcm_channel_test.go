@@ -30,7 +30,7 @@ func TestAccPinpointGCMChannel_basic(t *testing.T) {
 
 	apiKey := os.Getenv("GCM_API_KEY")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -12,7 +12,7 @@ import (
 )
 
 func TestAccPricingProductDataSource_ec2(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, pricing.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -29,7 +29,7 @@ func TestAccPricingProductDataSource_ec2(t *testing.T) {
 }
 
 func TestAccPricingProductDataSource_redshift(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, pricing.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -15,7 +15,7 @@ func TestAccQLDBLedgerDataSource_basic(t *testing.T) {
 	resourceName := "aws_qldb_ledger.test"
 	datasourceName := "data.aws_qldb_ledger.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(qldb.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, qldb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package qldb_test
+
+import (
+	"testing"
+)
+
+func TestAccQLDBLedger_TagsConsistency(t *testing.T) {
+	t.Skip("aws_qldb_ledger has no testAccLedgerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccQLDBStream_TagsConsistency(t *testing.T) {
+	t.Skip("aws_qldb_stream has no testAccStreamConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -20,7 +20,7 @@ func TestAccQuickSightGroupMembership_basic(t *testing.T) {
 	memberName := "tfacctest" + sdkacctest.RandString(10)
 	resourceName := "aws_quicksight_group_membership.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
 		CheckDestroy:      testAccCheckGroupMembershipDestroy,
@@ -46,7 +46,7 @@ func TestAccQuickSightGroupMembership_disappears(t *testing.T) {
 	memberName := "tfacctest" + sdkacctest.RandString(10)
 	resourceName := "aws_quicksight_group_membership.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -13,7 +13,7 @@ import (
 func TestAccRDSCertificateDataSource_id(t *testing.T) {
 	dataSourceName := "data.aws_rds_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccCertificatePreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -32,7 +32,7 @@ func TestAccRDSCertificateDataSource_id(t *testing.T) {
 func TestAccRDSCertificateDataSource_latestValidTill(t *testing.T) {
 	dataSourceName := "data.aws_rds_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccCertificatePreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -26,7 +26,7 @@ func TestAccRDSClusterActivityStream_basic(t *testing.T) {
 	rdsClusterResourceName := "aws_rds_cluster.test"
 	kmsKeyResourceName := "aws_kms_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartition(t, endpoints.AwsPartitionID)
@@ -63,7 +63,7 @@ func TestAccRDSClusterActivityStream_disappears(t *testing.T) {
 	instanceName := sdkacctest.RandomWithPrefix("tf-testacc-aurora-instance")
 	resourceName := "aws_rds_cluster_activity_stream.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartition(t, endpoints.AwsPartitionID)
@@ -12,7 +12,7 @@ import (
 func TestAccRDSEventCategoriesDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_db_event_categories.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -43,7 +43,7 @@ func TestAccRDSEventCategoriesDataSource_basic(t *testing.T) {
 func TestAccRDSEventCategoriesDataSource_sourceType(t *testing.T) {
 	dataSourceName := "data.aws_db_event_categories.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
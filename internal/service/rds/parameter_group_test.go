@@ -22,7 +22,7 @@ func TestAccRDSParameterGroup_basic(t *testing.T) {
 	resourceName := "aws_db_parameter_group.test"
 	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -114,7 +114,7 @@ func TestAccRDSParameterGroup_basic(t *testing.T) {
 func TestAccRDSParameterGroup_caseWithMixedParameters(t *testing.T) {
 	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -133,7 +133,7 @@ func TestAccRDSParameterGroup_limit(t *testing.T) {
 	resourceName := "aws_db_parameter_group.test"
 	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -501,7 +501,7 @@ func TestAccRDSParameterGroup_disappears(t *testing.T) {
 	resourceName := "aws_db_parameter_group.test"
 	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -522,7 +522,7 @@ func TestAccRDSParameterGroup_disappears(t *testing.T) {
 func TestAccRDSParameterGroup_namePrefix(t *testing.T) {
 	var v rds.DBParameterGroup
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -542,7 +542,7 @@ func TestAccRDSParameterGroup_namePrefix(t *testing.T) {
 func TestAccRDSParameterGroup_generatedName(t *testing.T) {
 	var v rds.DBParameterGroup
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -563,7 +563,7 @@ func TestAccRDSParameterGroup_withApplyMethod(t *testing.T) {
 	resourceName := "aws_db_parameter_group.test"
 	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -603,7 +603,7 @@ func TestAccRDSParameterGroup_only(t *testing.T) {
 	resourceName := "aws_db_parameter_group.test"
 	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -632,7 +632,7 @@ func TestAccRDSParameterGroup_matchDefault(t *testing.T) {
 	resourceName := "aws_db_parameter_group.test"
 	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -661,7 +661,7 @@ func TestAccRDSParameterGroup_updateParameters(t *testing.T) {
 	resourceName := "aws_db_parameter_group.test"
 	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -721,7 +721,7 @@ func TestAccRDSParameterGroup_caseParameters(t *testing.T) {
 	resourceName := "aws_db_parameter_group.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
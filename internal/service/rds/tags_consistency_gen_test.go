@@ -0,0 +1,67 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package rds_test
+
+import (
+	"testing"
+)
+
+func TestAccRDSCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_rds_cluster has no testAccClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSClusterEndpoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_rds_cluster_endpoint has no testAccClusterEndpointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSClusterInstance_TagsConsistency(t *testing.T) {
+	t.Skip("aws_rds_cluster_instance has no testAccClusterInstanceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSClusterParameterGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_rds_cluster_parameter_group has no testAccClusterParameterGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSClusterSnapshot_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_cluster_snapshot has no testAccClusterSnapshotConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSEventSubscription_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_event_subscription has no testAccEventSubscriptionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSInstance_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_instance has no testAccInstanceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSOptionGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_option_group has no testAccOptionGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSParameterGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_parameter_group has no testAccParameterGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSProxy_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_proxy has no testAccProxyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSProxyEndpoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_proxy_endpoint has no testAccProxyEndpointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSSecurityGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_security_group has no testAccSecurityGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSSnapshot_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_snapshot has no testAccSnapshotConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSSnapshotCopy_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_snapshot_copy has no testAccSnapshotCopyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRDSSubnetGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_db_subnet_group has no testAccSubnetGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
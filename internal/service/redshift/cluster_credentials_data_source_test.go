@@ -14,7 +14,7 @@ func TestAccRedshiftClusterCredentialsDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_redshift_cluster_credentials.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
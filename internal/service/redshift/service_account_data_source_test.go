@@ -14,7 +14,7 @@ func TestAccRedshiftServiceAccountDataSource_basic(t *testing.T) {
 
 	dataSourceName := "data.aws_redshift_service_account.main"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -35,7 +35,7 @@ func TestAccRedshiftServiceAccountDataSource_region(t *testing.T) {
 
 	dataSourceName := "data.aws_redshift_service_account.regional"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
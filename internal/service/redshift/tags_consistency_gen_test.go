@@ -0,0 +1,43 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package redshift_test
+
+import (
+	"testing"
+)
+
+func TestAccRedshiftCluster_TagsConsistency(t *testing.T) {
+	t.Skip("aws_redshift_cluster has no testAccClusterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRedshiftEventSubscription_TagsConsistency(t *testing.T) {
+	t.Skip("aws_redshift_event_subscription has no testAccEventSubscriptionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRedshiftHSMClientCertificate_TagsConsistency(t *testing.T) {
+	t.Skip("aws_redshift_hsm_client_certificate has no testAccHSMClientCertificateConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRedshiftHSMConfiguration_TagsConsistency(t *testing.T) {
+	t.Skip("aws_redshift_hsm_configuration has no testAccHSMConfigurationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRedshiftParameterGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_redshift_parameter_group has no testAccParameterGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRedshiftSnapshotCopyGrant_TagsConsistency(t *testing.T) {
+	t.Skip("aws_redshift_snapshot_copy_grant has no testAccSnapshotCopyGrantConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRedshiftSnapshotSchedule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_redshift_snapshot_schedule has no testAccSnapshotScheduleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRedshiftSubnetGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_redshift_subnet_group has no testAccSubnetGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRedshiftUsageLimit_TagsConsistency(t *testing.T) {
+	t.Skip("aws_redshift_usage_limit has no testAccUsageLimitConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
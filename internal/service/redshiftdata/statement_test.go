@@ -18,7 +18,7 @@ func TestAccRedshiftDataStatement_basic(t *testing.T) {
 	resourceName := "aws_redshiftdata_statement.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, redshiftdataapiservice.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -36,7 +36,7 @@ func TestAccResourceGroupsGroup_Resource_basic(t *testing.T) {
   ]
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, resourcegroups.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -74,7 +74,7 @@ func TestAccResourceGroupsGroup_Resource_tags(t *testing.T) {
 	n := fmt.Sprintf("test-group-%d", sdkacctest.RandInt())
 	desc1 := "Hello World"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, resourcegroups.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package resourcegroups_test
+
+import (
+	"testing"
+)
+
+func TestAccResourceGroupsGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_resourcegroups_group has no testAccGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
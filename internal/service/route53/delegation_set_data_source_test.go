@@ -16,7 +16,7 @@ func TestAccRoute53DelegationSetDataSource_basic(t *testing.T) {
 
 	zoneName := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
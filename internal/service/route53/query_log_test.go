@@ -31,7 +31,7 @@ func TestAccRoute53QueryLog_basic(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 
 	var queryLoggingConfig route53.QueryLoggingConfig
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckQueryLog(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -62,7 +62,7 @@ func TestAccRoute53QueryLog_disappears(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 
 	var queryLoggingConfig route53.QueryLoggingConfig
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckQueryLog(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -88,7 +88,7 @@ func TestAccRoute53QueryLog_Disappears_hostedZone(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 
 	var queryLoggingConfig route53.QueryLoggingConfig
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckQueryLog(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package route53_test
+
+import (
+	"testing"
+)
+
+func TestAccRoute53HealthCheck_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53_health_check has no testAccHealthCheckConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRoute53Zone_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53_zone has no testAccZoneConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
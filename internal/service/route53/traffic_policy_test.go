@@ -20,7 +20,7 @@ func TestAccRoute53TrafficPolicy_basic(t *testing.T) {
 	resourceName := "aws_route53_traffic_policy.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckTrafficPolicyDestroy,
@@ -51,7 +51,7 @@ func TestAccRoute53TrafficPolicy_disappears(t *testing.T) {
 	resourceName := "aws_route53_traffic_policy.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckTrafficPolicyDestroy,
@@ -76,7 +76,7 @@ func TestAccRoute53TrafficPolicy_update(t *testing.T) {
 	comment := `comment`
 	commentUpdated := `comment updated`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		CheckDestroy:      testAccCheckTrafficPolicyDestroy,
@@ -19,7 +19,7 @@ func TestAccRoute53VPCAssociationAuthorization_basic(t *testing.T) {
 	var providers []*schema.Provider
 	resourceName := "aws_route53_vpc_association_authorization.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -48,7 +48,7 @@ func TestAccRoute53VPCAssociationAuthorization_disappears(t *testing.T) {
 	var providers []*schema.Provider
 	resourceName := "aws_route53_vpc_association_authorization.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
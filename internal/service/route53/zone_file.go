@@ -0,0 +1,609 @@
+package route53
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+const (
+	zoneFileMaxTTL = 2147483647
+)
+
+// zoneRecordSet is a resource record set that can be represented in a zone file,
+// i.e. one without alias target, routing policy or traffic policy.
+type zoneRecordSet struct {
+	// Name is the lowercase fully qualified domain name, without the trailing period.
+	Name   string
+	Type   string
+	TTL    int64
+	Values []string
+}
+
+func (s zoneRecordSet) key() string {
+	return s.Name + " " + s.Type
+}
+
+func (s zoneRecordSet) equal(other zoneRecordSet) bool {
+	if s.key() != other.key() || s.TTL != other.TTL || len(s.Values) != len(other.Values) {
+		return false
+	}
+
+	for i, v := range s.Values {
+		if v != other.Values[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s zoneRecordSet) resourceRecordSet() *route53.ResourceRecordSet {
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String(s.Name),
+		TTL:  aws.Int64(s.TTL),
+		Type: aws.String(s.Type),
+	}
+
+	for _, v := range s.Values {
+		apiObject.ResourceRecords = append(apiObject.ResourceRecords, &route53.ResourceRecord{
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObject
+}
+
+// newZoneRecordSet returns the zone file representation of the specified resource record set,
+// or false if the resource record set can't be represented in a zone file.
+func newZoneRecordSet(apiObject *route53.ResourceRecordSet) (zoneRecordSet, bool) {
+	if apiObject == nil || apiObject.AliasTarget != nil || apiObject.SetIdentifier != nil || apiObject.TrafficPolicyInstanceId != nil {
+		return zoneRecordSet{}, false
+	}
+
+	s := zoneRecordSet{
+		Name: normalizeZoneFileName(CleanRecordName(aws.StringValue(apiObject.Name))),
+		TTL:  aws.Int64Value(apiObject.TTL),
+		Type: aws.StringValue(apiObject.Type),
+	}
+
+	for _, v := range apiObject.ResourceRecords {
+		s.Values = append(s.Values, aws.StringValue(v.Value))
+	}
+
+	sort.Strings(s.Values)
+
+	return s, true
+}
+
+// skippedRecordReason returns why the resource record set can't be represented in a zone file.
+func skippedRecordReason(apiObject *route53.ResourceRecordSet) string {
+	switch {
+	case apiObject.AliasTarget != nil:
+		return fmt.Sprintf("alias to %s", aws.StringValue(apiObject.AliasTarget.DNSName))
+	case apiObject.TrafficPolicyInstanceId != nil:
+		return fmt.Sprintf("traffic policy instance %s", aws.StringValue(apiObject.TrafficPolicyInstanceId))
+	default:
+		return fmt.Sprintf("routing policy set identifier %s", aws.StringValue(apiObject.SetIdentifier))
+	}
+}
+
+func normalizeZoneFileName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// isZoneApexNSOrSOA returns whether the record set is one of the NS or SOA records that Route 53 creates at the zone apex.
+func isZoneApexNSOrSOA(s zoneRecordSet, zoneName string) bool {
+	return s.Name == normalizeZoneFileName(zoneName) && (s.Type == route53.RRTypeNs || s.Type == route53.RRTypeSoa)
+}
+
+// isInZone returns whether the name is the zone's name or one of its subdomains.
+func isInZone(name, zoneName string) bool {
+	zoneName = normalizeZoneFileName(zoneName)
+
+	return name == zoneName || strings.HasSuffix(name, "."+zoneName)
+}
+
+// zoneFileNameFieldIndexes are the indexes of the record data fields that are domain names, by record type.
+var zoneFileNameFieldIndexes = map[string][]int{
+	route53.RRTypeCname: {0},
+	route53.RRTypeMx:    {1},
+	route53.RRTypeNaptr: {5},
+	route53.RRTypeNs:    {0},
+	route53.RRTypePtr:   {0},
+	route53.RRTypeSoa:   {0, 1},
+	route53.RRTypeSrv:   {3},
+}
+
+// normalizeZoneFileValue makes the domain names in the record value fully qualified.
+// Route 53 treats the domain names in record values as fully qualified whether or not they end with a period.
+func normalizeZoneFileValue(rrType, value string) string {
+	indexes, ok := zoneFileNameFieldIndexes[rrType]
+
+	if !ok {
+		return value
+	}
+
+	fields := strings.Fields(value)
+
+	for _, i := range indexes {
+		if i < len(fields) {
+			fields[i] = FQDN(fields[i])
+		}
+	}
+
+	return strings.Join(fields, " ")
+}
+
+func normalizeZoneRecordSet(s zoneRecordSet) zoneRecordSet {
+	values := make([]string, len(s.Values))
+
+	for i, v := range s.Values {
+		values[i] = normalizeZoneFileValue(s.Type, v)
+	}
+
+	sort.Strings(values)
+	s.Values = values
+
+	return s
+}
+
+func sortZoneRecordSets(sets []zoneRecordSet, zoneName string) {
+	sort.Slice(sets, func(i, j int) bool {
+		// The zone apex SOA and NS records come first.
+		if a, b := isZoneApexNSOrSOA(sets[i], zoneName), isZoneApexNSOrSOA(sets[j], zoneName); a != b {
+			return a
+		}
+
+		if a, b := reverseDomainLabels(sets[i].Name), reverseDomainLabels(sets[j].Name); a != b {
+			return a < b
+		}
+
+		if a, b := sets[i].Type == route53.RRTypeSoa, sets[j].Type == route53.RRTypeSoa; a != b {
+			return a
+		}
+
+		return sets[i].Type < sets[j].Type
+	})
+}
+
+// reverseDomainLabels returns the domain name's labels in reverse order, so that subdomains sort after their parent.
+func reverseDomainLabels(name string) string {
+	labels := strings.Split(name, ".")
+
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+
+	return strings.Join(labels, "\x00")
+}
+
+// zoneRecordSetsEquivalent returns whether the two lists contain the same record sets.
+func zoneRecordSetsEquivalent(a, b []zoneRecordSet) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	m := make(map[string]zoneRecordSet, len(a))
+
+	for _, v := range a {
+		m[v.key()] = v
+	}
+
+	for _, v := range b {
+		if w, ok := m[v.key()]; !ok || !w.equal(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// renderZoneFile returns the record sets in BIND zone file format, with fully qualified names.
+func renderZoneFile(zoneName string, sets []zoneRecordSet) string {
+	sets = append([]zoneRecordSet(nil), sets...)
+	sortZoneRecordSets(sets, zoneName)
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "$ORIGIN %s\n", FQDN(normalizeZoneFileName(zoneName)))
+
+	for _, s := range sets {
+		for _, v := range s.Values {
+			fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", FQDN(s.Name), s.TTL, s.Type, normalizeZoneFileValue(s.Type, v))
+		}
+	}
+
+	return b.String()
+}
+
+type zoneFileToken struct {
+	quoted bool
+	text   string
+}
+
+// zoneFileEntry is a logical line of a zone file, which may span several physical lines within parentheses.
+type zoneFileEntry struct {
+	// blankOwner is set when the entry starts with white space, i.e. it uses the previous entry's owner name.
+	blankOwner bool
+	line       int
+	tokens     []zoneFileToken
+}
+
+// tokenizeZoneFile splits the zone file into entries, removing comments and parentheses.
+func tokenizeZoneFile(content string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	var entry zoneFileEntry
+	var token strings.Builder
+	inToken, inQuotes, parens := false, false, 0
+	line, startOfLine := 1, true
+
+	endToken := func() {
+		if inToken {
+			entry.tokens = append(entry.tokens, zoneFileToken{quoted: inQuotes, text: token.String()})
+			token.Reset()
+			inToken = false
+		}
+	}
+	endEntry := func() {
+		if len(entry.tokens) > 0 {
+			entries = append(entries, entry)
+		}
+		entry = zoneFileEntry{}
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		if inQuotes {
+			token.WriteByte(c)
+
+			switch c {
+			case '\\':
+				if i+1 < len(content) {
+					i++
+					token.WriteByte(content[i])
+				}
+			case '"':
+				endToken()
+				inQuotes = false
+			case '\n':
+				line++
+			}
+
+			continue
+		}
+
+		if startOfLine && parens == 0 {
+			entry.line = line
+			entry.blankOwner = c == ' ' || c == '\t'
+		}
+		startOfLine = false
+
+		switch c {
+		case ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case '"':
+			endToken()
+			inToken, inQuotes = true, true
+			token.WriteByte(c)
+		case '(':
+			endToken()
+			parens++
+		case ')':
+			endToken()
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			parens--
+		case ' ', '\t', '\r':
+			endToken()
+		case '\n':
+			endToken()
+			if parens == 0 {
+				endEntry()
+			}
+			line++
+			startOfLine = true
+		case '\\':
+			inToken = true
+			token.WriteByte(c)
+			if i+1 < len(content) {
+				i++
+				token.WriteByte(content[i])
+			}
+		default:
+			inToken = true
+			token.WriteByte(c)
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+
+	if parens > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+
+	endToken()
+	endEntry()
+
+	return entries, nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds or in BIND's unit format, e.g. 1h30m.
+func parseZoneFileTTL(s string) (int64, bool) {
+	if s == "" {
+		return 0, false
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if v < 0 || v > zoneFileMaxTTL {
+			return 0, false
+		}
+
+		return v, true
+	}
+
+	var ttl, n int64
+	digits := false
+
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits = true
+
+			if n > zoneFileMaxTTL {
+				return 0, false
+			}
+
+			continue
+		}
+
+		if !digits {
+			return 0, false
+		}
+
+		switch c {
+		case 'w':
+			n *= 7 * 24 * 60 * 60
+		case 'd':
+			n *= 24 * 60 * 60
+		case 'h':
+			n *= 60 * 60
+		case 'm':
+			n *= 60
+		case 's':
+		default:
+			return 0, false
+		}
+
+		ttl += n
+		n, digits = 0, false
+
+		if ttl > zoneFileMaxTTL {
+			return 0, false
+		}
+	}
+
+	if digits {
+		return 0, false
+	}
+
+	return ttl, true
+}
+
+// resolveZoneFileName returns the fully qualified form, with trailing period, of a domain name relative to the origin.
+func resolveZoneFileName(name, origin string) (string, error) {
+	switch {
+	case name == "@":
+		name = origin
+	case strings.HasSuffix(name, ".") && !strings.HasSuffix(name, `\.`):
+	case origin == "":
+		return "", fmt.Errorf("relative domain name (%s) requires an origin", name)
+	case origin == ".":
+		name += "."
+	default:
+		name += "." + origin
+	}
+
+	if name == "" {
+		return "", errors.New("empty domain name")
+	}
+
+	return name, nil
+}
+
+// parseZoneFile parses the record sets in BIND zone file format content.
+// Relative domain names are relative to origin until an $ORIGIN directive.
+func parseZoneFile(content, origin string) ([]zoneRecordSet, error) {
+	entries, err := tokenizeZoneFile(content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if origin != "" {
+		origin = FQDN(strings.ToLower(origin))
+	}
+
+	var defaultTTL, lastTTL *int64
+	var owner string
+	var keys []string
+	sets := make(map[string]*zoneRecordSet)
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if first := tokens[0]; !first.quoted && !entry.blankOwner && strings.HasPrefix(first.text, "$") {
+			switch directive := strings.ToUpper(first.text); directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: %s requires a domain name", entry.line, directive)
+				}
+
+				v, err := resolveZoneFileName(strings.ToLower(tokens[1].text), origin)
+
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", entry.line, err)
+				}
+
+				origin = v
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: %s requires a TTL", entry.line, directive)
+				}
+
+				v, ok := parseZoneFileTTL(tokens[1].text)
+
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid TTL (%s)", entry.line, tokens[1].text)
+				}
+
+				defaultTTL = &v
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive (%s)", entry.line, first.text)
+			}
+
+			continue
+		}
+
+		if entry.blankOwner {
+			if owner == "" {
+				return nil, fmt.Errorf("line %d: record has no owner name", entry.line)
+			}
+		} else {
+			v, err := resolveZoneFileName(strings.ToLower(tokens[0].text), origin)
+
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", entry.line, err)
+			}
+
+			owner = v
+			tokens = tokens[1:]
+		}
+
+		var ttl *int64
+		var rrType string
+
+		for len(tokens) > 0 && rrType == "" {
+			token := tokens[0]
+			tokens = tokens[1:]
+
+			if token.quoted {
+				return nil, fmt.Errorf("line %d: unexpected quoted string (%s)", entry.line, token.text)
+			}
+
+			if v, ok := parseZoneFileTTL(token.text); ok && ttl == nil {
+				ttl = &v
+				continue
+			}
+
+			switch v := strings.ToUpper(token.text); v {
+			case "IN":
+			case "CH", "CS", "HS":
+				return nil, fmt.Errorf("line %d: unsupported class (%s)", entry.line, v)
+			default:
+				if _, ok := zoneFileRecordTypes[v]; !ok {
+					return nil, fmt.Errorf("line %d: unsupported record type (%s)", entry.line, token.text)
+				}
+
+				rrType = v
+			}
+		}
+
+		if rrType == "" {
+			return nil, fmt.Errorf("line %d: record has no type", entry.line)
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: %s record has no data", entry.line, rrType)
+		}
+
+		switch {
+		case ttl != nil:
+			lastTTL = ttl
+		case defaultTTL != nil:
+			ttl = defaultTTL
+		case lastTTL != nil:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: record has no TTL and no $TTL directive precedes it", entry.line)
+		}
+
+		fields := make([]string, len(tokens))
+
+		for i, token := range tokens {
+			fields[i] = token.text
+		}
+
+		for _, i := range zoneFileNameFieldIndexes[rrType] {
+			if i < len(fields) && !tokens[i].quoted {
+				v, err := resolveZoneFileName(fields[i], origin)
+
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", entry.line, err)
+				}
+
+				fields[i] = v
+			}
+		}
+
+		name := normalizeZoneFileName(owner)
+		value := strings.Join(fields, " ")
+		key := zoneRecordSet{Name: name, Type: rrType}.key()
+
+		s, ok := sets[key]
+
+		if !ok {
+			s = &zoneRecordSet{Name: name, Type: rrType, TTL: *ttl}
+			sets[key] = s
+			keys = append(keys, key)
+		}
+
+		if s.TTL != *ttl {
+			return nil, fmt.Errorf("line %d: %s %s record has TTL %d, but a previous record in the set has TTL %d", entry.line, name, rrType, *ttl, s.TTL)
+		}
+
+		s.Values = append(s.Values, value)
+	}
+
+	var output []zoneRecordSet
+
+	for _, key := range keys {
+		s := sets[key]
+		sort.Strings(s.Values)
+
+		// Duplicate records are ignored.
+		values := s.Values[:0]
+
+		for i, v := range s.Values {
+			if i == 0 || v != s.Values[i-1] {
+				values = append(values, v)
+			}
+		}
+
+		s.Values = values
+		output = append(output, *s)
+	}
+
+	return output, nil
+}
+
+var zoneFileRecordTypes = func() map[string]struct{} {
+	m := make(map[string]struct{})
+
+	for _, v := range route53.RRType_Values() {
+		m[v] = struct{}{}
+	}
+
+	return m
+}()
@@ -0,0 +1,89 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceZoneFileRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"skipped_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceZoneFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return diag.Errorf("reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	apiObjects, err := FindResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return diag.Errorf("listing Route 53 Hosted Zone (%s) records: %s", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	var sets []zoneRecordSet
+	var b strings.Builder
+	var recordCount int
+
+	fmt.Fprintf(&b, "; Route 53 Hosted Zone %s (%s)\n", zoneID, FQDN(zoneName))
+
+	for _, apiObject := range apiObjects {
+		s, ok := newZoneRecordSet(apiObject)
+
+		if !ok {
+			// Alias records and records with a routing policy have no zone file representation.
+			fmt.Fprintf(&b, "; Skipped %s %s record: %s\n", FQDN(CleanRecordName(aws.StringValue(apiObject.Name))), aws.StringValue(apiObject.Type), skippedRecordReason(apiObject))
+			continue
+		}
+
+		sets = append(sets, s)
+		recordCount += len(s.Values)
+	}
+
+	b.WriteString(renderZoneFile(zoneName, sets))
+
+	d.SetId(zoneID)
+	d.Set("name", TrimTrailingPeriod(zoneName))
+	d.Set("record_count", recordCount)
+	d.Set("skipped_record_count", len(apiObjects)-len(sets))
+	d.Set("zone_file", b.String())
+	d.Set("zone_id", zoneID)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package route53_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53ZoneFileDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_route53_zone_file.test"
+	zoneResourceName := "aws_route53_zone.test"
+	zoneName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", zoneName),
+					// 4 NS, 1 SOA, 2 A and 1 TXT records.
+					resource.TestCheckResourceAttr(dataSourceName, "record_count", "8"),
+					resource.TestCheckResourceAttr(dataSourceName, "skipped_record_count", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_id", zoneResourceName, "zone_id"),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexp.MustCompile(fmt.Sprintf(`(?m)^\$ORIGIN %[1]s\.$`, regexp.QuoteMeta(zoneName)))),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexp.MustCompile(fmt.Sprintf(`(?m)^%[1]s\.\t\d+\tIN\tSOA\t`, regexp.QuoteMeta(zoneName)))),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexp.MustCompile(fmt.Sprintf(`(?m)^www\.%[1]s\.\t300\tIN\tA\t192\.0\.2\.1$`, regexp.QuoteMeta(zoneName)))),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexp.MustCompile(fmt.Sprintf(`(?m)^%[1]s\.\t60\tIN\tTXT\t"v=spf1 -all"$`, regexp.QuoteMeta(zoneName)))),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexp.MustCompile(fmt.Sprintf(`(?m)^; Skipped alias\.%[1]s\. A record: alias to www\.%[1]s\.?$`, regexp.QuoteMeta(zoneName)))),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneFileDataSourceConfig_basic(zoneName string) string {
+	return acctest.ConfigCompose(testAccZoneRecordsConfig_basic(zoneName), fmt.Sprintf(`
+resource "aws_route53_record" "alias" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "alias.%[1]s"
+  type    = "A"
+
+  alias {
+    name                   = "www.%[1]s"
+    zone_id                = aws_route53_zone.test.zone_id
+    evaluate_target_health = false
+  }
+
+  depends_on = [aws_route53_zone_records.test]
+}
+
+data "aws_route53_zone_file" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.alias]
+}
+`, zoneName))
+}
@@ -0,0 +1,445 @@
+package route53
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func TestParseZoneFileTTL(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected int64
+		ok       bool
+	}{
+		{"0", 0, true},
+		{"300", 300, true},
+		{"1h", 3600, true},
+		{"1H30m", 5400, true},
+		{"1w2d", 777600, true},
+		{"2147483647", 2147483647, true},
+		{"2147483648", 0, false},
+		{"", 0, false},
+		{"h", 0, false},
+		{"1h30", 0, false},
+		{"1x", 0, false},
+		{"-1", 0, false},
+		{"A", 0, false},
+	}
+
+	for _, testCase := range testCases {
+		ttl, ok := parseZoneFileTTL(testCase.input)
+
+		if ok != testCase.ok || ttl != testCase.expected {
+			t.Errorf("parseZoneFileTTL(%q) = %d, %t; expected %d, %t", testCase.input, ttl, ok, testCase.expected, testCase.ok)
+		}
+	}
+}
+
+func TestParseZoneFile(t *testing.T) {
+	testCases := []struct {
+		name          string
+		content       string
+		origin        string
+		expected      []zoneRecordSet
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:     "empty",
+			content:  "; nothing here\n\n",
+			origin:   "example.com",
+			expected: nil,
+		},
+		{
+			name: "absolute and relative names",
+			content: `
+$TTL 300
+example.com.      IN  A     192.0.2.1
+www               IN  CNAME @
+WWW2              60  CNAME www
+mail.example.org.     A     192.0.2.2
+`,
+			origin: "example.com",
+			expected: []zoneRecordSet{
+				{Name: "example.com", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}},
+				{Name: "www.example.com", Type: "CNAME", TTL: 300, Values: []string{"example.com."}},
+				{Name: "www2.example.com", Type: "CNAME", TTL: 60, Values: []string{"www.example.com."}},
+				{Name: "mail.example.org", Type: "A", TTL: 300, Values: []string{"192.0.2.2"}},
+			},
+		},
+		{
+			name: "blank owner and class before TTL",
+			content: `
+@ IN 3600 MX 10 mail
+      IN 3600 MX 20 mail2.example.net.
+  3600 IN A 192.0.2.1
+  3600 IN A 192.0.2.1
+`,
+			origin: "example.com.",
+			expected: []zoneRecordSet{
+				{Name: "example.com", Type: "MX", TTL: 3600, Values: []string{"10 mail.example.com.", "20 mail2.example.net."}},
+				{Name: "example.com", Type: "A", TTL: 3600, Values: []string{"192.0.2.1"}},
+			},
+		},
+		{
+			name: "origin directive and previous TTL",
+			content: `
+$ORIGIN sub.example.com.
+a 120 A 192.0.2.1
+b A 192.0.2.2
+$ORIGIN deeper
+c A 192.0.2.3
+`,
+			origin: "example.com",
+			expected: []zoneRecordSet{
+				{Name: "a.sub.example.com", Type: "A", TTL: 120, Values: []string{"192.0.2.1"}},
+				{Name: "b.sub.example.com", Type: "A", TTL: 120, Values: []string{"192.0.2.2"}},
+				{Name: "c.deeper.sub.example.com", Type: "A", TTL: 120, Values: []string{"192.0.2.3"}},
+			},
+		},
+		{
+			name: "multi-line SOA with comments",
+			content: `
+$TTL 1d
+@ IN SOA ns-1.awsdns-00.com. awsdns-hostmaster.amazon.com. ( ; primary, contact
+        1       ; serial
+        7200    ; refresh
+        900     ; retry
+        1209600 ; expire
+        86400 ) ; minimum
+@ NS ns-1.awsdns-00.com.
+`,
+			origin: "example.com",
+			expected: []zoneRecordSet{
+				{Name: "example.com", Type: "SOA", TTL: 86400, Values: []string{"ns-1.awsdns-00.com. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400"}},
+				{Name: "example.com", Type: "NS", TTL: 86400, Values: []string{"ns-1.awsdns-00.com."}},
+			},
+		},
+		{
+			name: "quoted strings",
+			content: `
+txt 300 IN TXT "v=spf1 include:example.net ~all" ; trailing comment
+txt 300 IN TXT "a;b" "c\"d"
+srv 300 IN SRV 0 5 5060 sip
+`,
+			origin: "example.com",
+			expected: []zoneRecordSet{
+				{Name: "txt.example.com", Type: "TXT", TTL: 300, Values: []string{`"a;b" "c\"d"`, `"v=spf1 include:example.net ~all"`}},
+				{Name: "srv.example.com", Type: "SRV", TTL: 300, Values: []string{"0 5 5060 sip.example.com."}},
+			},
+		},
+		{
+			name: "wildcard",
+			content: `
+*.example.com. 300 IN A 192.0.2.1
+`,
+			origin: "example.com",
+			expected: []zoneRecordSet{
+				{Name: "*.example.com", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}},
+			},
+		},
+		{
+			name:          "no TTL",
+			content:       "www IN A 192.0.2.1\n",
+			origin:        "example.com",
+			expectedError: regexp.MustCompile(`line 1: record has no TTL`),
+		},
+		{
+			name:          "unsupported type",
+			content:       "$TTL 300\nwww IN HINFO \"PC\" \"Linux\"\n",
+			origin:        "example.com",
+			expectedError: regexp.MustCompile(`line 2: unsupported record type \(HINFO\)`),
+		},
+		{
+			name:          "unsupported class",
+			content:       "www 300 CH A 192.0.2.1\n",
+			origin:        "example.com",
+			expectedError: regexp.MustCompile(`unsupported class \(CH\)`),
+		},
+		{
+			name:          "unsupported directive",
+			content:       "$INCLUDE other.zone\n",
+			origin:        "example.com",
+			expectedError: regexp.MustCompile(`unsupported directive \(\$INCLUDE\)`),
+		},
+		{
+			name:          "unbalanced parentheses",
+			content:       "@ 300 SOA ns. host. ( 1 2 3 4 5\n",
+			origin:        "example.com",
+			expectedError: regexp.MustCompile(`unbalanced parentheses`),
+		},
+		{
+			name:          "unterminated quoted string",
+			content:       "www 300 TXT \"abc\n",
+			origin:        "example.com",
+			expectedError: regexp.MustCompile(`unterminated quoted string`),
+		},
+		{
+			name:          "no data",
+			content:       "www 300 IN A\n",
+			origin:        "example.com",
+			expectedError: regexp.MustCompile(`A record has no data`),
+		},
+		{
+			name:          "TTL mismatch",
+			content:       "www 300 A 192.0.2.1\nwww 60 A 192.0.2.2\n",
+			origin:        "example.com",
+			expectedError: regexp.MustCompile(`line 2: www.example.com A record has TTL 60`),
+		},
+		{
+			name:          "relative name without origin",
+			content:       "www 300 A 192.0.2.1\n",
+			expectedError: regexp.MustCompile(`relative domain name \(www\) requires an origin`),
+		},
+		{
+			name:          "blank owner on first record",
+			content:       "  300 A 192.0.2.1\n",
+			origin:        "example.com",
+			expectedError: regexp.MustCompile(`record has no owner name`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := parseZoneFile(testCase.content, testCase.origin)
+
+			if testCase.expectedError != nil {
+				if err == nil || !testCase.expectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %#v\nexpected %#v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	sets := []zoneRecordSet{
+		{Name: "www.example.com", Type: "CNAME", TTL: 300, Values: []string{"example.com"}},
+		{Name: "example.com", Type: "NS", TTL: 172800, Values: []string{"ns-1.awsdns-00.com.", "ns-2.awsdns-00.net."}},
+		{Name: "a.www.example.com", Type: "TXT", TTL: 60, Values: []string{`"hello world"`}},
+		{Name: "example.com", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}},
+		{Name: "example.com", Type: "SOA", TTL: 900, Values: []string{"ns-1.awsdns-00.com. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400"}},
+	}
+
+	expected := `$ORIGIN example.com.
+example.com.	900	IN	SOA	ns-1.awsdns-00.com. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400
+example.com.	172800	IN	NS	ns-1.awsdns-00.com.
+example.com.	172800	IN	NS	ns-2.awsdns-00.net.
+example.com.	300	IN	A	192.0.2.1
+www.example.com.	300	IN	CNAME	example.com.
+a.www.example.com.	60	IN	TXT	"hello world"
+`
+
+	got := renderZoneFile("example.com.", sets)
+
+	if got != expected {
+		t.Fatalf("got:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	// The rendered zone file parses back to the same record sets.
+	parsed, err := parseZoneFile(got, "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var normalized []zoneRecordSet
+
+	for _, v := range sets {
+		normalized = append(normalized, normalizeZoneRecordSet(v))
+	}
+
+	if !zoneRecordSetsEquivalent(parsed, normalized) {
+		t.Errorf("got %#v\nexpected %#v", parsed, normalized)
+	}
+}
+
+func TestNewZoneRecordSet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    *route53.ResourceRecordSet
+		expected zoneRecordSet
+		ok       bool
+	}{
+		{
+			name: "simple",
+			input: &route53.ResourceRecordSet{
+				Name: aws.String(`\052.Example.com.`),
+				ResourceRecords: []*route53.ResourceRecord{
+					{Value: aws.String("192.0.2.2")},
+					{Value: aws.String("192.0.2.1")},
+				},
+				TTL:  aws.Int64(300),
+				Type: aws.String("A"),
+			},
+			expected: zoneRecordSet{Name: "*.example.com", Type: "A", TTL: 300, Values: []string{"192.0.2.1", "192.0.2.2"}},
+			ok:       true,
+		},
+		{
+			name: "alias",
+			input: &route53.ResourceRecordSet{
+				AliasTarget: &route53.AliasTarget{},
+				Name:        aws.String("example.com."),
+				Type:        aws.String("A"),
+			},
+		},
+		{
+			name: "weighted",
+			input: &route53.ResourceRecordSet{
+				Name:          aws.String("example.com."),
+				SetIdentifier: aws.String("one"),
+				Type:          aws.String("A"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, ok := newZoneRecordSet(testCase.input)
+
+			if ok != testCase.ok {
+				t.Fatalf("got %t, expected %t", ok, testCase.ok)
+			}
+
+			if ok && !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %#v\nexpected %#v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestZoneRecordSetChanges(t *testing.T) {
+	current := []zoneRecordSet{
+		{Name: "a.example.com", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}},
+		{Name: "b.example.com", Type: "CNAME", TTL: 300, Values: []string{"a.example.com"}},
+		{Name: "c.example.com", Type: "TXT", TTL: 300, Values: []string{`"c"`}},
+		{Name: "e.example.com", Type: "CNAME", TTL: 300, Values: []string{"a.example.com"}},
+	}
+	desired := []zoneRecordSet{
+		{Name: "a.example.com", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}},
+		{Name: "b.example.com", Type: "A", TTL: 300, Values: []string{"192.0.2.2"}},
+		{Name: "d.example.com", Type: "A", TTL: 60, Values: []string{"192.0.2.3"}},
+		{Name: "e.example.com", Type: "CNAME", TTL: 300, Values: []string{"a.example.com."}},
+	}
+
+	testCases := []struct {
+		name      string
+		normalize bool
+		expected  []string
+	}{
+		{
+			name: "raw",
+			expected: []string{
+				"DELETE b.example.com CNAME",
+				"DELETE c.example.com TXT",
+				"UPSERT b.example.com A",
+				"UPSERT d.example.com A",
+				"UPSERT e.example.com CNAME",
+			},
+		},
+		{
+			name:      "normalized",
+			normalize: true,
+			expected: []string{
+				"DELETE b.example.com CNAME",
+				"DELETE c.example.com TXT",
+				"UPSERT b.example.com A",
+				"UPSERT d.example.com A",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var got []string
+
+			for _, v := range zoneRecordSetChanges(current, desired, testCase.normalize) {
+				got = append(got, fmt.Sprintf("%s %s %s", aws.StringValue(v.Action), aws.StringValue(v.ResourceRecordSet.Name), aws.StringValue(v.ResourceRecordSet.Type)))
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %q\nexpected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestBatchZoneRecordSetChanges(t *testing.T) {
+	newChanges := func(n int, prefix, action, value string) []*route53.Change {
+		var changes []*route53.Change
+
+		for i := 0; i < n; i++ {
+			changes = append(changes, &route53.Change{
+				Action:            aws.String(action),
+				ResourceRecordSet: zoneRecordSet{Name: fmt.Sprintf("%s%d.example.com", prefix, i), Type: "TXT", TTL: 300, Values: []string{value}}.resourceRecordSet(),
+			})
+		}
+
+		return changes
+	}
+
+	testCases := []struct {
+		name     string
+		changes  []*route53.Change
+		expected []int
+	}{
+		{
+			name:     "none",
+			expected: nil,
+		},
+		{
+			name:     "deletes",
+			changes:  newChanges(1500, "r", route53.ChangeActionDelete, `"x"`),
+			expected: []int{1000, 500},
+		},
+		{
+			name:     "upserts count twice",
+			changes:  newChanges(600, "r", route53.ChangeActionUpsert, `"x"`),
+			expected: []int{500, 100},
+		},
+		{
+			name:     "value length",
+			changes:  newChanges(20, "r", route53.ChangeActionUpsert, strings.Repeat("x", 1000)),
+			expected: []int{16, 4},
+		},
+		{
+			name:     "mixed",
+			changes:  append(newChanges(999, "r", route53.ChangeActionDelete, `"x"`), newChanges(2, "s", route53.ChangeActionUpsert, `"x"`)...),
+			expected: []int{999, 2},
+		},
+		{
+			name:     "name changes in one batch",
+			changes:  append(newChanges(999, "r", route53.ChangeActionDelete, `"x"`), newChanges(1, "s", route53.ChangeActionDelete, `"x"`)[0], newChanges(1, "S", route53.ChangeActionUpsert, `"x"`)[0]),
+			expected: []int{999, 2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var got []int
+
+			for _, v := range batchZoneRecordSetChanges(testCase.changes) {
+				got = append(got, len(v))
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,512 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// ChangeResourceRecordSets quotas.
+	// UPSERT changes count twice towards the resource record and value length quotas.
+	changeBatchMaxChanges         = 1000
+	changeBatchMaxResourceRecords = 1000
+	changeBatchMaxValueLength     = 32000
+)
+
+func ResourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceZoneRecordsPut,
+		ReadWithoutTimeout:   resourceZoneRecordsRead,
+		UpdateWithoutTimeout: resourceZoneRecordsPut,
+		DeleteWithoutTimeout: resourceZoneRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"manage_apex_ns_soa": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"record": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"record", "zone_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1024),
+								validation.StringDoesNotMatch(regexp.MustCompile(`\.$`), "cannot end with a period"),
+								validation.StringDoesNotMatch(regexp.MustCompile(`[A-Z]`), "must be lowercase"),
+							),
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 4000),
+							},
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, zoneFileMaxTTL),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+					},
+				},
+			},
+			"zone_file": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"record", "zone_file"},
+				ValidateFunc:     validZoneFile,
+				DiffSuppressFunc: suppressEquivalentZoneFile,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return CleanZoneID(v.(string))
+				},
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceZoneRecordsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return diag.Errorf("reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	manageApexNSOrSOA := d.Get("manage_apex_ns_soa").(bool)
+	desired, err := expandZoneRecordSets(d, zoneName, manageApexNSOrSOA)
+
+	if err != nil {
+		return diag.Errorf("Route 53 Hosted Zone (%s) records: %s", zoneID, err)
+	}
+
+	apiObjects, err := FindResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return diag.Errorf("listing Route 53 Hosted Zone (%s) records: %s", zoneID, err)
+	}
+
+	current := managedZoneRecordSets(apiObjects, zoneName, manageApexNSOrSOA)
+	_, isZoneFile := d.GetOk("zone_file")
+
+	if err := changeZoneRecordSets(ctx, conn, zoneID, zoneRecordSetChanges(current, desired, isZoneFile)); err != nil {
+		return diag.Errorf("changing Route 53 Hosted Zone (%s) records: %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceZoneRecordsRead(ctx, d, meta)
+}
+
+func resourceZoneRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	apiObjects, err := FindResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing Route 53 Hosted Zone (%s) records: %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	manageApexNSOrSOA := d.Get("manage_apex_ns_soa").(bool)
+	current := managedZoneRecordSets(apiObjects, zoneName, manageApexNSOrSOA)
+
+	d.Set("manage_apex_ns_soa", manageApexNSOrSOA)
+	d.Set("zone_id", d.Id())
+	d.Set("zone_name", TrimTrailingPeriod(zoneName))
+
+	if v, ok := d.GetOk("zone_file"); ok {
+		normalized := make([]zoneRecordSet, len(current))
+
+		for i, v := range current {
+			normalized[i] = normalizeZoneRecordSet(v)
+		}
+
+		// Keep the configured zone file unless the zone's records differ from it.
+		if sets, err := parseZoneFile(v.(string), zoneName); err != nil || !zoneRecordSetsEquivalent(filterZoneRecordSets(sets, zoneName, manageApexNSOrSOA), normalized) {
+			d.Set("zone_file", renderZoneFile(zoneName, normalized))
+		}
+	} else {
+		if err := d.Set("record", flattenZoneRecordSets(current)); err != nil {
+			return diag.Errorf("setting record: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceZoneRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	apiObjects, err := FindResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("listing Route 53 Hosted Zone (%s) records: %s", d.Id(), err)
+	}
+
+	// The zone apex NS and SOA records can't be deleted.
+	current := managedZoneRecordSets(apiObjects, aws.StringValue(zone.HostedZone.Name), false)
+
+	log.Printf("[DEBUG] Deleting Route 53 Hosted Zone (%s) records", d.Id())
+	if err := changeZoneRecordSets(ctx, conn, d.Id(), zoneRecordSetChanges(current, nil, false)); err != nil {
+		return diag.Errorf("deleting Route 53 Hosted Zone (%s) records: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// changeZoneRecordSets submits the changes in as few change batches as the quotas allow
+// and waits for them to propagate to all Route 53 DNS servers.
+func changeZoneRecordSets(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	batches := batchZoneRecordSetChanges(changes)
+	var changeIDs []string
+
+	for i, batch := range batches {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: batch,
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		log.Printf("[DEBUG] Changing Route 53 Hosted Zone (%s) records, batch %d of %d: %d changes", zoneID, i+1, len(batches), len(batch))
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, changeTimeout, func() (interface{}, error) {
+			return conn.ChangeResourceRecordSetsWithContext(ctx, input)
+		}, route53.ErrCodePriorRequestNotComplete)
+
+		if err != nil {
+			return fmt.Errorf("change batch %d of %d: %w", i+1, len(batches), err)
+		}
+
+		if output := outputRaw.(*route53.ChangeResourceRecordSetsOutput); output.ChangeInfo != nil {
+			changeIDs = append(changeIDs, aws.StringValue(output.ChangeInfo.Id))
+		}
+	}
+
+	for _, changeID := range changeIDs {
+		if _, err := waitChangeInfoStatusInsync(conn, changeID); err != nil {
+			return fmt.Errorf("waiting for change (%s): %w", changeID, err)
+		}
+	}
+
+	return nil
+}
+
+// zoneRecordSetChanges returns the changes that turn the current record sets into the desired ones.
+// Deletions come first so that a name's record type can be changed, e.g. from A to CNAME.
+// If normalize is set, the domain names in the current record values are made fully qualified before comparison.
+func zoneRecordSetChanges(current, desired []zoneRecordSet, normalize bool) []*route53.Change {
+	currentByKey := make(map[string]zoneRecordSet, len(current))
+	desiredByKey := make(map[string]zoneRecordSet, len(desired))
+
+	for _, v := range desired {
+		desiredByKey[v.key()] = v
+	}
+
+	var deletes, upserts []*route53.Change
+
+	for _, v := range current {
+		currentByKey[v.key()] = v
+
+		if _, ok := desiredByKey[v.key()]; !ok {
+			deletes = append(deletes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: v.resourceRecordSet(),
+			})
+		}
+	}
+
+	for _, v := range desired {
+		w, ok := currentByKey[v.key()]
+
+		if ok && normalize {
+			w = normalizeZoneRecordSet(w)
+		}
+
+		if !ok || !w.equal(v) {
+			upserts = append(upserts, &route53.Change{
+				Action:            aws.String(route53.ChangeActionUpsert),
+				ResourceRecordSet: v.resourceRecordSet(),
+			})
+		}
+	}
+
+	return append(deletes, upserts...)
+}
+
+// batchZoneRecordSetChanges splits the changes into batches that are within the ChangeResourceRecordSets quotas.
+// All changes to a name are kept in the same batch, so that the name is never left without records between batches,
+// e.g. when its record type is changed from A to CNAME.
+func batchZoneRecordSetChanges(changes []*route53.Change) [][]*route53.Change {
+	var names []string
+	changesByName := make(map[string][]*route53.Change)
+
+	for _, change := range changes {
+		name := strings.ToLower(strings.TrimSuffix(aws.StringValue(change.ResourceRecordSet.Name), "."))
+
+		if _, ok := changesByName[name]; !ok {
+			names = append(names, name)
+		}
+
+		changesByName[name] = append(changesByName[name], change)
+	}
+
+	var batches [][]*route53.Change
+	var batch []*route53.Change
+	var resourceRecords, valueLength int
+
+	for _, name := range names {
+		group := changesByName[name]
+		n, l := 0, 0
+
+		for _, change := range group {
+			m, k := 0, 0
+
+			for _, v := range change.ResourceRecordSet.ResourceRecords {
+				m++
+				k += len(aws.StringValue(v.Value))
+			}
+
+			if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+				m, k = m*2, k*2
+			}
+
+			n += m
+			l += k
+		}
+
+		if len(batch) > 0 && (len(batch)+len(group) > changeBatchMaxChanges || resourceRecords+n > changeBatchMaxResourceRecords || valueLength+l > changeBatchMaxValueLength) {
+			batches = append(batches, batch)
+			batch, resourceRecords, valueLength = nil, 0, 0
+		}
+
+		batch = append(batch, group...)
+		resourceRecords += n
+		valueLength += l
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// managedZoneRecordSets returns the record sets that are managed by aws_route53_zone_records.
+func managedZoneRecordSets(apiObjects []*route53.ResourceRecordSet, zoneName string, manageApexNSOrSOA bool) []zoneRecordSet {
+	var sets []zoneRecordSet
+
+	for _, apiObject := range apiObjects {
+		if v, ok := newZoneRecordSet(apiObject); ok {
+			sets = append(sets, v)
+		}
+	}
+
+	return filterZoneRecordSets(sets, zoneName, manageApexNSOrSOA)
+}
+
+func filterZoneRecordSets(sets []zoneRecordSet, zoneName string, manageApexNSOrSOA bool) []zoneRecordSet {
+	if manageApexNSOrSOA {
+		return sets
+	}
+
+	var output []zoneRecordSet
+
+	for _, v := range sets {
+		if !isZoneApexNSOrSOA(v, zoneName) {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
+
+// expandZoneRecordSets returns the configured record sets.
+// Unless manageApexNSOrSOA is set, the zone apex NS and SOA records in a zone file are ignored.
+func expandZoneRecordSets(d *schema.ResourceData, zoneName string, manageApexNSOrSOA bool) ([]zoneRecordSet, error) {
+	var sets []zoneRecordSet
+
+	if v, ok := d.GetOk("zone_file"); ok {
+		var err error
+		sets, err = parseZoneFile(v.(string), zoneName)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing zone_file: %w", err)
+		}
+
+		sets = filterZoneRecordSets(sets, zoneName, manageApexNSOrSOA)
+	} else {
+		keys := make(map[string]bool)
+
+		for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			s := expandZoneRecordSet(tfMap)
+
+			if keys[s.key()] {
+				return nil, fmt.Errorf("duplicate %s %s record", s.Name, s.Type)
+			}
+
+			if !manageApexNSOrSOA && isZoneApexNSOrSOA(s, zoneName) {
+				return nil, fmt.Errorf("%s %s record is a zone apex record, which requires manage_apex_ns_soa", s.Name, s.Type)
+			}
+
+			keys[s.key()] = true
+			sets = append(sets, s)
+		}
+	}
+
+	var apexNS, apexSOA bool
+
+	for _, v := range sets {
+		if !isInZone(v.Name, zoneName) {
+			return nil, fmt.Errorf("%s %s record is not in the zone (%s)", v.Name, v.Type, TrimTrailingPeriod(zoneName))
+		}
+
+		if isZoneApexNSOrSOA(v, zoneName) {
+			apexNS = apexNS || v.Type == route53.RRTypeNs
+			apexSOA = apexSOA || v.Type == route53.RRTypeSoa
+		}
+	}
+
+	if manageApexNSOrSOA && (!apexNS || !apexSOA) {
+		return nil, fmt.Errorf("manage_apex_ns_soa requires the zone apex NS and SOA records")
+	}
+
+	return sets, nil
+}
+
+func expandZoneRecordSet(tfMap map[string]interface{}) zoneRecordSet {
+	s := zoneRecordSet{
+		Name: tfMap["name"].(string),
+		TTL:  int64(tfMap["ttl"].(int)),
+		Type: tfMap["type"].(string),
+	}
+
+	if v, ok := tfMap["records"].(*schema.Set); ok {
+		s.Values = aws.StringValueSlice(flex.ExpandStringSet(v))
+		sort.Strings(s.Values)
+	}
+
+	return s
+}
+
+func flattenZoneRecordSets(sets []zoneRecordSet) []interface{} {
+	tfList := make([]interface{}, 0, len(sets))
+
+	for _, v := range sets {
+		tfList = append(tfList, map[string]interface{}{
+			"name":    v.Name,
+			"records": v.Values,
+			"ttl":     int(v.TTL),
+			"type":    v.Type,
+		})
+	}
+
+	return tfList
+}
+
+func validZoneFile(v interface{}, k string) (ws []string, errors []error) {
+	// Only the syntax can be checked before the zone name is known.
+	if _, err := parseZoneFile(v.(string), "example.com"); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid zone file: %w", k, err))
+	}
+
+	return
+}
+
+func suppressEquivalentZoneFile(k, old, new string, d *schema.ResourceData) bool {
+	zoneName := d.Get("zone_name").(string)
+
+	if zoneName == "" || old == "" || new == "" {
+		return false
+	}
+
+	oldSets, err := parseZoneFile(old, zoneName)
+
+	if err != nil {
+		return false
+	}
+
+	newSets, err := parseZoneFile(new, zoneName)
+
+	if err != nil {
+		return false
+	}
+
+	manageApexNSOrSOA := d.Get("manage_apex_ns_soa").(bool)
+
+	return zoneRecordSetsEquivalent(filterZoneRecordSets(oldSets, zoneName, manageApexNSOrSOA), filterZoneRecordSets(newSets, zoneName, manageApexNSOrSOA))
+}
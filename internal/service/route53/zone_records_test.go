@@ -0,0 +1,335 @@
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestAccRoute53ZoneRecords_basic(t *testing.T) {
+	var zone route53.GetHostedZoneOutput
+	resourceName := "aws_route53_zone_records.test"
+	zoneResourceName := "aws_route53_zone.test"
+	zoneName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists(zoneResourceName, &zone),
+					// The zone apex NS and SOA records and 2 managed records.
+					testAccCheckZoneRecordsRecordSetCount(zoneResourceName, 4),
+					resource.TestCheckResourceAttr(resourceName, "manage_apex_ns_soa", "false"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      fmt.Sprintf("www.%s", zoneName),
+						"records.#": "2",
+						"ttl":       "300",
+						"type":      "A",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      zoneName,
+						"records.#": "1",
+						"ttl":       "60",
+						"type":      "TXT",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", zoneResourceName, "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "zone_name", zoneName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccZoneRecordsConfig_updated(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRecordsRecordSetCount(zoneResourceName, 4),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      fmt.Sprintf("www.%s", zoneName),
+						"records.#": "1",
+						"ttl":       "300",
+						"type":      "CNAME",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      zoneName,
+						"records.#": "1",
+						"ttl":       "120",
+						"type":      "TXT",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_zoneFile(t *testing.T) {
+	resourceName := "aws_route53_zone_records.test"
+	zoneResourceName := "aws_route53_zone.test"
+	zoneName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					// The other DNS provider's apex NS and SOA records are ignored.
+					testAccCheckZoneRecordsRecordSetCount(zoneResourceName, 7),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "zone_name", zoneName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"record", "zone_file"},
+			},
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName, "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRecordsRecordSetCount(zoneResourceName, 7),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_unmanagedRecords(t *testing.T) {
+	var zone route53.GetHostedZoneOutput
+	zoneResourceName := "aws_route53_zone.test"
+	zoneName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists(zoneResourceName, &zone),
+					testAccCreateRandomRecordsInZoneID(&zone, 5),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccZoneRecordsConfig_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRecordsRecordSetCount(zoneResourceName, 4),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_manageApexNSSOA(t *testing.T) {
+	resourceName := "aws_route53_zone_records.test"
+	zoneResourceName := "aws_route53_zone.test"
+	zoneName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccZoneRecordsConfig_manageApexNSSOAMissing(zoneName),
+				ExpectError: regexp.MustCompile(`manage_apex_ns_soa requires the zone apex NS and SOA records`),
+			},
+			{
+				Config: testAccZoneRecordsConfig_manageApexNSSOA(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRecordsRecordSetCount(zoneResourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "manage_apex_ns_soa", "true"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": zoneName,
+						"ttl":  "3600",
+						"type": "NS",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckZoneRecordsRecordSetCount checks the number of resource record sets in the hosted zone.
+func testAccCheckZoneRecordsRecordSetCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		output, err := tfroute53.FindResourceRecordSetsByZoneID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != count {
+			return fmt.Errorf("Route 53 Hosted Zone (%s) has %d record sets, expected %d", rs.Primary.ID, got, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccZoneRecordsConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1", "192.0.2.2"]
+  }
+
+  record {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 60
+    records = ["\"v=spf1 -all\""]
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneRecordsConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www.%[1]s"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["example.com"]
+  }
+
+  record {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 120
+    records = ["\"v=spf1 -all\""]
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneRecordsConfig_zoneFile(zoneName, address string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  zone_file = <<EOT
+$ORIGIN %[1]s.
+$TTL 1h
+@       IN SOA ns1.example.net. hostmaster.example.net. (
+            2022010101 ; serial
+            7200       ; refresh
+            900        ; retry
+            1209600    ; expire
+            300 )      ; minimum
+        IN NS  ns1.example.net.
+        IN MX  10 mail
+www     IN A   %[2]s
+mail    IN A   192.0.2.10
+ftp 300 IN CNAME www
+_sip._tcp IN SRV 0 5 5060 www
+EOT
+}
+`, zoneName, address)
+}
+
+func testAccZoneRecordsConfig_manageApexNSSOAMissing(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id            = aws_route53_zone.test.zone_id
+  manage_apex_ns_soa = true
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1"]
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneRecordsConfig_manageApexNSSOA(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id            = aws_route53_zone.test.zone_id
+  manage_apex_ns_soa = true
+
+  record {
+    name    = %[1]q
+    type    = "NS"
+    ttl     = 3600
+    records = [for ns in aws_route53_zone.test.name_servers : "${ns}."]
+  }
+
+  record {
+    name    = %[1]q
+    type    = "SOA"
+    ttl     = 900
+    records = ["${aws_route53_zone.test.name_servers[0]}. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400"]
+  }
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1"]
+  }
+}
+`, zoneName)
+}
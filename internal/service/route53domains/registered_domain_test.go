@@ -66,7 +66,7 @@ func testAccRegisteredDomain_tags(t *testing.T) {
 
 	resourceName := "aws_route53domains_registered_domain.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, names.Route53DomainsEndpointID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -107,7 +107,7 @@ func testAccRegisteredDomain_autoRenew(t *testing.T) {
 
 	resourceName := "aws_route53domains_registered_domain.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, names.Route53DomainsEndpointID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -138,7 +138,7 @@ func testAccRegisteredDomain_contacts(t *testing.T) {
 
 	resourceName := "aws_route53domains_registered_domain.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, names.Route53DomainsEndpointID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -241,7 +241,7 @@ func testAccRegisteredDomain_contactPrivacy(t *testing.T) {
 
 	resourceName := "aws_route53domains_registered_domain.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, names.Route53DomainsEndpointID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -276,7 +276,7 @@ func testAccRegisteredDomain_nameservers(t *testing.T) {
 
 	resourceName := "aws_route53domains_registered_domain.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, names.Route53DomainsEndpointID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -320,7 +320,7 @@ func testAccRegisteredDomain_transferLock(t *testing.T) {
 
 	resourceName := "aws_route53domains_registered_domain.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, names.Route53DomainsEndpointID),
 		ProviderFactories: acctest.ProviderFactories,
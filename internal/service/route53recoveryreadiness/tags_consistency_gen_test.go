@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package route53recoveryreadiness_test
+
+import (
+	"testing"
+)
+
+func TestAccRoute53RecoveryReadinessCell_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53recoveryreadiness_cell has no testAccCellConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRoute53RecoveryReadinessReadinessCheck_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53recoveryreadiness_readiness_check has no testAccReadinessCheckConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRoute53RecoveryReadinessRecoveryGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53recoveryreadiness_recovery_group has no testAccRecoveryGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRoute53RecoveryReadinessResourceSet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53recoveryreadiness_resource_set has no testAccResourceSetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
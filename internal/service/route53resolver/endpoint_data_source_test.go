@@ -18,7 +18,7 @@ func TestAccRoute53ResolverEndpointDataSource_basic(t *testing.T) {
 	resourceName := "aws_route53_resolver_endpoint.foo"
 	datasourceName := "data.aws_route53_resolver_endpoint.foo"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, route53resolver.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -47,7 +47,7 @@ func TestAccRoute53ResolverEndpointDataSource_filter(t *testing.T) {
 	resourceName := "aws_route53_resolver_endpoint.foo"
 	datasourceName := "data.aws_route53_resolver_endpoint.foo"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, route53resolver.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -20,7 +20,7 @@ func TestAccRoute53ResolverEndpoint_basicInbound(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	name := fmt.Sprintf("terraform-testacc-r53-resolver-%d", rInt)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, route53resolver.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -53,7 +53,7 @@ func TestAccRoute53ResolverEndpoint_updateOutbound(t *testing.T) {
 	initialName := fmt.Sprintf("terraform-testacc-r53-resolver-%d", rInt)
 	updatedName := fmt.Sprintf("terraform-testacc-r53-rupdated-%d", rInt)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, route53resolver.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
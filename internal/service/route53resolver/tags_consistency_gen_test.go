@@ -0,0 +1,31 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package route53resolver_test
+
+import (
+	"testing"
+)
+
+func TestAccRoute53ResolverEndpoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53_resolver_endpoint has no testAccEndpointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRoute53ResolverFirewallDomainList_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53_resolver_firewall_domain_list has no testAccFirewallDomainListConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRoute53ResolverFirewallRuleGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53_resolver_firewall_rule_group has no testAccFirewallRuleGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRoute53ResolverFirewallRuleGroupAssociation_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53_resolver_firewall_rule_group_association has no testAccFirewallRuleGroupAssociationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRoute53ResolverQueryLogConfig_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53_resolver_query_log_config has no testAccQueryLogConfigConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccRoute53ResolverRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_route53_resolver_rule has no testAccRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
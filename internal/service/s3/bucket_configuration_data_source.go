@@ -0,0 +1,371 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// DataSourceBucketConfiguration returns the effective configuration of a bucket.
+// Each nested block has the same shape as the corresponding aws_s3_bucket_* resource
+// and is populated by that resource's flatten functions.
+func DataSourceBucketConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBucketConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"accelerate_configuration": bucketConfigurationBlockSchema(ResourceBucketAccelerateConfiguration(), "status"),
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cors_rule": bucketConfigurationAttributeSchema(ResourceBucketCorsConfiguration().Schema["cors_rule"]),
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"lifecycle_rule":            bucketConfigurationAttributeSchema(ResourceBucketLifecycleConfiguration().Schema["rule"]),
+			"logging":                   bucketConfigurationBlockSchema(ResourceBucketLogging(), "target_bucket", "target_grant", "target_prefix"),
+			"object_lock_configuration": bucketConfigurationBlockSchema(ResourceBucketObjectLockConfiguration(), "object_lock_enabled", "rule"),
+			"ownership_controls":        bucketConfigurationBlockSchema(ResourceBucketOwnershipControls(), "rule"),
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block":                  bucketConfigurationBlockSchema(ResourceBucketPublicAccessBlock(), "block_public_acls", "block_public_policy", "ignore_public_acls", "restrict_public_buckets"),
+			"replication_configuration":            bucketConfigurationBlockSchema(ResourceBucketReplicationConfiguration(), "role", "rule"),
+			"request_payment_configuration":        bucketConfigurationBlockSchema(ResourceBucketRequestPaymentConfiguration(), "payer"),
+			"server_side_encryption_configuration": bucketConfigurationBlockSchema(ResourceBucketServerSideEncryptionConfiguration(), "rule"),
+			"versioning_configuration":             bucketConfigurationAttributeSchema(ResourceBucketVersioning().Schema["versioning_configuration"]),
+		},
+	}
+}
+
+func dataSourceBucketConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient)
+	conn := client.S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	// Some bucket configuration, e.g. replication, can only be read in the bucket's region.
+	region, err := s3manager.GetBucketRegionWithClient(ctx, conn, bucket, func(r *request.Request) {
+		// Same options as the aws_s3_bucket data source.
+		r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
+		r.Config.Credentials = conn.Config.Credentials
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket (%s) region: %s", bucket, err)
+	}
+
+	if region != client.Region {
+		conn = s3.New(client.Session, conn.Config.Copy(&aws.Config{Region: aws.String(region)}))
+	}
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+
+	var owner *string
+	if expectedBucketOwner != "" {
+		owner = aws.String(expectedBucketOwner)
+	}
+
+	log.Printf("[DEBUG] Reading S3 Bucket (%s) configuration", bucket)
+	_, err = conn.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket (%s): %s", bucket, err)
+	}
+
+	d.SetId(CreateResourceID(bucket, expectedBucketOwner))
+
+	accelerate, err := conn.GetBucketAccelerateConfigurationWithContext(ctx, &s3.GetBucketAccelerateConfigurationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeMethodNotAllowed, ErrCodeUnsupportedArgument, ErrCodeNotImplemented):
+		d.Set("accelerate_configuration", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) accelerate configuration: %s", bucket, err)
+	case accelerate.Status == nil:
+		d.Set("accelerate_configuration", nil)
+	default:
+		if err := d.Set("accelerate_configuration", []interface{}{map[string]interface{}{
+			"status": aws.StringValue(accelerate.Status),
+		}}); err != nil {
+			return diag.Errorf("error setting accelerate_configuration: %s", err)
+		}
+	}
+
+	cors, err := conn.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNoSuchCORSConfiguration, ErrCodeNotImplemented, ErrCodeXNotImplemented):
+		d.Set("cors_rule", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) CORS configuration: %s", bucket, err)
+	default:
+		if err := d.Set("cors_rule", flattenBucketCorsConfigurationCorsRules(cors.CORSRules)); err != nil {
+			return diag.Errorf("error setting cors_rule: %s", err)
+		}
+	}
+
+	lifecycle, err := conn.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration):
+		d.Set("lifecycle_rule", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) lifecycle configuration: %s", bucket, err)
+	default:
+		if err := d.Set("lifecycle_rule", FlattenLifecycleRules(lifecycle.Rules)); err != nil {
+			return diag.Errorf("error setting lifecycle_rule: %s", err)
+		}
+	}
+
+	logging, err := conn.GetBucketLoggingWithContext(ctx, &s3.GetBucketLoggingInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNotImplemented):
+		d.Set("logging", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) logging: %s", bucket, err)
+	case logging.LoggingEnabled == nil:
+		d.Set("logging", nil)
+	default:
+		if err := d.Set("logging", []interface{}{map[string]interface{}{
+			"target_bucket": aws.StringValue(logging.LoggingEnabled.TargetBucket),
+			"target_grant":  flattenBucketLoggingTargetGrants(logging.LoggingEnabled.TargetGrants),
+			"target_prefix": aws.StringValue(logging.LoggingEnabled.TargetPrefix),
+		}}); err != nil {
+			return diag.Errorf("error setting logging: %s", err)
+		}
+	}
+
+	objectLock, err := conn.GetObjectLockConfigurationWithContext(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeObjectLockConfigurationNotFound, ErrCodeMethodNotAllowed):
+		d.Set("object_lock_configuration", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) object lock configuration: %s", bucket, err)
+	case objectLock.ObjectLockConfiguration == nil:
+		d.Set("object_lock_configuration", nil)
+	default:
+		if err := d.Set("object_lock_configuration", []interface{}{map[string]interface{}{
+			"object_lock_enabled": aws.StringValue(objectLock.ObjectLockConfiguration.ObjectLockEnabled),
+			"rule":                flattenBucketObjectLockConfigurationRule(objectLock.ObjectLockConfiguration.Rule),
+		}}); err != nil {
+			return diag.Errorf("error setting object_lock_configuration: %s", err)
+		}
+	}
+
+	ownershipControls, err := conn.GetBucketOwnershipControlsWithContext(ctx, &s3.GetBucketOwnershipControlsInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, "OwnershipControlsNotFoundError"):
+		d.Set("ownership_controls", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) ownership controls: %s", bucket, err)
+	case ownershipControls.OwnershipControls == nil:
+		d.Set("ownership_controls", nil)
+	default:
+		if err := d.Set("ownership_controls", []interface{}{map[string]interface{}{
+			"rule": flattenOwnershipControlsRules(ownershipControls.OwnershipControls.Rules),
+		}}); err != nil {
+			return diag.Errorf("error setting ownership_controls: %s", err)
+		}
+	}
+
+	policy, err := conn.GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucketPolicy):
+		d.Set("policy", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) policy: %s", bucket, err)
+	default:
+		v, err := structure.NormalizeJsonString(aws.StringValue(policy.Policy))
+
+		if err != nil {
+			return diag.Errorf("policy (%s) is an invalid JSON: %s", aws.StringValue(policy.Policy), err)
+		}
+
+		d.Set("policy", v)
+	}
+
+	publicAccessBlock, err := conn.GetPublicAccessBlockWithContext(ctx, &s3.GetPublicAccessBlockInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNoSuchPublicAccessBlockConfiguration):
+		d.Set("public_access_block", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) public access block: %s", bucket, err)
+	case publicAccessBlock.PublicAccessBlockConfiguration == nil:
+		d.Set("public_access_block", nil)
+	default:
+		v := publicAccessBlock.PublicAccessBlockConfiguration
+
+		if err := d.Set("public_access_block", []interface{}{map[string]interface{}{
+			"block_public_acls":       aws.BoolValue(v.BlockPublicAcls),
+			"block_public_policy":     aws.BoolValue(v.BlockPublicPolicy),
+			"ignore_public_acls":      aws.BoolValue(v.IgnorePublicAcls),
+			"restrict_public_buckets": aws.BoolValue(v.RestrictPublicBuckets),
+		}}); err != nil {
+			return diag.Errorf("error setting public_access_block: %s", err)
+		}
+	}
+
+	replication, err := conn.GetBucketReplicationWithContext(ctx, &s3.GetBucketReplicationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeReplicationConfigurationNotFound):
+		d.Set("replication_configuration", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) replication configuration: %s", bucket, err)
+	case replication.ReplicationConfiguration == nil:
+		d.Set("replication_configuration", nil)
+	default:
+		if err := d.Set("replication_configuration", []interface{}{map[string]interface{}{
+			"role": aws.StringValue(replication.ReplicationConfiguration.Role),
+			"rule": FlattenReplicationRules(replication.ReplicationConfiguration.Rules),
+		}}); err != nil {
+			return diag.Errorf("error setting replication_configuration: %s", err)
+		}
+	}
+
+	requestPayment, err := conn.GetBucketRequestPaymentWithContext(ctx, &s3.GetBucketRequestPaymentInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNotImplemented):
+		d.Set("request_payment_configuration", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) request payment configuration: %s", bucket, err)
+	default:
+		if err := d.Set("request_payment_configuration", []interface{}{map[string]interface{}{
+			"payer": aws.StringValue(requestPayment.Payer),
+		}}); err != nil {
+			return diag.Errorf("error setting request_payment_configuration: %s", err)
+		}
+	}
+
+	encryption, err := conn.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeServerSideEncryptionConfigurationNotFound):
+		d.Set("server_side_encryption_configuration", nil)
+	case err != nil:
+		return diag.Errorf("error reading S3 Bucket (%s) server-side encryption configuration: %s", bucket, err)
+	case encryption.ServerSideEncryptionConfiguration == nil:
+		d.Set("server_side_encryption_configuration", nil)
+	default:
+		if err := d.Set("server_side_encryption_configuration", []interface{}{map[string]interface{}{
+			"rule": flattenBucketServerSideEncryptionConfigurationRules(encryption.ServerSideEncryptionConfiguration.Rules),
+		}}); err != nil {
+			return diag.Errorf("error setting server_side_encryption_configuration: %s", err)
+		}
+	}
+
+	versioning, err := conn.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: owner,
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket (%s) versioning: %s", bucket, err)
+	}
+
+	if err := d.Set("versioning_configuration", flattenBucketVersioningConfiguration(versioning)); err != nil {
+		return diag.Errorf("error setting versioning_configuration: %s", err)
+	}
+
+	return nil
+}
+
+// bucketConfigurationBlockSchema returns a computed block containing the specified
+// attributes of an aws_s3_bucket_* resource.
+func bucketConfigurationBlockSchema(r *schema.Resource, keys ...string) *schema.Schema {
+	m := make(map[string]*schema.Schema, len(keys))
+
+	for _, key := range keys {
+		m[key] = bucketConfigurationAttributeSchema(r.Schema[key])
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: m,
+		},
+	}
+}
+
+// bucketConfigurationAttributeSchema returns a computed-only copy of a resource attribute's schema
+// that is suitable for use in a data source.
+func bucketConfigurationAttributeSchema(v *schema.Schema) *schema.Schema {
+	s := &schema.Schema{
+		Type:      v.Type,
+		Computed:  true,
+		Sensitive: v.Sensitive,
+		Set:       v.Set,
+	}
+
+	switch elem := v.Elem.(type) {
+	case *schema.Resource:
+		m := make(map[string]*schema.Schema, len(elem.Schema))
+
+		for k, v := range elem.Schema {
+			m[k] = bucketConfigurationAttributeSchema(v)
+		}
+
+		s.Elem = &schema.Resource{Schema: m}
+	case *schema.Schema:
+		s.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return s
+}
@@ -0,0 +1,254 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestAccS3BucketConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_configuration.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accelerate_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "cors_rule.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "logging.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "replication_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "request_payment_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "request_payment_configuration.0.payer", s3.PayerBucketOwner),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.0.status", tfs3.BucketVersioningStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketConfigurationDataSource_splitResources(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_configuration.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationDataSourceConfig_splitResources(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "accelerate_configuration.0.status", "aws_s3_bucket_accelerate_configuration.test", "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cors_rule.#", "aws_s3_bucket_cors_configuration.test", "cors_rule.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "cors_rule.*", map[string]string{
+						"allowed_methods.#": "1",
+						"allowed_origins.#": "1",
+						"max_age_seconds":   "3000",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "lifecycle_rule.#", "aws_s3_bucket_lifecycle_configuration.test", "rule.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", "expire"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.expiration.0.days", "90"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ownership_controls.0.rule.0.object_ownership", "aws_s3_bucket_ownership_controls.test", "rule.0.object_ownership"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_policy", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.ignore_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.restrict_public_buckets", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "server_side_encryption_configuration.0.rule.*", map[string]string{
+						"apply_server_side_encryption_by_default.#":               "1",
+						"apply_server_side_encryption_by_default.0.sse_algorithm": s3.ServerSideEncryptionAes256,
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versioning_configuration.0.status", "aws_s3_bucket_versioning.test", "versioning_configuration.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketConfigurationDataSource_otherRegion(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_configuration.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationDataSourceConfig_otherRegion(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versioning_configuration.0.status", "aws_s3_bucket_versioning.test", "versioning_configuration.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketConfigurationDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_s3_bucket_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+}
+`, rName)
+}
+
+func testAccBucketConfigurationDataSourceConfig_splitResources(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_accelerate_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  status = "Enabled"
+}
+
+resource "aws_s3_bucket_cors_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  cors_rule {
+    allowed_methods = ["GET"]
+    allowed_origins = ["https://www.example.com"]
+    max_age_seconds = 3000
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id     = "expire"
+    status = "Enabled"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+}
+
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    object_ownership = "BucketOwnerEnforced"
+  }
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowAccount"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "s3:GetObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+data "aws_s3_bucket_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  depends_on = [
+    aws_s3_bucket_accelerate_configuration.test,
+    aws_s3_bucket_cors_configuration.test,
+    aws_s3_bucket_lifecycle_configuration.test,
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_policy.test,
+    aws_s3_bucket_public_access_block.test,
+    aws_s3_bucket_server_side_encryption_configuration.test,
+    aws_s3_bucket_versioning.test,
+  ]
+}
+`, rName)
+}
+
+func testAccBucketConfigurationDataSourceConfig_otherRegion(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  provider = "awsalternate"
+
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.bucket
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+data "aws_s3_bucket_configuration" "test" {
+  bucket = aws_s3_bucket_versioning.test.bucket
+}
+`, rName))
+}
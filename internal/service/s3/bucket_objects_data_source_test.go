@@ -17,7 +17,7 @@ import (
 func TestAccS3BucketObjectsDataSource_basic(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -43,7 +43,7 @@ func TestAccS3BucketObjectsDataSource_basic(t *testing.T) {
 func TestAccS3BucketObjectsDataSource_basicViaAccessPoint(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -69,7 +69,7 @@ func TestAccS3BucketObjectsDataSource_basicViaAccessPoint(t *testing.T) {
 func TestAccS3BucketObjectsDataSource_all(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -100,7 +100,7 @@ func TestAccS3BucketObjectsDataSource_all(t *testing.T) {
 func TestAccS3BucketObjectsDataSource_prefixes(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -130,7 +130,7 @@ func TestAccS3BucketObjectsDataSource_prefixes(t *testing.T) {
 func TestAccS3BucketObjectsDataSource_encoded(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -156,7 +156,7 @@ func TestAccS3BucketObjectsDataSource_encoded(t *testing.T) {
 func TestAccS3BucketObjectsDataSource_maxKeys(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -182,7 +182,7 @@ func TestAccS3BucketObjectsDataSource_maxKeys(t *testing.T) {
 func TestAccS3BucketObjectsDataSource_startAfter(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -207,7 +207,7 @@ func TestAccS3BucketObjectsDataSource_startAfter(t *testing.T) {
 func TestAccS3BucketObjectsDataSource_fetchOwner(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -0,0 +1,604 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	directoryDefaultConcurrency = 10
+	directoryDefaultPartSize    = 16 // MiB.
+	directoryMinPartSize        = 5  // MiB.
+
+	// The maximum number of keys in a DeleteObjects request.
+	directoryDeleteObjectsBatchSize = 1000
+)
+
+func ResourceDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDirectoryCreate,
+		Read:   resourceDirectoryRead,
+		Update: resourceDirectoryUpdate,
+		Delete: resourceDirectoryDelete,
+
+		CustomizeDiff: resourceDirectoryCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directoryDefaultConcurrency,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"delete_removed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validDirectoryGlob,
+				},
+			},
+			"file_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_language": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"glob": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.NoZeroValues,
+								validDirectoryGlob,
+							),
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateMetadataIsLowerCase,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([^/].*/)?$`), "must be empty or end with a slash, and must not start with a slash"),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"manifest": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directoryDefaultPartSize,
+				ValidateFunc: validation.IntAtLeast(directoryMinPartSize),
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3API()
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	files, err := directoryFilesFromResourceData(d)
+
+	if err != nil {
+		return err
+	}
+
+	if err := uploadDirectoryFiles(conn, d, files); err != nil {
+		return fmt.Errorf("error uploading S3 Directory (%s/%s): %w", bucket, keyPrefix, err)
+	}
+
+	d.SetId(DirectoryCreateResourceID(bucket, keyPrefix))
+	d.Set("manifest", directoryManifest(files))
+
+	if d.Get("delete_removed").(bool) {
+		if err := deleteRemovedDirectoryObjects(conn, bucket, keyPrefix, files); err != nil {
+			return fmt.Errorf("error deleting S3 Directory (%s) removed objects: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDirectoryRead(d, meta)
+}
+
+func resourceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3API()
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	objects, err := FindDirectoryObjectETags(conn, bucket, keyPrefix)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Directory (%s): %w", d.Id(), err)
+	}
+
+	// ETags of SSE-KMS encrypted objects are not MD5 digests of the object data,
+	// so only object deletion can be detected.
+	compareETags := d.Get("kms_key_id").(string) == "" && d.Get("server_side_encryption").(string) != s3.ServerSideEncryptionAwsKms
+	manifest := make(map[string]string)
+
+	for key, v := range d.Get("manifest").(map[string]interface{}) {
+		etag, ok := objects[key]
+
+		if !ok {
+			log.Printf("[DEBUG] S3 Directory (%s) object (%s) not found", d.Id(), key)
+			continue
+		}
+
+		if compareETags {
+			manifest[key] = etag
+		} else {
+			manifest[key] = v.(string)
+		}
+	}
+
+	// Objects not uploaded from the directory show as removed.
+	if d.Get("delete_removed").(bool) {
+		for key, etag := range objects {
+			if _, ok := manifest[key]; !ok {
+				manifest[key] = etag
+			}
+		}
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		return fmt.Errorf("error setting manifest: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3API()
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	files, err := directoryFilesFromResourceData(d)
+
+	if err != nil {
+		return err
+	}
+
+	uploads := files
+
+	// Object properties apply to all objects, so upload all objects if any changed.
+	if !d.HasChanges("acl", "file_rule", "kms_key_id", "server_side_encryption", "storage_class") {
+		o, _ := d.GetChange("manifest")
+		old := o.(map[string]interface{})
+		uploads = nil
+
+		for _, file := range files {
+			if v, ok := old[file.Key]; !ok || v.(string) != file.ETag {
+				uploads = append(uploads, file)
+			}
+		}
+	}
+
+	if err := uploadDirectoryFiles(conn, d, uploads); err != nil {
+		return fmt.Errorf("error uploading S3 Directory (%s): %w", d.Id(), err)
+	}
+
+	d.Set("manifest", directoryManifest(files))
+
+	if d.Get("delete_removed").(bool) {
+		if err := deleteRemovedDirectoryObjects(conn, bucket, keyPrefix, files); err != nil {
+			return fmt.Errorf("error deleting S3 Directory (%s) removed objects: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDirectoryRead(d, meta)
+}
+
+func resourceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3API()
+
+	bucket := d.Get("bucket").(string)
+	var keys []string
+
+	for key := range d.Get("manifest").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory (%s): %d objects", d.Id(), len(keys))
+	err := deleteDirectoryObjects(conn, bucket, keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Directory (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDirectoryCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source") || !diff.NewValueKnown("exclude") || !diff.NewValueKnown("key_prefix") {
+		return diff.SetNewComputed("manifest")
+	}
+
+	exclude, err := expandDirectoryGlobs(diff.Get("exclude").(*schema.Set))
+
+	if err != nil {
+		return err
+	}
+
+	files, err := directoryFiles(
+		diff.Get("source").(string),
+		diff.Get("key_prefix").(string),
+		exclude,
+		int64(diff.Get("part_size").(int))*1024*1024,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	o := diff.Get("manifest").(map[string]interface{})
+	n := directoryManifest(files)
+
+	if len(o) == len(n) {
+		changed := false
+
+		for key, etag := range n {
+			if v, ok := o[key]; !ok || v.(string) != etag {
+				changed = true
+				break
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+	}
+
+	return diff.SetNew("manifest", n)
+}
+
+// DirectoryCreateResourceID returns the ID of an S3 Directory resource.
+func DirectoryCreateResourceID(bucket, keyPrefix string) string {
+	return fmt.Sprintf("%s/%s", bucket, keyPrefix)
+}
+
+// FindDirectoryObjectETags returns the ETags of the objects under the key prefix, keyed by object key.
+func FindDirectoryObjectETags(conn s3iface.S3API, bucket, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	etags := make(map[string]string)
+
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, object := range page.Contents {
+			// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+			etags[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return etags, nil
+}
+
+func directoryFilesFromResourceData(d *schema.ResourceData) ([]*directoryFile, error) {
+	exclude, err := expandDirectoryGlobs(d.Get("exclude").(*schema.Set))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return directoryFiles(
+		d.Get("source").(string),
+		d.Get("key_prefix").(string),
+		exclude,
+		int64(d.Get("part_size").(int))*1024*1024,
+	)
+}
+
+func directoryManifest(files []*directoryFile) map[string]string {
+	manifest := make(map[string]string, len(files))
+
+	for _, file := range files {
+		manifest[file.Key] = file.ETag
+	}
+
+	return manifest
+}
+
+// directoryObjectInput returns the upload input for the file.
+// Content type is detected from the file extension.
+// Matching file rules are applied in order, so later rules override earlier ones.
+func directoryObjectInput(d *schema.ResourceData, rules []*directoryFileRule, file *directoryFile, rel string) *s3manager.UploadInput {
+	input := &s3manager.UploadInput{
+		ACL:    aws.String(d.Get("acl").(string)),
+		Bucket: aws.String(d.Get("bucket").(string)),
+		Key:    aws.String(file.Key),
+	}
+
+	if v := mime.TypeByExtension(path.Ext(rel)); v != "" {
+		input.ContentType = aws.String(v)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	for _, rule := range rules {
+		if !rule.glob.match(rel) {
+			continue
+		}
+
+		tfMap := rule.tfMap
+
+		if v, ok := tfMap["cache_control"].(string); ok && v != "" {
+			input.CacheControl = aws.String(v)
+		}
+
+		if v, ok := tfMap["content_disposition"].(string); ok && v != "" {
+			input.ContentDisposition = aws.String(v)
+		}
+
+		if v, ok := tfMap["content_encoding"].(string); ok && v != "" {
+			input.ContentEncoding = aws.String(v)
+		}
+
+		if v, ok := tfMap["content_language"].(string); ok && v != "" {
+			input.ContentLanguage = aws.String(v)
+		}
+
+		if v, ok := tfMap["content_type"].(string); ok && v != "" {
+			input.ContentType = aws.String(v)
+		}
+
+		if v, ok := tfMap["metadata"].(map[string]interface{}); ok && len(v) > 0 {
+			if input.Metadata == nil {
+				input.Metadata = make(map[string]*string)
+			}
+
+			for k, v := range flex.ExpandStringMap(v) {
+				input.Metadata[k] = v
+			}
+		}
+	}
+
+	return input
+}
+
+// uploadDirectoryFiles uploads the files in parallel.
+// Files larger than the part size are uploaded in parts. Failed multipart uploads are aborted.
+func uploadDirectoryFiles(conn s3iface.S3API, d *schema.ResourceData, files []*directoryFile) error {
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("part_size").(int)) * 1024 * 1024
+	})
+	keyPrefix := d.Get("key_prefix").(string)
+	rules, err := expandDirectoryFileRules(d.Get("file_rule").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs *multierror.Error
+	queue := make(chan *directoryFile)
+
+	for i := 0; i < d.Get("concurrency").(int); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for file := range queue {
+				if err := uploadDirectoryFile(uploader, directoryObjectInput(d, rules, file, strings.TrimPrefix(file.Key, keyPrefix)), file); err != nil {
+					mu.Lock()
+					errs = multierror.Append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, file := range files {
+		queue <- file
+	}
+
+	close(queue)
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+func uploadDirectoryFile(uploader *s3manager.Uploader, input *s3manager.UploadInput, file *directoryFile) error {
+	f, err := os.Open(file.Path)
+
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", file.Path, err)
+	}
+
+	defer f.Close()
+
+	input.Body = f
+
+	log.Printf("[DEBUG] Uploading S3 Object (%s): %s", file.Key, file.Path)
+	if _, err := uploader.Upload(input); err != nil {
+		return fmt.Errorf("uploading %s to S3 Object (%s): %w", file.Path, file.Key, err)
+	}
+
+	return nil
+}
+
+// deleteRemovedDirectoryObjects deletes objects under the key prefix that have no corresponding file.
+func deleteRemovedDirectoryObjects(conn s3iface.S3API, bucket, keyPrefix string, files []*directoryFile) error {
+	objects, err := FindDirectoryObjectETags(conn, bucket, keyPrefix)
+
+	if err != nil {
+		return err
+	}
+
+	manifest := directoryManifest(files)
+	var keys []string
+
+	for key := range objects {
+		if _, ok := manifest[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	return deleteDirectoryObjects(conn, bucket, keys)
+}
+
+func deleteDirectoryObjects(conn s3iface.S3API, bucket string, keys []string) error {
+	sort.Strings(keys)
+
+	var errs *multierror.Error
+
+	for len(keys) > 0 {
+		n := len(keys)
+
+		if n > directoryDeleteObjectsBatchSize {
+			n = directoryDeleteObjectsBatchSize
+		}
+
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Quiet: aws.Bool(true),
+			},
+		}
+
+		for _, key := range keys[:n] {
+			input.Delete.Objects = append(input.Delete.Objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		keys = keys[n:]
+
+		output, err := conn.DeleteObjects(input)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("deleting S3 Object (%s): %s: %s", aws.StringValue(v.Key), aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
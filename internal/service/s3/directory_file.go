@@ -0,0 +1,316 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+type directoryFile struct {
+	// Absolute path of the local file.
+	Path string
+	// Object key.
+	Key string
+	// Expected object ETag.
+	ETag string
+}
+
+// directoryFiles returns the regular files under the source directory, except those matching exclude globs.
+// The files' keys are their slash-separated paths relative to the directory, prefixed with keyPrefix.
+func directoryFiles(source, keyPrefix string, exclude []*directoryGlob, partSize int64) ([]*directoryFile, error) {
+	root, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source (%s): %w", source, err)
+	}
+
+	var files []*directoryFile
+
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		for _, glob := range exclude {
+			if glob.match(rel) {
+				return nil
+			}
+		}
+
+		etag, err := directoryFileETag(path, info.Size(), partSize)
+
+		if err != nil {
+			return err
+		}
+
+		files = append(files, &directoryFile{
+			Path: path,
+			Key:  keyPrefix + rel,
+			ETag: etag,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading S3 Directory source (%s): %w", source, err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Key < files[j].Key
+	})
+
+	return files, nil
+}
+
+// directoryFileETag returns the ETag S3 computes for the file when uploaded with the specified part size.
+// Files larger than the part size are uploaded in parts and their ETag is the MD5 digest of
+// the parts' MD5 digests, followed by the number of parts.
+func directoryFileETag(path string, size, partSize int64) (string, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	if size <= partSize {
+		h := md5.New()
+
+		if _, err := io.Copy(h, f); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	// Same adjustment as the s3manager Uploader.
+	if size/partSize >= s3manager.MaxUploadParts {
+		partSize = (size / s3manager.MaxUploadParts) + 1
+	}
+
+	var digests []byte
+	var parts int
+
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, f, partSize)
+
+		if n > 0 {
+			digests = append(digests, h.Sum(nil)...)
+			parts++
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	sum := md5.Sum(digests)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
+// directoryGlob is a compiled glob that matches slash-separated relative paths.
+type directoryGlob struct {
+	baseName bool
+	re       *regexp.Regexp
+}
+
+// newDirectoryGlob compiles the glob.
+// "*" and "?" do not match "/", "**" matches any number of directories,
+// "[...]" matches a character class, negated by a leading "!" or "^",
+// and "\" escapes the following character.
+// Globs without a "/" are matched against the path's base name.
+func newDirectoryGlob(glob string) (*directoryGlob, error) {
+	var b strings.Builder
+
+	b.WriteString("^")
+
+	runes := []rune(glob)
+
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' {
+				i++
+
+				if i+1 < len(runes) && runes[i+1] == '/' {
+					i++
+					b.WriteString("(.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		case '[':
+			class, n, err := directoryGlobClass(runes[i+1:])
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid glob %q: %w", glob, err)
+			}
+
+			b.WriteString(class)
+			i += n
+		case '\\':
+			if i+1 == len(runes) {
+				return nil, fmt.Errorf("invalid glob %q: trailing escape character", glob)
+			}
+
+			i++
+			b.WriteString(regexp.QuoteMeta(string(runes[i])))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob %q: %w", glob, err)
+	}
+
+	return &directoryGlob{
+		baseName: !strings.Contains(glob, "/"),
+		re:       re,
+	}, nil
+}
+
+// directoryGlobClass returns the regular expression for the character class that starts after a "[",
+// and the number of runes it consumes including the closing "]". Negated classes never match "/".
+func directoryGlobClass(runes []rune) (string, int, error) {
+	var b strings.Builder
+	i := 0
+
+	b.WriteString("[")
+
+	if i < len(runes) && (runes[i] == '!' || runes[i] == '^') {
+		b.WriteString("^/")
+		i++
+	}
+
+	for start := i; i < len(runes); i++ {
+		switch c := runes[i]; {
+		case c == ']' && i > start:
+			b.WriteString("]")
+
+			return b.String(), i + 1, nil
+		case c == '\\':
+			if i+1 == len(runes) {
+				return "", 0, fmt.Errorf("trailing escape character")
+			}
+
+			i++
+
+			// Escaped ASCII punctuation, including "-", is literal within a regular expression class.
+			if e := runes[i]; e < utf8.RuneSelf && !unicode.IsLetter(e) && !unicode.IsDigit(e) {
+				b.WriteString(`\`)
+			}
+
+			b.WriteRune(runes[i])
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated character class")
+}
+
+// match returns whether the slash-separated relative path matches the glob.
+func (g *directoryGlob) match(name string) bool {
+	if g.baseName {
+		name = path.Base(name)
+	}
+
+	return g.re.MatchString(name)
+}
+
+func expandDirectoryGlobs(tfSet *schema.Set) ([]*directoryGlob, error) {
+	var globs []*directoryGlob
+
+	for _, v := range tfSet.List() {
+		glob, err := newDirectoryGlob(v.(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		globs = append(globs, glob)
+	}
+
+	return globs, nil
+}
+
+func validDirectoryGlob(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := newDirectoryGlob(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// directoryFileRule is a file_rule whose glob has been compiled.
+type directoryFileRule struct {
+	glob  *directoryGlob
+	tfMap map[string]interface{}
+}
+
+func expandDirectoryFileRules(tfList []interface{}) ([]*directoryFileRule, error) {
+	var rules []*directoryFileRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		glob, err := newDirectoryGlob(tfMap["glob"].(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, &directoryFileRule{
+			glob:  glob,
+			tfMap: tfMap,
+		})
+	}
+
+	return rules, nil
+}
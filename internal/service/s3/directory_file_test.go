@@ -0,0 +1,171 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDirectoryFileETag(t *testing.T) {
+	const partSize = 4
+
+	testCases := []struct {
+		Name     string
+		Data     string
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			Data:     "",
+			Expected: testDirectoryMD5(""),
+		},
+		{
+			Name:     "below part size",
+			Data:     "abc",
+			Expected: testDirectoryMD5("abc"),
+		},
+		{
+			Name:     "at part size",
+			Data:     "abcd",
+			Expected: testDirectoryMD5("abcd"),
+		},
+		{
+			Name:     "above part size",
+			Data:     "abcde",
+			Expected: testDirectoryMultipartETag("abcd", "e"),
+		},
+		{
+			Name:     "multiple of part size",
+			Data:     "abcdefgh",
+			Expected: testDirectoryMultipartETag("abcd", "efgh"),
+		},
+		{
+			Name:     "above multiple of part size",
+			Data:     "abcdefghi",
+			Expected: testDirectoryMultipartETag("abcd", "efgh", "i"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file")
+
+			if err := os.WriteFile(path, []byte(testCase.Data), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := directoryFileETag(path, int64(len(testCase.Data)), partSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDirectoryGlob(t *testing.T) {
+	testCases := []struct {
+		Glob     string
+		Name     string
+		Expected bool
+	}{
+		// Globs without a slash match base names.
+		{Glob: "*.txt", Name: "a.txt", Expected: true},
+		{Glob: "*.txt", Name: "dir/a.txt", Expected: true},
+		{Glob: "*.txt", Name: "a.txt.gz", Expected: false},
+		{Glob: "?.txt", Name: "a.txt", Expected: true},
+		{Glob: "?.txt", Name: "ab.txt", Expected: false},
+
+		// "*" and "?" don't match slashes.
+		{Glob: "dir/*", Name: "dir/a.txt", Expected: true},
+		{Glob: "dir/*", Name: "dir/sub/a.txt", Expected: false},
+		{Glob: "dir?a.txt", Name: "dir/a.txt", Expected: false},
+
+		// "**" matches any number of directories.
+		{Glob: "**/a.txt", Name: "a.txt", Expected: true},
+		{Glob: "**/a.txt", Name: "dir/sub/a.txt", Expected: true},
+		{Glob: "dir/**", Name: "dir/sub/a.txt", Expected: true},
+		{Glob: "dir/**", Name: "other/a.txt", Expected: false},
+		{Glob: "dir/**/*.txt", Name: "dir/a.txt", Expected: true},
+		{Glob: "dir/**/*.txt", Name: "dir/sub/sub/a.txt", Expected: true},
+		{Glob: "dir/**/*.txt", Name: "dirx/a.txt", Expected: false},
+
+		// Character classes.
+		{Glob: "[ab].txt", Name: "a.txt", Expected: true},
+		{Glob: "[ab].txt", Name: "c.txt", Expected: false},
+		{Glob: "[a-c].txt", Name: "b.txt", Expected: true},
+		{Glob: "[a-c].txt", Name: "d.txt", Expected: false},
+		{Glob: "[!a-c].txt", Name: "d.txt", Expected: true},
+		{Glob: "[!a-c].txt", Name: "b.txt", Expected: false},
+		{Glob: "[^a].txt", Name: "b.txt", Expected: true},
+		{Glob: "dir[!x]a.txt", Name: "dir/a.txt", Expected: false},
+		{Glob: "[]].txt", Name: "].txt", Expected: true},
+		{Glob: `[a\-c].txt`, Name: "-.txt", Expected: true},
+		{Glob: `[a\-c].txt`, Name: "b.txt", Expected: false},
+
+		// Escaping and regular expression metacharacters.
+		{Glob: `\*.txt`, Name: "*.txt", Expected: true},
+		{Glob: `\*.txt`, Name: "a.txt", Expected: false},
+		{Glob: `\[a].txt`, Name: "[a].txt", Expected: true},
+		{Glob: `\[a].txt`, Name: "a.txt", Expected: false},
+		{Glob: "a.txt", Name: "abtxt", Expected: false},
+		{Glob: "a+(b).txt", Name: "a+(b).txt", Expected: true},
+		{Glob: "é*.txt", Name: "été.txt", Expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%s %s", testCase.Glob, testCase.Name), func(t *testing.T) {
+			glob, err := newDirectoryGlob(testCase.Glob)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := glob.match(testCase.Name); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDirectoryGlob_invalid(t *testing.T) {
+	for _, glob := range []string{
+		"[a",
+		"[!",
+		`a\`,
+		`[a\`,
+		"[c-a]",
+	} {
+		t.Run(glob, func(t *testing.T) {
+			if _, err := newDirectoryGlob(glob); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func testDirectoryMD5(s string) string {
+	sum := md5.Sum([]byte(s))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func testDirectoryMultipartETag(parts ...string) string {
+	var digests []byte
+
+	for _, part := range parts {
+		sum := md5.Sum([]byte(part))
+		digests = append(digests, sum[:]...)
+	}
+
+	sum := md5.Sum(digests)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), len(parts))
+}
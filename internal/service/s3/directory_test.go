@@ -0,0 +1,417 @@
+package s3_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3Directory_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory.test"
+	source := testAccDirectorySource(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/site.css":  "body {}",
+		"logs/test.log": "excluded",
+	})
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.site/index.html", "c83301425b2ad1d496473a5ff3d9ecca"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.site/css/site.css", "fcdce6b6d6e2175f6406869882f6f1ce"),
+					testAccCheckDirectoryObjectContentType(resourceName, "index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectoryObjectContentType(resourceName, "css/site.css", "text/css; charset=utf-8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Directory_fileRule(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory.test"
+	source := testAccDirectorySource(t, map[string]string{
+		"index.html":    "<html></html>",
+		"assets/app.js": "console.log(1);",
+	})
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_fileRule(rName, source, "max-age=3600"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					testAccCheckDirectoryObjectCacheControl(resourceName, "index.html", "no-cache"),
+					testAccCheckDirectoryObjectCacheControl(resourceName, "assets/app.js", "max-age=3600"),
+				),
+			},
+			{
+				Config: testAccDirectoryConfig_fileRule(rName, source, "max-age=86400"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					testAccCheckDirectoryObjectCacheControl(resourceName, "index.html", "no-cache"),
+					testAccCheckDirectoryObjectCacheControl(resourceName, "assets/app.js", "max-age=86400"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Directory_deleteRemoved(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory.test"
+	source1 := testAccDirectorySource(t, map[string]string{
+		"a.txt": "a",
+		"b.txt": "b",
+	})
+	source2 := testAccDirectorySource(t, map[string]string{
+		"a.txt": "a2",
+	})
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_deleteRemoved(rName, source1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.a.txt", "0cc175b9c0f1b6a831c399e269772661"),
+				),
+			},
+			{
+				Config: testAccDirectoryConfig_deleteRemoved(rName, source2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.a.txt", "693a9fdd4c2fd0700968fba0d07ff3c0"),
+					testAccCheckDirectoryObjectNotExists(resourceName, "b.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3Directory_fake(t *testing.T) {
+	bucket := "tf-fake-bucket"
+	s3Fake := fake.NewS3()
+	client := acctest.FakeClient(t, map[string]interface{}{
+		names.S3: s3Fake,
+	})
+
+	if _, err := s3Fake.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s3Fake.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String("site/stale.txt"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	source1 := testAccDirectorySource(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/site.css":  "body {}",
+		"logs/test.log": "excluded",
+	})
+	source2 := testAccDirectorySource(t, map[string]string{
+		"index.html": "<html><body></body></html>",
+	})
+
+	acctest.FakeResourceTest(t, tfs3.ResourceDirectory(), client,
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"bucket":     bucket,
+				"key_prefix": "site/",
+				"source":     source1,
+				"exclude":    []interface{}{"*.log"},
+				"file_rule": []interface{}{
+					map[string]interface{}{
+						"glob":          "**/*.css",
+						"cache_control": "max-age=3600",
+					},
+				},
+			},
+			Check: func(d *schema.ResourceData) error {
+				if got, want := len(d.Get("manifest").(map[string]interface{})), 2; got != want {
+					return fmt.Errorf("len(manifest) = %d, want %d", got, want)
+				}
+
+				output, err := s3Fake.HeadObject(&s3.HeadObjectInput{
+					Bucket: aws.String(bucket),
+					Key:    aws.String("site/css/site.css"),
+				})
+
+				if err != nil {
+					return err
+				}
+
+				if got, want := aws.StringValue(output.CacheControl), "max-age=3600"; got != want {
+					return fmt.Errorf("CacheControl = %q, want %q", got, want)
+				}
+				if got, want := aws.StringValue(output.ContentType), "text/css; charset=utf-8"; got != want {
+					return fmt.Errorf("ContentType = %q, want %q", got, want)
+				}
+
+				if _, err := s3Fake.HeadObject(&s3.HeadObjectInput{
+					Bucket: aws.String(bucket),
+					Key:    aws.String("site/stale.txt"),
+				}); err != nil {
+					return fmt.Errorf("site/stale.txt deleted without delete_removed: %w", err)
+				}
+
+				return nil
+			},
+		},
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"bucket":         bucket,
+				"key_prefix":     "site/",
+				"source":         source2,
+				"delete_removed": true,
+			},
+			Check: func(d *schema.ResourceData) error {
+				manifest := d.Get("manifest").(map[string]interface{})
+
+				if got, want := len(manifest), 1; got != want {
+					return fmt.Errorf("len(manifest) = %d, want %d", got, want)
+				}
+
+				output, err := s3Fake.ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket: aws.String(bucket),
+				})
+
+				if err != nil {
+					return err
+				}
+
+				if got, want := len(output.Contents), 1; got != want {
+					return fmt.Errorf("len(objects) = %d, want %d", got, want)
+				}
+				if got, want := aws.StringValue(output.Contents[0].Key), "site/index.html"; got != want {
+					return fmt.Errorf("object key = %q, want %q", got, want)
+				}
+
+				return nil
+			},
+		},
+	)
+
+	output, err := s3Fake.ListObjectsV2(&s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(output.Contents); got != 0 {
+		t.Errorf("len(objects) = %d after destroy, want 0", got)
+	}
+}
+
+func TestS3Directory_keyPrefixValidation(t *testing.T) {
+	validateFunc := tfs3.ResourceDirectory().Schema["key_prefix"].ValidateFunc
+
+	for _, v := range []string{"", "site/", "site/v1/", "a b/"} {
+		if _, errs := validateFunc(v, "key_prefix"); len(errs) > 0 {
+			t.Errorf("%q: unexpected errors: %v", v, errs)
+		}
+	}
+
+	for _, v := range []string{"site", "/site/", "/", "site/v1"} {
+		if _, errs := validateFunc(v, "key_prefix"); len(errs) == 0 {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+}
+
+// testAccDirectorySource creates a temporary directory containing the specified files.
+func testAccDirectorySource(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func testAccCheckDirectoryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory" {
+			continue
+		}
+
+		objects, err := tfs3.FindDirectoryObjectETags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		for key := range objects {
+			if _, ok := rs.Primary.Attributes["manifest."+key]; ok {
+				return fmt.Errorf("S3 Directory %s object (%s) still exists", rs.Primary.ID, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectoryObjectHead(n, key string, f func(*s3.HeadObjectOutput) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key_prefix"] + key),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return f(output)
+	}
+}
+
+func testAccCheckDirectoryObjectCacheControl(n, key, expected string) resource.TestCheckFunc {
+	return testAccCheckDirectoryObjectHead(n, key, func(output *s3.HeadObjectOutput) error {
+		if got := aws.StringValue(output.CacheControl); got != expected {
+			return fmt.Errorf("S3 Object (%s) CacheControl = %q, want %q", key, got, expected)
+		}
+
+		return nil
+	})
+}
+
+func testAccCheckDirectoryObjectContentType(n, key, expected string) resource.TestCheckFunc {
+	return testAccCheckDirectoryObjectHead(n, key, func(output *s3.HeadObjectOutput) error {
+		if got := aws.StringValue(output.ContentType); got != expected {
+			return fmt.Errorf("S3 Object (%s) ContentType = %q, want %q", key, got, expected)
+		}
+
+		return nil
+	})
+}
+
+func testAccCheckDirectoryObjectNotExists(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		objects, err := tfs3.FindDirectoryObjectETags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := objects[rs.Primary.Attributes["key_prefix"]+key]; ok {
+			return fmt.Errorf("S3 Object (%s) still exists", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[2]q
+  exclude    = ["*.log"]
+}
+`, rName, source)
+}
+
+func testAccDirectoryConfig_fileRule(rName, source, cacheControl string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  source = %[2]q
+
+  file_rule {
+    glob          = "**"
+    cache_control = %[3]q
+  }
+
+  file_rule {
+    glob          = "*.html"
+    cache_control = "no-cache"
+  }
+}
+`, rName, source, cacheControl)
+}
+
+func testAccDirectoryConfig_deleteRemoved(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  source         = %[2]q
+  delete_removed = true
+}
+`, rName, source)
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
 	"os"
@@ -15,6 +14,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -70,6 +70,32 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -151,6 +177,11 @@ func ResourceObject() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IsRFC3339Time,
 			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize / (1024 * 1024))),
+			},
 			"server_side_encryption": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -191,7 +222,7 @@ func resourceObjectCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3API()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -203,6 +234,10 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 		Key:    aws.String(key),
 	}
 
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.Retry(objectCreationTimeout, func() *resource.RetryError {
@@ -239,6 +274,10 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("bucket_key_enabled", resp.BucketKeyEnabled)
 	d.Set("cache_control", resp.CacheControl)
+	d.Set("checksum_crc32", resp.ChecksumCRC32)
+	d.Set("checksum_crc32c", resp.ChecksumCRC32C)
+	d.Set("checksum_sha1", resp.ChecksumSHA1)
+	d.Set("checksum_sha256", resp.ChecksumSHA256)
 	d.Set("content_disposition", resp.ContentDisposition)
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
@@ -309,7 +348,7 @@ func resourceObjectUpdate(d *schema.ResourceData, meta interface{}) error {
 		return resourceObjectUpload(d, meta)
 	}
 
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3API()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -376,7 +415,7 @@ func resourceObjectUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3API()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -419,53 +458,24 @@ func resourceObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.R
 }
 
 func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
-	uploader := s3manager.NewUploaderWithClient(conn)
+	conn := meta.(*conns.AWSClient).S3API()
+	uploader := newObjectUploader(conn, int64(d.Get("part_size").(int))*1024*1024, d.Get("concurrency").(int))
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
+	body, closeBody, err := openObjectBody(d.Get("source").(string), d.Get("content").(string), d.Get("content_base64").(string))
 
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return fmt.Errorf("Error opening S3 object source (%s): %s", path, err)
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return fmt.Errorf("error decoding content_base64: %s", err)
-		}
-		body = bytes.NewReader(contentRaw)
-	} else {
-		body = bytes.NewReader([]byte{})
+	if err != nil {
+		return err
 	}
 
+	defer closeBody()
+
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	input := &s3manager.UploadInput{
+	input := &s3.PutObjectInput{
 		ACL:    aws.String(d.Get("acl").(string)),
-		Body:   body,
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
@@ -478,6 +488,10 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 		input.CacheControl = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumAlgorithm = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("content_type"); ok {
 		input.ContentType = aws.String(v.(string))
 	}
@@ -532,7 +546,7 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	if _, err := uploader.upload(input, body); err != nil {
 		return fmt.Errorf("Error uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -541,6 +555,45 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 	return resourceObjectRead(d, meta)
 }
 
+// openObjectBody returns the object data from the first of source, content or content_base64 that is set,
+// and a function to close it.
+func openObjectBody(source, content, contentBase64 string) (objectBody, func(), error) {
+	if source != "" {
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error opening S3 object source (%s): %s", path, err)
+		}
+
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}, nil
+	}
+
+	if content != "" {
+		return bytes.NewReader([]byte(content)), func() {}, nil
+	}
+
+	if contentBase64 != "" {
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the upload requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(contentBase64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error decoding content_base64: %s", err)
+		}
+
+		return bytes.NewReader(contentRaw), func() {}, nil
+	}
+
+	return bytes.NewReader([]byte{}), func() {}, nil
+}
+
 func resourceObjectSetKMS(d *schema.ResourceData, meta interface{}, sseKMSKeyId *string) error {
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if sseKMSKeyId != nil {
@@ -573,6 +626,10 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceObjectChecksumCustomizeDiff(d); err != nil {
+		return err
+	}
+
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -585,10 +642,55 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// resourceObjectChecksumCustomizeDiff plans an upload if the checksum of the object data
+// differs from the object's checksum, e.g. because the source file or object changed.
+// The data is compared using the part size it was uploaded with, so changing only
+// part_size doesn't plan an upload.
+func resourceObjectChecksumCustomizeDiff(d *schema.ResourceDiff) error {
+	algorithm := d.Get("checksum_algorithm").(string)
+
+	if d.Id() == "" || algorithm == "" {
+		return nil
+	}
+
+	attribute := objectChecksumAttribute(algorithm)
+
+	if d.HasChange("checksum_algorithm") || !d.NewValueKnown("source") || !d.NewValueKnown("content") || !d.NewValueKnown("content_base64") {
+		return d.SetNewComputed(attribute)
+	}
+
+	source, content, contentBase64 := d.Get("source").(string), d.Get("content").(string), d.Get("content_base64").(string)
+	o, n := d.GetChange("part_size")
+	checksum, err := objectBodyChecksum(source, content, contentBase64, int64(o.(int))*1024*1024, algorithm)
+
+	if err != nil {
+		return err
+	}
+
+	if objectChecksumsEqual(d.Get(attribute).(string), checksum) {
+		return nil
+	}
+
+	if n.(int) != o.(int) {
+		checksum, err = objectBodyChecksum(source, content, contentBase64, int64(n.(int))*1024*1024, algorithm)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.SetNew(attribute, checksum)
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
+		"checksum_crc32",
+		"checksum_crc32c",
+		"checksum_sha1",
+		"checksum_sha256",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -615,7 +717,7 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
 // Returns the number of objects deleted.
-func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreObjectErrors bool) (int64, error) {
+func DeleteAllObjectVersions(conn s3iface.S3API, bucketName, key string, force, ignoreObjectErrors bool) (int64, error) {
 	var nObjects int64
 
 	input := &s3.ListObjectVersionsInput{
@@ -763,7 +865,7 @@ func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreO
 
 // deleteObjectVersion deletes a specific object version.
 // Set force to true to override any S3 object lock protections.
-func deleteObjectVersion(conn *s3.S3, b, k, v string, force bool) error {
+func deleteObjectVersion(conn s3iface.S3API, b, k, v string, force bool) error {
 	input := &s3.DeleteObjectInput{
 		Bucket: aws.String(b),
 		Key:    aws.String(k),
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,17 +20,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAccS3Object_noNameNoKey(t *testing.T) {
 	bucketError := regexp.MustCompile(`bucket must not be empty`)
 	keyError := regexp.MustCompile(`key must not be empty`)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -54,7 +57,7 @@ func TestAccS3Object_empty(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -87,7 +90,7 @@ func TestAccS3Object_source(t *testing.T) {
 	source := testAccObjectCreateTempFile(t, "{anything will do }")
 	defer os.Remove(source)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -116,7 +119,7 @@ func TestAccS3Object_content(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -141,6 +144,94 @@ func TestAccS3Object_content(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_checksumAlgorithm(t *testing.T) {
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "CRC32", "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "hello"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", "NhCmhg=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "checksum_algorithm", "checksum_crc32", "content", "content_base64", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "SHA256", "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj2),
+					testAccCheckObjectVersionIdDiffers(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_multipart(t *testing.T) {
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 12 MiB uploaded in three 5 MiB (minimum size) parts.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("a", 12*1024*1024))
+	defer os.Remove(source)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipart(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestMatchResourceAttr(resourceName, "checksum_sha256", regexp.MustCompile(`-3$`)),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+				),
+			},
+			{
+				// No drift although the ETag is not an MD5 digest of the source.
+				Config:   testAccObjectConfig_multipart(rName, source),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(source, []byte(strings.Repeat("b", 12*1024*1024)), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectConfig_multipart(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj2),
+					testAccCheckObjectVersionIdDiffers(&obj2, &obj1),
+					resource.TestMatchResourceAttr(resourceName, "checksum_sha256", regexp.MustCompile(`-3$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_etagEncryption(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_object.object"
@@ -148,7 +239,7 @@ func TestAccS3Object_etagEncryption(t *testing.T) {
 	source := testAccObjectCreateTempFile(t, "{anything will do }")
 	defer os.Remove(source)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -179,7 +270,7 @@ func TestAccS3Object_contentBase64(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -216,7 +307,7 @@ func TestAccS3Object_sourceHashTrigger(t *testing.T) {
 		return nil
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -261,7 +352,7 @@ func TestAccS3Object_withContentCharacteristics(t *testing.T) {
 	source := testAccObjectCreateTempFile(t, "{anything will do }")
 	defer os.Remove(source)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -287,7 +378,7 @@ func TestAccS3Object_nonVersioned(t *testing.T) {
 	var originalObj s3.GetObjectOutput
 	resourceName := "aws_s3_object.object"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAssumeRoleARN(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -322,7 +413,7 @@ func TestAccS3Object_updates(t *testing.T) {
 	sourceModified := testAccObjectCreateTempFile(t, "modified object")
 	defer os.Remove(sourceInitial)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -380,7 +471,7 @@ func TestAccS3Object_updateSameFile(t *testing.T) {
 		return nil
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -418,7 +509,7 @@ func TestAccS3Object_updatesWithVersioning(t *testing.T) {
 	sourceModified := testAccObjectCreateTempFile(t, "modified versioned object")
 	defer os.Remove(sourceInitial)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -463,7 +554,7 @@ func TestAccS3Object_updatesWithVersioningViaAccessPoint(t *testing.T) {
 	sourceModified := testAccObjectCreateTempFile(t, "modified versioned object")
 	defer os.Remove(sourceInitial)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -499,7 +590,7 @@ func TestAccS3Object_kms(t *testing.T) {
 	source := testAccObjectCreateTempFile(t, "{anything will do }")
 	defer os.Remove(source)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -533,7 +624,7 @@ func TestAccS3Object_sse(t *testing.T) {
 	source := testAccObjectCreateTempFile(t, "{anything will do }")
 	defer os.Remove(source)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -564,7 +655,7 @@ func TestAccS3Object_acl(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -615,7 +706,7 @@ func TestAccS3Object_metadata(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_object.object"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -662,7 +753,7 @@ func TestAccS3Object_storageClass(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -726,7 +817,7 @@ func TestAccS3Object_tags(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	key := "test-key"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -798,7 +889,7 @@ func TestAccS3Object_tagsLeadingSingleSlash(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	key := "/test-key"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -870,7 +961,7 @@ func TestAccS3Object_tagsLeadingMultipleSlashes(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	key := "/////test-key"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -935,7 +1026,7 @@ func TestAccS3Object_tagsMultipleSlashes(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	key := "first//second///third//"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -999,7 +1090,7 @@ func TestAccS3Object_objectLockLegalHoldStartWithNone(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1047,7 +1138,7 @@ func TestAccS3Object_objectLockLegalHoldStartWithOn(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1084,7 +1175,7 @@ func TestAccS3Object_objectLockRetentionStartWithNone(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	retainUntilDate := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1135,7 +1226,7 @@ func TestAccS3Object_objectLockRetentionStartWithSet(t *testing.T) {
 	retainUntilDate2 := time.Now().UTC().AddDate(0, 0, 30).Format(time.RFC3339)
 	retainUntilDate3 := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1193,7 +1284,7 @@ func TestAccS3Object_objectBucketKeyEnabled(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1216,7 +1307,7 @@ func TestAccS3Object_bucketBucketKeyEnabled(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1239,7 +1330,7 @@ func TestAccS3Object_defaultBucketSSE(t *testing.T) {
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -1263,7 +1354,7 @@ func TestAccS3Object_ignoreTags(t *testing.T) {
 	key := "test-key"
 	var providers []*schema.Provider
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories: acctest.FactoriesInternal(&providers),
@@ -1628,6 +1719,58 @@ resource "aws_s3_object" "object" {
 `, rName, content)
 }
 
+func testAccObjectConfig_checksumAlgorithm(rName, algorithm, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  depends_on = [aws_s3_bucket_versioning.test]
+
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  content            = %[3]q
+  checksum_algorithm = %[2]q
+}
+`, rName, algorithm, content)
+}
+
+func testAccObjectConfig_multipart(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  depends_on = [aws_s3_bucket_versioning.test]
+
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  checksum_algorithm = "SHA256"
+  part_size          = 5
+  concurrency        = 2
+}
+`, rName, source)
+}
+
 func testAccObjectConfig_etagEncryption(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -2117,3 +2260,155 @@ resource "aws_s3_object" "object" {
 }
 `, rName, content)
 }
+
+func TestS3Object_fake(t *testing.T) {
+	bucket := "tf-fake-bucket"
+	s3Fake := fake.NewS3()
+	client := acctest.FakeClient(t, map[string]interface{}{
+		names.S3: s3Fake,
+	})
+
+	if _, err := s3Fake.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		t.Fatal(err)
+	}
+
+	acctest.FakeResourceTest(t, tfs3.ResourceObject(), client,
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"bucket":  bucket,
+				"key":     "test-key",
+				"content": "initial object state",
+			},
+			Check: func(d *schema.ResourceData) error {
+				if got, want := d.Get("etag").(string), "647d1d58e1011c743ec67d5e8af87b53"; got != want {
+					return fmt.Errorf("etag = %q, want %q", got, want)
+				}
+				if got, want := d.Get("content_type").(string), "binary/octet-stream"; got != want {
+					return fmt.Errorf("content_type = %q, want %q", got, want)
+				}
+				return nil
+			},
+		},
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"bucket":       bucket,
+				"key":          "test-key",
+				"content":      "updated object state",
+				"content_type": "text/plain",
+				"tags": map[string]interface{}{
+					"Key1": "Value1",
+				},
+			},
+			Check: func(d *schema.ResourceData) error {
+				output, err := s3Fake.GetObject(&s3.GetObjectInput{
+					Bucket: aws.String(bucket),
+					Key:    aws.String("test-key"),
+				})
+
+				if err != nil {
+					return err
+				}
+
+				body, err := io.ReadAll(output.Body)
+
+				if err != nil {
+					return err
+				}
+
+				if got, want := string(body), "updated object state"; got != want {
+					return fmt.Errorf("body = %q, want %q", got, want)
+				}
+				if got, want := d.Get("tags_all.Key1").(string), "Value1"; got != want {
+					return fmt.Errorf("tags_all.Key1 = %q, want %q", got, want)
+				}
+				return nil
+			},
+		},
+	)
+
+	output, err := s3Fake.ListObjectsV2(&s3.ListObjectsV2Input{Bucket: aws.String(bucket)})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(output.Contents); n != 0 {
+		t.Errorf("%d objects remain after destroy", n)
+	}
+}
+
+func TestS3Object_fakeMultipart(t *testing.T) {
+	bucket := "tf-fake-bucket"
+	s3Fake := fake.NewS3()
+	client := acctest.FakeClient(t, map[string]interface{}{
+		names.S3: s3Fake,
+	})
+
+	if _, err := s3Fake.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 12 MiB uploaded in three 5 MiB (minimum size) parts.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("a", 12*1024*1024))
+	defer os.Remove(source)
+
+	config := map[string]interface{}{
+		"bucket":             bucket,
+		"key":                "test-key",
+		"source":             source,
+		"checksum_algorithm": s3.ChecksumAlgorithmSha256,
+		"part_size":          5,
+		"concurrency":        2,
+	}
+
+	acctest.FakeResourceTest(t, tfs3.ResourceObject(), client,
+		acctest.FakeResourceStep{
+			Config: config,
+			Check: func(d *schema.ResourceData) error {
+				if got := d.Get("etag").(string); !strings.HasSuffix(got, "-3") {
+					return fmt.Errorf("etag = %q, want multipart ETag", got)
+				}
+				if got := d.Get("checksum_sha256").(string); !strings.HasSuffix(got, "-3") {
+					return fmt.Errorf("checksum_sha256 = %q, want multipart checksum", got)
+				}
+
+				// Change the source without changing its path; the next step must detect it from the checksum.
+				return os.WriteFile(source, []byte(strings.Repeat("b", 12*1024*1024)), 0644)
+			},
+		},
+		acctest.FakeResourceStep{
+			Config: config,
+			Check: func(d *schema.ResourceData) error {
+				output, err := s3Fake.GetObject(&s3.GetObjectInput{
+					Bucket: aws.String(bucket),
+					Key:    aws.String("test-key"),
+				})
+
+				if err != nil {
+					return err
+				}
+
+				body, err := io.ReadAll(output.Body)
+
+				if err != nil {
+					return err
+				}
+
+				if got, want := string(body), strings.Repeat("b", 12*1024*1024); got != want {
+					return fmt.Errorf("body not updated from source")
+				}
+				return nil
+			},
+		},
+	)
+
+	output, err := s3Fake.ListMultipartUploads(&s3.ListMultipartUploadsInput{Bucket: aws.String(bucket)})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(output.Uploads); n != 0 {
+		t.Errorf("%d multipart uploads remain", n)
+	}
+}
@@ -0,0 +1,515 @@
+package s3
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Objects larger than the part size are uploaded in parts.
+	objectDefaultPartSize    = s3manager.DefaultUploadPartSize
+	objectDefaultConcurrency = s3manager.DefaultUploadConcurrency
+)
+
+type objectBody interface {
+	io.ReaderAt
+	io.ReadSeeker
+}
+
+type objectUploadOutput struct {
+	ETag      string
+	VersionID string
+	// Checksum of the object data computed using the upload's checksum algorithm, if any.
+	Checksum string
+}
+
+// objectUploader uploads object data in a single PutObject request, or in parts if larger than the part size.
+// Unlike the s3manager Uploader, it computes the checksums of the data and parts for the input's ChecksumAlgorithm.
+type objectUploader struct {
+	conn        s3iface.S3API
+	partSize    int64
+	concurrency int
+}
+
+func newObjectUploader(conn s3iface.S3API, partSize int64, concurrency int) *objectUploader {
+	if partSize == 0 {
+		partSize = objectDefaultPartSize
+	}
+
+	if concurrency == 0 {
+		concurrency = objectDefaultConcurrency
+	}
+
+	return &objectUploader{
+		conn:        conn,
+		partSize:    partSize,
+		concurrency: concurrency,
+	}
+}
+
+func (u *objectUploader) upload(input *s3.PutObjectInput, body objectBody) (*objectUploadOutput, error) {
+	size, err := body.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if size <= u.partSize {
+		return u.uploadSingle(input, body)
+	}
+
+	return u.uploadMultipart(input, body, size)
+}
+
+func (u *objectUploader) uploadSingle(input *s3.PutObjectInput, body objectBody) (*objectUploadOutput, error) {
+	algorithm := aws.StringValue(input.ChecksumAlgorithm)
+	var checksum string
+
+	if algorithm != "" {
+		var err error
+
+		if checksum, err = objectPartChecksum(body, algorithm); err != nil {
+			return nil, err
+		}
+
+		setPutObjectInputChecksum(input, algorithm, checksum)
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	input.Body = body
+
+	output, err := u.conn.PutObject(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &objectUploadOutput{
+		ETag:      aws.StringValue(output.ETag),
+		VersionID: aws.StringValue(output.VersionId),
+		Checksum:  checksum,
+	}, nil
+}
+
+// uploadMultipart uploads the data in parts, in parallel. The upload is aborted on error.
+func (u *objectUploader) uploadMultipart(input *s3.PutObjectInput, body objectBody, size int64) (*objectUploadOutput, error) {
+	algorithm := aws.StringValue(input.ChecksumAlgorithm)
+	partSize := objectPartSize(size, u.partSize)
+	nParts := int((size + partSize - 1) / partSize)
+
+	createInput := &s3.CreateMultipartUploadInput{}
+	awsutil.Copy(createInput, input)
+
+	log.Printf("[DEBUG] Creating S3 Object (%s) multipart upload: %d parts", aws.StringValue(input.Key), nParts)
+	createOutput, err := u.conn.CreateMultipartUpload(createInput)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating multipart upload: %w", err)
+	}
+
+	uploadID := createOutput.UploadId
+	parts := make([]*s3.CompletedPart, nParts)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs *multierror.Error
+	queue := make(chan int)
+
+	for i := 0; i < u.concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for n := range queue {
+				offset := int64(n) * partSize
+				part, err := u.uploadPart(input, uploadID, int64(n+1), io.NewSectionReader(body, offset, minInt64(partSize, size-offset)))
+
+				mu.Lock()
+				if err != nil {
+					errs = multierror.Append(errs, err)
+				} else {
+					parts[n] = part
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for n := 0; n < nParts; n++ {
+		mu.Lock()
+		failed := errs.ErrorOrNil() != nil
+		mu.Unlock()
+
+		if failed {
+			break
+		}
+
+		queue <- n
+	}
+
+	close(queue)
+	wg.Wait()
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, u.abort(input, uploadID, err)
+	}
+
+	completeInput := &s3.CompleteMultipartUploadInput{
+		Bucket:              input.Bucket,
+		ExpectedBucketOwner: input.ExpectedBucketOwner,
+		Key:                 input.Key,
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		RequestPayer: input.RequestPayer,
+		UploadId:     uploadID,
+	}
+
+	completeOutput, err := u.conn.CompleteMultipartUpload(completeInput)
+
+	if err != nil {
+		return nil, u.abort(input, uploadID, fmt.Errorf("completing multipart upload: %w", err))
+	}
+
+	output := &objectUploadOutput{
+		ETag:      aws.StringValue(completeOutput.ETag),
+		VersionID: aws.StringValue(completeOutput.VersionId),
+	}
+
+	if algorithm != "" {
+		checksums := make([]string, 0, len(parts))
+
+		for _, part := range parts {
+			checksums = append(checksums, completedPartChecksum(part, algorithm))
+		}
+
+		if output.Checksum, err = objectChecksumOfChecksums(checksums, algorithm); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func (u *objectUploader) uploadPart(input *s3.PutObjectInput, uploadID *string, partNumber int64, body io.ReadSeeker) (*s3.CompletedPart, error) {
+	partInput := &s3.UploadPartInput{
+		Body:                 body,
+		Bucket:               input.Bucket,
+		ExpectedBucketOwner:  input.ExpectedBucketOwner,
+		Key:                  input.Key,
+		PartNumber:           aws.Int64(partNumber),
+		RequestPayer:         input.RequestPayer,
+		SSECustomerAlgorithm: input.SSECustomerAlgorithm,
+		SSECustomerKey:       input.SSECustomerKey,
+		SSECustomerKeyMD5:    input.SSECustomerKeyMD5,
+		UploadId:             uploadID,
+	}
+	part := &s3.CompletedPart{
+		PartNumber: aws.Int64(partNumber),
+	}
+
+	if algorithm := aws.StringValue(input.ChecksumAlgorithm); algorithm != "" {
+		checksum, err := objectPartChecksum(body, algorithm)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+
+		partInput.ChecksumAlgorithm = aws.String(algorithm)
+		setUploadPartInputChecksum(partInput, algorithm, checksum)
+		setCompletedPartChecksum(part, algorithm, checksum)
+	}
+
+	output, err := u.conn.UploadPart(partInput)
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading part %d: %w", partNumber, err)
+	}
+
+	part.ETag = output.ETag
+
+	return part, nil
+}
+
+// abort aborts the multipart upload so that its parts are not retained (and billed).
+func (u *objectUploader) abort(input *s3.PutObjectInput, uploadID *string, err error) error {
+	log.Printf("[DEBUG] Aborting S3 Object (%s) multipart upload (%s)", aws.StringValue(input.Key), aws.StringValue(uploadID))
+	_, abortErr := u.conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:              input.Bucket,
+		ExpectedBucketOwner: input.ExpectedBucketOwner,
+		Key:                 input.Key,
+		RequestPayer:        input.RequestPayer,
+		UploadId:            uploadID,
+	})
+
+	if abortErr != nil {
+		return multierror.Append(err, fmt.Errorf("aborting multipart upload (%s): %w", aws.StringValue(uploadID), abortErr))
+	}
+
+	return err
+}
+
+// objectPartSize returns the part size used to upload data of the specified size,
+// increased if required to stay within the maximum number of parts.
+func objectPartSize(size, partSize int64) int64 {
+	if size/partSize >= s3manager.MaxUploadParts {
+		partSize = (size / s3manager.MaxUploadParts) + 1
+	}
+
+	return partSize
+}
+
+// objectChecksum returns the checksum S3 computes for data uploaded with the specified part size.
+// The checksum of data uploaded in parts is the checksum of the parts' checksums, followed by the number of parts.
+func objectChecksum(body objectBody, partSize int64, algorithm string) (string, error) {
+	size, err := body.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return "", err
+	}
+
+	if partSize == 0 {
+		partSize = objectDefaultPartSize
+	}
+
+	if size <= partSize {
+		return objectPartChecksum(io.NewSectionReader(body, 0, size), algorithm)
+	}
+
+	partSize = objectPartSize(size, partSize)
+	var checksums []string
+
+	for offset := int64(0); offset < size; offset += partSize {
+		checksum, err := objectPartChecksum(io.NewSectionReader(body, offset, minInt64(partSize, size-offset)), algorithm)
+
+		if err != nil {
+			return "", err
+		}
+
+		checksums = append(checksums, checksum)
+	}
+
+	return objectChecksumOfChecksums(checksums, algorithm)
+}
+
+type objectChecksumCacheKey struct {
+	path      string
+	size      int64
+	modTime   time.Time
+	partSize  int64
+	algorithm string
+}
+
+// objectChecksumCache holds the checksums of source files, so that a file is only read
+// once per plan unless its size or modification time changes.
+var objectChecksumCache = struct {
+	sync.Mutex
+	checksums map[objectChecksumCacheKey]string
+}{
+	checksums: make(map[objectChecksumCacheKey]string),
+}
+
+// objectBodyChecksum returns the checksum of the object data from source, content or content_base64
+// uploaded with the specified part size.
+func objectBodyChecksum(source, content, contentBase64 string, partSize int64, algorithm string) (string, error) {
+	var key *objectChecksumCacheKey
+
+	if source != "" {
+		if path, err := homedir.Expand(source); err == nil {
+			if info, err := os.Stat(path); err == nil {
+				key = &objectChecksumCacheKey{
+					path:      path,
+					size:      info.Size(),
+					modTime:   info.ModTime(),
+					partSize:  partSize,
+					algorithm: algorithm,
+				}
+
+				objectChecksumCache.Lock()
+				checksum, ok := objectChecksumCache.checksums[*key]
+				objectChecksumCache.Unlock()
+
+				if ok {
+					return checksum, nil
+				}
+			}
+		}
+	}
+
+	body, closeBody, err := openObjectBody(source, content, contentBase64)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer closeBody()
+
+	checksum, err := objectChecksum(body, partSize, algorithm)
+
+	if err != nil {
+		return "", fmt.Errorf("computing S3 object %s checksum: %w", algorithm, err)
+	}
+
+	if key != nil {
+		objectChecksumCache.Lock()
+		objectChecksumCache.checksums[*key] = checksum
+		objectChecksumCache.Unlock()
+	}
+
+	return checksum, nil
+}
+
+func objectPartChecksum(r io.Reader, algorithm string) (string, error) {
+	h, err := newChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+func objectChecksumOfChecksums(checksums []string, algorithm string) (string, error) {
+	h, err := newChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, checksum := range checksums {
+		b, err := base64.StdEncoding.DecodeString(checksum)
+
+		if err != nil {
+			return "", err
+		}
+
+		h.Write(b)
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), len(checksums)), nil
+}
+
+// objectChecksumsEqual returns whether two object checksums are equal, ignoring any number of parts.
+func objectChecksumsEqual(a, b string) bool {
+	trim := func(s string) string {
+		if i := strings.LastIndex(s, "-"); i >= 0 {
+			return s[:i]
+		}
+
+		return s
+	}
+
+	return trim(a) == trim(b)
+}
+
+func newChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+}
+
+// objectChecksumAttribute returns the name of the aws_s3_object attribute holding checksums of the specified algorithm.
+func objectChecksumAttribute(algorithm string) string {
+	return "checksum_" + strings.ToLower(algorithm)
+}
+
+func setPutObjectInputChecksum(input *s3.PutObjectInput, algorithm, checksum string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
+func setUploadPartInputChecksum(input *s3.UploadPartInput, algorithm, checksum string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
+func setCompletedPartChecksum(part *s3.CompletedPart, algorithm, checksum string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		part.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		part.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		part.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		part.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
+func completedPartChecksum(part *s3.CompletedPart, algorithm string) string {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return aws.StringValue(part.ChecksumCRC32)
+	case s3.ChecksumAlgorithmCrc32c:
+		return aws.StringValue(part.ChecksumCRC32C)
+	case s3.ChecksumAlgorithmSha1:
+		return aws.StringValue(part.ChecksumSHA1)
+	case s3.ChecksumAlgorithmSha256:
+		return aws.StringValue(part.ChecksumSHA256)
+	}
+
+	return ""
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,220 @@
+package s3
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestObjectChecksum(t *testing.T) {
+	const mib = 1024 * 1024
+	data := bytes.Repeat([]byte("0123456789abcdef"), 12*mib/16)
+
+	testCases := []struct {
+		Name      string
+		Data      []byte
+		PartSize  int64
+		Algorithm string
+		Expected  string
+	}{
+		{
+			Name:      "empty CRC32",
+			Data:      []byte{},
+			Algorithm: s3.ChecksumAlgorithmCrc32,
+			Expected:  "AAAAAA==",
+		},
+		{
+			Name:      "single part CRC32",
+			Data:      []byte("hello"),
+			Algorithm: s3.ChecksumAlgorithmCrc32,
+			Expected:  "NhCmhg==",
+		},
+		{
+			Name:      "single part CRC32C",
+			Data:      []byte("hello"),
+			Algorithm: s3.ChecksumAlgorithmCrc32c,
+			Expected:  "mnG7TA==",
+		},
+		{
+			Name:      "single part SHA1",
+			Data:      []byte("hello"),
+			Algorithm: s3.ChecksumAlgorithmSha1,
+			Expected:  "qvTGHdzF6KLavt4PO0gs2a6pQ00=",
+		},
+		{
+			Name:      "single part SHA256",
+			Data:      []byte("hello"),
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			Expected:  "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=",
+		},
+		{
+			Name:      "multipart",
+			Data:      data,
+			PartSize:  5 * mib,
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			Expected:  testObjectChecksumOfParts(t, data, 5*mib, s3.ChecksumAlgorithmSha256),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := objectChecksum(bytes.NewReader(testCase.Data), testCase.PartSize, testCase.Algorithm)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestObjectChecksum_multipartSuffix(t *testing.T) {
+	data := make([]byte, 11*1024*1024)
+
+	got, err := objectChecksum(bytes.NewReader(data), 5*1024*1024, s3.ChecksumAlgorithmCrc32)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "-3"; !bytes.HasSuffix([]byte(got), []byte(expected)) {
+		t.Errorf("got %s, expected suffix %s", got, expected)
+	}
+}
+
+func TestObjectChecksum_unsupportedAlgorithm(t *testing.T) {
+	if _, err := objectChecksum(bytes.NewReader([]byte("hello")), 0, "MD5"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestObjectBodyChecksum_cache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "source")
+	modTime := time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	write := func(data string, modTime time.Time) {
+		if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	checksum := func() string {
+		got, err := objectBodyChecksum(path, "", "", 0, s3.ChecksumAlgorithmCrc32)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return got
+	}
+
+	write("hello", modTime)
+
+	if got, expected := checksum(), "NhCmhg=="; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	// Same size and modification time: the file isn't read again.
+	write("world", modTime)
+
+	if got, expected := checksum(), "NhCmhg=="; got != expected {
+		t.Errorf("got %s, expected cached %s", got, expected)
+	}
+
+	write("world", modTime.Add(time.Second))
+
+	if got, unexpected := checksum(), "NhCmhg=="; got == unexpected {
+		t.Errorf("got cached %s after modification", got)
+	}
+}
+
+func TestObjectChecksumsEqual(t *testing.T) {
+	testCases := []struct {
+		A, B     string
+		Expected bool
+	}{
+		{"NhCmhg==", "NhCmhg==", true},
+		{"NhCmhg==-3", "NhCmhg==", true},
+		{"NhCmhg==-3", "NhCmhg==-3", true},
+		{"NhCmhg==", "mnG7TA==", false},
+		{"NhCmhg==-3", "mnG7TA==-3", false},
+		{"", "NhCmhg==", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := objectChecksumsEqual(testCase.A, testCase.B); got != testCase.Expected {
+			t.Errorf("objectChecksumsEqual(%q, %q) = %t, expected %t", testCase.A, testCase.B, got, testCase.Expected)
+		}
+	}
+}
+
+func TestObjectPartSize(t *testing.T) {
+	const mib = 1024 * 1024
+
+	testCases := []struct {
+		Name     string
+		Size     int64
+		PartSize int64
+		Expected int64
+	}{
+		{
+			Name:     "within max parts",
+			Size:     100 * mib,
+			PartSize: 5 * mib,
+			Expected: 5 * mib,
+		},
+		{
+			Name:     "exceeds max parts",
+			Size:     100000 * mib,
+			PartSize: 5 * mib,
+			Expected: 100000*mib/10000 + 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := objectPartSize(testCase.Size, testCase.PartSize); got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+// testObjectChecksumOfParts computes the checksum of checksums of fixed-size parts independently of objectChecksum.
+func testObjectChecksumOfParts(t *testing.T, data []byte, partSize int, algorithm string) string {
+	var checksums []string
+
+	for offset := 0; offset < len(data); offset += partSize {
+		end := offset + partSize
+		if end > len(data) {
+			end = len(data)
+		}
+
+		checksum, err := objectPartChecksum(bytes.NewReader(data[offset:end]), algorithm)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		checksums = append(checksums, checksum)
+	}
+
+	checksum, err := objectChecksumOfChecksums(checksums, algorithm)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return checksum
+}
@@ -14,7 +14,7 @@ import (
 func TestAccS3ObjectsDataSource_basic(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -40,7 +40,7 @@ func TestAccS3ObjectsDataSource_basic(t *testing.T) {
 func TestAccS3ObjectsDataSource_basicViaAccessPoint(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -66,7 +66,7 @@ func TestAccS3ObjectsDataSource_basicViaAccessPoint(t *testing.T) {
 func TestAccS3ObjectsDataSource_all(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -97,7 +97,7 @@ func TestAccS3ObjectsDataSource_all(t *testing.T) {
 func TestAccS3ObjectsDataSource_prefixes(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -127,7 +127,7 @@ func TestAccS3ObjectsDataSource_prefixes(t *testing.T) {
 func TestAccS3ObjectsDataSource_encoded(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -153,7 +153,7 @@ func TestAccS3ObjectsDataSource_encoded(t *testing.T) {
 func TestAccS3ObjectsDataSource_maxKeys(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -179,7 +179,7 @@ func TestAccS3ObjectsDataSource_maxKeys(t *testing.T) {
 func TestAccS3ObjectsDataSource_startAfter(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -204,7 +204,7 @@ func TestAccS3ObjectsDataSource_startAfter(t *testing.T) {
 func TestAccS3ObjectsDataSource_fetchOwner(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
 		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
 		ProviderFactories:         acctest.ProviderFactories,
@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package s3_test
+
+import (
+	"testing"
+)
+
+func TestAccS3Bucket_TagsConsistency(t *testing.T) {
+	t.Skip("aws_s3_bucket has no testAccBucketConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccS3BucketObject_TagsConsistency(t *testing.T) {
+	t.Skip("aws_s3_bucket_object has no testAccBucketObjectConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccS3Object_TagsConsistency(t *testing.T) {
+	t.Skip("aws_s3_object has no testAccObjectConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccS3ObjectCopy_TagsConsistency(t *testing.T) {
+	t.Skip("aws_s3_object_copy has no testAccObjectCopyConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
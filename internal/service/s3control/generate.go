@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -TagType=S3Tag
+//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -TagType=S3Tag -TagType2=StorageLensTag
+//go:generate go run ../../generate/tagstests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package s3control
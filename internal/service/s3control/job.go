@@ -0,0 +1,1884 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceJobCreate,
+		Read:   resourceJobRead,
+		Update: resourceJobUpdate,
+		Delete: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirm": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(s3control.JobManifestFieldName_Values(), false),
+										},
+									},
+									"format": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.JobManifestFormat_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"glacier_job_tier": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3GlacierJobTier_Values(), false),
+									},
+								},
+							},
+						},
+						"s3_put_object_acl": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_policy": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_control_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"grant": jobGrantsSchema(),
+															"owner": {
+																Type:     schema.TypeList,
+																Required: true,
+																ForceNew: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"display_name": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"id": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"canned_access_control_list": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(s3control.S3CannedAccessControlList_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_grant": jobGrantsSchema(),
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3CannedAccessControlList_Values(), false),
+									},
+									"checksum_algorithm": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ChecksumAlgorithm_Values(), false),
+									},
+									"metadata_directive": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3MetadataDirective_Values(), false),
+									},
+									"new_object_metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cache_control": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_disposition": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_encoding": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_language": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"sse_algorithm": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(s3control.S3SSEAlgorithm_Values(), false),
+												},
+												"user_metadata": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"object_lock_legal_hold_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ObjectLockLegalHoldStatus_Values(), false),
+									},
+									"object_lock_mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ObjectLockMode_Values(), false),
+									},
+									"object_lock_retain_until_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3StorageClass_Values(), false),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_put_object_legal_hold": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ObjectLockLegalHoldStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"s3_put_object_retention": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bypass_governance_retention": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ObjectLockRetentionMode_Values(), false),
+									},
+									"retain_until_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportFormat_Values(), false),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportScope_Values(), false),
+						},
+					},
+				},
+			},
+			"report_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+var jobOperationKeys = []string{
+	"operation.0.lambda_invoke",
+	"operation.0.s3_initiate_restore_object",
+	"operation.0.s3_put_object_acl",
+	"operation.0.s3_put_object_copy",
+	"operation.0.s3_put_object_legal_hold",
+	"operation.0.s3_put_object_retention",
+	"operation.0.s3_put_object_tagging",
+}
+
+func jobGrantsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"grantee": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"display_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"identifier": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"type_identifier": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(s3control.S3GranteeTypeIdentifier_Values(), false),
+							},
+						},
+					},
+				},
+				"permission": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(s3control.S3Permission_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(resource.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Operation = expandJobOperation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating S3 Batch Operations Job: %s", input)
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(propagationTimeout, func() (interface{}, error) {
+		return conn.CreateJob(input)
+	}, s3control.ErrCodeBadRequestException, "not authorized to assume the role")
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Batch Operations Job: %w", err)
+	}
+
+	d.SetId(JobCreateResourceID(accountID, aws.StringValue(outputRaw.(*s3control.CreateJobOutput).JobId)))
+
+	if err := jobConfirmAndWait(conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceJobRead(d, meta)
+}
+
+func resourceJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	job, err := FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	d.Set("description", job.Description)
+	if err := d.Set("failure_reasons", flattenJobFailures(job.FailureReasons)); err != nil {
+		return fmt.Errorf("error setting failure_reasons: %w", err)
+	}
+	d.Set("job_id", jobID)
+	if job.Manifest != nil {
+		if err := d.Set("manifest", []interface{}{flattenJobManifest(job.Manifest)}); err != nil {
+			return fmt.Errorf("error setting manifest: %w", err)
+		}
+	} else {
+		d.Set("manifest", nil)
+	}
+	if job.Operation != nil {
+		if err := d.Set("operation", []interface{}{flattenJobOperation(job.Operation)}); err != nil {
+			return fmt.Errorf("error setting operation: %w", err)
+		}
+	} else {
+		d.Set("operation", nil)
+	}
+	d.Set("priority", job.Priority)
+	if job.ProgressSummary != nil {
+		if err := d.Set("progress_summary", []interface{}{flattenJobProgressSummary(job.ProgressSummary)}); err != nil {
+			return fmt.Errorf("error setting progress_summary: %w", err)
+		}
+	} else {
+		d.Set("progress_summary", nil)
+	}
+	if job.Report != nil {
+		if err := d.Set("report", []interface{}{flattenJobReport(job.Report)}); err != nil {
+			return fmt.Errorf("error setting report: %w", err)
+		}
+	} else {
+		d.Set("report", nil)
+	}
+	d.Set("report_location", jobReportLocation(job.Report, jobID))
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+
+	tags, err := jobListTags(conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating S3 Batch Operations Job priority: %s", input)
+		_, err := conn.UpdateJobPriority(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating S3 Batch Operations Job (%s) priority: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := jobUpdateTags(conn, accountID, jobID, o, n); err != nil {
+			return fmt.Errorf("error updating S3 Batch Operations Job (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("confirm", "wait_for_completion") {
+		if err := jobConfirmAndWait(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceJobRead(d, meta)
+}
+
+func resourceJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	job, err := FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	// Jobs cannot be deleted. They are retained for 90 days after they finish.
+	if jobStatusIsTerminal(aws.StringValue(job.Status)) {
+		log.Printf("[DEBUG] S3 Batch Operations Job (%s) has finished (%s), removing from state", d.Id(), aws.StringValue(job.Status))
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	_, err = conn.UpdateJobStatus(&s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusCancelled),
+		StatusUpdateReason: aws.String("Cancelled by Terraform"),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	// The job finished or is already being cancelled.
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeJobStatusException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitJobFinished(conn, accountID, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) cancel: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// jobConfirmAndWait confirms the job if it is awaiting confirmation and `confirm` is set,
+// then waits for the job to complete if `wait_for_completion` is set.
+func jobConfirmAndWait(conn *s3control.S3Control, d *schema.ResourceData, timeout time.Duration) error {
+	confirm, waitForCompletion := d.Get("confirm").(bool), d.Get("wait_for_completion").(bool)
+
+	if !confirm && !waitForCompletion {
+		return nil
+	}
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// A job can only be confirmed once it has been prepared.
+	job, err := waitJobPrepared(conn, accountID, jobID, timeout)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) prepare: %w", d.Id(), err)
+	}
+
+	if confirm && aws.BoolValue(job.ConfirmationRequired) && aws.StringValue(job.Status) == s3control.JobStatusSuspended {
+		log.Printf("[DEBUG] Confirming S3 Batch Operations Job: %s", d.Id())
+		_, err := conn.UpdateJobStatus(&s3control.UpdateJobStatusInput{
+			AccountId:          aws.String(accountID),
+			JobId:              aws.String(jobID),
+			RequestedJobStatus: aws.String(s3control.RequestedJobStatusReady),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error confirming S3 Batch Operations Job (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitJobConfirmed(conn, accountID, jobID, timeout); err != nil {
+			return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) confirm: %w", d.Id(), err)
+		}
+	}
+
+	if waitForCompletion {
+		if _, err := waitJobComplete(conn, accountID, jobID, timeout); err != nil {
+			return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) complete: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func jobStatusIsTerminal(status string) bool {
+	switch status {
+	case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+		return true
+	}
+
+	return false
+}
+
+// jobReportLocation returns the S3 URI of the folder to which the job's completion report is written.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/batch-ops-job-status.html#batch-ops-completion-report.
+func jobReportLocation(report *s3control.JobReport, jobID string) string {
+	if report == nil || !aws.BoolValue(report.Enabled) || report.Bucket == nil {
+		return ""
+	}
+
+	bucketARN, err := arn.Parse(aws.StringValue(report.Bucket))
+
+	if err != nil {
+		return ""
+	}
+
+	key := fmt.Sprintf("job-%s/", jobID)
+
+	if prefix := strings.Trim(aws.StringValue(report.Prefix), "/"); prefix != "" {
+		key = prefix + "/" + key
+	}
+
+	return fmt.Sprintf("s3://%s/%s", bucketARN.Resource, key)
+}
+
+const jobResourceIDSeparator = ":"
+
+func JobCreateResourceID(accountID, jobID string) string {
+	parts := []string{accountID, jobID}
+	id := strings.Join(parts, jobResourceIDSeparator)
+
+	return id
+}
+
+func JobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, jobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sjob-id", id, jobResourceIDSeparator)
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *s3control.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifest{}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Location = expandJobManifestLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Spec = expandJobManifestSpec(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandJobManifestLocation(tfMap map[string]interface{}) *s3control.JobManifestLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifestLocation{}
+
+	if v, ok := tfMap["etag"].(string); ok && v != "" {
+		apiObject.ETag = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+		apiObject.ObjectArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+		apiObject.ObjectVersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestSpec(tfMap map[string]interface{}) *s3control.JobManifestSpec {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifestSpec{}
+
+	if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Fields = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(tfMap map[string]interface{}) *s3control.JobOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LambdaInvoke = expandLambdaInvokeOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 {
+		// All arguments are optional, so the block may be empty.
+		tfMap, _ := v[0].(map[string]interface{})
+		apiObject.S3InitiateRestoreObject = expandS3InitiateRestoreObjectOperation(tfMap)
+	}
+
+	if v, ok := tfMap["s3_put_object_acl"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectAcl = expandS3SetObjectAclOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_legal_hold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectLegalHold = expandS3SetObjectLegalHoldOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_retention"].([]interface{}); ok && len(v) > 0 {
+		// All arguments are optional, so the block may be empty.
+		tfMap, _ := v[0].(map[string]interface{})
+		apiObject.S3PutObjectRetention = expandS3SetObjectRetentionOperation(tfMap)
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectTagging = expandS3SetObjectTaggingOperation(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandLambdaInvokeOperation(tfMap map[string]interface{}) *s3control.LambdaInvokeOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.LambdaInvokeOperation{}
+
+	if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3InitiateRestoreObjectOperation(tfMap map[string]interface{}) *s3control.S3InitiateRestoreObjectOperation {
+	apiObject := &s3control.S3InitiateRestoreObjectOperation{}
+
+	if tfMap == nil {
+		return apiObject
+	}
+
+	if v, ok := tfMap["expiration_in_days"].(int); ok && v != 0 {
+		apiObject.ExpirationInDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+		apiObject.GlacierJobTier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectAclOperation(tfMap map[string]interface{}) *s3control.S3SetObjectAclOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3SetObjectAclOperation{}
+
+	if v, ok := tfMap["access_control_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AccessControlPolicy = expandS3AccessControlPolicy(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3AccessControlPolicy(tfMap map[string]interface{}) *s3control.S3AccessControlPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3AccessControlPolicy{}
+
+	if v, ok := tfMap["access_control_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AccessControlList = expandS3AccessControlList(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3AccessControlList(tfMap map[string]interface{}) *s3control.S3AccessControlList {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3AccessControlList{}
+
+	if v, ok := tfMap["grant"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Grants = expandS3Grants(v.List())
+	}
+
+	if v, ok := tfMap["owner"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Owner = expandS3ObjectOwner(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3ObjectOwner(tfMap map[string]interface{}) *s3control.S3ObjectOwner {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3ObjectOwner{}
+
+	if v, ok := tfMap["display_name"].(string); ok && v != "" {
+		apiObject.DisplayName = aws.String(v)
+	}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.ID = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Grant(tfMap map[string]interface{}) *s3control.S3Grant {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3Grant{}
+
+	if v, ok := tfMap["grantee"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Grantee = expandS3Grantee(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["permission"].(string); ok && v != "" {
+		apiObject.Permission = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Grants(tfList []interface{}) []*s3control.S3Grant {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*s3control.S3Grant
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandS3Grant(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3Grantee(tfMap map[string]interface{}) *s3control.S3Grantee {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3Grantee{}
+
+	if v, ok := tfMap["display_name"].(string); ok && v != "" {
+		apiObject.DisplayName = aws.String(v)
+	}
+
+	if v, ok := tfMap["identifier"].(string); ok && v != "" {
+		apiObject.Identifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["type_identifier"].(string); ok && v != "" {
+		apiObject.TypeIdentifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3CopyObjectOperation(tfMap map[string]interface{}) *s3control.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["access_control_grant"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AccessControlGrants = expandS3Grants(v.List())
+	}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok && v {
+		apiObject.BucketKeyEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = aws.String(v)
+	}
+
+	if v, ok := tfMap["checksum_algorithm"].(string); ok && v != "" {
+		apiObject.ChecksumAlgorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = aws.String(v)
+	}
+
+	if v, ok := tfMap["new_object_metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NewObjectMetadata = expandS3ObjectMetadata(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.NewObjectTagging = Tags(tftags.New(v))
+	}
+
+	if v, ok := tfMap["object_lock_legal_hold_status"].(string); ok && v != "" {
+		apiObject.ObjectLockLegalHoldStatus = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_lock_mode"].(string); ok && v != "" {
+		apiObject.ObjectLockMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_lock_retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.ObjectLockRetainUntilDate = aws.Time(v)
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ObjectMetadata(tfMap map[string]interface{}) *s3control.S3ObjectMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3ObjectMetadata{}
+
+	if v, ok := tfMap["cache_control"].(string); ok && v != "" {
+		apiObject.CacheControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_disposition"].(string); ok && v != "" {
+		apiObject.ContentDisposition = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_encoding"].(string); ok && v != "" {
+		apiObject.ContentEncoding = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_language"].(string); ok && v != "" {
+		apiObject.ContentLanguage = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_type"].(string); ok && v != "" {
+		apiObject.ContentType = aws.String(v)
+	}
+
+	if v, ok := tfMap["sse_algorithm"].(string); ok && v != "" {
+		apiObject.SSEAlgorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_metadata"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserMetadata = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectLegalHoldOperation(tfMap map[string]interface{}) *s3control.S3SetObjectLegalHoldOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3SetObjectLegalHoldOperation{}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.LegalHold = &s3control.S3ObjectLockLegalHold{
+			Status: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectRetentionOperation(tfMap map[string]interface{}) *s3control.S3SetObjectRetentionOperation {
+	apiObject := &s3control.S3SetObjectRetentionOperation{
+		Retention: &s3control.S3Retention{},
+	}
+
+	if tfMap == nil {
+		return apiObject
+	}
+
+	if v, ok := tfMap["bypass_governance_retention"].(bool); ok && v {
+		apiObject.BypassGovernanceRetention = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Retention.Mode = aws.String(v)
+	}
+
+	if v, ok := tfMap["retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.Retention.RetainUntilDate = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectTaggingOperation(tfMap map[string]interface{}) *s3control.S3SetObjectTaggingOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3SetObjectTaggingOperation{}
+
+	if v, ok := tfMap["tag_set"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagSet = Tags(tftags.New(v))
+	}
+
+	return apiObject
+}
+
+func expandJobReport(tfMap map[string]interface{}) *s3control.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobReport{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobFailure(apiObject *s3control.JobFailure) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FailureCode; v != nil {
+		tfMap["failure_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FailureReason; v != nil {
+		tfMap["failure_reason"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenJobFailures(apiObjects []*s3control.JobFailure) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenJobFailure(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenJobManifest(apiObject *s3control.JobManifest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = []interface{}{flattenJobManifestLocation(v)}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{flattenJobManifestSpec(v)}
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestLocation(apiObject *s3control.JobManifestLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ETag; v != nil {
+		tfMap["etag"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ObjectArn; v != nil {
+		tfMap["object_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ObjectVersionId; v != nil {
+		tfMap["object_version_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestSpec(apiObject *s3control.JobManifestSpec) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Fields; v != nil {
+		tfMap["fields"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Format; v != nil {
+		tfMap["format"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenJobOperation(apiObject *s3control.JobOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{flattenLambdaInvokeOperation(v)}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{flattenS3InitiateRestoreObjectOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectAcl; v != nil {
+		tfMap["s3_put_object_acl"] = []interface{}{flattenS3SetObjectAclOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{flattenS3CopyObjectOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectLegalHold; v != nil {
+		tfMap["s3_put_object_legal_hold"] = []interface{}{flattenS3SetObjectLegalHoldOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectRetention; v != nil {
+		tfMap["s3_put_object_retention"] = []interface{}{flattenS3SetObjectRetentionOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tfMap["s3_put_object_tagging"] = []interface{}{flattenS3SetObjectTaggingOperation(v)}
+	}
+
+	return tfMap
+}
+
+func flattenLambdaInvokeOperation(apiObject *s3control.LambdaInvokeOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FunctionArn; v != nil {
+		tfMap["function_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3InitiateRestoreObjectOperation(apiObject *s3control.S3InitiateRestoreObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ExpirationInDays; v != nil {
+		tfMap["expiration_in_days"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.GlacierJobTier; v != nil {
+		tfMap["glacier_job_tier"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3SetObjectAclOperation(apiObject *s3control.S3SetObjectAclOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccessControlPolicy; v != nil {
+		tfMap["access_control_policy"] = []interface{}{flattenS3AccessControlPolicy(v)}
+	}
+
+	return tfMap
+}
+
+func flattenS3AccessControlPolicy(apiObject *s3control.S3AccessControlPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccessControlList; v != nil {
+		tfMap["access_control_list"] = []interface{}{flattenS3AccessControlList(v)}
+	}
+
+	if v := apiObject.CannedAccessControlList; v != nil {
+		tfMap["canned_access_control_list"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3AccessControlList(apiObject *s3control.S3AccessControlList) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Grants; v != nil {
+		tfMap["grant"] = flattenS3Grants(v)
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = []interface{}{flattenS3ObjectOwner(v)}
+	}
+
+	return tfMap
+}
+
+func flattenS3ObjectOwner(apiObject *s3control.S3ObjectOwner) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DisplayName; v != nil {
+		tfMap["display_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ID; v != nil {
+		tfMap["id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3Grant(apiObject *s3control.S3Grant) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Grantee; v != nil {
+		tfMap["grantee"] = []interface{}{flattenS3Grantee(v)}
+	}
+
+	if v := apiObject.Permission; v != nil {
+		tfMap["permission"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3Grants(apiObjects []*s3control.S3Grant) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenS3Grant(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenS3Grantee(apiObject *s3control.S3Grantee) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DisplayName; v != nil {
+		tfMap["display_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Identifier; v != nil {
+		tfMap["identifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TypeIdentifier; v != nil {
+		tfMap["type_identifier"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3CopyObjectOperation(apiObject *s3control.S3CopyObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccessControlGrants; v != nil {
+		tfMap["access_control_grant"] = flattenS3Grants(v)
+	}
+
+	if v := apiObject.BucketKeyEnabled; v != nil {
+		tfMap["bucket_key_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.CannedAccessControlList; v != nil {
+		tfMap["canned_access_control_list"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ChecksumAlgorithm; v != nil {
+		tfMap["checksum_algorithm"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MetadataDirective; v != nil {
+		tfMap["metadata_directive"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.NewObjectMetadata; v != nil {
+		tfMap["new_object_metadata"] = []interface{}{flattenS3ObjectMetadata(v)}
+	}
+
+	if v := apiObject.NewObjectTagging; v != nil {
+		tfMap["new_object_tagging"] = KeyValueTags(v).Map()
+	}
+
+	if v := apiObject.ObjectLockLegalHoldStatus; v != nil {
+		tfMap["object_lock_legal_hold_status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ObjectLockMode; v != nil {
+		tfMap["object_lock_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ObjectLockRetainUntilDate; v != nil {
+		tfMap["object_lock_retain_until_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.SSEAwsKmsKeyId; v != nil {
+		tfMap["sse_aws_kms_key_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StorageClass; v != nil {
+		tfMap["storage_class"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TargetKeyPrefix; v != nil {
+		tfMap["target_key_prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TargetResource; v != nil {
+		tfMap["target_resource"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3ObjectMetadata(apiObject *s3control.S3ObjectMetadata) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CacheControl; v != nil {
+		tfMap["cache_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ContentDisposition; v != nil {
+		tfMap["content_disposition"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ContentEncoding; v != nil {
+		tfMap["content_encoding"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ContentLanguage; v != nil {
+		tfMap["content_language"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ContentType; v != nil {
+		tfMap["content_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SSEAlgorithm; v != nil {
+		tfMap["sse_algorithm"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UserMetadata; v != nil {
+		tfMap["user_metadata"] = aws.StringValueMap(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3SetObjectLegalHoldOperation(apiObject *s3control.S3SetObjectLegalHoldOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LegalHold; v != nil && v.Status != nil {
+		tfMap["status"] = aws.StringValue(v.Status)
+	}
+
+	return tfMap
+}
+
+func flattenS3SetObjectRetentionOperation(apiObject *s3control.S3SetObjectRetentionOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BypassGovernanceRetention; v != nil {
+		tfMap["bypass_governance_retention"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Retention; v != nil {
+		if v := v.Mode; v != nil {
+			tfMap["mode"] = aws.StringValue(v)
+		}
+
+		if v := v.RetainUntilDate; v != nil {
+			tfMap["retain_until_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+	}
+
+	return tfMap
+}
+
+func flattenS3SetObjectTaggingOperation(apiObject *s3control.S3SetObjectTaggingOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TagSet; v != nil {
+		tfMap["tag_set"] = KeyValueTags(v).Map()
+	}
+
+	return tfMap
+}
+
+func flattenJobProgressSummary(apiObject *s3control.JobProgressSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NumberOfTasksFailed; v != nil {
+		tfMap["number_of_tasks_failed"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.NumberOfTasksSucceeded; v != nil {
+		tfMap["number_of_tasks_succeeded"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TotalNumberOfTasks; v != nil {
+		tfMap["total_number_of_tasks"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenJobReport(apiObject *s3control.JobReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap["bucket"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Format; v != nil {
+		tfMap["format"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ReportScope; v != nil {
+		tfMap["report_scope"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,652 @@
+package s3control_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3ControlJob_basic(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "s3", regexp.MustCompile(`job/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "confirm", "false"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "manifest.0.location.0.etag", "aws_s3_object.manifest", "etag"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.format", s3control.JobManifestFormatS3batchOperationsCsv20180820),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "report_location", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirm", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_disappears(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3control.ResourceJob(), resourceName),
+					testAccCheckJobStatus(&v, s3control.JobStatusCancelled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_priority(t *testing.T) {
+	var v1, v2 s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_priority(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+				),
+			},
+			{
+				Config: testAccJobConfig_priority(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v2),
+					testAccCheckJobNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_tags(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirm", "wait_for_completion"},
+			},
+			{
+				Config: testAccJobConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccJobConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_confirm(t *testing.T) {
+	var v1, v2 s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_confirm(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "confirm", "false"),
+				),
+			},
+			{
+				Config: testAccJobConfig_confirm(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v2),
+					testAccCheckJobNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "confirm", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_failed", "0"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "2"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", "2"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "report.0.bucket", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.format", s3control.JobReportFormatReportCsv20180820),
+					resource.TestCheckResourceAttr(resourceName, "report.0.prefix", "reports"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.report_scope", s3control.JobReportScopeAllTasks),
+					resource.TestMatchResourceAttr(resourceName, "report_location", regexp.MustCompile(fmt.Sprintf(`^s3://%s/reports/job-[0-9a-f-]+/$`, rName))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_putObjectCopy(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_putObjectCopy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "operation.0.s3_put_object_copy.0.target_resource", "aws_s3_bucket.target", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.metadata_directive", s3control.S3MetadataDirectiveReplace),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.new_object_metadata.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.new_object_metadata.0.content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.storage_class", s3control.S3StorageClassStandardIa),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.target_key_prefix", "copied"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_lambdaInvoke(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_lambdaInvoke(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.lambda_invoke.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "operation.0.lambda_invoke.0.function_arn", "aws_lambda_function.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirm", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func testAccCheckJobDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_job" {
+			continue
+		}
+
+		accountID, jobID, err := tfs3control.JobParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfs3control.FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Jobs cannot be deleted, only cancelled.
+		switch aws.StringValue(output.Status) {
+		case s3control.JobStatusCancelled, s3control.JobStatusCancelling, s3control.JobStatusComplete, s3control.JobStatusFailed:
+			continue
+		}
+
+		return fmt.Errorf("S3 Batch Operations Job %s still active (%s)", rs.Primary.ID, aws.StringValue(output.Status))
+	}
+
+	return nil
+}
+
+func testAccCheckJobExists(n string, v *s3control.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Batch Operations Job ID is set")
+		}
+
+		accountID, jobID, err := tfs3control.JobParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+		output, err := tfs3control.FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJobNotRecreated(before, after *s3control.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.JobId), aws.StringValue(after.JobId); before != after {
+			return fmt.Errorf("S3 Batch Operations Job (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobStatus(v *s3control.JobDescriptor, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+		output, err := tfs3control.FindJobByAccountIDAndJobID(conn, acctest.AccountID(), aws.StringValue(v.JobId))
+
+		if err != nil {
+			return err
+		}
+
+		for _, status := range expected {
+			if aws.StringValue(output.Status) == status {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("S3 Batch Operations Job (%s) status is %s, expected %v", aws.StringValue(v.JobId), aws.StringValue(output.Status), expected)
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test1" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "object1"
+  content = "object1"
+}
+
+resource "aws_s3_object" "test2" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "object2"
+  content = "object2"
+}
+
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "manifest.csv"
+  content = <<EOT
+${aws_s3_bucket.test.bucket},${aws_s3_object.test1.key}
+${aws_s3_bucket.test.bucket},${aws_s3_object.test2.key}
+EOT
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "batchoperations.s3.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:*", "lambda:InvokeFunction"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccJobConfig_manifest() string {
+	return `
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+`
+}
+
+func testAccJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+%[1]s
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, testAccJobConfig_manifest()))
+}
+
+func testAccJobConfig_priority(rName string, priority int) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  priority              = %[2]d
+  role_arn              = aws_iam_role.test.arn
+
+%[1]s
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, testAccJobConfig_manifest(), priority))
+}
+
+func testAccJobConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+%[1]s
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, testAccJobConfig_manifest(), tagKey1, tagValue1))
+}
+
+func testAccJobConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+%[1]s
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, testAccJobConfig_manifest(), tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccJobConfig_confirm(rName string, confirm bool) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  confirm               = %[2]t
+  wait_for_completion   = %[2]t
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+%[1]s
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    bucket       = aws_s3_bucket.test.arn
+    enabled      = true
+    format       = "Report_CSV_20180820"
+    prefix       = "reports"
+    report_scope = "AllTasks"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, testAccJobConfig_manifest(), confirm))
+}
+
+func testAccJobConfig_putObjectCopy(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "target" {
+  bucket        = "%[2]s-target"
+  force_destroy = true
+}
+
+resource "aws_s3control_job" "test" {
+  wait_for_completion = true
+  priority            = 10
+  role_arn            = aws_iam_role.test.arn
+
+%[1]s
+
+  operation {
+    s3_put_object_copy {
+      metadata_directive = "REPLACE"
+      storage_class      = "STANDARD_IA"
+      target_key_prefix  = "copied"
+      target_resource    = aws_s3_bucket.target.arn
+
+      new_object_metadata {
+        content_type = "text/plain"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, testAccJobConfig_manifest(), rName))
+}
+
+func testAccJobConfig_lambdaInvoke(rName string) string {
+	return acctest.ConfigCompose(
+		testAccJobConfig_base(rName),
+		acctest.ConfigLambdaBase(rName+"-lambda", rName+"-lambda", rName+"-lambda"),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[2]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "index.handler"
+  runtime       = "nodejs14.x"
+}
+
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+%[1]s
+
+  operation {
+    lambda_invoke {
+      function_arn = aws_lambda_function.test.arn
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, testAccJobConfig_manifest(), rName))
+}
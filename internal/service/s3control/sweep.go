@@ -34,6 +34,11 @@ func init() {
 		Name: "aws_s3control_object_lambda_access_point",
 		F:    sweepObjectLambdaAccessPoints,
 	})
+
+	resource.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
+		Name: "aws_s3control_storage_lens_configuration",
+		F:    sweepStorageLensConfigurations,
+	})
 }
 
 func sweepAccessPoints(region string) error {
@@ -192,3 +197,54 @@ func sweepObjectLambdaAccessPoints(region string) error {
 
 	return nil
 }
+
+func sweepStorageLensConfigurations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).S3ControlConn
+	accountID := client.(*conns.AWSClient).AccountID
+	input := &s3control.ListStorageLensConfigurationsInput{
+		AccountId: aws.String(accountID),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListStorageLensConfigurationsPages(input, func(page *s3control.ListStorageLensConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, configuration := range page.StorageLensConfigurationList {
+			// The default dashboard cannot be deleted and configurations can only be deleted in their home Region.
+			if aws.StringValue(configuration.Id) == "default-account-dashboard" || aws.StringValue(configuration.HomeRegion) != region {
+				continue
+			}
+
+			r := ResourceStorageLensConfiguration()
+			d := r.Data(nil)
+			d.SetId(StorageLensConfigurationCreateResourceID(accountID, aws.StringValue(configuration.Id)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Storage Lens Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Storage Lens Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Storage Lens Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -92,3 +92,135 @@ func bucketUpdateTags(conn *s3control.S3Control, identifier string, oldTagsMap i
 
 	return nil
 }
+
+// jobListTags lists S3control job tags.
+func jobListTags(conn *s3control.S3Control, accountID, jobID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetJobTaggingInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.GetJobTagging(input)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(output.Tags), nil
+}
+
+// jobUpdateTags updates S3control job tags.
+func jobUpdateTags(conn *s3control.S3Control, accountID, jobID string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := jobListTags(conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("error listing resource tags (%s): %w", jobID, err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Tags:      Tags(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutJobTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags (%s): %w", jobID, err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+		}
+
+		_, err := conn.DeleteJobTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags (%s): %w", jobID, err)
+		}
+	}
+
+	return nil
+}
+
+// storageLensTags returns S3control Storage Lens configuration tags.
+func storageLensTags(tags tftags.KeyValueTags) []*s3control.StorageLensTag {
+	result := make([]*s3control.StorageLensTag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &s3control.StorageLensTag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// storageLensConfigurationListTags lists S3control Storage Lens configuration tags.
+func storageLensConfigurationListTags(conn *s3control.S3Control, accountID, configID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetStorageLensConfigurationTaggingInput{
+		AccountId: aws.String(accountID),
+		ConfigId:  aws.String(configID),
+	}
+
+	output, err := conn.GetStorageLensConfigurationTagging(input)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(output.Tags), nil
+}
+
+// storageLensConfigurationUpdateTags updates S3control Storage Lens configuration tags.
+func storageLensConfigurationUpdateTags(conn *s3control.S3Control, accountID, configID string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := storageLensConfigurationListTags(conn, accountID, configID)
+
+	if err != nil {
+		return fmt.Errorf("error listing resource tags (%s): %w", configID, err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutStorageLensConfigurationTaggingInput{
+			AccountId: aws.String(accountID),
+			ConfigId:  aws.String(configID),
+			Tags:      storageLensTags(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutStorageLensConfigurationTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags (%s): %w", configID, err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteStorageLensConfigurationTaggingInput{
+			AccountId: aws.String(accountID),
+			ConfigId:  aws.String(configID),
+		}
+
+		_, err := conn.DeleteStorageLensConfigurationTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags (%s): %w", configID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package s3control_test
+
+import (
+	"testing"
+)
+
+func TestAccS3ControlBucket_TagsConsistency(t *testing.T) {
+	t.Skip("aws_s3control_bucket has no testAccBucketConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccS3ControlJob_TagsConsistency(t *testing.T) {
+	t.Skip("aws_s3control_job has no testAccJobConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccS3ControlStorageLensConfiguration_TagsConsistency(t *testing.T) {
+	t.Skip("aws_s3control_storage_lens_configuration has no testAccStorageLensConfigurationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -26,12 +26,29 @@ func Tags(tags tftags.KeyValueTags) []*s3control.S3Tag {
 }
 
 // KeyValueTags creates tftags.KeyValueTags from s3control service tags.
-func KeyValueTags(tags []*s3control.S3Tag) tftags.KeyValueTags {
-	m := make(map[string]*string, len(tags))
+//
+// Accepts the following types:
+//   - []*s3control.S3Tag
+//   - []*s3control.StorageLensTag
+func KeyValueTags(tags interface{}) tftags.KeyValueTags {
+	switch tags := tags.(type) {
+	case []*s3control.S3Tag:
+		m := make(map[string]*string, len(tags))
+
+		for _, tag := range tags {
+			m[aws.StringValue(tag.Key)] = tag.Value
+		}
 
-	for _, tag := range tags {
-		m[aws.StringValue(tag.Key)] = tag.Value
-	}
+		return tftags.New(m)
+	case []*s3control.StorageLensTag:
+		m := make(map[string]*string, len(tags))
 
-	return tftags.New(m)
+		for _, tag := range tags {
+			m[aws.StringValue(tag.Key)] = tag.Value
+		}
+
+		return tftags.New(m)
+	default:
+		return tftags.New(nil)
+	}
 }
@@ -1,12 +1,14 @@
 package s3control
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3control"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -24,6 +26,8 @@ const (
 	multiRegionAccessPointRequestSucceededMinTimeout = 5 * time.Second
 
 	multiRegionAccessPointRequestSucceededDelay = 15 * time.Second
+
+	jobMinTimeout = 10 * time.Second
 )
 
 func waitPublicAccessBlockConfigurationBlockPublicACLsUpdated(conn *s3control.S3Control, accountID string, expectedValue bool) (*s3control.PublicAccessBlockConfiguration, error) {
@@ -119,3 +123,133 @@ func waitMultiRegionAccessPointRequestSucceeded(conn *s3control.S3Control, accou
 
 	return nil, err
 }
+
+func waitJobPrepared(conn *s3control.S3Control, accountID string, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{s3control.JobStatusNew, s3control.JobStatusPreparing},
+		Target: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCancelled,
+			s3control.JobStatusCancelling,
+			s3control.JobStatusComplete,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusFailed,
+			s3control.JobStatusFailing,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusReady,
+			s3control.JobStatusSuspended,
+		},
+		Refresh:    statusJob(conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: jobMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitJobConfirmed waits for a confirmed job to leave the Suspended status.
+// A job can still report Suspended for a while after it is confirmed.
+func waitJobConfirmed(conn *s3control.S3Control, accountID string, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{s3control.JobStatusSuspended},
+		Target: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCancelled,
+			s3control.JobStatusCancelling,
+			s3control.JobStatusComplete,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusFailed,
+			s3control.JobStatusFailing,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusReady,
+		},
+		Refresh:    statusJob(conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: jobMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobComplete(conn *s3control.S3Control, accountID string, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusNew,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+		},
+		Target:     []string{s3control.JobStatusComplete},
+		Refresh:    statusJob(conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: jobMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		setJobLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobFinished(conn *s3control.S3Control, accountID string, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCancelling,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusFailing,
+			s3control.JobStatusNew,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+			s3control.JobStatusSuspended,
+		},
+		Target:     []string{s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed},
+		Refresh:    statusJob(conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: jobMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func setJobLastError(err error, job *s3control.JobDescriptor) {
+	var errs []error
+
+	for _, failure := range job.FailureReasons {
+		errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(failure.FailureCode), aws.StringValue(failure.FailureReason)))
+	}
+
+	if v := aws.StringValue(job.StatusUpdateReason); v != "" && len(errs) == 0 {
+		errs = append(errs, errors.New(v))
+	}
+
+	tfresource.SetLastError(err, multierror.Append(nil, errs...).ErrorOrNil())
+}
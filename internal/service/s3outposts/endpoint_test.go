@@ -18,7 +18,7 @@ func TestAccS3OutpostsEndpoint_basic(t *testing.T) {
 	resourceName := "aws_s3outposts_endpoint.test"
 	rInt := sdkacctest.RandIntRange(0, 255)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3outposts.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -51,7 +51,7 @@ func TestAccS3OutpostsEndpoint_disappears(t *testing.T) {
 	resourceName := "aws_s3outposts_endpoint.test"
 	rInt := sdkacctest.RandIntRange(0, 255)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, s3outposts.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
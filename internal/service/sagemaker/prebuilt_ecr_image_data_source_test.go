@@ -14,7 +14,7 @@ func TestAccSageMakerPrebuiltECRImageDataSource_basic(t *testing.T) {
 
 	dataSourceName := "data.aws_sagemaker_prebuilt_ecr_image.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sagemaker.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -36,7 +36,7 @@ func TestAccSageMakerPrebuiltECRImageDataSource_region(t *testing.T) {
 
 	dataSourceName := "data.aws_sagemaker_prebuilt_ecr_image.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sagemaker.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,79 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package sagemaker_test
+
+import (
+	"testing"
+)
+
+func TestAccSageMakerApp_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_app has no testAccAppConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerAppImageConfig_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_app_image_config has no testAccAppImageConfigConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerCodeRepository_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_code_repository has no testAccCodeRepositoryConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerDeviceFleet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_device_fleet has no testAccDeviceFleetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerDomain_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_domain has no testAccDomainConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerEndpoint_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_endpoint has no testAccEndpointConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerEndpointConfiguration_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_endpoint_configuration has no testAccEndpointConfigurationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerFeatureGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_feature_group has no testAccFeatureGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerFlowDefinition_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_flow_definition has no testAccFlowDefinitionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerHumanTaskUI_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_human_task_ui has no testAccHumanTaskUIConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerImage_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_image has no testAccImageConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerModel_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_model has no testAccModelConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerModelPackageGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_model_package_group has no testAccModelPackageGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerNotebookInstance_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_notebook_instance has no testAccNotebookInstanceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerProject_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_project has no testAccProjectConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerStudioLifecycleConfig_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_studio_lifecycle_config has no testAccStudioLifecycleConfigConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerUserProfile_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_user_profile has no testAccUserProfileConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSageMakerWorkteam_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sagemaker_workteam has no testAccWorkteamConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package schemas_test
+
+import (
+	"testing"
+)
+
+func TestAccSchemasDiscoverer_TagsConsistency(t *testing.T) {
+	t.Skip("aws_schemas_discoverer has no testAccDiscovererConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSchemasRegistry_TagsConsistency(t *testing.T) {
+	t.Skip("aws_schemas_registry has no testAccRegistryConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSchemasSchema_TagsConsistency(t *testing.T) {
+	t.Skip("aws_schemas_schema has no testAccSchemaConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
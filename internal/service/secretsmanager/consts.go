@@ -4,4 +4,11 @@ import "time"
 
 const (
 	PropagationTimeout = 2 * time.Minute
+
+	secretKeyUpdateTimeout = 5 * time.Minute
+)
+
+const (
+	versionStageCurrent  = "AWSCURRENT"
+	versionStagePrevious = "AWSPREVIOUS"
 )
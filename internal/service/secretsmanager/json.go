@@ -0,0 +1,76 @@
+package secretsmanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+// mergeSecretJSONValues applies the changes made from base to other onto value.
+func mergeSecretJSONValues(base, value, other map[string]interface{}) map[string]interface{} {
+	merged := copySecretJSONValue(value)
+
+	for k, v := range other {
+		if baseV, ok := base[k]; !ok || !reflect.DeepEqual(baseV, v) {
+			merged[k] = v
+		}
+	}
+
+	for k := range base {
+		if _, ok := other[k]; !ok {
+			delete(merged, k)
+		}
+	}
+
+	return merged
+}
+
+func copySecretJSONValue(tfMap map[string]interface{}) map[string]interface{} {
+	value := make(map[string]interface{}, len(tfMap))
+
+	for k, v := range tfMap {
+		value[k] = v
+	}
+
+	return value
+}
+
+func decodeSecretJSONValue(output *secretsmanager.GetSecretValueOutput) (map[string]interface{}, error) {
+	if output.SecretString == nil {
+		return nil, fmt.Errorf("secret version (%s) has no SecretString", aws.StringValue(output.VersionId))
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if aws.StringValue(output.SecretString) == "" {
+		return tfMap, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(aws.StringValue(output.SecretString)))
+	decoder.UseNumber()
+
+	// The error may contain part of the secret value.
+	if err := decoder.Decode(&tfMap); err != nil {
+		return nil, fmt.Errorf("secret version (%s) SecretString is not a JSON object", aws.StringValue(output.VersionId))
+	}
+
+	return tfMap, nil
+}
+
+func encodeSecretJSONValue(tfMap map[string]interface{}) (string, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(tfMap); err != nil {
+		return "", fmt.Errorf("error encoding secret value: %w", err)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
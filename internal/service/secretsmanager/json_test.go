@@ -0,0 +1,122 @@
+package secretsmanager
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+func TestDecodeSecretJSONValue(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		SecretString  *string
+		Expected      map[string]interface{}
+		ExpectedError bool
+	}{
+		{
+			Name:          "binary",
+			ExpectedError: true,
+		},
+		{
+			Name:         "empty",
+			SecretString: aws.String(""),
+			Expected:     map[string]interface{}{},
+		},
+		{
+			Name:         "object",
+			SecretString: aws.String(`{"username":"admin","port":5432}`),
+			Expected:     map[string]interface{}{"username": "admin", "port": json.Number("5432")},
+		},
+		{
+			Name:          "plain text",
+			SecretString:  aws.String("hunter2"),
+			ExpectedError: true,
+		},
+		{
+			Name:          "array",
+			SecretString:  aws.String(`["a"]`),
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := decodeSecretJSONValue(&secretsmanager.GetSecretValueOutput{
+				SecretString: testCase.SecretString,
+				VersionId:    aws.String("v1"),
+			})
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if testCase.SecretString != nil && strings.Contains(err.Error(), *testCase.SecretString) {
+					t.Errorf("error contains secret value: %s", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("expected %v, got %v", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestEncodeSecretJSONValue(t *testing.T) {
+	got, err := encodeSecretJSONValue(map[string]interface{}{
+		"password": "<p&ss>",
+		"port":     json.Number("5432"),
+		"username": "admin",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := `{"password":"<p&ss>","port":5432,"username":"admin"}`; got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestMergeSecretJSONValues(t *testing.T) {
+	base := map[string]interface{}{
+		"a": "1",
+		"b": "2",
+		"c": "3",
+	}
+	// This writer set "mine" and changed "a".
+	value := map[string]interface{}{
+		"a":    "10",
+		"b":    "2",
+		"c":    "3",
+		"mine": "x",
+	}
+	// A concurrent writer set "theirs", changed "b" and removed "c".
+	other := map[string]interface{}{
+		"a":      "1",
+		"b":      "20",
+		"theirs": "y",
+	}
+
+	expected := map[string]interface{}{
+		"a":      "10",
+		"b":      "20",
+		"mine":   "x",
+		"theirs": "y",
+	}
+
+	if got := mergeSecretJSONValues(base, value, other); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
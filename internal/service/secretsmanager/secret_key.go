@@ -0,0 +1,389 @@
+package secretsmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	secretKeyIDSeparator = "|"
+
+	// secretKeyMaxRebases is the number of times a write is rebased onto versions put concurrently by other writers.
+	secretKeyMaxRebases = 10
+)
+
+func ResourceSecretKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecretKeyPut,
+		Read:   resourceSecretKeyRead,
+		Update: resourceSecretKeyPut,
+		Delete: resourceSecretKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(secretKeyUpdateTimeout),
+			Update: schema.DefaultTimeout(secretKeyUpdateTimeout),
+			Delete: schema.DefaultTimeout(secretKeyUpdateTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecretKeyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	secretID := d.Get("secret_id").(string)
+	key := d.Get("key").(string)
+	value := d.Get("value").(string)
+	id := SecretKeyCreateResourceID(secretID, key)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	log.Printf("[DEBUG] Putting Secrets Manager Secret Key: %s", id)
+	_, err := updateSecretJSONValue(conn, secretID, timeout, func(tfMap map[string]interface{}) {
+		tfMap[key] = value
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting Secrets Manager Secret Key (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceSecretKeyRead(d, meta)
+}
+
+func resourceSecretKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	secretID, key, err := SecretKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindSecretValue(conn, secretID, "", versionStageCurrent)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Secrets Manager Secret Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret Key (%s): %w", d.Id(), err)
+	}
+
+	output := outputRaw.(*secretsmanager.GetSecretValueOutput)
+	tfMap, err := decodeSecretJSONValue(output)
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret Key (%s): %w", d.Id(), err)
+	}
+
+	v, ok := tfMap[key]
+
+	if !d.IsNewResource() && !ok {
+		log.Printf("[WARN] Secrets Manager Secret Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	value, ok := v.(string)
+
+	if !ok {
+		// Any other JSON type is shown as its JSON encoding so that a change is planned.
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return fmt.Errorf("error reading Secrets Manager Secret Key (%s): %w", d.Id(), err)
+		}
+
+		value = string(b)
+	}
+
+	d.Set("key", key)
+	d.Set("secret_id", secretID)
+	d.Set("value", value)
+	d.Set("version_id", output.VersionId)
+
+	return nil
+}
+
+func resourceSecretKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	secretID, key, err := SecretKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Secrets Manager Secret Key: %s", d.Id())
+	_, err = updateSecretJSONValue(conn, secretID, d.Timeout(schema.TimeoutDelete), func(tfMap map[string]interface{}) {
+		delete(tfMap, key)
+	})
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Secrets Manager Secret Key (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateSecretJSONValue applies update to the key-value pairs of the secret's current JSON value
+// and, if they changed, puts the result as a new version of the secret. It returns the ID of the current version.
+//
+// Secrets Manager has no conditional writes, so concurrent writers are detected by checking that the AWSCURRENT
+// version is still the one that was read immediately before putting the new version, retrying on conflict,
+// and by checking that it became the AWSPREVIOUS version afterwards. If another version was put in between,
+// its changes are merged into the new value, which is put again.
+func updateSecretJSONValue(conn *secretsmanager.SecretsManager, secretID string, timeout time.Duration, update func(map[string]interface{})) (string, error) {
+	outputRaw, err := tfresource.RetryWhen(timeout,
+		func() (interface{}, error) {
+			return updateSecretJSONValueOnce(conn, secretID, update)
+		},
+		func(err error) (bool, error) {
+			var conflictErr *secretVersionConflictError
+
+			if errors.As(err, &conflictErr) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return "", err
+	}
+
+	return outputRaw.(string), nil
+}
+
+func updateSecretJSONValueOnce(conn *secretsmanager.SecretsManager, secretID string, update func(map[string]interface{})) (string, error) {
+	var baseVersionID string
+	base := map[string]interface{}{}
+
+	output, err := FindSecretValue(conn, secretID, "", versionStageCurrent)
+
+	switch {
+	case err == nil:
+		baseVersionID = aws.StringValue(output.VersionId)
+		base, err = decodeSecretJSONValue(output)
+
+		if err != nil {
+			return "", err
+		}
+	case tfresource.NotFound(err):
+		// The secret may not have a value yet.
+		if _, err := FindSecretByID(conn, secretID); err != nil {
+			return "", err
+		}
+	default:
+		return "", err
+	}
+
+	value := copySecretJSONValue(base)
+	update(value)
+
+	for i := 0; ; i++ {
+		if reflect.DeepEqual(base, value) {
+			return baseVersionID, nil
+		}
+
+		if i == secretKeyMaxRebases {
+			return "", fmt.Errorf("secret (%s) is being updated concurrently by other writers", secretID)
+		}
+
+		// Optimistic concurrency check.
+		currentVersionID, err := findSecretVersionIDByStage(conn, secretID, versionStageCurrent)
+
+		if err != nil {
+			return "", err
+		}
+
+		if currentVersionID != baseVersionID {
+			return "", &secretVersionConflictError{secretID: secretID, expected: baseVersionID, actual: currentVersionID}
+		}
+
+		secretString, err := encodeSecretJSONValue(value)
+
+		if err != nil {
+			return "", err
+		}
+
+		output, err := conn.PutSecretValue(&secretsmanager.PutSecretValueInput{
+			SecretId:     aws.String(secretID),
+			SecretString: aws.String(secretString),
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		versionID := aws.StringValue(output.VersionId)
+
+		if baseVersionID == "" {
+			return versionID, nil
+		}
+
+		previousVersionID, err := findSecretVersionIDByStage(conn, secretID, versionStagePrevious)
+
+		if err != nil {
+			return "", err
+		}
+
+		if previousVersionID == baseVersionID {
+			return versionID, nil
+		}
+
+		// Another writer put a version between the check and the put, and its changes were overwritten.
+		log.Printf("[WARN] Secrets Manager Secret (%s) version (%s) was put concurrently, merging its changes", secretID, previousVersionID)
+
+		other, err := FindSecretValue(conn, secretID, previousVersionID, "")
+
+		if err != nil {
+			return "", err
+		}
+
+		otherValue, err := decodeSecretJSONValue(other)
+
+		if err != nil {
+			return "", err
+		}
+
+		merged := mergeSecretJSONValues(base, value, otherValue)
+		update(merged)
+
+		base, baseVersionID, value = value, versionID, merged
+	}
+}
+
+// secretVersionConflictError is returned when a secret's AWSCURRENT version changes during an update.
+type secretVersionConflictError struct {
+	actual   string
+	expected string
+	secretID string
+}
+
+func (e *secretVersionConflictError) Error() string {
+	return fmt.Sprintf("secret (%s) %s version changed from (%s) to (%s)", e.secretID, versionStageCurrent, e.expected, e.actual)
+}
+
+// FindSecretValue returns the value of the secret version with the specified ID or staging label.
+func FindSecretValue(conn *secretsmanager.SecretsManager, secretID, versionID, versionStage string) (*secretsmanager.GetSecretValueOutput, error) {
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	}
+
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	if versionStage != "" {
+		input.VersionStage = aws.String(versionStage)
+	}
+
+	output, err := conn.GetSecretValue(input)
+
+	if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, secretsmanager.ErrCodeInvalidRequestException, "You can’t perform this operation on the secret because it was deleted") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findSecretVersionIDByStage returns the ID of the secret version with the specified staging label, or "" if there is none.
+func findSecretVersionIDByStage(conn *secretsmanager.SecretsManager, secretID, versionStage string) (string, error) {
+	output, err := FindSecretByID(conn, secretID)
+
+	if err != nil {
+		return "", err
+	}
+
+	for versionID, stages := range output.VersionIdsToStages {
+		for _, stage := range stages {
+			if aws.StringValue(stage) == versionStage {
+				return versionID, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func SecretKeyCreateResourceID(secretID, key string) string {
+	return strings.Join([]string{secretID, key}, secretKeyIDSeparator)
+}
+
+// SecretKeyParseResourceID parses a resource ID of the form SECRET-ID|KEY.
+// Secret IDs can't contain the separator but keys can.
+func SecretKeyParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, secretKeyIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SECRET-ID%[2]sKEY", id, secretKeyIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,347 @@
+package secretsmanager_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestSecretKeyParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		InputID          string
+		ExpectError      bool
+		ExpectedSecretID string
+		ExpectedKey      string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "no separator",
+			InputID:     "my-secret",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty key",
+			InputID:     "my-secret|",
+			ExpectError: true,
+		},
+		{
+			TestName:         "name",
+			InputID:          "my-secret|password",
+			ExpectedSecretID: "my-secret",
+			ExpectedKey:      "password",
+		},
+		{
+			TestName:         "ARN and key containing separator",
+			InputID:          "arn:aws:secretsmanager:us-west-2:123456789012:secret:my-secret-AbCdEf|a|b",
+			ExpectedSecretID: "arn:aws:secretsmanager:us-west-2:123456789012:secret:my-secret-AbCdEf",
+			ExpectedKey:      "a|b",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotSecretID, gotKey, err := tfsecretsmanager.SecretKeyParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotSecretID != testCase.ExpectedSecretID {
+				t.Errorf("got secret ID %s, expected %s", gotSecretID, testCase.ExpectedSecretID)
+			}
+
+			if gotKey != testCase.ExpectedKey {
+				t.Errorf("got key %s, expected %s", gotKey, testCase.ExpectedKey)
+			}
+		})
+	}
+}
+
+func TestAccSecretsManagerSecretKey_basic(t *testing.T) {
+	var value map[string]interface{}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_key.username"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSecretKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "s3cr3t"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(resourceName, &value),
+					resource.TestCheckResourceAttr(resourceName, "key", "username"),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_id", "aws_secretsmanager_secret.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "value", "admin"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttr("aws_secretsmanager_secret_key.password", "value", "s3cr3t"),
+					testAccCheckSecretKeyValue(&value, "password", "s3cr3t"),
+					testAccCheckSecretKeyValue(&value, "username", "admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Another key may have been written after this one.
+				ImportStateVerifyIgnore: []string{"version_id"},
+			},
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "n3w-s3cr3t"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(resourceName, &value),
+					resource.TestCheckResourceAttr("aws_secretsmanager_secret_key.password", "value", "n3w-s3cr3t"),
+					testAccCheckSecretKeyValue(&value, "password", "n3w-s3cr3t"),
+					testAccCheckSecretKeyValue(&value, "username", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretKey_preservesOtherKeys(t *testing.T) {
+	var value map[string]interface{}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_key.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSecretKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_existingValue(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(resourceName, &value),
+					testAccCheckSecretKeyValue(&value, "host", "db.example.com"),
+					testAccCheckSecretKeyValue(&value, "port", "5432"),
+					testAccCheckSecretKeyValue(&value, "password", "s3cr3t"),
+				),
+			},
+			{
+				// Removing the key resource removes only its own key.
+				Config: testAccSecretKeyConfig_existingValue(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyCurrentValue("aws_secretsmanager_secret.test", &value),
+					testAccCheckSecretKeyValue(&value, "host", "db.example.com"),
+					testAccCheckSecretKeyValue(&value, "port", "5432"),
+					testAccCheckSecretKeyValue(&value, "password", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretKey_disappears(t *testing.T) {
+	var value map[string]interface{}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_key.username"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSecretKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "s3cr3t"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(resourceName, &value),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsecretsmanager.ResourceSecretKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSecretKeyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_secretsmanager_secret_key" {
+			continue
+		}
+
+		secretID, key, err := tfsecretsmanager.SecretKeyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfsecretsmanager.FindSecretValue(conn, secretID, "", "AWSCURRENT")
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		var value map[string]interface{}
+
+		if err := json.Unmarshal([]byte(aws.StringValue(output.SecretString)), &value); err != nil {
+			return err
+		}
+
+		if _, ok := value[key]; ok {
+			return fmt.Errorf("Secrets Manager Secret Key %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSecretKeyExists(n string, v *map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Secrets Manager Secret Key ID is set")
+		}
+
+		secretID, key, err := tfsecretsmanager.SecretKeyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if err := testAccCheckSecretKeyCurrentValue(secretID, v)(s); err != nil {
+			return err
+		}
+
+		if _, ok := (*v)[key]; !ok {
+			return fmt.Errorf("Secrets Manager Secret Key %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckSecretKeyCurrentValue reads the AWSCURRENT value of a secret, identified by a resource name or a secret ID.
+func testAccCheckSecretKeyCurrentValue(secretID string, v *map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if rs, ok := s.RootModule().Resources[secretID]; ok {
+			secretID = rs.Primary.ID
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn
+
+		output, err := tfsecretsmanager.FindSecretValue(conn, secretID, "", "AWSCURRENT")
+
+		if err != nil {
+			return err
+		}
+
+		value := map[string]interface{}{}
+
+		if err := json.Unmarshal([]byte(aws.StringValue(output.SecretString)), &value); err != nil {
+			return err
+		}
+
+		*v = value
+
+		return nil
+	}
+}
+
+// testAccCheckSecretKeyValue checks the value of a key in a secret's JSON value. An empty expected value checks that the key is absent.
+func testAccCheckSecretKeyValue(v *map[string]interface{}, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		actual, ok := (*v)[key]
+
+		if expected == "" {
+			if ok {
+				return fmt.Errorf("expected secret key %s to be absent", key)
+			}
+
+			return nil
+		}
+
+		if !ok {
+			return fmt.Errorf("expected secret key %s to be present", key)
+		}
+
+		if got := fmt.Sprintf("%v", actual); got != expected {
+			return fmt.Errorf("secret key %s: expected %s, got %s", key, expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccSecretKeyConfig_basic(rName, password string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_key" "username" {
+  secret_id = aws_secretsmanager_secret.test.id
+  key       = "username"
+  value     = "admin"
+}
+
+resource "aws_secretsmanager_secret_key" "password" {
+  secret_id = aws_secretsmanager_secret.test.id
+  key       = "password"
+  value     = %[2]q
+}
+`, rName, password)
+}
+
+func testAccSecretKeyConfig_existingValue(rName string, withKey bool) string {
+	config := fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    host = "db.example.com"
+    port = 5432
+  })
+}
+`, rName)
+
+	if !withKey {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, `
+resource "aws_secretsmanager_secret_key" "test" {
+  secret_id = aws_secretsmanager_secret_version.test.secret_id
+  key       = "password"
+  value     = "s3cr3t"
+}
+`)
+}
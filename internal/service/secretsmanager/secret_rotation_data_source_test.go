@@ -16,7 +16,7 @@ func TestAccSecretsManagerSecretRotationDataSource_basic(t *testing.T) {
 	resourceName := "aws_secretsmanager_secret_rotation.test"
 	datasourceName := "data.aws_secretsmanager_secret_rotation.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, secretsmanager.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -20,7 +20,7 @@ func TestAccSecretsManagerSecretRotation_basic(t *testing.T) {
 	resourceName := "aws_secretsmanager_secret_rotation.test"
 	lambdaFunctionResourceName := "aws_lambda_function.test1"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, secretsmanager.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package secretsmanager_test
+
+import (
+	"testing"
+)
+
+func TestAccSecretsManagerSecret_TagsConsistency(t *testing.T) {
+	t.Skip("aws_secretsmanager_secret has no testAccSecretConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func testAccAccount_basic(t *testing.T) {
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
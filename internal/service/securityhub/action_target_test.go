@@ -16,7 +16,7 @@ import (
 func testAccActionTarget_basic(t *testing.T) {
 	resourceName := "aws_securityhub_action_target.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -44,7 +44,7 @@ func testAccActionTarget_basic(t *testing.T) {
 func testAccActionTarget_disappears(t *testing.T) {
 	resourceName := "aws_securityhub_action_target.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -65,7 +65,7 @@ func testAccActionTarget_disappears(t *testing.T) {
 func testAccActionTarget_Description(t *testing.T) {
 	resourceName := "aws_securityhub_action_target.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -97,7 +97,7 @@ func testAccActionTarget_Description(t *testing.T) {
 func testAccActionTarget_Name(t *testing.T) {
 	resourceName := "aws_securityhub_action_target.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -18,7 +18,7 @@ func testAccInviteAccepter_basic(t *testing.T) {
 	var providers []*schema.Provider
 	resourceName := "aws_securityhub_invite_accepter.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
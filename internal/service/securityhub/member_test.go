@@ -18,7 +18,7 @@ func testAccMember_basic(t *testing.T) {
 	var member securityhub.Member
 	resourceName := "aws_securityhub_member.example"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -43,7 +43,7 @@ func testAccMember_invite(t *testing.T) {
 	var member securityhub.Member
 	resourceName := "aws_securityhub_member.example"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -17,7 +17,7 @@ import (
 func testAccOrganizationAdminAccount_basic(t *testing.T) {
 	resourceName := "aws_securityhub_organization_admin_account.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckOrganizationsAccount(t)
@@ -45,7 +45,7 @@ func testAccOrganizationAdminAccount_basic(t *testing.T) {
 func testAccOrganizationAdminAccount_disappears(t *testing.T) {
 	resourceName := "aws_securityhub_organization_admin_account.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckOrganizationsAccount(t)
@@ -73,7 +73,7 @@ func testAccOrganizationAdminAccount_MultiRegion(t *testing.T) {
 	altResourceName := "aws_securityhub_organization_admin_account.alternate"
 	thirdResourceName := "aws_securityhub_organization_admin_account.third"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckOrganizationsAccount(t)
@@ -18,7 +18,7 @@ func testAccStandardsControl_basic(t *testing.T) {
 	var standardsControl securityhub.StandardsControl
 	resourceName := "aws_securityhub_standards_control.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -47,7 +47,7 @@ func testAccStandardsControl_disabledControlStatus(t *testing.T) {
 	var standardsControl securityhub.StandardsControl
 	resourceName := "aws_securityhub_standards_control.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -66,7 +66,7 @@ func testAccStandardsControl_disabledControlStatus(t *testing.T) {
 }
 
 func testAccStandardsControl_enabledControlStatusAndDisabledReason(t *testing.T) {
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -18,7 +18,7 @@ func testAccStandardsSubscription_basic(t *testing.T) {
 	var standardsSubscription securityhub.StandardsSubscription
 	resourceName := "aws_securityhub_standards_subscription.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -43,7 +43,7 @@ func testAccStandardsSubscription_disappears(t *testing.T) {
 	var standardsSubscription securityhub.StandardsSubscription
 	resourceName := "aws_securityhub_standards_subscription.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,11 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package serverlessrepo_test
+
+import (
+	"testing"
+)
+
+func TestAccServerlessRepoCloudFormationStack_TagsConsistency(t *testing.T) {
+	t.Skip("aws_serverlessapplicationrepository_cloudformation_stack has no testAccCloudFormationStackConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -14,7 +14,7 @@ func TestAccServiceCatalogConstraintDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_servicecatalog_constraint.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, servicecatalog.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
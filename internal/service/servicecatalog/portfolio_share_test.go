@@ -22,7 +22,7 @@ func TestAccServiceCatalogPortfolioShare_basic(t *testing.T) {
 	dataSourceName := "data.aws_caller_identity.alternate"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
@@ -61,7 +61,7 @@ func TestAccServiceCatalogPortfolioShare_organizationalUnit(t *testing.T) {
 	compareName := "aws_servicecatalog_portfolio.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckOrganizationsEnabled(t)
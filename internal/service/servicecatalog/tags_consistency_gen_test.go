@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package servicecatalog_test
+
+import (
+	"testing"
+)
+
+func TestAccServiceCatalogPortfolio_TagsConsistency(t *testing.T) {
+	t.Skip("aws_servicecatalog_portfolio has no testAccPortfolioConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccServiceCatalogProduct_TagsConsistency(t *testing.T) {
+	t.Skip("aws_servicecatalog_product has no testAccProductConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccServiceCatalogProvisionedProduct_TagsConsistency(t *testing.T) {
+	t.Skip("aws_servicecatalog_provisioned_product has no testAccProvisionedProductConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package servicediscovery_test
+
+import (
+	"testing"
+)
+
+func TestAccServiceDiscoveryHTTPNamespace_TagsConsistency(t *testing.T) {
+	t.Skip("aws_service_discovery_http_namespace has no testAccHTTPNamespaceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccServiceDiscoveryPrivateDNSNamespace_TagsConsistency(t *testing.T) {
+	t.Skip("aws_service_discovery_private_dns_namespace has no testAccPrivateDNSNamespaceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccServiceDiscoveryPublicDNSNamespace_TagsConsistency(t *testing.T) {
+	t.Skip("aws_service_discovery_public_dns_namespace has no testAccPublicDNSNamespaceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccServiceDiscoveryService_TagsConsistency(t *testing.T) {
+	t.Skip("aws_service_discovery_service has no testAccServiceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -12,7 +12,7 @@ import (
 func TestAccServiceQuotasServiceDataSource_serviceName(t *testing.T) {
 	dataSourceName := "data.aws_servicequotas_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(servicequotas.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, servicequotas.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -18,7 +18,7 @@ func TestAccSESDomainDKIM_basic(t *testing.T) {
 	resourceName := "aws_ses_domain_dkim.test"
 	domain := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheck(t)
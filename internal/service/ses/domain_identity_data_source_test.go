@@ -12,7 +12,7 @@ import (
 func TestAccSESDomainIdentityDataSource_basic(t *testing.T) {
 	domain := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ses.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -14,7 +14,7 @@ import (
 func TestAccSESEmailIdentityDataSource_basic(t *testing.T) {
 	email := acctest.DefaultEmailAddress
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ses.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -34,7 +34,7 @@ func TestAccSESEmailIdentityDataSource_basic(t *testing.T) {
 func TestAccSESEmailIdentityDataSource_trailingPeriod(t *testing.T) {
 	email := fmt.Sprintf("%s.", acctest.DefaultEmailAddress)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ses.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -20,7 +20,7 @@ func TestAccSESIdentityNotificationTopic_basic(t *testing.T) {
 	topicName := sdkacctest.RandomWithPrefix("test-topic")
 	resourceName := "aws_ses_identity_notification_topic.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheck(t)
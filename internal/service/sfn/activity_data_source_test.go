@@ -15,7 +15,7 @@ func TestAccSFNActivityDataSource_StepFunctions_basic(t *testing.T) {
 	resourceName := "aws_sfn_activity.test"
 	dataName := "data.aws_sfn_activity.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
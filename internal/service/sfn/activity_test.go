@@ -19,7 +19,7 @@ func TestAccSFNActivity_basic(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_sfn_activity.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -46,7 +46,7 @@ func TestAccSFNActivity_tags(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_sfn_activity.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,1125 @@
+package sfn
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Amazon States Language.
+// See https://states-language.net/spec.html and https://docs.aws.amazon.com/step-functions/latest/dg/concepts-amazon-states-language.html.
+
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+
+	stateNameMaxLength = 80
+
+	queryLanguageJSONata  = "JSONata"
+	queryLanguageJSONPath = "JSONPath"
+
+	errorNameAll = "States.ALL"
+)
+
+func stateType_Values() []string {
+	return []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+}
+
+var (
+	// See https://docs.aws.amazon.com/step-functions/latest/dg/amazon-states-language-intrinsic-functions.html.
+	intrinsicFunctionNames = map[string]struct{}{
+		"States.Array":          {},
+		"States.ArrayContains":  {},
+		"States.ArrayGetItem":   {},
+		"States.ArrayLength":    {},
+		"States.ArrayPartition": {},
+		"States.ArrayRange":     {},
+		"States.ArrayUnique":    {},
+		"States.Base64Decode":   {},
+		"States.Base64Encode":   {},
+		"States.Format":         {},
+		"States.Hash":           {},
+		"States.JsonMerge":      {},
+		"States.JsonToString":   {},
+		"States.MathAdd":        {},
+		"States.MathRandom":     {},
+		"States.StringSplit":    {},
+		"States.StringToJson":   {},
+		"States.UUID":           {},
+	}
+
+	intrinsicFunctionRegexp             = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9.]*\s*\(`)
+	jsonPathSliceRegexp                 = regexp.MustCompile(`^-?\d*:-?\d*(:-?\d*)?$`)
+	jsonPathIndexRegexp                 = regexp.MustCompile(`^-?\d+(\s*,\s*-?\d+)*$`)
+	jsonPathQuotedRegexp                = regexp.MustCompile(`^('[^']*'|"[^"]*")(\s*,\s*('[^']*'|"[^"]*"))*$`)
+	serviceIntegrationResourceRegexp    = regexp.MustCompile(`^[a-zA-Z0-9-]+(:[a-zA-Z0-9-]+)+(\.(sync(:2)?|waitForTaskToken))?$`)
+	jsonPathReferenceIndexRegexp        = regexp.MustCompile(`^\d+$`)
+	jsonPathReferenceQuotedRegexp       = regexp.MustCompile(`^('[^']*'|"[^"]*")$`)
+	choiceRuleBooleanExpressionOperands = []string{"And", "Not", "Or"}
+)
+
+type choiceRuleOperandKind int
+
+const (
+	choiceRuleOperandBoolean choiceRuleOperandKind = iota
+	choiceRuleOperandNumber
+	choiceRuleOperandPath
+	choiceRuleOperandString
+	choiceRuleOperandTimestamp
+)
+
+// choiceRuleComparisonOperators maps each data-test expression operator to the type of its operand.
+var choiceRuleComparisonOperators = func() map[string]choiceRuleOperandKind {
+	operators := map[string]choiceRuleOperandKind{
+		"BooleanEquals": choiceRuleOperandBoolean,
+		"IsBoolean":     choiceRuleOperandBoolean,
+		"IsNull":        choiceRuleOperandBoolean,
+		"IsNumeric":     choiceRuleOperandBoolean,
+		"IsPresent":     choiceRuleOperandBoolean,
+		"IsString":      choiceRuleOperandBoolean,
+		"IsTimestamp":   choiceRuleOperandBoolean,
+		"StringMatches": choiceRuleOperandString,
+	}
+
+	for _, comparison := range []string{"Equals", "GreaterThan", "GreaterThanEquals", "LessThan", "LessThanEquals"} {
+		operators["Numeric"+comparison] = choiceRuleOperandNumber
+		operators["String"+comparison] = choiceRuleOperandString
+		operators["Timestamp"+comparison] = choiceRuleOperandTimestamp
+
+		for _, prefix := range []string{"Numeric", "String", "Timestamp"} {
+			operators[prefix+comparison+"Path"] = choiceRuleOperandPath
+		}
+	}
+
+	operators["BooleanEqualsPath"] = choiceRuleOperandPath
+
+	return operators
+}()
+
+// definitionValidator collects the errors found in a state machine definition.
+// Constructs it does not recognize, which may be newer additions to the language, are warnings.
+type definitionValidator struct {
+	errors   []error
+	warnings []string
+}
+
+// validateDefinition parses a state machine definition and returns any warnings and errors in it.
+func validateDefinition(definition string) ([]string, []error) {
+	v, err := decodeDefinition(definition)
+
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok {
+		return nil, []error{fmt.Errorf("definition must be a JSON object")}
+	}
+
+	validator := &definitionValidator{}
+	validator.validateStateMachine(tfMap, "", false)
+
+	return validator.warnings, validator.errors
+}
+
+// canonicalDefinition returns the state machine definition as indented JSON with sorted keys.
+func canonicalDefinition(definition string) (string, error) {
+	v, err := decodeDefinition(definition)
+
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func decodeDefinition(definition string) (interface{}, error) {
+	var v interface{}
+
+	decoder := json.NewDecoder(strings.NewReader(definition))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&v); err != nil {
+		return nil, fmt.Errorf("definition is invalid JSON: %w", err)
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("definition is invalid JSON: unexpected data after JSON value")
+	}
+
+	return v, nil
+}
+
+func (v *definitionValidator) errorf(path, format string, a ...interface{}) {
+	if path == "" {
+		path = "definition"
+	}
+
+	v.errors = append(v.errors, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+}
+
+func (v *definitionValidator) warnf(path, format string, a ...interface{}) {
+	if path == "" {
+		path = "definition"
+	}
+
+	v.warnings = append(v.warnings, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, a...)))
+}
+
+// queryLanguage returns whether a state machine or state uses JSONata,
+// either by setting "QueryLanguage" itself or by inheriting it.
+func (v *definitionValidator) queryLanguage(tfMap map[string]interface{}, path string, jsonata bool) bool {
+	value, ok := tfMap["QueryLanguage"]
+
+	if !ok {
+		return jsonata
+	}
+
+	switch value {
+	case queryLanguageJSONata:
+		return true
+	case queryLanguageJSONPath:
+		return false
+	default:
+		v.errorf(joinDefinitionPath(path, "QueryLanguage"), "must be one of %s, %s, got %v", queryLanguageJSONPath, queryLanguageJSONata, value)
+
+		return jsonata
+	}
+}
+
+// validateStateMachine validates a top-level state machine, a Parallel state branch or a Map state iterator.
+// JSONPath and payload template checks are skipped in states that use JSONata.
+func (v *definitionValidator) validateStateMachine(tfMap map[string]interface{}, path string, jsonata bool) {
+	jsonata = v.queryLanguage(tfMap, path, jsonata)
+
+	startAt, ok := tfMap["StartAt"].(string)
+
+	if !ok {
+		v.errorf(joinDefinitionPath(path, "StartAt"), "is required and must be a string")
+	}
+
+	if n, ok := tfMap["TimeoutSeconds"]; ok && !isPositiveInteger(n) {
+		v.errorf(joinDefinitionPath(path, "TimeoutSeconds"), "must be a positive integer")
+	}
+
+	states, ok := tfMap["States"].(map[string]interface{})
+
+	if !ok || len(states) == 0 {
+		v.errorf(joinDefinitionPath(path, "States"), "is required and must be an object containing at least one state")
+
+		return
+	}
+
+	if startAt != "" {
+		if _, ok := states[startAt]; !ok {
+			v.errorf(joinDefinitionPath(path, "StartAt"), "state %q does not exist", startAt)
+			startAt = ""
+		}
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	transitions := make(map[string][]string)
+
+	for _, name := range names {
+		statePath := joinDefinitionPath(path, "States."+name)
+
+		if len(name) > stateNameMaxLength {
+			v.errorf(statePath, "state name cannot be longer than %d characters", stateNameMaxLength)
+		}
+
+		state, ok := states[name].(map[string]interface{})
+
+		if !ok {
+			v.errorf(statePath, "must be an object")
+
+			continue
+		}
+
+		transitions[name] = v.validateState(state, statePath, states, jsonata)
+	}
+
+	if startAt == "" {
+		return
+	}
+
+	// Every state must be reachable from StartAt.
+	reachable := map[string]bool{startAt: true}
+	queue := []string{startAt}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, next := range transitions[name] {
+			if !reachable[next] {
+				reachable[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !reachable[name] {
+			v.errorf(joinDefinitionPath(path, "States."+name), "state is unreachable from StartAt state %q", startAt)
+		}
+	}
+}
+
+// validateState validates a single state and returns the names of the states it can transition to.
+func (v *definitionValidator) validateState(tfMap map[string]interface{}, path string, states map[string]interface{}, jsonata bool) []string {
+	stateType, ok := tfMap["Type"].(string)
+
+	if !ok {
+		v.errorf(joinDefinitionPath(path, "Type"), "is required and must be a string")
+
+		return nil
+	}
+
+	jsonata = v.queryLanguage(tfMap, path, jsonata)
+
+	var transitions []string
+
+	switch stateType {
+	case stateTypeMap, stateTypeParallel, stateTypePass, stateTypeTask, stateTypeWait:
+		next, hasNext := tfMap["Next"]
+		end, hasEnd := tfMap["End"]
+
+		if hasEnd {
+			if _, ok := end.(bool); !ok {
+				v.errorf(joinDefinitionPath(path, "End"), "must be a boolean")
+			}
+		}
+
+		switch {
+		case hasNext && end == true:
+			v.errorf(path, `"Next" and "End" cannot both be set`)
+		case !hasNext && end != true:
+			v.errorf(path, `%s state must have either "Next" or "End": true`, stateType)
+		}
+
+		if hasNext {
+			if name, ok := v.validateTransition(next, joinDefinitionPath(path, "Next"), states); ok {
+				transitions = append(transitions, name)
+			}
+		}
+
+	case stateTypeChoice, stateTypeFail, stateTypeSucceed:
+		for _, key := range []string{"Next", "End"} {
+			if _, ok := tfMap[key]; ok {
+				v.errorf(joinDefinitionPath(path, key), "is not allowed in %s states", stateType)
+			}
+		}
+
+	default:
+		v.errorf(joinDefinitionPath(path, "Type"), "must be one of %s, got %q", strings.Join(stateType_Values(), ", "), stateType)
+
+		return nil
+	}
+
+	if !jsonata {
+		for _, key := range []string{"InputPath", "OutputPath"} {
+			if value, ok := tfMap[key]; ok && value != nil {
+				v.validatePath(value, joinDefinitionPath(path, key), false)
+			}
+		}
+
+		switch stateType {
+		case stateTypeMap, stateTypeParallel, stateTypePass, stateTypeTask:
+			if value, ok := tfMap["ResultPath"]; ok && value != nil {
+				v.validatePath(value, joinDefinitionPath(path, "ResultPath"), true)
+			}
+
+			if value, ok := tfMap["Parameters"]; ok {
+				v.validatePayloadTemplate(value, joinDefinitionPath(path, "Parameters"))
+			}
+		}
+
+		switch stateType {
+		case stateTypeMap, stateTypeParallel, stateTypeTask:
+			if value, ok := tfMap["ResultSelector"]; ok {
+				v.validatePayloadTemplate(value, joinDefinitionPath(path, "ResultSelector"))
+			}
+		}
+	}
+
+	switch stateType {
+	case stateTypeMap, stateTypeParallel, stateTypeTask:
+
+		if value, ok := tfMap["Retry"]; ok {
+			v.validateRetriers(value, joinDefinitionPath(path, "Retry"))
+		}
+
+		if value, ok := tfMap["Catch"]; ok {
+			transitions = append(transitions, v.validateCatchers(value, joinDefinitionPath(path, "Catch"), states, jsonata)...)
+		}
+	}
+
+	switch stateType {
+	case stateTypeChoice:
+		transitions = append(transitions, v.validateChoiceState(tfMap, path, states, jsonata)...)
+
+	case stateTypeMap:
+		iterator, ok := tfMap["Iterator"]
+		iteratorKey := "Iterator"
+
+		if !ok {
+			iterator, ok = tfMap["ItemProcessor"]
+			iteratorKey = "ItemProcessor"
+		}
+
+		if !ok {
+			v.errorf(path, `Map state must have an "Iterator"`)
+		} else if iterator, ok := iterator.(map[string]interface{}); ok {
+			v.validateStateMachine(iterator, joinDefinitionPath(path, iteratorKey), jsonata)
+		} else {
+			v.errorf(joinDefinitionPath(path, iteratorKey), "must be an object")
+		}
+
+		if value, ok := tfMap["ItemsPath"]; ok && !jsonata {
+			v.validatePath(value, joinDefinitionPath(path, "ItemsPath"), false)
+		}
+
+		if n, ok := tfMap["MaxConcurrency"]; ok && !isNonNegativeInteger(n) && !(jsonata && isJSONataExpression(n)) {
+			v.errorf(joinDefinitionPath(path, "MaxConcurrency"), "must be a non-negative integer")
+		}
+
+	case stateTypeParallel:
+		branches, ok := tfMap["Branches"].([]interface{})
+
+		if !ok || len(branches) == 0 {
+			v.errorf(joinDefinitionPath(path, "Branches"), "is required and must be an array containing at least one branch")
+
+			break
+		}
+
+		for i, branch := range branches {
+			branchPath := fmt.Sprintf("%s[%d]", joinDefinitionPath(path, "Branches"), i)
+
+			if branch, ok := branch.(map[string]interface{}); ok {
+				v.validateStateMachine(branch, branchPath, jsonata)
+			} else {
+				v.errorf(branchPath, "must be an object")
+			}
+		}
+
+	case stateTypeTask:
+		if resource, ok := tfMap["Resource"].(string); !ok {
+			v.errorf(joinDefinitionPath(path, "Resource"), "is required and must be a string")
+		} else if err := validTaskResource(resource); err != nil {
+			v.errorf(joinDefinitionPath(path, "Resource"), "%s", err)
+		}
+
+		for _, key := range []string{"HeartbeatSeconds", "TimeoutSeconds"} {
+			value, ok := tfMap[key]
+
+			if ok && !isPositiveInteger(value) && !(jsonata && isJSONataExpression(value)) {
+				v.errorf(joinDefinitionPath(path, key), "must be a positive integer")
+			}
+
+			if pathValue, ok := tfMap[key+"Path"]; ok && !jsonata {
+				if value != nil {
+					v.errorf(path, "%q and %q cannot both be set", key, key+"Path")
+				}
+
+				v.validatePath(pathValue, joinDefinitionPath(path, key+"Path"), true)
+			}
+		}
+
+		heartbeat, ok1 := tfMap["HeartbeatSeconds"].(json.Number)
+		timeout, ok2 := tfMap["TimeoutSeconds"].(json.Number)
+
+		if ok1 && ok2 && isPositiveInteger(heartbeat) && isPositiveInteger(timeout) && jsonNumberInt64(heartbeat) >= jsonNumberInt64(timeout) {
+			v.errorf(joinDefinitionPath(path, "HeartbeatSeconds"), "must be less than TimeoutSeconds (%s)", timeout)
+		}
+
+	case stateTypeWait:
+		var keys []string
+
+		for _, key := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := tfMap[key]; ok {
+				keys = append(keys, key)
+			}
+		}
+
+		if len(keys) != 1 {
+			v.errorf(path, `Wait state must have exactly one of "Seconds", "SecondsPath", "Timestamp" or "TimestampPath"`)
+		}
+
+		if value, ok := tfMap["Seconds"]; ok && !isNonNegativeInteger(value) && !(jsonata && isJSONataExpression(value)) {
+			v.errorf(joinDefinitionPath(path, "Seconds"), "must be a non-negative integer")
+		}
+
+		if value, ok := tfMap["Timestamp"]; ok && !(jsonata && isJSONataExpression(value)) {
+			if s, ok := value.(string); !ok || !isTimestamp(s) {
+				v.errorf(joinDefinitionPath(path, "Timestamp"), "must be an RFC3339 timestamp")
+			}
+		}
+
+		for _, key := range []string{"SecondsPath", "TimestampPath"} {
+			if value, ok := tfMap[key]; ok && !jsonata {
+				v.validatePath(value, joinDefinitionPath(path, key), true)
+			}
+		}
+	}
+
+	return transitions
+}
+
+func (v *definitionValidator) validateTransition(next interface{}, path string, states map[string]interface{}) (string, bool) {
+	name, ok := next.(string)
+
+	if !ok {
+		v.errorf(path, "must be a string")
+
+		return "", false
+	}
+
+	if _, ok := states[name]; !ok {
+		v.errorf(path, "state %q does not exist", name)
+
+		return "", false
+	}
+
+	return name, true
+}
+
+func (v *definitionValidator) validateChoiceState(tfMap map[string]interface{}, path string, states map[string]interface{}, jsonata bool) []string {
+	var transitions []string
+
+	choices, ok := tfMap["Choices"].([]interface{})
+
+	if !ok || len(choices) == 0 {
+		v.errorf(joinDefinitionPath(path, "Choices"), "is required and must be an array containing at least one rule")
+	}
+
+	for i, rule := range choices {
+		rulePath := fmt.Sprintf("%s[%d]", joinDefinitionPath(path, "Choices"), i)
+
+		rule, ok := rule.(map[string]interface{})
+
+		if !ok {
+			v.errorf(rulePath, "must be an object")
+
+			continue
+		}
+
+		if jsonata {
+			v.validateJSONataChoiceRule(rule, rulePath)
+		} else {
+			v.validateChoiceRule(rule, rulePath)
+		}
+
+		if next, ok := rule["Next"]; ok {
+			if name, ok := v.validateTransition(next, joinDefinitionPath(rulePath, "Next"), states); ok {
+				transitions = append(transitions, name)
+			}
+		} else {
+			v.errorf(rulePath, `choice rule must have a "Next" state`)
+		}
+	}
+
+	if next, ok := tfMap["Default"]; ok {
+		if name, ok := v.validateTransition(next, joinDefinitionPath(path, "Default"), states); ok {
+			transitions = append(transitions, name)
+		}
+	}
+
+	return transitions
+}
+
+// validateJSONataChoiceRule validates a choice rule of a state that uses JSONata,
+// which tests a "Condition" instead of a "Variable".
+func (v *definitionValidator) validateJSONataChoiceRule(tfMap map[string]interface{}, path string) {
+	switch value := tfMap["Condition"].(type) {
+	case bool:
+	case string:
+		if !isJSONataExpression(value) {
+			v.errorf(joinDefinitionPath(path, "Condition"), `must be a JSONata expression enclosed in "{%%" and "%%}", got %q`, value)
+		}
+	default:
+		v.errorf(path, `choice rule must have a "Condition"`)
+	}
+}
+
+// validateChoiceRule validates a choice rule, either a boolean expression or a data-test expression.
+// The rule's "Next" field, only allowed at the top level, is validated by the caller.
+func (v *definitionValidator) validateChoiceRule(tfMap map[string]interface{}, path string) {
+	var booleanOperators, comparisonOperators []string
+
+	for key := range tfMap {
+		if _, ok := choiceRuleComparisonOperators[key]; ok {
+			comparisonOperators = append(comparisonOperators, key)
+		}
+	}
+
+	for _, key := range choiceRuleBooleanExpressionOperands {
+		if _, ok := tfMap[key]; ok {
+			booleanOperators = append(booleanOperators, key)
+		}
+	}
+
+	sort.Strings(comparisonOperators)
+
+	if len(booleanOperators) > 0 {
+		if len(booleanOperators) > 1 || len(comparisonOperators) > 0 {
+			v.errorf(path, `choice rule must have exactly one of "And", "Or", "Not" or a comparison operator`)
+
+			return
+		}
+
+		if _, ok := tfMap["Variable"]; ok {
+			v.errorf(joinDefinitionPath(path, "Variable"), "is not allowed in a boolean expression")
+		}
+
+		operator := booleanOperators[0]
+		operatorPath := joinDefinitionPath(path, operator)
+
+		if operator == "Not" {
+			rule, ok := tfMap[operator].(map[string]interface{})
+
+			if !ok {
+				v.errorf(operatorPath, "must be an object")
+
+				return
+			}
+
+			v.validateNestedChoiceRule(rule, operatorPath)
+
+			return
+		}
+
+		rules, ok := tfMap[operator].([]interface{})
+
+		if !ok || len(rules) == 0 {
+			v.errorf(operatorPath, "must be an array containing at least one rule")
+
+			return
+		}
+
+		for i, rule := range rules {
+			rulePath := fmt.Sprintf("%s[%d]", operatorPath, i)
+
+			if rule, ok := rule.(map[string]interface{}); ok {
+				v.validateNestedChoiceRule(rule, rulePath)
+			} else {
+				v.errorf(rulePath, "must be an object")
+			}
+		}
+
+		return
+	}
+
+	if len(comparisonOperators) != 1 {
+		if len(comparisonOperators) == 0 {
+			v.warnf(path, `choice rule has none of "And", "Or", "Not" or a known comparison operator such as "StringEquals"`)
+		} else {
+			v.errorf(path, "choice rule must have exactly one comparison operator, got %s", strings.Join(comparisonOperators, ", "))
+		}
+
+		return
+	}
+
+	if variable, ok := tfMap["Variable"]; ok {
+		v.validatePath(variable, joinDefinitionPath(path, "Variable"), false)
+	} else {
+		v.errorf(path, `choice rule with a comparison operator must have a "Variable"`)
+	}
+
+	operator := comparisonOperators[0]
+	operatorPath := joinDefinitionPath(path, operator)
+	value := tfMap[operator]
+
+	switch choiceRuleComparisonOperators[operator] {
+	case choiceRuleOperandBoolean:
+		if _, ok := value.(bool); !ok {
+			v.errorf(operatorPath, "must be a boolean")
+		}
+	case choiceRuleOperandNumber:
+		if _, ok := value.(json.Number); !ok {
+			v.errorf(operatorPath, "must be a number")
+		}
+	case choiceRuleOperandPath:
+		v.validatePath(value, operatorPath, false)
+	case choiceRuleOperandString:
+		if _, ok := value.(string); !ok {
+			v.errorf(operatorPath, "must be a string")
+		}
+	case choiceRuleOperandTimestamp:
+		if s, ok := value.(string); !ok || !isTimestamp(s) {
+			v.errorf(operatorPath, "must be an RFC3339 timestamp")
+		}
+	}
+}
+
+func (v *definitionValidator) validateNestedChoiceRule(tfMap map[string]interface{}, path string) {
+	if _, ok := tfMap["Next"]; ok {
+		v.errorf(joinDefinitionPath(path, "Next"), "is only allowed in top-level choice rules")
+	}
+
+	v.validateChoiceRule(tfMap, path)
+}
+
+func (v *definitionValidator) validateRetriers(value interface{}, path string) {
+	retriers, ok := value.([]interface{})
+
+	if !ok {
+		v.errorf(path, "must be an array")
+
+		return
+	}
+
+	for i, retrier := range retriers {
+		retrierPath := fmt.Sprintf("%s[%d]", path, i)
+
+		retrier, ok := retrier.(map[string]interface{})
+
+		if !ok {
+			v.errorf(retrierPath, "must be an object")
+
+			continue
+		}
+
+		v.validateErrorEquals(retrier["ErrorEquals"], joinDefinitionPath(retrierPath, "ErrorEquals"), i == len(retriers)-1)
+
+		if n, ok := retrier["IntervalSeconds"]; ok && !isPositiveInteger(n) {
+			v.errorf(joinDefinitionPath(retrierPath, "IntervalSeconds"), "must be a positive integer")
+		}
+
+		if n, ok := retrier["MaxAttempts"]; ok && !isNonNegativeInteger(n) {
+			v.errorf(joinDefinitionPath(retrierPath, "MaxAttempts"), "must be a non-negative integer")
+		}
+
+		if n, ok := retrier["BackoffRate"]; ok {
+			if n, ok := n.(json.Number); !ok || jsonNumberFloat64(n) < 1.0 {
+				v.errorf(joinDefinitionPath(retrierPath, "BackoffRate"), "must be a number greater than or equal to 1.0")
+			}
+		}
+	}
+}
+
+func (v *definitionValidator) validateCatchers(value interface{}, path string, states map[string]interface{}, jsonata bool) []string {
+	catchers, ok := value.([]interface{})
+
+	if !ok {
+		v.errorf(path, "must be an array")
+
+		return nil
+	}
+
+	var transitions []string
+
+	for i, catcher := range catchers {
+		catcherPath := fmt.Sprintf("%s[%d]", path, i)
+
+		catcher, ok := catcher.(map[string]interface{})
+
+		if !ok {
+			v.errorf(catcherPath, "must be an object")
+
+			continue
+		}
+
+		v.validateErrorEquals(catcher["ErrorEquals"], joinDefinitionPath(catcherPath, "ErrorEquals"), i == len(catchers)-1)
+
+		if next, ok := catcher["Next"]; ok {
+			if name, ok := v.validateTransition(next, joinDefinitionPath(catcherPath, "Next"), states); ok {
+				transitions = append(transitions, name)
+			}
+		} else {
+			v.errorf(catcherPath, `catcher must have a "Next" state`)
+		}
+
+		if value, ok := catcher["ResultPath"]; ok && value != nil && !jsonata {
+			v.validatePath(value, joinDefinitionPath(catcherPath, "ResultPath"), true)
+		}
+	}
+
+	return transitions
+}
+
+func (v *definitionValidator) validateErrorEquals(value interface{}, path string, last bool) {
+	errorNames, ok := value.([]interface{})
+
+	if !ok || len(errorNames) == 0 {
+		v.errorf(path, "is required and must be an array containing at least one error name")
+
+		return
+	}
+
+	for _, errorName := range errorNames {
+		errorName, ok := errorName.(string)
+
+		if !ok {
+			v.errorf(path, "must contain only strings")
+
+			return
+		}
+
+		if errorName == errorNameAll && (len(errorNames) > 1 || !last) {
+			v.errorf(path, "%q must appear alone and only in the last retrier or catcher", errorNameAll)
+		}
+	}
+}
+
+// validatePayloadTemplate validates the fields of a Parameters or ResultSelector payload template
+// whose names end in ".$" and whose values are therefore JSONPaths or intrinsic functions.
+func (v *definitionValidator) validatePayloadTemplate(value interface{}, path string) {
+	switch value := value.(type) {
+	case []interface{}:
+		for i, value := range value {
+			v.validatePayloadTemplate(value, fmt.Sprintf("%s[%d]", path, i))
+		}
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			value := value[key]
+			fieldPath := joinDefinitionPath(path, key)
+
+			if !strings.HasSuffix(key, ".$") {
+				v.validatePayloadTemplate(value, fieldPath)
+
+				continue
+			}
+
+			s, ok := value.(string)
+
+			switch {
+			case !ok:
+				v.errorf(fieldPath, "must be a JSONPath or an intrinsic function")
+			case strings.HasPrefix(s, "$"):
+				if err := validJSONPath(s, false); err != nil {
+					v.errorf(fieldPath, "%s", err)
+				}
+			case intrinsicFunctionRegexp.MatchString(s):
+				unknown, err := validIntrinsicFunction(s)
+
+				if err != nil {
+					v.errorf(fieldPath, "%s", err)
+				}
+
+				for _, name := range unknown {
+					v.warnf(fieldPath, "unknown intrinsic function %q", name)
+				}
+			default:
+				v.errorf(fieldPath, "must be a JSONPath or an intrinsic function, got %q", s)
+			}
+		}
+	}
+}
+
+func (v *definitionValidator) validatePath(value interface{}, path string, reference bool) {
+	s, ok := value.(string)
+
+	if !ok {
+		v.errorf(path, "must be a string")
+
+		return
+	}
+
+	if err := validJSONPath(s, reference); err != nil {
+		v.errorf(path, "%s", err)
+	}
+}
+
+// validJSONPath checks the syntax of a JSONPath.
+// A path starts with "$" for the state input, "$$" for the context object or "$name" for a variable.
+// Reference paths, used where a path identifies a single node to write to such as ResultPath,
+// may only use dot notation and single names or indexes in brackets.
+func validJSONPath(s string, reference bool) error {
+	var i int
+
+	switch {
+	case strings.HasPrefix(s, "$$"):
+		i = 2
+	case strings.HasPrefix(s, "$"):
+		i = 1
+
+		for i < len(s) && isIdentifierByte(s[i]) {
+			i++
+		}
+	default:
+		return fmt.Errorf("JSONPath must start with \"$\", got %q", s)
+	}
+
+	for i < len(s) {
+		switch s[i] {
+		case '.':
+			i++
+
+			if i < len(s) && s[i] == '.' {
+				if reference {
+					return fmt.Errorf("reference path cannot use \"..\", got %q", s)
+				}
+
+				i++
+			}
+
+			start := i
+			for i < len(s) && s[i] != '.' && s[i] != '[' && s[i] != ']' {
+				i++
+			}
+
+			switch name := s[start:i]; {
+			case name == "":
+				return fmt.Errorf("JSONPath has an empty field name at position %d, got %q", start, s)
+			case name == "*" && reference:
+				return fmt.Errorf("reference path cannot use \"*\", got %q", s)
+			}
+
+		case '[':
+			end := jsonPathBracketEnd(s, i)
+
+			if end < 0 {
+				return fmt.Errorf("JSONPath has an unterminated \"[\" at position %d, got %q", i, s)
+			}
+
+			content := strings.TrimSpace(s[i+1 : end])
+
+			if reference {
+				if !jsonPathReferenceIndexRegexp.MatchString(content) && !jsonPathReferenceQuotedRegexp.MatchString(content) {
+					return fmt.Errorf("reference path can only use a single name or index in brackets, got %q", s)
+				}
+			} else if !(content == "*" || jsonPathIndexRegexp.MatchString(content) || jsonPathQuotedRegexp.MatchString(content) ||
+				jsonPathSliceRegexp.MatchString(content) || (strings.HasPrefix(content, "?(") && strings.HasSuffix(content, ")"))) {
+				return fmt.Errorf("JSONPath has an invalid bracket expression %q, got %q", content, s)
+			}
+
+			i = end + 1
+
+		default:
+			return fmt.Errorf("JSONPath has an unexpected %q at position %d, got %q", s[i], i, s)
+		}
+	}
+
+	return nil
+}
+
+// jsonPathBracketEnd returns the index of the "]" closing the "[" at position start, or -1.
+func jsonPathBracketEnd(s string, start int) int {
+	var quote byte
+	depth := 0
+
+	for i := start + 1; i < len(s); i++ {
+		c := s[i]
+
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch c {
+		case '\'', '"':
+			quote = c
+		case '(', '[':
+			depth++
+		case ')':
+			depth--
+		case ']':
+			if depth == 0 {
+				return i
+			}
+
+			depth--
+		}
+	}
+
+	return -1
+}
+
+// validIntrinsicFunction checks that the parentheses and quotes of an intrinsic function expression are balanced
+// and returns the names of any functions it calls that are not known.
+func validIntrinsicFunction(s string) ([]string, error) {
+	var quoted bool
+	var unknown []string
+	depth := 0
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if quoted {
+			if c == '\\' {
+				i++
+			} else if c == '\'' {
+				quoted = false
+			}
+
+			continue
+		}
+
+		switch c {
+		case '\'':
+			quoted = true
+
+		case '(':
+			j := i
+			for j > 0 && s[j-1] == ' ' {
+				j--
+			}
+
+			k := j
+			for k > 0 && (isIdentifierByte(s[k-1]) || s[k-1] == '.') {
+				k--
+			}
+
+			if name := s[k:j]; name != "" {
+				if _, ok := intrinsicFunctionNames[name]; !ok {
+					unknown = append(unknown, name)
+				}
+			}
+
+			depth++
+
+		case ')':
+			depth--
+
+			if depth < 0 {
+				return unknown, fmt.Errorf("intrinsic function has an unbalanced \")\", got %q", s)
+			}
+
+			if depth == 0 && strings.TrimSpace(s[i+1:]) != "" {
+				return unknown, fmt.Errorf("intrinsic function has unexpected characters after its closing \")\", got %q", s)
+			}
+		}
+	}
+
+	if quoted {
+		return unknown, fmt.Errorf("intrinsic function has an unterminated string, got %q", s)
+	}
+
+	if depth != 0 {
+		return unknown, fmt.Errorf("intrinsic function has an unbalanced \"(\", got %q", s)
+	}
+
+	return unknown, nil
+}
+
+// validTaskResource checks that a Task state's resource is an ARN and, for service integrations,
+// that it names a service API and an optional integration pattern.
+func validTaskResource(s string) error {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return fmt.Errorf("must be a valid ARN, got %q", s)
+	}
+
+	switch {
+	case parsedARN.Service == "states" && parsedARN.Region == "" && parsedARN.AccountID == "":
+		if !serviceIntegrationResourceRegexp.MatchString(parsedARN.Resource) {
+			return fmt.Errorf("must be a service integration ARN such as \"arn:%s:states:::lambda:invoke\", got %q", parsedARN.Partition, s)
+		}
+	case parsedARN.Service == "lambda":
+		if parsedARN.Region == "" || parsedARN.AccountID == "" || !strings.HasPrefix(parsedARN.Resource, "function:") {
+			return fmt.Errorf("must be a Lambda function ARN, got %q", s)
+		}
+	case parsedARN.Service == "states":
+		if parsedARN.Region == "" || parsedARN.AccountID == "" || !strings.HasPrefix(parsedARN.Resource, "activity:") {
+			return fmt.Errorf("must be an activity ARN, got %q", s)
+		}
+	case parsedARN.Resource == "":
+		return fmt.Errorf("must be a valid ARN, got %q", s)
+	}
+
+	return nil
+}
+
+// isJSONataExpression returns whether the value is a string containing a JSONata expression.
+func isJSONataExpression(v interface{}) bool {
+	s, ok := v.(string)
+
+	return ok && strings.HasPrefix(s, "{%") && strings.HasSuffix(s, "%}")
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+func isNonNegativeInteger(v interface{}) bool {
+	n, ok := v.(json.Number)
+
+	if !ok {
+		return false
+	}
+
+	i, err := n.Int64()
+
+	return err == nil && i >= 0
+}
+
+func isPositiveInteger(v interface{}) bool {
+	return isNonNegativeInteger(v) && jsonNumberInt64(v.(json.Number)) > 0
+}
+
+func jsonNumberFloat64(n json.Number) float64 {
+	f, _ := n.Float64()
+
+	return f
+}
+
+func jsonNumberInt64(n json.Number) int64 {
+	i, _ := n.Int64()
+
+	return i
+}
+
+func isTimestamp(s string) bool {
+	_, err := time.Parse(time.RFC3339, s)
+
+	return err == nil
+}
+
+func joinDefinitionPath(path, elem string) string {
+	if path == "" {
+		return elem
+	}
+
+	return path + "." + elem
+}
@@ -0,0 +1,412 @@
+package sfn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDefinition(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Definition       string
+		ExpectedErrors   []string
+		ExpectedWarnings []string
+	}{
+		{
+			Name: "valid",
+			Definition: `{
+  "Comment": "Order processing",
+  "StartAt": "Validate",
+  "TimeoutSeconds": 3600,
+  "States": {
+    "Validate": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {
+        "FunctionName": "arn:aws:lambda:us-east-1:123456789012:function:validate",
+        "Payload.$": "$",
+        "Id.$": "States.Format('order-{}', $.order['id'])",
+        "Items.$": "States.ArrayLength(States.StringToJson($.items))"
+      },
+      "ResultSelector": {
+        "valid.$": "$.Payload.valid"
+      },
+      "ResultPath": "$.validation",
+      "TimeoutSeconds": 60,
+      "HeartbeatSeconds": 30,
+      "Retry": [
+        {"ErrorEquals": ["Lambda.ServiceException"], "IntervalSeconds": 2, "MaxAttempts": 3, "BackoffRate": 1.5},
+        {"ErrorEquals": ["States.ALL"]}
+      ],
+      "Catch": [
+        {"ErrorEquals": ["States.ALL"], "ResultPath": "$.error", "Next": "Failed"}
+      ],
+      "Next": "IsValid"
+    },
+    "IsValid": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.validation.valid", "BooleanEquals": true, "Next": "Process"},
+        {
+          "And": [
+            {"Variable": "$.total", "NumericGreaterThan": 100},
+            {"Not": {"Variable": "$.placed", "TimestampLessThan": "2022-01-01T00:00:00Z"}}
+          ],
+          "Next": "Wait"
+        }
+      ],
+      "Default": "Failed"
+    },
+    "Wait": {
+      "Type": "Wait",
+      "SecondsPath": "$.delay",
+      "Next": "Process"
+    },
+    "Process": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Ship",
+          "States": {
+            "Ship": {"Type": "Task", "Resource": "arn:aws:states:us-east-1:123456789012:activity:ship", "End": true}
+          }
+        },
+        {
+          "StartAt": "Notify",
+          "States": {
+            "Notify": {"Type": "Task", "Resource": "arn:aws:states:::sns:publish.waitForTaskToken", "End": true}
+          }
+        }
+      ],
+      "Next": "Items"
+    },
+    "Items": {
+      "Type": "Map",
+      "ItemsPath": "$.items[*]",
+      "MaxConcurrency": 0,
+      "Iterator": {
+        "StartAt": "Item",
+        "States": {
+          "Item": {"Type": "Pass", "Parameters": {"value.$": "$$.Map.Item.Value"}, "End": true}
+        }
+      },
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed", "OutputPath": "$..id"},
+    "Failed": {"Type": "Fail", "Error": "OrderFailed"}
+  }
+}`,
+		},
+		{
+			Name:           "invalid JSON",
+			Definition:     `{"StartAt": }`,
+			ExpectedErrors: []string{"definition is invalid JSON"},
+		},
+		{
+			Name:           "not an object",
+			Definition:     `[]`,
+			ExpectedErrors: []string{"definition must be a JSON object"},
+		},
+		{
+			Name:       "missing StartAt and States",
+			Definition: `{}`,
+			ExpectedErrors: []string{
+				"StartAt: is required and must be a string",
+				"States: is required and must be an object containing at least one state",
+			},
+		},
+		{
+			Name:           "StartAt does not exist",
+			Definition:     `{"StartAt": "Missing", "States": {"A": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{`StartAt: state "Missing" does not exist`},
+		},
+		{
+			Name:           "unknown type",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Lambda", "End": true}}}`,
+			ExpectedErrors: []string{`States.A.Type: must be one of Choice, Fail, Map, Parallel, Pass, Succeed, Task, Wait, got "Lambda"`},
+		},
+		{
+			Name:           "missing Next and End",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+			ExpectedErrors: []string{`States.A: Pass state must have either "Next" or "End": true`},
+		},
+		{
+			Name:           "both Next and End",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B", "End": true}, "B": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{`States.A: "Next" and "End" cannot both be set`},
+		},
+		{
+			Name:           "Next does not exist",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}}}`,
+			ExpectedErrors: []string{`States.A.Next: state "B" does not exist`},
+		},
+		{
+			Name:           "End in terminal state",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Succeed", "End": true}}}`,
+			ExpectedErrors: []string{"States.A.End: is not allowed in Succeed states"},
+		},
+		{
+			Name:       "unreachable states",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Succeed"}, "B": {"Type": "Pass", "Next": "C"}, "C": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{
+				`States.B: state is unreachable from StartAt state "A"`,
+				`States.C: state is unreachable from StartAt state "A"`,
+			},
+		},
+		{
+			Name:           "unreachable state in branch",
+			Definition:     `{"StartAt": "P", "States": {"P": {"Type": "Parallel", "End": true, "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Succeed"}, "B": {"Type": "Succeed"}}}]}}}`,
+			ExpectedErrors: []string{`States.P.Branches[0].States.B: state is unreachable from StartAt state "A"`},
+		},
+		{
+			Name:           "catcher makes state reachable",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn:aws:states:::lambda:invoke", "End": true, "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "B"}]}, "B": {"Type": "Fail"}}}`,
+			ExpectedErrors: nil,
+		},
+		{
+			Name:           "choice without rules",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": []}}}`,
+			ExpectedErrors: []string{"States.A.Choices: is required and must be an array containing at least one rule"},
+		},
+		{
+			Name:           "choice rule without Next",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.a", "StringEquals": "x"}], "Default": "B"}, "B": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{`States.A.Choices[0]: choice rule must have a "Next" state`},
+		},
+		{
+			Name:             "choice rule unknown operator",
+			Definition:       `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.a", "StringEqual": "x", "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedWarnings: []string{`States.A.Choices[0]: choice rule has none of "And", "Or", "Not" or a known comparison operator such as "StringEquals"`},
+		},
+		{
+			Name:           "choice rule multiple operators",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.a", "StringEquals": "x", "IsPresent": true, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{"States.A.Choices[0]: choice rule must have exactly one comparison operator, got IsPresent, StringEquals"},
+		},
+		{
+			Name:       "choice rule operand types",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Or": [{"Variable": "$.a", "NumericEquals": "1"}, {"Variable": "$.b", "TimestampEquals": "yesterday"}, {"Variable": "$.c", "StringEqualsPath": "c"}], "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{
+				"States.A.Choices[0].Or[0].NumericEquals: must be a number",
+				"States.A.Choices[0].Or[1].TimestampEquals: must be an RFC3339 timestamp",
+				`States.A.Choices[0].Or[2].StringEqualsPath: JSONPath must start with "$", got "c"`,
+			},
+		},
+		{
+			Name:           "nested choice rule with Next",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Not": {"Variable": "$.a", "IsNull": true, "Next": "B"}, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{"States.A.Choices[0].Not.Next: is only allowed in top-level choice rules"},
+		},
+		{
+			Name:           "choice rule without Variable",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"IsPresent": true, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{`States.A.Choices[0]: choice rule with a comparison operator must have a "Variable"`},
+		},
+		{
+			Name:       "bad paths",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "a.b", "OutputPath": "$.a[", "ResultPath": "$.items[*]", "End": true}}}`,
+			ExpectedErrors: []string{
+				`States.A.InputPath: JSONPath must start with "$", got "a.b"`,
+				`States.A.OutputPath: JSONPath has an unterminated "[" at position 3, got "$.a["`,
+				`States.A.ResultPath: reference path can only use a single name or index in brackets, got "$.items[*]"`,
+			},
+		},
+		{
+			Name:           "null paths",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": null, "OutputPath": null, "ResultPath": null, "End": true}}}`,
+			ExpectedErrors: nil,
+		},
+		{
+			Name:           "reference path descendant",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "ResultPath": "$..a", "End": true}}}`,
+			ExpectedErrors: []string{`States.A.ResultPath: reference path cannot use "..", got "$..a"`},
+		},
+		{
+			Name:           "empty field name",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "$.a.", "End": true}}}`,
+			ExpectedErrors: []string{`States.A.InputPath: JSONPath has an empty field name at position 4, got "$.a."`},
+		},
+		{
+			Name:       "bad payload template",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"a.$": "x", "b": {"c.$": 1}, "d": [{"e.$": "$.e]"}]}, "End": true}}}`,
+			ExpectedErrors: []string{
+				`States.A.Parameters.a.$: must be a JSONPath or an intrinsic function, got "x"`,
+				"States.A.Parameters.b.c.$: must be a JSONPath or an intrinsic function",
+				`States.A.Parameters.d[0].e.$: JSONPath has an unexpected ']' at position 3, got "$.e]"`,
+			},
+		},
+		{
+			Name:             "unknown intrinsic function",
+			Definition:       `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"a.$": "States.Format('{}', States.Lower($.a))"}, "End": true}}}`,
+			ExpectedWarnings: []string{`States.A.Parameters.a.$: unknown intrinsic function "States.Lower"`},
+		},
+		{
+			Name:           "intrinsic function in string argument",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"a.$": "States.Format('States.Lower(\\'x\\') {}', $.a)"}, "End": true}}}`,
+			ExpectedErrors: nil,
+		},
+		{
+			Name:       "unbalanced intrinsic function",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"a.$": "States.Array($.a", "b.$": "States.UUID()) "}, "End": true}}}`,
+			ExpectedErrors: []string{
+				`States.A.Parameters.a.$: intrinsic function has an unbalanced "(", got "States.Array($.a"`,
+				`States.A.Parameters.b.$: intrinsic function has unexpected characters after its closing ")", got "States.UUID()) "`,
+			},
+		},
+		{
+			Name:       "malformed task resources",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "my-function", "Next": "B"}, "B": {"Type": "Task", "Resource": "arn:aws:states:::lambda", "Next": "C"}, "C": {"Type": "Task", "Resource": "arn:aws:lambda:us-east-1:123456789012:my-function", "Next": "D"}, "D": {"Type": "Task", "End": true}}}`,
+			ExpectedErrors: []string{
+				`States.A.Resource: must be a valid ARN, got "my-function"`,
+				`States.B.Resource: must be a service integration ARN such as "arn:aws:states:::lambda:invoke", got "arn:aws:states:::lambda"`,
+				`States.C.Resource: must be a Lambda function ARN, got "arn:aws:lambda:us-east-1:123456789012:my-function"`,
+				"States.D.Resource: is required and must be a string",
+			},
+		},
+		{
+			Name:       "task timeouts",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn:aws:states:::aws-sdk:s3:listBuckets", "TimeoutSeconds": 10, "TimeoutSecondsPath": "$.t", "HeartbeatSeconds": 20, "End": true}}}`,
+			ExpectedErrors: []string{
+				`States.A: "TimeoutSeconds" and "TimeoutSecondsPath" cannot both be set`,
+				"States.A.HeartbeatSeconds: must be less than TimeoutSeconds (10)",
+			},
+		},
+		{
+			Name:       "retriers and catchers",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn:aws:states:::ecs:runTask.sync", "Retry": [{"ErrorEquals": ["States.ALL"]}, {"ErrorEquals": []}, {"ErrorEquals": ["X"], "BackoffRate": 0.5, "IntervalSeconds": 0}], "Catch": [{"ErrorEquals": ["States.ALL", "X"]}], "End": true}}}`,
+			ExpectedErrors: []string{
+				`States.A.Retry[0].ErrorEquals: "States.ALL" must appear alone and only in the last retrier or catcher`,
+				"States.A.Retry[1].ErrorEquals: is required and must be an array containing at least one error name",
+				"States.A.Retry[2].IntervalSeconds: must be a positive integer",
+				"States.A.Retry[2].BackoffRate: must be a number greater than or equal to 1.0",
+				`States.A.Catch[0].ErrorEquals: "States.ALL" must appear alone and only in the last retrier or catcher`,
+				`States.A.Catch[0]: catcher must have a "Next" state`,
+			},
+		},
+		{
+			Name:       "wait",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Wait", "Seconds": 10, "Timestamp": "tomorrow", "Next": "B"}, "B": {"Type": "Wait", "Next": "C"}, "C": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{
+				`States.A: Wait state must have exactly one of "Seconds", "SecondsPath", "Timestamp" or "TimestampPath"`,
+				"States.A.Timestamp: must be an RFC3339 timestamp",
+				`States.B: Wait state must have exactly one of "Seconds", "SecondsPath", "Timestamp" or "TimestampPath"`,
+			},
+		},
+		{
+			Name:       "parallel and map",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Parallel", "Branches": [], "Next": "B"}, "B": {"Type": "Map", "MaxConcurrency": -1, "End": true}}}`,
+			ExpectedErrors: []string{
+				"States.A.Branches: is required and must be an array containing at least one branch",
+				`States.B: Map state must have an "Iterator"`,
+				"States.B.MaxConcurrency: must be a non-negative integer",
+			},
+		},
+		{
+			Name:           "map iterator",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Map", "ItemProcessor": {"StartAt": "X", "States": {"X": {"Type": "Pass"}}}, "End": true}}}`,
+			ExpectedErrors: []string{`States.A.ItemProcessor.States.X: Pass state must have either "Next" or "End": true`},
+		},
+		{
+			Name:           "variables",
+			Definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Assign": {"limit": 10}, "Parameters": {"a.$": "$limit", "b.$": "$order.items[0]"}, "Next": "B"}, "B": {"Type": "Choice", "Choices": [{"Variable": "$limit", "NumericGreaterThanPath": "$.count", "Next": "C"}], "Default": "C"}, "C": {"Type": "Succeed"}}}`,
+			ExpectedErrors: nil,
+		},
+		{
+			Name: "JSONata",
+			Definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Arguments": {"FunctionName": "arn:aws:lambda:us-east-1:123456789012:function:f", "Payload": "{% $states.input %}"},
+      "Output": "{% $states.result.Payload %}",
+      "Assign": {"total": "{% $states.result.Payload.total %}"},
+      "TimeoutSeconds": "{% $timeout %}",
+      "Next": "B"
+    },
+    "B": {
+      "Type": "Choice",
+      "Choices": [{"Condition": "{% $total > 100 %}", "Next": "C"}],
+      "Default": "C"
+    },
+    "C": {"Type": "Wait", "Seconds": "{% $delay %}", "End": true}
+  }
+}`,
+			ExpectedErrors: nil,
+		},
+		{
+			Name:       "JSONata state",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Choice", "QueryLanguage": "JSONata", "Choices": [{"Condition": "$x", "Next": "B"}, {"Variable": "$.a", "IsPresent": true, "Next": "B"}]}, "B": {"Type": "Pass", "QueryLanguage": "JSONPath", "OutputPath": "x", "End": true}}}`,
+			ExpectedErrors: []string{
+				`States.A.Choices[0].Condition: must be a JSONata expression enclosed in "{%" and "%}", got "$x"`,
+				`States.A.Choices[1]: choice rule must have a "Condition"`,
+				`States.B.OutputPath: JSONPath must start with "$", got "x"`,
+			},
+		},
+		{
+			Name:           "unknown query language",
+			Definition:     `{"QueryLanguage": "XPath", "StartAt": "A", "States": {"A": {"Type": "Succeed"}}}`,
+			ExpectedErrors: []string{"QueryLanguage: must be one of JSONPath, JSONata, got XPath"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			warnings, errs := validateDefinition(testCase.Definition)
+
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+
+			if len(got) != len(testCase.ExpectedErrors) {
+				t.Fatalf("expected %d errors, got %d:\n%s", len(testCase.ExpectedErrors), len(got), strings.Join(got, "\n"))
+			}
+
+			for i, expected := range testCase.ExpectedErrors {
+				if !strings.Contains(got[i], expected) {
+					t.Errorf("expected error %d to contain %q, got %q", i, expected, got[i])
+				}
+			}
+
+			if len(warnings) != len(testCase.ExpectedWarnings) {
+				t.Fatalf("expected %d warnings, got %d:\n%s", len(testCase.ExpectedWarnings), len(warnings), strings.Join(warnings, "\n"))
+			}
+
+			for i, expected := range testCase.ExpectedWarnings {
+				if !strings.Contains(warnings[i], expected) {
+					t.Errorf("expected warning %d to contain %q, got %q", i, expected, warnings[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCanonicalDefinition(t *testing.T) {
+	definition := `{"States":{"A":{"Type":"Pass","Result":{"n":1.50,"s":"<a&b>"},"End":true}},   "StartAt":"A"}`
+	expected := `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "End": true,
+      "Result": {
+        "n": 1.50,
+        "s": "<a&b>"
+      },
+      "Type": "Pass"
+    }
+  }
+}`
+
+	got, err := canonicalDefinition(definition)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
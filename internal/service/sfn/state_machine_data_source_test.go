@@ -15,7 +15,7 @@ func TestAccSFNStateMachineDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_sfn_state_machine.test"
 	resourceName := "aws_sfn_state_machine.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,80 @@
+package sfn
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourceStateMachineDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStateMachineDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 1024*1024), // 1048576
+					validStateMachineDefinition,
+				),
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceStateMachineDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	definition := d.Get("definition").(string)
+
+	warnings, errs := validateDefinition(definition)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("state machine definition is invalid: %w", errs[0])
+	}
+
+	for _, warning := range warnings {
+		log.Printf("[WARN] state machine definition: %s", warning)
+	}
+
+	jsonString, err := canonicalDefinition(definition)
+
+	if err != nil {
+		return err
+	}
+
+	v, _ := decodeDefinition(definition)
+	tfMap := v.(map[string]interface{})
+
+	var stateNames []string
+
+	for name := range tfMap["States"].(map[string]interface{}) {
+		stateNames = append(stateNames, name)
+	}
+
+	sort.Strings(stateNames)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("json", jsonString)
+	d.Set("start_at", tfMap["StartAt"])
+	d.Set("state_names", stateNames)
+
+	return nil
+}
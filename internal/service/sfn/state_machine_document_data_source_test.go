@@ -0,0 +1,89 @@
+package sfn_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSFNStateMachineDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_sfn_state_machine_document.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccStateMachineDocumentExpectedJSON),
+					resource.TestCheckResourceAttr(dataSourceName, "start_at", "Wait"),
+					resource.TestCheckResourceAttr(dataSourceName, "state_names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "state_names.0", "Done"),
+					resource.TestCheckResourceAttr(dataSourceName, "state_names.1", "Wait"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDocumentDataSource_invalid(t *testing.T) {
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDocumentDataSourceConfig_invalid,
+				ExpectError: regexp.MustCompile(`States.Wait.Next: state "Missing" does not exist`),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDocumentExpectedJSON = `{
+  "StartAt": "Wait",
+  "States": {
+    "Done": {
+      "Type": "Succeed"
+    },
+    "Wait": {
+      "Next": "Done",
+      "Seconds": 5,
+      "Type": "Wait"
+    }
+  }
+}`
+
+const testAccStateMachineDocumentDataSourceConfig_basic = `
+data "aws_sfn_state_machine_document" "test" {
+  definition = <<EOF
+{
+  "StartAt" : "Wait",
+  "States" : {
+    "Wait" : { "Type" : "Wait", "Seconds" : 5, "Next" : "Done" },
+    "Done" : { "Type" : "Succeed" }
+  }
+}
+EOF
+}
+`
+
+const testAccStateMachineDocumentDataSourceConfig_invalid = `
+data "aws_sfn_state_machine_document" "test" {
+  definition = jsonencode({
+    StartAt = "Wait"
+    States = {
+      Wait = {
+        Type    = "Wait"
+        Seconds = 5
+        Next    = "Missing"
+      }
+    }
+  })
+}
+`
@@ -21,7 +21,7 @@ func TestAccSFNStateMachine_createUpdate(t *testing.T) {
 	roleResourceName := "aws_iam_role.for_sfn"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -82,7 +82,7 @@ func TestAccSFNStateMachine_expressUpdate(t *testing.T) {
 	resourceName := "aws_sfn_state_machine.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -122,7 +122,7 @@ func TestAccSFNStateMachine_standardUpdate(t *testing.T) {
 	resourceName := "aws_sfn_state_machine.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -162,7 +162,7 @@ func TestAccSFNStateMachine_tags(t *testing.T) {
 	resourceName := "aws_sfn_state_machine.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -207,7 +207,7 @@ func TestAccSFNStateMachine_tracing(t *testing.T) {
 	resourceName := "aws_sfn_state_machine.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -243,7 +243,7 @@ func TestAccSFNStateMachine_disappears(t *testing.T) {
 	resourceName := "aws_sfn_state_machine.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -261,12 +261,29 @@ func TestAccSFNStateMachine_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_definitionInvalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_definitionInvalid(rName),
+				ExpectError: regexp.MustCompile(`States.Orphan: state is unreachable from StartAt state "HelloWorld"`),
+			},
+		},
+	})
+}
+
 func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	var sm sfn.DescribeStateMachineOutput
 	resourceName := "aws_sfn_state_machine.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -709,3 +726,27 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineConfig_definitionInvalid(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Pass",
+      "End": true
+    },
+    "Orphan": {
+      "Type": "Succeed"
+    }
+  }
+}
+EOF
+}
+`, rName))
+}
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package sfn_test
+
+import (
+	"testing"
+)
+
+func TestAccSFNActivity_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sfn_activity has no testAccActivityConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSFNStateMachine_TagsConsistency(t *testing.T) {
+	t.Skip("aws_sfn_state_machine has no testAccStateMachineConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -17,3 +17,17 @@ func validStateMachineName(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+func validStateMachineDefinition(v interface{}, k string) (ws []string, errors []error) {
+	warnings, errs := validateDefinition(v.(string))
+
+	for _, warning := range warnings {
+		ws = append(ws, fmt.Sprintf("%q: %s", k, warning))
+	}
+
+	for _, err := range errs {
+		errors = append(errors, fmt.Errorf("%q is not a valid Amazon States Language definition: %w", k, err))
+	}
+
+	return
+}
@@ -20,7 +20,7 @@ func TestAccShieldProtectionGroup_basic(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -55,7 +55,7 @@ func TestAccShieldProtectionGroup_disappears(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -81,7 +81,7 @@ func TestAccShieldProtectionGroup_aggregation(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -123,7 +123,7 @@ func TestAccShieldProtectionGroup_members(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -156,7 +156,7 @@ func TestAccShieldProtectionGroup_protectionGroupID(t *testing.T) {
 	testID1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	testID2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -198,7 +198,7 @@ func TestAccShieldProtectionGroup_resourceType(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -242,7 +242,7 @@ func TestAccShieldProtectionGroup_tags(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
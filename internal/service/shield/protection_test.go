@@ -21,7 +21,7 @@ func TestAccShieldProtection_globalAccelerator(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -52,7 +52,7 @@ func TestAccShieldProtection_elasticIPAddress(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -83,7 +83,7 @@ func TestAccShieldProtection_disappears(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -109,7 +109,7 @@ func TestAccShieldProtection_alb(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -140,7 +140,7 @@ func TestAccShieldProtection_elb(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -171,7 +171,7 @@ func TestAccShieldProtection_cloudFront(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -203,7 +203,7 @@ func TestAccShieldProtection_CloudFront_tags(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
@@ -255,7 +255,7 @@ func TestAccShieldProtection_route53(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
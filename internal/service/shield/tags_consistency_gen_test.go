@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package shield_test
+
+import (
+	"testing"
+)
+
+func TestAccShieldProtection_TagsConsistency(t *testing.T) {
+	t.Skip("aws_shield_protection has no testAccProtectionConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccShieldProtectionGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_shield_protection_group has no testAccProtectionGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -15,7 +15,7 @@ func TestAccSignerSigningJobDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_signer_signing_job.test"
 	resourceName := "aws_signer_signing_job.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
 		ErrorCheck:        acctest.ErrorCheck(t, signer.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
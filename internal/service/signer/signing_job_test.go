@@ -21,7 +21,7 @@ func TestAccSignerSigningJob_basic(t *testing.T) {
 	var job signer.DescribeSigningJobOutput
 	var conf signer.GetSigningProfileOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
 		ErrorCheck:        acctest.ErrorCheck(t, signer.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
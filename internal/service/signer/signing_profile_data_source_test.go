@@ -16,7 +16,7 @@ func TestAccSignerSigningProfileDataSource_basic(t *testing.T) {
 	rString := sdkacctest.RandString(48)
 	profileName := fmt.Sprintf("tf_acc_sp_basic_%s", rString)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
 		ErrorCheck:        acctest.ErrorCheck(t, signer.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -23,7 +23,7 @@ func TestAccSignerSigningProfilePermission_basic(t *testing.T) {
 	var conf signer.GetSigningProfileOutput
 	var sppconf signer.ListProfilePermissionsOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
 		ErrorCheck:        acctest.ErrorCheck(t, signer.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -57,7 +57,7 @@ func TestAccSignerSigningProfilePermission_getSigningProfile(t *testing.T) {
 	var conf signer.GetSigningProfileOutput
 	var sppconf signer.ListProfilePermissionsOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
 		ErrorCheck:        acctest.ErrorCheck(t, signer.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -99,7 +99,7 @@ func TestAccSignerSigningProfilePermission_StartSigningJob_getSP(t *testing.T) {
 	var conf signer.GetSigningProfileOutput
 	var sppconf signer.ListProfilePermissionsOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
 		ErrorCheck:        acctest.ErrorCheck(t, signer.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -133,7 +133,7 @@ func TestAccSignerSigningProfilePermission_statementPrefix(t *testing.T) {
 	//var conf signer.GetSigningProfileOutput
 	var sppconf signer.ListProfilePermissionsOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
 		ErrorCheck:        acctest.ErrorCheck(t, signer.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -16,7 +16,7 @@ import (
 func TestAccSimpleDBDomain_basic(t *testing.T) {
 	resourceName := "aws_simpledb_domain.test_domain"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(simpledb.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, simpledb.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -3,6 +3,7 @@ package sns
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -33,7 +34,7 @@ func FindSubscriptionAttributesByARN(conn *sns.SNS, arn string) (map[string]stri
 	return aws.StringValueMap(output.Attributes), nil
 }
 
-func FindTopicAttributesByARN(conn *sns.SNS, arn string) (map[string]string, error) {
+func FindTopicAttributesByARN(conn snsiface.SNSAPI, arn string) (map[string]string, error) {
 	input := &sns.GetTopicAttributesInput{
 		TopicArn: aws.String(arn),
 	}
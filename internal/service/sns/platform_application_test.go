@@ -160,7 +160,7 @@ func TestAccSNSPlatformApplication_basic(t *testing.T) {
 		}
 
 		t.Run(platform.Name, func(*testing.T) {
-			resource.ParallelTest(t, resource.TestCase{
+			acctest.ParallelTest(t, resource.TestCase{
 				PreCheck:          func() { acctest.PreCheck(t) },
 				ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 				ProviderFactories: acctest.ProviderFactories,
@@ -211,7 +211,7 @@ func TestAccSNSPlatformApplication_basicAttributes(t *testing.T) {
 				t.Run(fmt.Sprintf("%s/%s", platform.Name, tc.AttributeKey), func(*testing.T) {
 					name := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
 
-					resource.ParallelTest(t, resource.TestCase{
+					acctest.ParallelTest(t, resource.TestCase{
 						PreCheck:          func() { acctest.PreCheck(t) },
 						ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 						ProviderFactories: acctest.ProviderFactories,
@@ -262,7 +262,7 @@ func TestAccSNSPlatformApplication_iamRoleAttributes(t *testing.T) {
 					iamRoleName2 := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
 					name := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
 
-					resource.ParallelTest(t, resource.TestCase{
+					acctest.ParallelTest(t, resource.TestCase{
 						PreCheck:          func() { acctest.PreCheck(t) },
 						ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 						ProviderFactories: acctest.ProviderFactories,
@@ -315,7 +315,7 @@ func TestAccSNSPlatformApplication_snsTopicAttributes(t *testing.T) {
 					snsTopicName2 := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
 					name := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
 
-					resource.ParallelTest(t, resource.TestCase{
+					acctest.ParallelTest(t, resource.TestCase{
 						PreCheck:          func() { acctest.PreCheck(t) },
 						ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 						ProviderFactories: acctest.ProviderFactories,
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -211,7 +212,7 @@ func ResourceTopic() *schema.Resource {
 }
 
 func resourceTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSAPI()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -287,7 +288,7 @@ func resourceTopicCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSAPI()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -350,7 +351,7 @@ func resourceTopicRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSAPI()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		attributes, err := topicAttributeMap.ResourceDataToAPIAttributesUpdate(d)
@@ -386,7 +387,7 @@ func resourceTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSAPI()
 
 	log.Printf("[DEBUG] Deleting SNS Topic: %s", d.Id())
 	_, err := conn.DeleteTopic(&sns.DeleteTopicInput{
@@ -440,7 +441,7 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 	return nil
 }
 
-func putTopicAttributes(conn *sns.SNS, arn string, attributes map[string]string) error {
+func putTopicAttributes(conn snsiface.SNSAPI, arn string, attributes map[string]string) error {
 	for name, value := range attributes {
 		// Ignore an empty policy.
 		if name == TopicAttributeNamePolicy && value == "" {
@@ -457,7 +458,7 @@ func putTopicAttributes(conn *sns.SNS, arn string, attributes map[string]string)
 	return nil
 }
 
-func putTopicAttribute(conn *sns.SNS, arn string, name, value string) error {
+func putTopicAttribute(conn snsiface.SNSAPI, arn string, name, value string) error {
 	input := &sns.SetTopicAttributesInput{
 		AttributeName:  aws.String(name),
 		AttributeValue: aws.String(value),
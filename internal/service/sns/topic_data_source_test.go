@@ -15,7 +15,7 @@ func TestAccSNSTopicDataSource_basic(t *testing.T) {
 	datasourceName := "data.aws_sns_topic.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
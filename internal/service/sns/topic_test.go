@@ -10,17 +10,24 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func init() {
 	acctest.RegisterServiceErrorCheckFunc(sns.EndpointsID, testAccErrorCheckSkip)
+	acctest.RegisterEmulatorCompatibleTests(
+		"TestAccSNSTopic_basic",
+		"TestAccSNSTopic_tags",
+	)
 }
 
 func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
@@ -34,7 +41,7 @@ func TestAccSNSTopic_basic(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -86,7 +93,7 @@ func TestAccSNSTopic_name(t *testing.T) {
 	resourceName := "aws_sns_topic.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -114,7 +121,7 @@ func TestAccSNSTopic_namePrefix(t *testing.T) {
 	resourceName := "aws_sns_topic.test"
 	rName := "tf-acc-test-prefix-"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -144,7 +151,7 @@ func TestAccSNSTopic_policy(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	expectedPolicy := fmt.Sprintf(`{"Statement":[{"Sid":"Stmt1445931846145","Effect":"Allow","Principal":{"AWS":"*"},"Action":"sns:Publish","Resource":"arn:%s:sns:%s::example"}],"Version":"2012-10-17","Id":"Policy1445931846145"}`, acctest.Partition(), acctest.Region())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -171,7 +178,7 @@ func TestAccSNSTopic_withIAMRole(t *testing.T) {
 	resourceName := "aws_sns_topic.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -194,7 +201,7 @@ func TestAccSNSTopic_withIAMRole(t *testing.T) {
 
 func TestAccSNSTopic_withFakeIAMRole(t *testing.T) {
 	rName := sdkacctest.RandString(10)
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -214,7 +221,7 @@ func TestAccSNSTopic_withDeliveryPolicy(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	expectedPolicy := `{"http":{"defaultHealthyRetryPolicy": {"minDelayTarget": 20,"maxDelayTarget": 20,"numMaxDelayRetries": 0,"numRetries": 3,"numNoDelayRetries": 0,"numMinDelayRetries": 0,"backoffFunction": "linear"},"disableSubscriptionOverrides": false}}`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -243,7 +250,7 @@ func TestAccSNSTopic_deliveryStatus(t *testing.T) {
 
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -283,7 +290,7 @@ func TestAccSNSTopic_NameGenerated_fifoTopic(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -312,7 +319,7 @@ func TestAccSNSTopic_Name_fifoTopic(t *testing.T) {
 	resourceName := "aws_sns_topic.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix) + tfsns.FIFOTopicNameSuffix
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -340,7 +347,7 @@ func TestAccSNSTopic_NamePrefix_fifoTopic(t *testing.T) {
 	resourceName := "aws_sns_topic.test"
 	rName := "tf-acc-test-prefix-"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -369,7 +376,7 @@ func TestAccSNSTopic_fifoWithContentBasedDeduplication(t *testing.T) {
 	resourceName := "aws_sns_topic.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -402,7 +409,7 @@ func TestAccSNSTopic_fifoWithContentBasedDeduplication(t *testing.T) {
 
 func TestAccSNSTopic_fifoExpectContentBasedDeduplicationError(t *testing.T) {
 	rName := sdkacctest.RandString(10)
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -421,7 +428,7 @@ func TestAccSNSTopic_encryption(t *testing.T) {
 	resourceName := "aws_sns_topic.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -455,7 +462,7 @@ func TestAccSNSTopic_tags(t *testing.T) {
 	resourceName := "aws_sns_topic.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -499,7 +506,7 @@ func TestAccSNSTopic_disappears(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -951,6 +958,16 @@ resource "aws_sns_topic" "test" {
 `, r, tag1Key, tag1Value)
 }
 
+func testAccTopicConfig_tagsConsistency(rName, tags string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  tags = %[2]s
+}
+`, rName, tags)
+}
+
 func testAccTopicConfig_tags2(r, tag1Key, tag1Value, tag2Key, tag2Value string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -963,3 +980,45 @@ resource "aws_sns_topic" "test" {
 }
 `, r, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func TestSNSTopic_fake(t *testing.T) {
+	client := acctest.FakeClient(t, map[string]interface{}{
+		names.SNS: fake.NewSNS(),
+	})
+	rName := "tf-fake-topic"
+
+	acctest.FakeResourceTest(t, tfsns.ResourceTopic(), client,
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"name": rName,
+			},
+			Check: func(d *schema.ResourceData) error {
+				if got, want := d.Get("arn").(string), fmt.Sprintf("arn:%s:sns:%s:%s:%s", fake.Partition, fake.Region, fake.AccountID, rName); got != want {
+					return fmt.Errorf("arn = %q, want %q", got, want)
+				}
+				if got, want := d.Get("owner").(string), fake.AccountID; got != want {
+					return fmt.Errorf("owner = %q, want %q", got, want)
+				}
+				return nil
+			},
+		},
+		acctest.FakeResourceStep{
+			Config: map[string]interface{}{
+				"name":         rName,
+				"display_name": "updated",
+				"tags": map[string]interface{}{
+					"key1": "value1",
+				},
+			},
+			Check: func(d *schema.ResourceData) error {
+				if got, want := d.Get("display_name").(string), "updated"; got != want {
+					return fmt.Errorf("display_name = %q, want %q", got, want)
+				}
+				if got, want := d.Get("tags_all.key1").(string), "value1"; got != want {
+					return fmt.Errorf("tags_all.key1 = %q, want %q", got, want)
+				}
+				return nil
+			},
+		},
+	)
+}
@@ -37,8 +37,12 @@ func FindQueueAttributesByURL(conn *sqs.SQS, url string) (map[string]string, err
 }
 
 func FindQueuePolicyByURL(conn *sqs.SQS, url string) (string, error) {
+	return FindQueueAttributeByURL(conn, url, sqs.QueueAttributeNamePolicy)
+}
+
+func FindQueueAttributeByURL(conn *sqs.SQS, url string, attributeName string) (string, error) {
 	input := &sqs.GetQueueAttributesInput{
-		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNamePolicy}),
+		AttributeNames: aws.StringSlice([]string{attributeName}),
 		QueueUrl:       aws.String(url),
 	}
 
@@ -62,7 +66,7 @@ func FindQueuePolicyByURL(conn *sqs.SQS, url string) (string, error) {
 		}
 	}
 
-	v, ok := output.Attributes[sqs.QueueAttributeNamePolicy]
+	v, ok := output.Attributes[attributeName]
 
 	if !ok || aws.StringValue(v) == "" {
 		return "", &resource.NotFoundError{
@@ -1,6 +1,7 @@
 package sqs
 
 import (
+	"encoding/json"
 	"testing"
 )
 
@@ -63,3 +64,49 @@ func TestStringsEquivalentWhitespaceAndNoWhitespace(t *testing.T) {
 		t.Errorf("Expected StringsEquivalent to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
 	}
 }
+
+func TestQueueRedrivePolicyUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		json     string
+		expected queueRedrivePolicy
+		err      bool
+	}{
+		{
+			json:     `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":5}`,                //lintignore:AWSAT003,AWSAT005
+			expected: queueRedrivePolicy{DeadLetterTargetARN: "arn:aws:sqs:us-west-2:123456789012:dlq", MaxReceiveCount: 5}, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			json:     `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":"10"}`,              //lintignore:AWSAT003,AWSAT005
+			expected: queueRedrivePolicy{DeadLetterTargetARN: "arn:aws:sqs:us-west-2:123456789012:dlq", MaxReceiveCount: 10}, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			json:     `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq"}`,                //lintignore:AWSAT003,AWSAT005
+			expected: queueRedrivePolicy{DeadLetterTargetARN: "arn:aws:sqs:us-west-2:123456789012:dlq"}, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			json: `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":"five"}`, //lintignore:AWSAT003,AWSAT005
+			err:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var got queueRedrivePolicy
+		err := json.Unmarshal([]byte(testCase.json), &got)
+
+		if testCase.err {
+			if err == nil {
+				t.Errorf("%s: expected error", testCase.json)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: %s", testCase.json, err)
+		}
+
+		if got != testCase.expected {
+			t.Errorf("%s: expected %+v, got %+v", testCase.json, testCase.expected, got)
+		}
+	}
+}
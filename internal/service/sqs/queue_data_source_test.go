@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAccSQSQueueDataSource_basic(t *testing.T) {
@@ -16,7 +20,7 @@ func TestAccSQSQueueDataSource_basic(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	datasourceName := "data.aws_sqs_queue.by_name"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -37,7 +41,7 @@ func TestAccSQSQueueDataSource_tags(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	datasourceName := "data.aws_sqs_queue.by_name"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -121,3 +125,38 @@ data "aws_sqs_queue" "by_name" {
 }
 `, rName)
 }
+
+func TestSQSQueueDataSource_fake(t *testing.T) {
+	rName := "tf-fake-queue"
+	sqsFake := fake.NewSQS()
+	client := acctest.FakeClient(t, map[string]interface{}{
+		names.SQS: sqsFake,
+	})
+
+	output, err := sqsFake.CreateQueue(&sqs.CreateQueueInput{
+		QueueName: aws.String(rName),
+		Tags: aws.StringMap(map[string]string{
+			"Environment": "test",
+		}),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := acctest.FakeDataSourceRead(t, tfsqs.DataSourceQueue(), client, map[string]interface{}{
+		"name": rName,
+	})
+
+	if got, want := d.Get("url").(string), aws.StringValue(output.QueueUrl); got != want {
+		t.Errorf("url = %q, want %q", got, want)
+	}
+
+	if got, want := d.Get("arn").(string), fmt.Sprintf("arn:%s:sqs:%s:%s:%s", fake.Partition, fake.Region, fake.AccountID, rName); got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	if got, want := d.Get("tags.Environment").(string), "test"; got != want {
+		t.Errorf("tags.Environment = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,186 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var (
+	queueEmptyRedriveAllowPolicyAttributes = map[string]string{
+		sqs.QueueAttributeNameRedriveAllowPolicy: "",
+	}
+)
+
+func ResourceQueueRedriveAllowPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceQueueRedriveAllowPolicyUpsert,
+		Read:   resourceQueueRedriveAllowPolicyRead,
+		Update: resourceQueueRedriveAllowPolicyUpsert,
+		Delete: resourceQueueRedriveAllowPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceQueueRedriveAllowPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"redrive_permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(RedrivePermission_Values(), false),
+			},
+			"source_queue_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceQueueRedriveAllowPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	apiObject := &queueRedriveAllowPolicy{
+		RedrivePermission: d.Get("redrive_permission").(string),
+	}
+
+	if v, ok := d.GetOk("source_queue_arns"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.SourceQueueARNs = aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))
+	}
+
+	policy, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return fmt.Errorf("error marshaling SQS Queue Redrive Allow Policy: %w", err)
+	}
+
+	policyAttributes := map[string]string{
+		sqs.QueueAttributeNameRedriveAllowPolicy: string(policy),
+	}
+
+	url := d.Get("queue_url").(string)
+	input := &sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(policyAttributes),
+		QueueUrl:   aws.String(url),
+	}
+
+	log.Printf("[DEBUG] Setting SQS Queue Redrive Allow Policy: %s", input)
+	_, err = conn.SetQueueAttributes(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting SQS Queue Redrive Allow Policy (%s): %w", url, err)
+	}
+
+	d.SetId(url)
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), policyAttributes)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Allow Policy (%s) to be set: %w", d.Id(), err)
+	}
+
+	return resourceQueueRedriveAllowPolicyRead(d, meta)
+}
+
+func resourceQueueRedriveAllowPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	outputRaw, err := tfresource.RetryWhenNotFound(queueRedrivePolicyReadTimeout, func() (interface{}, error) {
+		return FindQueueAttributeByURL(conn, d.Id(), sqs.QueueAttributeNameRedriveAllowPolicy)
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue Redrive Allow Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queue Redrive Allow Policy (%s): %w", d.Id(), err)
+	}
+
+	policy := &queueRedriveAllowPolicy{}
+
+	if err := json.Unmarshal([]byte(outputRaw.(string)), policy); err != nil {
+		return fmt.Errorf("error parsing SQS Queue Redrive Allow Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("queue_url", d.Id())
+	d.Set("redrive_permission", policy.RedrivePermission)
+	d.Set("source_queue_arns", policy.SourceQueueARNs)
+
+	return nil
+}
+
+func resourceQueueRedriveAllowPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	log.Printf("[DEBUG] Deleting SQS Queue Redrive Allow Policy: %s", d.Id())
+	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(queueEmptyRedriveAllowPolicyAttributes),
+		QueueUrl:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SQS Queue Redrive Allow Policy (%s): %w", d.Id(), err)
+	}
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), queueEmptyRedriveAllowPolicyAttributes)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Allow Policy (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceQueueRedriveAllowPolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("redrive_permission") || !diff.NewValueKnown("source_queue_arns") {
+		return nil
+	}
+
+	redrivePermission := diff.Get("redrive_permission").(string)
+	n := diff.Get("source_queue_arns").(*schema.Set).Len()
+
+	if redrivePermission == RedrivePermissionByQueue && n == 0 {
+		return fmt.Errorf("source_queue_arns must be set when redrive_permission is %q", RedrivePermissionByQueue)
+	}
+
+	if redrivePermission != RedrivePermissionByQueue && n > 0 {
+		return fmt.Errorf("source_queue_arns can only be set when redrive_permission is %q", RedrivePermissionByQueue)
+	}
+
+	return nil
+}
+
+// queueRedriveAllowPolicy is the JSON representation of the RedriveAllowPolicy queue attribute.
+type queueRedriveAllowPolicy struct {
+	RedrivePermission string   `json:"redrivePermission"`
+	SourceQueueARNs   []string `json:"sourceQueueArns,omitempty"`
+}
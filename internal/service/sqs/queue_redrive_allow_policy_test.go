@@ -0,0 +1,192 @@
+package sqs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+)
+
+func TestAccSQSQueueRedriveAllowPolicy_basic(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_allow_policy.test"
+	dlqResourceName := "aws_sqs_queue.dlq"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveAllowPolicyConfig_byQueue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(dlqResourceName, &queueAttributes),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", dlqResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_permission", "byQueue"),
+					resource.TestCheckResourceAttr(resourceName, "source_queue_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "source_queue_arns.*", "aws_sqs_queue.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueRedriveAllowPolicyConfig_permission(rName, "denyAll"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "redrive_permission", "denyAll"),
+					resource.TestCheckResourceAttr(resourceName, "source_queue_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedriveAllowPolicy_disappears(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_allow_policy.test"
+	dlqResourceName := "aws_sqs_queue.dlq"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveAllowPolicyConfig_byQueue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(dlqResourceName, &queueAttributes),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsqs.ResourceQueueRedriveAllowPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedriveAllowPolicy_sourceQueueARNsValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueRedriveAllowPolicyConfig_permission(rName, "byQueue"),
+				ExpectError: regexp.MustCompile(`source_queue_arns must be set`),
+			},
+		},
+	})
+}
+
+// A DLQ allowing redrive from a queue whose redrive policy targets the DLQ,
+// which can't be expressed with inline attributes without a dependency cycle.
+func TestAccSQSQueueRedriveAllowPolicy_withRedrivePolicy(t *testing.T) {
+	var queueAttributes map[string]string
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveAllowPolicyConfig_withRedrivePolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					resource.TestCheckResourceAttr("aws_sqs_queue_redrive_allow_policy.test", "redrive_permission", "byQueue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue_redrive_policy.test", "max_receive_count", "3"),
+				),
+			},
+			{
+				Config:   testAccQueueRedriveAllowPolicyConfig_withRedrivePolicy(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccQueueRedriveAllowPolicyConfig_byQueue(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+
+  lifecycle {
+    ignore_changes = [redrive_allow_policy]
+  }
+}
+
+resource "aws_sqs_queue_redrive_allow_policy" "test" {
+  queue_url          = aws_sqs_queue.dlq.id
+  redrive_permission = "byQueue"
+  source_queue_arns  = [aws_sqs_queue.test.arn]
+}
+`, rName)
+}
+
+func testAccQueueRedriveAllowPolicyConfig_permission(rName, redrivePermission string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+
+  lifecycle {
+    ignore_changes = [redrive_allow_policy]
+  }
+}
+
+resource "aws_sqs_queue_redrive_allow_policy" "test" {
+  queue_url          = aws_sqs_queue.dlq.id
+  redrive_permission = %[2]q
+}
+`, rName, redrivePermission)
+}
+
+func testAccQueueRedriveAllowPolicyConfig_withRedrivePolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [redrive_policy]
+  }
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+
+  lifecycle {
+    ignore_changes = [redrive_allow_policy]
+  }
+}
+
+resource "aws_sqs_queue_redrive_policy" "test" {
+  queue_url              = aws_sqs_queue.test.id
+  dead_letter_target_arn = aws_sqs_queue.dlq.arn
+  max_receive_count      = 3
+}
+
+resource "aws_sqs_queue_redrive_allow_policy" "test" {
+  queue_url          = aws_sqs_queue.dlq.id
+  redrive_permission = "byQueue"
+  source_queue_arns  = [aws_sqs_queue.test.arn]
+}
+`, rName)
+}
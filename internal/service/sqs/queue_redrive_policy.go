@@ -0,0 +1,190 @@
+package sqs
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var (
+	queueEmptyRedrivePolicyAttributes = map[string]string{
+		sqs.QueueAttributeNameRedrivePolicy: "",
+	}
+)
+
+func ResourceQueueRedrivePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceQueueRedrivePolicyUpsert,
+		Read:   resourceQueueRedrivePolicyRead,
+		Update: resourceQueueRedrivePolicyUpsert,
+		Delete: resourceQueueRedrivePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dead_letter_target_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"max_receive_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceQueueRedrivePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	policy, err := json.Marshal(&queueRedrivePolicy{
+		DeadLetterTargetARN: d.Get("dead_letter_target_arn").(string),
+		MaxReceiveCount:     d.Get("max_receive_count").(int),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error marshaling SQS Queue Redrive Policy: %w", err)
+	}
+
+	policyAttributes := map[string]string{
+		sqs.QueueAttributeNameRedrivePolicy: string(policy),
+	}
+
+	url := d.Get("queue_url").(string)
+	input := &sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(policyAttributes),
+		QueueUrl:   aws.String(url),
+	}
+
+	log.Printf("[DEBUG] Setting SQS Queue Redrive Policy: %s", input)
+	_, err = conn.SetQueueAttributes(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting SQS Queue Redrive Policy (%s): %w", url, err)
+	}
+
+	d.SetId(url)
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), policyAttributes)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Policy (%s) to be set: %w", d.Id(), err)
+	}
+
+	return resourceQueueRedrivePolicyRead(d, meta)
+}
+
+func resourceQueueRedrivePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	outputRaw, err := tfresource.RetryWhenNotFound(queueRedrivePolicyReadTimeout, func() (interface{}, error) {
+		return FindQueueAttributeByURL(conn, d.Id(), sqs.QueueAttributeNameRedrivePolicy)
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue Redrive Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queue Redrive Policy (%s): %w", d.Id(), err)
+	}
+
+	policy := &queueRedrivePolicy{}
+
+	if err := json.Unmarshal([]byte(outputRaw.(string)), policy); err != nil {
+		return fmt.Errorf("error parsing SQS Queue Redrive Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("dead_letter_target_arn", policy.DeadLetterTargetARN)
+	d.Set("max_receive_count", policy.MaxReceiveCount)
+	d.Set("queue_url", d.Id())
+
+	return nil
+}
+
+func resourceQueueRedrivePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	log.Printf("[DEBUG] Deleting SQS Queue Redrive Policy: %s", d.Id())
+	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(queueEmptyRedrivePolicyAttributes),
+		QueueUrl:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SQS Queue Redrive Policy (%s): %w", d.Id(), err)
+	}
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), queueEmptyRedrivePolicyAttributes)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Policy (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// queueRedrivePolicy is the JSON representation of the RedrivePolicy queue attribute.
+type queueRedrivePolicy struct {
+	DeadLetterTargetARN string `json:"deadLetterTargetArn"`
+	MaxReceiveCount     int    `json:"maxReceiveCount"`
+}
+
+// UnmarshalJSON accepts maxReceiveCount as either a number or a string,
+// as queues created outside of Terraform may have either.
+func (p *queueRedrivePolicy) UnmarshalJSON(b []byte) error {
+	var v struct {
+		DeadLetterTargetARN string          `json:"deadLetterTargetArn"`
+		MaxReceiveCount     json.RawMessage `json:"maxReceiveCount"`
+	}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	p.DeadLetterTargetARN = v.DeadLetterTargetARN
+	p.MaxReceiveCount = 0
+
+	if len(v.MaxReceiveCount) == 0 {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(v.MaxReceiveCount, &s); err == nil {
+		n, err := strconv.Atoi(s)
+
+		if err != nil {
+			return fmt.Errorf("invalid maxReceiveCount (%s): %w", s, err)
+		}
+
+		p.MaxReceiveCount = n
+
+		return nil
+	}
+
+	return json.Unmarshal(v.MaxReceiveCount, &p.MaxReceiveCount)
+}
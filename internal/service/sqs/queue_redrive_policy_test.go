@@ -0,0 +1,123 @@
+package sqs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+)
+
+func TestAccSQSQueueRedrivePolicy_basic(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	dlqResourceName := "aws_sqs_queue.dlq"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedrivePolicyConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_target_arn", dlqResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "max_receive_count", "5"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", queueResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueRedrivePolicyConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_receive_count", "10"),
+				),
+			},
+			{
+				// The inline redrive_policy attribute doesn't diff when the standalone resource is used.
+				Config:   testAccQueueRedrivePolicyConfig_basic(rName, 10),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedrivePolicy_disappears(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedrivePolicyConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsqs.ResourceQueueRedrivePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedrivePolicy_Disappears_queue(t *testing.T) {
+	var queueAttributes map[string]string
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedrivePolicyConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsqs.ResourceQueue(), queueResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccQueueRedrivePolicyConfig_basic(rName string, maxReceiveCount int) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [redrive_policy]
+  }
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+}
+
+resource "aws_sqs_queue_redrive_policy" "test" {
+  queue_url              = aws_sqs_queue.test.id
+  dead_letter_target_arn = aws_sqs_queue.dlq.arn
+  max_receive_count      = %[2]d
+}
+`, rName, maxReceiveCount)
+}
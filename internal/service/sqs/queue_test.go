@@ -20,6 +20,11 @@ import (
 
 func init() {
 	acctest.RegisterServiceErrorCheckFunc(sqs.EndpointsID, testAccErrorCheckSkip)
+	acctest.RegisterEmulatorCompatibleTests(
+		"TestAccSQSQueue_basic",
+		"TestAccSQSQueue_tags",
+		"TestAccSQSQueueDataSource_basic",
+	)
 }
 
 func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
@@ -33,7 +38,7 @@ func TestAccSQSQueue_basic(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -78,7 +83,7 @@ func TestAccSQSQueue_disappears(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -100,7 +105,7 @@ func TestAccSQSQueue_Name_generated(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
@@ -128,7 +133,7 @@ func TestAccSQSQueue_NameGenerated_fifoQueue(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ProviderFactories: acctest.ProviderFactories,
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
@@ -156,7 +161,7 @@ func TestAccSQSQueue_namePrefix(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -184,7 +189,7 @@ func TestAccSQSQueue_NamePrefix_fifoQueue(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -213,7 +218,7 @@ func TestAccSQSQueue_tags(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -258,7 +263,7 @@ func TestAccSQSQueue_update(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -341,7 +346,7 @@ func TestAccSQSQueue_Policy_basic(t *testing.T) {
 }
 `
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -394,7 +399,7 @@ func TestAccSQSQueue_Policy_ignoreEquivalent(t *testing.T) {
 }
 `
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -425,7 +430,7 @@ func TestAccSQSQueue_recentlyDeleted(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -454,7 +459,7 @@ func TestAccSQSQueue_redrivePolicy(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -483,7 +488,7 @@ func TestAccSQSQueue_redriveAllowPolicy(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -512,7 +517,7 @@ func TestAccSQSQueue_fifoQueue(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := fmt.Sprintf("%s.fifo", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -539,7 +544,7 @@ func TestAccSQSQueue_fifoQueue(t *testing.T) {
 func TestAccSQSQueue_FIFOQueue_expectNameError(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -558,7 +563,7 @@ func TestAccSQSQueue_FIFOQueue_contentBasedDeduplication(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := fmt.Sprintf("%s.fifo", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -586,7 +591,7 @@ func TestAccSQSQueue_FIFOQueue_highThroughputMode(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := fmt.Sprintf("%s.fifo", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -622,7 +627,7 @@ func TestAccSQSQueue_FIFOQueue_highThroughputMode(t *testing.T) {
 func TestAccSQSQueue_StandardQueue_expectContentBasedDeduplicationError(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -641,7 +646,7 @@ func TestAccSQSQueue_encryption(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -684,7 +689,7 @@ func TestAccSQSQueue_zeroVisibilityTimeoutSeconds(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -712,7 +717,7 @@ func TestAccSQSQueue_defaultKMSDataKeyReusePeriodSeconds(t *testing.T) {
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -867,6 +872,16 @@ resource "aws_sqs_queue" "test" {
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
 
+func testAccQueueConfig_tagsConsistency(rName, tags string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  tags = %[2]s
+}
+`, rName, tags)
+}
+
 func testAccQueueConfig_updated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -22,7 +22,8 @@ const (
 	queueDeletedTimeout = 3 * time.Minute
 	queueTagsTimeout    = 60 * time.Second
 
-	queuePolicyReadTimeout = 20 * time.Second
+	queuePolicyReadTimeout        = 20 * time.Second
+	queueRedrivePolicyReadTimeout = 20 * time.Second
 
 	queueStateExists = "exists"
 
@@ -20,7 +20,7 @@ func TestAccSSMActivation_basic(t *testing.T) {
 	tag := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_ssm_activation.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -52,7 +52,7 @@ func TestAccSSMActivation_update(t *testing.T) {
 	name := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_ssm_activation.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -104,7 +104,7 @@ func TestAccSSMActivation_expirationDate(t *testing.T) {
 	expirationDateS := expirationTime.Format(time.RFC3339)
 	resourceName := "aws_ssm_activation.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -135,7 +135,7 @@ func TestAccSSMActivation_disappears(t *testing.T) {
 	tag := sdkacctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_ssm_activation.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,349 @@
+package ssm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	commandInvocationTimeout = 20 * time.Minute
+
+	// commandInvocationErrorOutputLength is the number of characters of standard error included in errors.
+	commandInvocationErrorOutputLength = 1024
+)
+
+func ResourceCommandInvocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCommandInvocationCreate,
+		Read:   resourceCommandInvocationRead,
+		Delete: resourceCommandInvocationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(commandInvocationTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"command_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"document_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([$]LATEST|[$]DEFAULT|[1-9][0-9]*)$`), "must be $DEFAULT, $LATEST or a version number"),
+			},
+			"instance_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     50,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"instance_ids", "targets"},
+			},
+			"invocations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"response_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"standard_error_content": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"standard_output_content": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_concurrency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"max_errors": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 163),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"instance_ids", "targets"},
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, 2592000),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCommandInvocationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	documentName := d.Get("document_name").(string)
+	input := &ssm.SendCommandInput{
+		DocumentName: aws.String(documentName),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("document_version"); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("max_concurrency"); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_errors"); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Parameters = expandDocumentParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("targets"); ok && len(v.([]interface{})) > 0 {
+		input.Targets = expandTargets(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("timeout_seconds"); ok {
+		input.TimeoutSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	start := time.Now()
+
+	// Newly launched instances can take a few minutes to register as managed nodes.
+	log.Printf("[DEBUG] Sending SSM Command: %s", input)
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(timeout, func() (interface{}, error) {
+		return conn.SendCommand(input)
+	}, ssm.ErrCodeInvalidInstanceId)
+
+	if err != nil {
+		return fmt.Errorf("error sending SSM Command (%s): %w", documentName, err)
+	}
+
+	commandID := aws.StringValue(outputRaw.(*ssm.SendCommandOutput).Command.CommandId)
+
+	command, err := waitCommandCompleted(conn, commandID, timeout-time.Since(start))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SSM Command (%s) to complete: %w", commandID, err)
+	}
+
+	invocations, err := FindCommandInvocationsByCommandID(conn, commandID)
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Command (%s) invocations: %w", commandID, err)
+	}
+
+	tfList, err := flattenCommandInvocations(conn, invocations)
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Command (%s) invocations: %w", commandID, err)
+	}
+
+	var errs *multierror.Error
+
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if status := tfMap["status"].(string); status != ssm.CommandInvocationStatusSuccess {
+			errs = multierror.Append(errs, fmt.Errorf("instance (%s): %s (%s), response code %d: %s",
+				tfMap["instance_id"].(string), status, tfMap["status_details"].(string), tfMap["response_code"].(int),
+				truncateCommandOutput(tfMap["standard_error_content"].(string), commandInvocationErrorOutputLength)))
+		}
+	}
+
+	if status := aws.StringValue(command.Status); status != ssm.CommandStatusSuccess {
+		errs = multierror.Append(errs, fmt.Errorf("command status: %s (%s)", status, aws.StringValue(command.StatusDetails)))
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return fmt.Errorf("SSM Command (%s) failed: %w", commandID, err)
+	}
+
+	d.SetId(commandID)
+	d.Set("command_id", commandID)
+
+	if err := d.Set("invocations", tfList); err != nil {
+		return fmt.Errorf("error setting invocations: %w", err)
+	}
+
+	d.Set("status", command.Status)
+
+	return nil
+}
+
+func resourceCommandInvocationRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceCommandInvocationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] SSM Command Invocation (%s) \"deleted\" by removing from state", d.Id())
+	return nil
+}
+
+// flattenCommandInvocations returns the status and output of each command invocation.
+// The output of each step of the document is concatenated.
+func flattenCommandInvocations(conn *ssm.SSM, apiObjects []*ssm.CommandInvocation) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		commandID := aws.StringValue(apiObject.CommandId)
+		instanceID := aws.StringValue(apiObject.InstanceId)
+		tfMap := map[string]interface{}{
+			"instance_id":    instanceID,
+			"response_code":  0,
+			"status":         aws.StringValue(apiObject.Status),
+			"status_details": aws.StringValue(apiObject.StatusDetails),
+		}
+
+		// Output is only available for single-step documents unless the step is named.
+		pluginNames := []string{""}
+
+		if len(apiObject.CommandPlugins) > 1 {
+			pluginNames = nil
+
+			for _, plugin := range apiObject.CommandPlugins {
+				pluginNames = append(pluginNames, aws.StringValue(plugin.Name))
+			}
+		}
+
+		var stdout, stderr string
+
+		for _, pluginName := range pluginNames {
+			output, err := FindCommandInvocation(conn, commandID, instanceID, pluginName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			stdout += aws.StringValue(output.StandardOutputContent)
+			stderr += aws.StringValue(output.StandardErrorContent)
+
+			// Use the response code of the first failed step.
+			if v := int(aws.Int64Value(output.ResponseCode)); v != 0 && tfMap["response_code"].(int) == 0 {
+				tfMap["response_code"] = v
+			}
+		}
+
+		tfMap["standard_error_content"] = stderr
+		tfMap["standard_output_content"] = stdout
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
+// truncateCommandOutput returns at most the last n bytes of the output, starting on a UTF-8 rune boundary.
+func truncateCommandOutput(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	i := len(s) - n
+
+	for i < len(s) && !utf8.RuneStart(s[i]) {
+		i++
+	}
+
+	return s[i:]
+}
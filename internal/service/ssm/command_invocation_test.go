@@ -0,0 +1,215 @@
+package ssm_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMCommandInvocation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command_invocation.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCommandInvocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommandInvocationConfig_basic(rName, "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "command_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "command_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Success"),
+					resource.TestCheckResourceAttr(resourceName, "invocations.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "invocations.0.instance_id", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "invocations.0.status", "Success"),
+					resource.TestCheckResourceAttr(resourceName, "invocations.0.response_code", "0"),
+					resource.TestCheckResourceAttr(resourceName, "invocations.0.standard_output_content", "hello\n"),
+					resource.TestCheckResourceAttr(resourceName, "invocations.0.standard_error_content", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMCommandInvocation_failed(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCommandInvocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCommandInvocationConfig_basic(rName, "echo oops >&2; exit 3"),
+				ExpectError: regexp.MustCompile(`response code 3: oops`),
+			},
+		},
+	})
+}
+
+func TestAccSSMCommandInvocation_targets(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command_invocation.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCommandInvocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Wait for the instance to register as a managed node before targeting it by tag.
+				Config: testAccCommandInvocationConfig_basic(rName, "true"),
+			},
+			{
+				Config: testAccCommandInvocationConfig_targets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Success"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "50%"),
+					resource.TestCheckResourceAttr(resourceName, "max_errors", "0"),
+					resource.TestCheckResourceAttr(resourceName, "invocations.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "invocations.0.instance_id", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "invocations.0.standard_output_content", "targeted\n"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMCommandInvocation_triggers(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command_invocation.test"
+	var commandID string
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCommandInvocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommandInvocationConfig_triggers(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Success"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					testAccCheckCommandInvocationID(resourceName, &commandID),
+				),
+			},
+			{
+				Config:   testAccCommandInvocationConfig_triggers(rName, "1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCommandInvocationConfig_triggers(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Success"),
+					testAccCheckCommandInvocationRerun(resourceName, &commandID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCommandInvocationDestroy(s *terraform.State) error {
+	// Command invocations can't be destroyed, only removed from state.
+	return nil
+}
+
+func testAccCheckCommandInvocationID(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Command Invocation ID is set")
+		}
+
+		*v = rs.Primary.ID
+
+		return nil
+	}
+}
+
+func testAccCheckCommandInvocationRerun(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == *v {
+			return fmt.Errorf("SSM Command (%s) was not sent again", *v)
+		}
+
+		return nil
+	}
+}
+
+func testAccCommandInvocationConfig_basic(rName, command string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+resource "aws_ssm_command_invocation" "test" {
+  document_name = "AWS-RunShellScript"
+  instance_ids  = [aws_instance.test.id]
+
+  parameters = {
+    commands = %[1]q
+  }
+}
+`, command))
+}
+
+func testAccCommandInvocationConfig_targets(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		`
+resource "aws_ssm_command_invocation" "test" {
+  document_name   = "AWS-RunShellScript"
+  comment         = "targeted by tag"
+  max_concurrency = "50%"
+  max_errors      = "0"
+  timeout_seconds = 600
+
+  targets {
+    key    = "tag:Name"
+    values = [aws_instance.test.tags["Name"]]
+  }
+
+  parameters = {
+    commands = "echo targeted"
+  }
+}
+`)
+}
+
+func testAccCommandInvocationConfig_triggers(rName, trigger string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+resource "aws_ssm_command_invocation" "test" {
+  document_name = "AWS-RunShellScript"
+  instance_ids  = [aws_instance.test.id]
+
+  parameters = {
+    commands = "echo triggered"
+  }
+
+  triggers = {
+    revision = %[1]q
+  }
+}
+`, trigger))
+}
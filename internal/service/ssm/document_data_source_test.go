@@ -14,7 +14,7 @@ func TestAccSSMDocumentDataSource_basic(t *testing.T) {
 	resourceName := "data.aws_ssm_document.test"
 	name := fmt.Sprintf("test_document-%d", sdkacctest.RandInt())
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -48,7 +48,7 @@ func TestAccSSMDocumentDataSource_basic(t *testing.T) {
 func TestAccSSMDocumentDataSource_managed(t *testing.T) {
 	resourceName := "data.aws_ssm_document.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
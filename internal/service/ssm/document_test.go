@@ -18,7 +18,7 @@ import (
 func TestAccSSMDocument_basic(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -50,7 +50,7 @@ func TestAccSSMDocument_name(t *testing.T) {
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_document.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -82,7 +82,7 @@ func TestAccSSMDocument_name(t *testing.T) {
 func TestAccSSMDocument_Target_type(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -114,7 +114,7 @@ func TestAccSSMDocument_Target_type(t *testing.T) {
 func TestAccSSMDocument_versionName(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -146,7 +146,7 @@ func TestAccSSMDocument_versionName(t *testing.T) {
 func TestAccSSMDocument_update(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -181,7 +181,7 @@ func TestAccSSMDocument_update(t *testing.T) {
 func TestAccSSMDocument_Permission_public(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -208,7 +208,7 @@ func TestAccSSMDocument_Permission_private(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	ids := "123456789012"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -234,7 +234,7 @@ func TestAccSSMDocument_Permission_batching(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	ids := "123456789012,123456789013,123456789014,123456789015,123456789016,123456789017,123456789018,123456789019,123456789020,123456789021,123456789022,123456789023,123456789024,123456789025,123456789026,123456789027,123456789028,123456789029,123456789030,123456789031,123456789032"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -262,7 +262,7 @@ func TestAccSSMDocument_Permission_change(t *testing.T) {
 	idsInitial := "123456789012,123456789013"
 	idsRemove := "123456789012"
 	idsAdd := "123456789012,123456789014"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -304,7 +304,7 @@ func TestAccSSMDocument_Permission_change(t *testing.T) {
 func TestAccSSMDocument_params(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -334,7 +334,7 @@ func TestAccSSMDocument_params(t *testing.T) {
 func TestAccSSMDocument_automation(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -362,7 +362,7 @@ func TestAccSSMDocument_package(t *testing.T) {
 	rInt2 := sdkacctest.RandInt()
 	resourceName := "aws_ssm_document.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -396,7 +396,7 @@ func TestAccSSMDocument_SchemaVersion_1(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_document.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -428,7 +428,7 @@ func TestAccSSMDocument_SchemaVersion_1(t *testing.T) {
 func TestAccSSMDocument_session(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -475,7 +475,7 @@ mainSteps:
     runCommand:
       - Get-Process
 `
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -510,7 +510,7 @@ func TestAccSSMDocument_tags(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -553,7 +553,7 @@ func TestAccSSMDocument_tags(t *testing.T) {
 func TestAccSSMDocument_disappears(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -86,3 +86,246 @@ func FindPatchGroup(conn *ssm.SSM, patchGroup, baselineId string) (*ssm.PatchGro
 
 	return result, err
 }
+
+// FindParametersByPath returns the decrypted parameters in the hierarchy under path, keyed by name.
+func FindParametersByPath(conn *ssm.SSM, path string) (map[string]*ssm.Parameter, error) {
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+	output := make(map[string]*ssm.Parameter)
+
+	err := conn.GetParametersByPathPages(input, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v != nil {
+				output[aws.StringValue(v.Name)] = v
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindParameterMetadataByPath returns the metadata of the parameters in the hierarchy under path, keyed by name.
+func FindParameterMetadataByPath(conn *ssm.SSM, path string) (map[string]*ssm.ParameterMetadata, error) {
+	input := &ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{
+			{
+				Key:    aws.String("Path"),
+				Option: aws.String("Recursive"),
+				Values: aws.StringSlice([]string{path}),
+			},
+		},
+	}
+	output := make(map[string]*ssm.ParameterMetadata)
+
+	err := conn.DescribeParametersPages(input, func(page *ssm.DescribeParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v != nil {
+				output[aws.StringValue(v.Name)] = v
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindParametersByNames returns the decrypted parameters with the specified names, keyed by name.
+// Parameters that don't exist are omitted.
+func FindParametersByNames(conn *ssm.SSM, names []string) (map[string]*ssm.Parameter, error) {
+	output := make(map[string]*ssm.Parameter)
+
+	for len(names) > 0 {
+		n := len(names)
+
+		if n > parametersGetBatchSize {
+			n = parametersGetBatchSize
+		}
+
+		input := &ssm.GetParametersInput{
+			Names:          aws.StringSlice(names[:n]),
+			WithDecryption: aws.Bool(true),
+		}
+		names = names[n:]
+
+		page, err := conn.GetParameters(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			continue
+		}
+
+		for _, v := range page.Parameters {
+			if v != nil {
+				output[aws.StringValue(v.Name)] = v
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// FindParameterMetadataByNames returns the metadata of the parameters with the specified names, keyed by name.
+// Parameters that don't exist are omitted.
+func FindParameterMetadataByNames(conn *ssm.SSM, names []string) (map[string]*ssm.ParameterMetadata, error) {
+	output := make(map[string]*ssm.ParameterMetadata)
+
+	for len(names) > 0 {
+		n := len(names)
+
+		if n > parametersGetBatchSize {
+			n = parametersGetBatchSize
+		}
+
+		input := &ssm.DescribeParametersInput{
+			ParameterFilters: []*ssm.ParameterStringFilter{
+				{
+					Key:    aws.String("Name"),
+					Option: aws.String("Equals"),
+					Values: aws.StringSlice(names[:n]),
+				},
+			},
+		}
+		names = names[n:]
+
+		err := conn.DescribeParametersPages(input, func(page *ssm.DescribeParametersOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Parameters {
+				if v != nil {
+					output[aws.StringValue(v.Name)] = v
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func FindCommandByID(conn *ssm.SSM, id string) (*ssm.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvalidCommandId) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Commands) == 0 || output.Commands[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Commands); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Commands[0], nil
+}
+
+func FindCommandInvocationsByCommandID(conn *ssm.SSM, id string) ([]*ssm.CommandInvocation, error) {
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   aws.Bool(true),
+	}
+	var output []*ssm.CommandInvocation
+
+	err := conn.ListCommandInvocationsPages(input, func(page *ssm.ListCommandInvocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CommandInvocations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvalidCommandId) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindCommandInvocation returns the output of a command invocation on an instance.
+// pluginName is required for documents with more than one step.
+func FindCommandInvocation(conn *ssm.SSM, commandID, instanceID, pluginName string) (*ssm.GetCommandInvocationOutput, error) {
+	input := &ssm.GetCommandInvocationInput{
+		CommandId:  aws.String(commandID),
+		InstanceId: aws.String(instanceID),
+	}
+
+	if pluginName != "" {
+		input.PluginName = aws.String(pluginName)
+	}
+
+	output, err := conn.GetCommandInvocation(input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvocationDoesNotExist) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
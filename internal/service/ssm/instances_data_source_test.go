@@ -26,7 +26,7 @@ func TestAccSSMInstancesDataSource_filter(t *testing.T) {
 		}
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,535 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	parametersTimeout = 10 * time.Minute
+
+	// parametersPutConcurrency is the number of parameters written concurrently.
+	// PutParameter has a low default throughput limit, so keep this small.
+	parametersPutConcurrency = 5
+
+	// parametersDeleteBatchSize is the maximum number of names accepted by DeleteParameters.
+	parametersDeleteBatchSize = 10
+
+	// parametersGetBatchSize is the maximum number of names accepted by GetParameters.
+	parametersGetBatchSize = 10
+)
+
+func ResourceParameters() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceParametersCreate,
+		Read:   resourceParametersRead,
+		Update: resourceParametersUpdate,
+		Delete: resourceParametersDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceParametersImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(parametersTimeout),
+			Update: schema.DefaultTimeout(parametersTimeout),
+			Delete: schema.DefaultTimeout(parametersTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// A map keyed by name can't hold per-parameter attributes, so parameters are a set of blocks
+			// and their names are checked for uniqueness in CustomizeDiff.
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1011),
+								validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+(/[a-zA-Z0-9_.-]+)*$`), "must be a parameter name relative to path, without leading or trailing slashes"),
+							),
+						},
+						"tier": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.ParameterTierStandard,
+							ValidateFunc: validation.StringInSlice([]string{ssm.ParameterTierStandard, ssm.ParameterTierAdvanced}, false),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.ParameterTypeString,
+							ValidateFunc: validation.StringInSlice(ssm.ParameterType_Values(), false),
+						},
+						"value": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 1011),
+					validation.StringMatch(regexp.MustCompile(`^/([a-zA-Z0-9_.-]+(/[a-zA-Z0-9_.-]+)*)?$`), "must start with a slash and must not end with one"),
+				),
+			},
+			"versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ComputedIf("arns", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("parameter")
+			}),
+			customdiff.ComputedIf("versions", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("parameter")
+			}),
+			resourceParametersCustomizeDiff,
+		),
+	}
+}
+
+func resourceParametersCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	path := d.Get("path").(string)
+
+	var o []interface{}
+
+	// Existing parameters under an owned path are overwritten or deleted.
+	if d.Get("exclusive").(bool) {
+		var err error
+		o, err = expandUnmanagedParameters(conn, path, nil)
+
+		if err != nil {
+			return fmt.Errorf("error reading SSM Parameters (%s): %w", path, err)
+		}
+	}
+
+	if err := updateParameters(conn, path, o, d.Get("parameter").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error creating SSM Parameters (%s): %w", path, err)
+	}
+
+	d.SetId(path)
+
+	return resourceParametersRead(d, meta)
+}
+
+func resourceParametersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	path := d.Id()
+
+	parameters, err := FindParametersByPath(conn, path)
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Parameters (%s): %w", path, err)
+	}
+
+	metadata, err := FindParameterMetadataByPath(conn, path)
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Parameters (%s): %w", path, err)
+	}
+
+	prior := expandParametersByName(d.Get("parameter").(*schema.Set).List())
+	exclusive := d.Get("exclusive").(bool)
+
+	arns := make(map[string]interface{})
+	versions := make(map[string]interface{})
+	var tfList []interface{}
+
+	for fullName, parameter := range parameters {
+		name, ok := parametersRelativeName(path, fullName)
+
+		if !ok {
+			continue
+		}
+
+		priorMap, managed := prior[name]
+
+		// Unmanaged parameters are only read when the whole path is owned, so that they are planned for deletion.
+		if !managed && !exclusive {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":  name,
+			"type":  aws.StringValue(parameter.Type),
+			"value": aws.StringValue(parameter.Value),
+		}
+
+		if v, ok := metadata[fullName]; ok {
+			tfMap["description"] = aws.StringValue(v.Description)
+			tfMap["tier"] = aws.StringValue(v.Tier)
+
+			// The AWS managed key is used if no key is configured.
+			if keyID, _ := priorMap["key_id"].(string); keyID != "" {
+				tfMap["key_id"] = aws.StringValue(v.KeyId)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+		arns[name] = aws.StringValue(parameter.ARN)
+		versions[name] = int(aws.Int64Value(parameter.Version))
+	}
+
+	d.Set("path", path)
+
+	if err := d.Set("parameter", tfList); err != nil {
+		return fmt.Errorf("error setting parameter: %w", err)
+	}
+
+	d.Set("arns", arns)
+	d.Set("versions", versions)
+
+	return nil
+}
+
+func resourceParametersUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	if d.HasChanges("exclusive", "parameter") {
+		o, n := d.GetChange("parameter")
+		oldList := o.(*schema.Set).List()
+
+		// Parameters under the path that weren't read before it became owned are deleted.
+		if d.Get("exclusive").(bool) {
+			unmanaged, err := expandUnmanagedParameters(conn, d.Id(), oldList)
+
+			if err != nil {
+				return fmt.Errorf("error reading SSM Parameters (%s): %w", d.Id(), err)
+			}
+
+			oldList = append(oldList, unmanaged...)
+		}
+
+		if err := updateParameters(conn, d.Id(), oldList, n.(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error updating SSM Parameters (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceParametersRead(d, meta)
+}
+
+func resourceParametersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	log.Printf("[DEBUG] Deleting SSM Parameters: %s", d.Id())
+	if err := updateParameters(conn, d.Id(), d.Get("parameter").(*schema.Set).List(), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error deleting SSM Parameters (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceParametersImport takes ownership of every parameter under the imported path.
+func resourceParametersImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	parameters, err := FindParametersByPath(conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading SSM Parameters (%s): %w", d.Id(), err)
+	}
+
+	var tfList []interface{}
+
+	for fullName := range parameters {
+		if name, ok := parametersRelativeName(d.Id(), fullName); ok {
+			tfList = append(tfList, map[string]interface{}{
+				"name": name,
+			})
+		}
+	}
+
+	d.Set("parameter", tfList)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceParametersCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	names := make(map[string]struct{})
+
+	for _, tfMapRaw := range diff.Get("parameter").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if name == "" {
+			continue
+		}
+
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("duplicate parameter name: %s", name)
+		}
+
+		names[name] = struct{}{}
+	}
+
+	return nil
+}
+
+// updateParameters deletes and puts parameters under path to turn the old set of parameters into the new one.
+// Only parameters that are added, removed or changed are written.
+func updateParameters(conn *ssm.SSM, path string, o, n []interface{}, timeout time.Duration) error {
+	oldParameters := expandParametersByName(o)
+	newParameters := expandParametersByName(n)
+
+	var deletes []string
+	var puts []*ssm.PutParameterInput
+
+	for name, oldMap := range oldParameters {
+		newMap, ok := newParameters[name]
+
+		// Parameters can't be downgraded from the advanced tier to the standard tier.
+		if !ok || (oldMap["tier"].(string) == ssm.ParameterTierAdvanced && newMap["tier"].(string) == ssm.ParameterTierStandard) {
+			deletes = append(deletes, parametersFullName(path, name))
+		}
+	}
+
+	for name, newMap := range newParameters {
+		oldMap, ok := oldParameters[name]
+
+		if ok && parameterEqual(oldMap, newMap) {
+			continue
+		}
+
+		input := expandPutParameterInput(path, newMap)
+		input.Overwrite = aws.Bool(ok && !(oldMap["tier"].(string) == ssm.ParameterTierAdvanced && newMap["tier"].(string) == ssm.ParameterTierStandard))
+
+		puts = append(puts, input)
+	}
+
+	if err := deleteParameters(conn, deletes, timeout); err != nil {
+		return err
+	}
+
+	versions, err := putParameters(conn, puts, timeout)
+
+	if err != nil {
+		return err
+	}
+
+	if len(deletes) == 0 && len(versions) == 0 {
+		return nil
+	}
+
+	if err := waitParametersPropagated(conn, versions, deletes); err != nil {
+		return fmt.Errorf("waiting for changes to propagate: %w", err)
+	}
+
+	return nil
+}
+
+// putParameters puts parameters concurrently, returning the new version of each parameter keyed by name.
+func putParameters(conn *ssm.SSM, inputs []*ssm.PutParameterInput, timeout time.Duration) (map[string]int64, error) {
+	var errs *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parametersPutConcurrency)
+	versions := make(map[string]int64)
+
+	for _, input := range inputs {
+		input := input
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			name := aws.StringValue(input.Name)
+
+			log.Printf("[DEBUG] Putting SSM Parameter: %s", name)
+			outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(timeout, func() (interface{}, error) {
+				return conn.PutParameter(input)
+			}, ssm.ErrCodeTooManyUpdates, "ThrottlingException")
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("putting SSM Parameter (%s): %w", name, err))
+				return
+			}
+
+			versions[name] = aws.Int64Value(outputRaw.(*ssm.PutParameterOutput).Version)
+		}()
+	}
+
+	wg.Wait()
+
+	return versions, errs.ErrorOrNil()
+}
+
+// deleteParameters deletes parameters in batches. Parameters that don't exist are ignored.
+func deleteParameters(conn *ssm.SSM, names []string, timeout time.Duration) error {
+	for len(names) > 0 {
+		n := len(names)
+
+		if n > parametersDeleteBatchSize {
+			n = parametersDeleteBatchSize
+		}
+
+		batch := names[:n]
+		names = names[n:]
+
+		log.Printf("[DEBUG] Deleting SSM Parameters: %s", strings.Join(batch, ", "))
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(timeout, func() (interface{}, error) {
+			return conn.DeleteParameters(&ssm.DeleteParametersInput{
+				Names: aws.StringSlice(batch),
+			})
+		}, "ThrottlingException")
+
+		if err != nil {
+			return fmt.Errorf("deleting SSM Parameters (%s): %w", strings.Join(batch, ", "), err)
+		}
+	}
+
+	return nil
+}
+
+// expandUnmanagedParameters returns the names of the parameters under path that aren't in the managed set of parameters.
+// Other attributes are left empty, so existing parameters are always overwritten.
+func expandUnmanagedParameters(conn *ssm.SSM, path string, managed []interface{}) ([]interface{}, error) {
+	parameters, err := FindParametersByPath(conn, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	managedParameters := expandParametersByName(managed)
+	var tfList []interface{}
+
+	for fullName := range parameters {
+		name, ok := parametersRelativeName(path, fullName)
+
+		if !ok {
+			continue
+		}
+
+		if _, ok := managedParameters[name]; ok {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description": "",
+			"key_id":      "",
+			"name":        name,
+			"tier":        "",
+			"type":        "",
+			"value":       "",
+		})
+	}
+
+	return tfList, nil
+}
+
+func expandPutParameterInput(path string, tfMap map[string]interface{}) *ssm.PutParameterInput {
+	input := &ssm.PutParameterInput{
+		Description: aws.String(tfMap["description"].(string)),
+		Name:        aws.String(parametersFullName(path, tfMap["name"].(string))),
+		Tier:        aws.String(tfMap["tier"].(string)),
+		Type:        aws.String(tfMap["type"].(string)),
+		Value:       aws.String(tfMap["value"].(string)),
+	}
+
+	if v := tfMap["key_id"].(string); v != "" && tfMap["type"].(string) == ssm.ParameterTypeSecureString {
+		input.KeyId = aws.String(v)
+	}
+
+	return input
+}
+
+func expandParametersByName(tfList []interface{}) map[string]map[string]interface{} {
+	apiObjects := make(map[string]map[string]interface{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["name"].(string)] = tfMap
+	}
+
+	return apiObjects
+}
+
+func parameterEqual(a, b map[string]interface{}) bool {
+	for _, k := range []string{"description", "key_id", "tier", "type", "value"} {
+		if a[k] != b[k] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parametersFullName returns the full name of a parameter from its name relative to path.
+func parametersFullName(path, name string) string {
+	return strings.TrimSuffix(path, "/") + "/" + name
+}
+
+// parametersRelativeName returns the name of a parameter relative to path.
+func parametersRelativeName(path, fullName string) (string, bool) {
+	prefix := strings.TrimSuffix(path, "/") + "/"
+
+	if !strings.HasPrefix(fullName, prefix) || fullName == prefix {
+		return "", false
+	}
+
+	return strings.TrimPrefix(fullName, prefix), true
+}
@@ -0,0 +1,311 @@
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+)
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, "host", "port", "db/user"),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":        "host",
+						"value":       "value1",
+						"type":        "String",
+						"tier":        "Standard",
+						"description": "The host",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "db/user",
+						"value": "admin",
+						"type":  "SecureString",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "arns.%", "3"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arns.host", "ssm", fmt.Sprintf("parameter/%s/host", rName)),
+					resource.TestCheckResourceAttr(resourceName, "versions.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "versions.host", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParametersConfig_updated(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, "host", "db/user", "db/password"),
+					testAccCheckParametersNotExist("/"+rName, "port"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":        "host",
+						"value":       "value2",
+						"tier":        "Advanced",
+						"description": "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "db/password",
+						"value": "s3cr3t",
+						"type":  "SecureString",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "versions.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "versions.host", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.db/user", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_exclusive(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+	path := "/" + rName
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { testAccPutParameter(t, path+"/stray/one") },
+				Config:    testAccParametersConfig_exclusive(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, "host"),
+					testAccCheckParametersNotExist(path, "stray/one"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+				),
+			},
+			{
+				// Parameters added outside of Terraform are deleted.
+				PreConfig: func() { testAccPutParameter(t, path+"/stray/two") },
+				Config:    testAccParametersConfig_exclusive(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, "host"),
+					testAccCheckParametersNotExist(path, "stray/two"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+				),
+			},
+			{
+				// Parameters added outside of Terraform are left alone.
+				PreConfig: func() { testAccPutParameter(t, path+"/stray/three") },
+				Config:    testAccParametersConfig_exclusive(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, "host"),
+					testAccCheckParametersExistOutside(path, "stray/three"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+				),
+			},
+			{
+				Config: testAccParametersConfig_exclusive(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersNotExist(path, "stray/three"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, "host", "port", "db/user"),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssm.ResourceParameters(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckParametersDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssm_parameters" {
+			continue
+		}
+
+		output, err := tfssm.FindParametersByPath(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) > 0 {
+			return fmt.Errorf("SSM Parameters %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckParametersExists(n string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Parameters ID is set")
+		}
+
+		return testAccCheckParametersExistOutside(rs.Primary.ID, names...)(s)
+	}
+}
+
+// testAccCheckParametersExistOutside checks that parameters exist under path, whether or not they are managed.
+func testAccCheckParametersExistOutside(path string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+		output, err := tfssm.FindParametersByPath(conn, path)
+
+		if err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			if _, ok := output[path+"/"+name]; !ok {
+				return fmt.Errorf("SSM Parameter %s/%s not found", path, name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersNotExist(path string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+		output, err := tfssm.FindParametersByPath(conn, path)
+
+		if err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			if _, ok := output[path+"/"+name]; ok {
+				return fmt.Errorf("SSM Parameter %s/%s still exists", path, name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccPutParameter(t *testing.T, name string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+	_, err := conn.PutParameter(&ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Overwrite: aws.Bool(true),
+		Type:      aws.String(ssm.ParameterTypeString),
+		Value:     aws.String("stray"),
+	})
+
+	if err != nil {
+		t.Fatalf("error putting SSM Parameter (%s): %s", name, err)
+	}
+}
+
+func testAccParametersConfig_basic(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name        = "host"
+    value       = %[2]q
+    description = "The host"
+  }
+
+  parameter {
+    name  = "port"
+    value = "5432"
+  }
+
+  parameter {
+    name  = "db/user"
+    type  = "SecureString"
+    value = "admin"
+  }
+}
+`, rName, value)
+}
+
+func testAccParametersConfig_updated(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "host"
+    value = %[2]q
+    tier  = "Advanced"
+  }
+
+  parameter {
+    name  = "db/user"
+    type  = "SecureString"
+    value = "admin"
+  }
+
+  parameter {
+    name  = "db/password"
+    type  = "SecureString"
+    value = "s3cr3t"
+  }
+}
+`, rName, value)
+}
+
+func testAccParametersConfig_exclusive(rName string, exclusive bool) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path      = "/%[1]s"
+  exclusive = %[2]t
+
+  parameter {
+    name  = "host"
+    value = "db.example.com"
+  }
+}
+`, rName, exclusive)
+}
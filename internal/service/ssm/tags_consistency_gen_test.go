@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package ssm_test
+
+import (
+	"testing"
+)
+
+func TestAccSSMDocument_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ssm_document has no testAccDocumentConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSSMMaintenanceWindow_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ssm_maintenance_window has no testAccMaintenanceWindowConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSSMParameter_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ssm_parameter has no testAccParameterConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccSSMPatchBaseline_TagsConsistency(t *testing.T) {
+	t.Skip("aws_ssm_patch_baseline has no testAccPatchBaselineConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -15,6 +15,83 @@ const (
 	documentActiveTimeout = 2 * time.Minute
 )
 
+// waitCommandCompleted waits for a command to finish running, successfully or not.
+func waitCommandCompleted(conn *ssm.SSM, id string, timeout time.Duration) (*ssm.Command, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssm.CommandStatusPending, ssm.CommandStatusInProgress, ssm.CommandStatusCancelling},
+		Target:     []string{ssm.CommandStatusSuccess, ssm.CommandStatusFailed, ssm.CommandStatusCancelled, ssm.CommandStatusTimedOut},
+		Refresh:    statusCommand(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ssm.Command); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitParametersPropagated waits for parameters and their metadata to be returned with at least the specified versions,
+// keyed by name, and for deleted parameters to no longer be returned. Only the changed parameters are read.
+func waitParametersPropagated(conn *ssm.SSM, versions map[string]int64, deleted []string) error {
+	var names []string
+
+	for name := range versions {
+		names = append(names, name)
+	}
+
+	for _, name := range deleted {
+		if _, ok := versions[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	checkFunc := func() (bool, error) {
+		output, err := FindParametersByNames(conn, names)
+
+		if err != nil {
+			return false, err
+		}
+
+		metadata, err := FindParameterMetadataByNames(conn, names)
+
+		if err != nil {
+			return false, err
+		}
+
+		for name, version := range versions {
+			if v, ok := output[name]; !ok || aws.Int64Value(v.Version) < version {
+				return false, nil
+			}
+
+			if v, ok := metadata[name]; !ok || aws.Int64Value(v.Version) < version {
+				return false, nil
+			}
+		}
+
+		for _, name := range deleted {
+			if _, ok := versions[name]; ok {
+				continue
+			}
+
+			if _, ok := output[name]; ok {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                2 * time.Second,
+	}
+
+	return tfresource.WaitUntil(propagationTimeout, checkFunc, opts)
+}
+
 func waitAssociationSuccess(conn *ssm.SSM, id string, timeout time.Duration) (*ssm.AssociationDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ssm.AssociationStatusNamePending},
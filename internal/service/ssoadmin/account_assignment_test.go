@@ -21,7 +21,7 @@ func TestAccSSOAdminAccountAssignment_Basic_group(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckInstances(t)
@@ -54,7 +54,7 @@ func TestAccSSOAdminAccountAssignment_Basic_user(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	userName := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckInstances(t)
@@ -87,7 +87,7 @@ func TestAccSSOAdminAccountAssignment_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckInstances(t)
@@ -40,7 +40,7 @@ func testAccPreCheckInstances(t *testing.T) {
 func TestAccSSOAdminInstancesDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_ssoadmin_instances.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssoadmin.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
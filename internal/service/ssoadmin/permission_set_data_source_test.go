@@ -16,7 +16,7 @@ func TestAccSSOAdminPermissionSetDataSource_arn(t *testing.T) {
 	resourceName := "aws_ssoadmin_permission_set.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssoadmin.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -41,7 +41,7 @@ func TestAccSSOAdminPermissionSetDataSource_name(t *testing.T) {
 	resourceName := "aws_ssoadmin_permission_set.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssoadmin.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -63,7 +63,7 @@ func TestAccSSOAdminPermissionSetDataSource_name(t *testing.T) {
 
 func TestAccSSOAdminPermissionSetDataSource_nonExistent(t *testing.T) {
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, ssoadmin.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
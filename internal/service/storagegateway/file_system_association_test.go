@@ -25,7 +25,7 @@ func TestAccStorageGatewayFileSystemAssociation_basic(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	username := "Admin"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(storagegateway.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, storagegateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -60,7 +60,7 @@ func TestAccStorageGatewayFileSystemAssociation_tags(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	username := "Admin"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(storagegateway.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, storagegateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -111,7 +111,7 @@ func TestAccStorageGatewayFileSystemAssociation_cacheAttributes(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	username := "Admin"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(storagegateway.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, storagegateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -150,7 +150,7 @@ func TestAccStorageGatewayFileSystemAssociation_auditDestination(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	username := "Admin"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(storagegateway.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, storagegateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -193,7 +193,7 @@ func TestAccStorageGatewayFileSystemAssociation_disappears(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	username := "Admin"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(storagegateway.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, storagegateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -218,7 +218,7 @@ func TestAccStorageGatewayFileSystemAssociation_Disappears_storageGateway(t *tes
 	domainName := acctest.RandomDomainName()
 	username := "Admin"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(storagegateway.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, storagegateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -246,7 +246,7 @@ func TestAccStorageGatewayFileSystemAssociation_Disappears_fsxFileSystem(t *test
 	domainName := acctest.RandomDomainName()
 	username := "Admin"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(storagegateway.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, storagegateway.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,35 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package storagegateway_test
+
+import (
+	"testing"
+)
+
+func TestAccStorageGatewayCachediSCSIVolume_TagsConsistency(t *testing.T) {
+	t.Skip("aws_storagegateway_cached_iscsi_volume has no testAccCachediSCSIVolumeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccStorageGatewayFileSystemAssociation_TagsConsistency(t *testing.T) {
+	t.Skip("aws_storagegateway_file_system_association has no testAccFileSystemAssociationConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccStorageGatewayGateway_TagsConsistency(t *testing.T) {
+	t.Skip("aws_storagegateway_gateway has no testAccGatewayConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccStorageGatewayNFSFileShare_TagsConsistency(t *testing.T) {
+	t.Skip("aws_storagegateway_nfs_file_share has no testAccNFSFileShareConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccStorageGatewaySMBFileShare_TagsConsistency(t *testing.T) {
+	t.Skip("aws_storagegateway_smb_file_share has no testAccSMBFileShareConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccStorageGatewayStorediSCSIVolume_TagsConsistency(t *testing.T) {
+	t.Skip("aws_storagegateway_stored_iscsi_volume has no testAccStorediSCSIVolumeConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccStorageGatewayTapePool_TagsConsistency(t *testing.T) {
+	t.Skip("aws_storagegateway_tape_pool has no testAccTapePoolConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func TestAccSTSCallerIdentityDataSource_basic(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, sts.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -28,7 +28,7 @@ func TestAccSWFDomain_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_swf_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDomainTestingEnabled(t)
@@ -59,7 +59,7 @@ func TestAccSWFDomain_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_swf_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDomainTestingEnabled(t)
@@ -105,7 +105,7 @@ func TestAccSWFDomain_tags(t *testing.T) {
 func TestAccSWFDomain_namePrefix(t *testing.T) {
 	resourceName := "aws_swf_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDomainTestingEnabled(t)
@@ -134,7 +134,7 @@ func TestAccSWFDomain_namePrefix(t *testing.T) {
 func TestAccSWFDomain_generatedName(t *testing.T) {
 	resourceName := "aws_swf_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDomainTestingEnabled(t)
@@ -162,7 +162,7 @@ func TestAccSWFDomain_description(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_swf_domain.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDomainTestingEnabled(t)
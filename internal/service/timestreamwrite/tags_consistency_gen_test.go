@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package timestreamwrite_test
+
+import (
+	"testing"
+)
+
+func TestAccTimestreamWriteDatabase_TagsConsistency(t *testing.T) {
+	t.Skip("aws_timestreamwrite_database has no testAccDatabaseConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccTimestreamWriteTable_TagsConsistency(t *testing.T) {
+	t.Skip("aws_timestreamwrite_table has no testAccTableConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package transfer_test
+
+import (
+	"testing"
+)
+
+func TestAccTransferServer_TagsConsistency(t *testing.T) {
+	t.Skip("aws_transfer_server has no testAccServerConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccTransferUser_TagsConsistency(t *testing.T) {
+	t.Skip("aws_transfer_user has no testAccUserConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccTransferWorkflow_TagsConsistency(t *testing.T) {
+	t.Skip("aws_transfer_workflow has no testAccWorkflowConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package waf_test
+
+import (
+	"testing"
+)
+
+func TestAccWAFRateBasedRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_waf_rate_based_rule has no testAccRateBasedRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWAFRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_waf_rule has no testAccRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWAFRuleGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_waf_rule_group has no testAccRuleGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWAFWebACL_TagsConsistency(t *testing.T) {
+	t.Skip("aws_waf_web_acl has no testAccWebACLConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -16,7 +16,7 @@ func TestAccWAFRegionalIPSetDataSource_basic(t *testing.T) {
 	resourceName := "aws_wafregional_ipset.ipset"
 	datasourceName := "data.aws_wafregional_ipset.ipset"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
 		ErrorCheck:        acctest.ErrorCheck(t, wafregional.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
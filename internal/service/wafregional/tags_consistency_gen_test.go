@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package wafregional_test
+
+import (
+	"testing"
+)
+
+func TestAccWAFRegionalRateBasedRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_wafregional_rate_based_rule has no testAccRateBasedRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWAFRegionalRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_wafregional_rule has no testAccRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWAFRegionalRuleGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_wafregional_rule_group has no testAccRuleGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWAFRegionalWebACL_TagsConsistency(t *testing.T) {
+	t.Skip("aws_wafregional_web_acl has no testAccWebACLConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
@@ -16,7 +16,7 @@ func TestAccWAFV2RegexPatternSetDataSource_basic(t *testing.T) {
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 	datasourceName := "data.aws_wafv2_regex_pattern_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, wafv2.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,23 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package wafv2_test
+
+import (
+	"testing"
+)
+
+func TestAccWAFV2IPSet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_wafv2_ip_set has no testAccIPSetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWAFV2RegexPatternSet_TagsConsistency(t *testing.T) {
+	t.Skip("aws_wafv2_regex_pattern_set has no testAccRegexPatternSetConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWAFV2RuleGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_wafv2_rule_group has no testAccRuleGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWAFV2WebACL_TagsConsistency(t *testing.T) {
+	t.Skip("aws_wafv2_web_acl has no testAccWebACLConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
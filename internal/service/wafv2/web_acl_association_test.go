@@ -21,7 +21,7 @@ func TestAccWAFV2WebACLAssociation_basic(t *testing.T) {
 	testName := fmt.Sprintf("web-acl-association-%s", sdkacctest.RandString(5))
 	resourceName := "aws_wafv2_web_acl_association.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAPIGatewayTypeEDGE(t)
@@ -53,7 +53,7 @@ func TestAccWAFV2WebACLAssociation_disappears(t *testing.T) {
 	testName := fmt.Sprintf("web-acl-association-%s", sdkacctest.RandString(5))
 	resourceName := "aws_wafv2_web_acl_association.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAPIGatewayTypeEDGE(t)
@@ -26,7 +26,7 @@ func testAccDirectory_basic(t *testing.T) {
 
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -93,7 +93,7 @@ func testAccDirectory_disappears(t *testing.T) {
 
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -124,7 +124,7 @@ func testAccDirectory_subnetIDs(t *testing.T) {
 
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -159,7 +159,7 @@ func testAccDirectory_tags(t *testing.T) {
 
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -212,7 +212,7 @@ func testAccDirectory_selfServicePermissions(t *testing.T) {
 
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -247,7 +247,7 @@ func testAccDirectory_workspaceAccessProperties(t *testing.T) {
 
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -286,7 +286,7 @@ func testAccDirectory_workspaceCreationProperties(t *testing.T) {
 
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -322,7 +322,7 @@ func testAccDirectory_workspaceCreationProperties_customSecurityGroupId_defaultO
 
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -373,7 +373,7 @@ func testAccDirectory_ipGroupIDs(t *testing.T) {
 
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckHasIAMRole(t, "workspaces_DefaultRole") },
 		ErrorCheck:        acctest.ErrorCheck(t, workspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package workspaces_test
+
+import (
+	"testing"
+)
+
+func TestAccWorkSpacesDirectory_TagsConsistency(t *testing.T) {
+	t.Skip("aws_workspaces_directory has no testAccDirectoryConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWorkSpacesIPGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_workspaces_ip_group has no testAccIPGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccWorkSpacesWorkspace_TagsConsistency(t *testing.T) {
+	t.Skip("aws_workspaces_workspace has no testAccWorkspaceConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
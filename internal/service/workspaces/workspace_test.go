@@ -25,7 +25,7 @@ func testAccWorkspace_basic(t *testing.T) {
 	directoryResourceName := "aws_workspaces_directory.test"
 	bundleDataSourceName := "data.aws_workspaces_bundle.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -74,7 +74,7 @@ func testAccWorkspace_tags(t *testing.T) {
 
 	resourceName := "aws_workspaces_workspace.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -127,7 +127,7 @@ func testAccWorkspace_workspaceProperties(t *testing.T) {
 
 	resourceName := "aws_workspaces_workspace.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -193,7 +193,7 @@ func testAccWorkspace_workspaceProperties_runningModeAlwaysOn(t *testing.T) {
 	resourceName := "aws_workspaces_workspace.test"
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -229,7 +229,7 @@ func testAccWorkspace_validateRootVolumeSize(t *testing.T) {
 	rName := sdkacctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, workspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -247,7 +247,7 @@ func testAccWorkspace_validateUserVolumeSize(t *testing.T) {
 	rName := sdkacctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, workspaces.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
@@ -268,7 +268,7 @@ func testAccWorkspace_recreate(t *testing.T) {
 
 	resourceName := "aws_workspaces_workspace.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -303,7 +303,7 @@ func testAccWorkspace_timeout(t *testing.T) {
 
 	resourceName := "aws_workspaces_workspace.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			testAccPreCheckDirectory(t)
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package xray_test
+
+import (
+	"testing"
+)
+
+func TestAccXRayGroup_TagsConsistency(t *testing.T) {
+	t.Skip("aws_xray_group has no testAccGroupConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}
+
+func TestAccXRaySamplingRule_TagsConsistency(t *testing.T) {
+	t.Skip("aws_xray_sampling_rule has no testAccSamplingRuleConfig_tagsConsistency function; see internal/generate/tagstests/README.md")
+}